@@ -0,0 +1,668 @@
+package main
+
+import (
+	"errors"
+	"image"
+	"math"
+	"unsafe"
+
+	"github.com/bbredesen/go-vk"
+	"github.com/bbredesen/ttf-renderer/sdf"
+	"github.com/bbredesen/ttf-renderer/ttf"
+	"github.com/bbredesen/ttf-renderer/vkctx"
+	"github.com/bbredesen/vkm"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// atlasFormat stores only coverage. The color pipeline writes white, so covered texels are 1 in the red channel.
+const atlasFormat = vk.FORMAT_R8_UNORM
+
+// atlasPadding is the empty border, in pixels, kept around each glyph so that linear filtering never picks up texels
+// from a neighbouring glyph.
+const atlasPadding = 1
+
+// ErrAtlasFull is returned by GlyphAtlas.Add when there is no room left for a glyph.
+var ErrAtlasFull = errors.New("glyph atlas is full")
+
+type atlasKey struct {
+	idx  sfnt.GlyphIndex
+	ppem float64
+}
+
+// AtlasEntry describes where a glyph was rendered in a GlyphAtlas.
+type AtlasEntry struct {
+	// UV is the glyph's rectangle in normalized texture coordinates, as (u0, v0, u1, v1)
+	UV [4]float32
+
+	// Offset is the top left of the glyph's rectangle, in pixels relative to the glyph's origin on the baseline (Y
+	// down). Size is the rectangle's size in pixels.
+	Offset, Size vkm.Pt2
+
+	// Empty is set for glyphs without an outline, such as a space. Nothing is stored in the atlas for them.
+	Empty bool
+}
+
+// atlasInstance is the per-instance vertex data for the textured quad pipeline: one glyph, drawn as a quad covering
+// position to position+size, textured with uv from the atlas.
+type atlasInstance struct {
+	position, size vkm.Pt2
+	uv             [4]float32
+}
+
+// GlyphAtlas caches rasterized glyphs in a single GPU texture. Each glyph is rendered once, at a given ppem, with the
+// same stencil pipelines used to draw text directly, into a rectangle allocated by shelf packing. Strings can then be
+// drawn from the atlas as textured quads, one instance per glyph, with a single draw call.
+//
+// An MSDF atlas stores multi-channel signed distance fields from sdf.GenerateMSDF instead, generated on the CPU and
+// copied into the atlas, and draws them with shaders/msdf.frag.
+type GlyphAtlas struct {
+	vp       *VulkanPipeline
+	fontData *sfnt.Font
+	outlines *ttf.Font
+
+	extent vk.Extent2D
+	format vk.Format
+
+	// msdfSpread is the spread, in pixels, of the fields in an MSDF atlas, and zero in a coverage atlas
+	msdfSpread float64
+
+	image   vk.Image
+	memory  vk.DeviceMemory
+	view    vk.ImageView
+	sampler vk.Sampler
+
+	stencilImage  vk.Image
+	stencilMemory vk.DeviceMemory
+	stencilView   vk.ImageView
+
+	// Used to render glyphs into a coverage atlas
+	renderPass  vk.RenderPass
+	framebuffer vk.Framebuffer
+	pipelines   []vk.Pipeline
+
+	// Used to draw textured quads from the atlas, in the color subpass of the window's render pass
+	descriptorSetLayout                        vk.DescriptorSetLayout
+	descriptorPool                             vk.DescriptorPool
+	descriptorSet                              vk.DescriptorSet
+	textPipelineLayout                         vk.PipelineLayout
+	textPipeline                               vk.Pipeline
+	textVertShaderModule, textFragShaderModule vk.ShaderModule
+
+	packer  *shelfPacker
+	entries map[atlasKey]AtlasEntry
+}
+
+// NewGlyphAtlas creates an empty size x size atlas for glyphs from fontData. If outlines is non-nil, glyph geometry is
+// read from it instead of sfnt, as in layoutString. vp must already be initialized, since the atlas shares its shader
+// modules and pipeline layout, and draws into its render pass. If msdfSpread is positive, the atlas stores MSDFs with
+// that spread rather than coverage.
+func NewGlyphAtlas(vp *VulkanPipeline, fontData *sfnt.Font, outlines *ttf.Font, size uint32, msdfSpread float64) *GlyphAtlas {
+	atlas := &GlyphAtlas{
+		vp:         vp,
+		fontData:   fontData,
+		outlines:   outlines,
+		extent:     vk.Extent2D{Width: size, Height: size},
+		format:     atlasFormat,
+		msdfSpread: msdfSpread,
+		packer:     newShelfPacker(int(size), int(size)),
+		entries:    make(map[atlasKey]AtlasEntry),
+	}
+	if msdfSpread > 0 {
+		atlas.format = msdfFormat
+	}
+
+	atlas.createImages()
+	// Fields are copied into an MSDF atlas rather than rendered
+	if msdfSpread <= 0 {
+		atlas.createRenderPass()
+		atlas.pipelines = vp.createStencilPipelines(atlas.renderPass)
+	}
+
+	atlas.createDescriptorSet()
+	atlas.createTextPipeline()
+
+	return atlas
+}
+
+func (atlas *GlyphAtlas) createImages() {
+	ctx := atlas.vp.ctx
+
+	atlas.image, atlas.memory = ctx.CreateImage(atlas.extent, atlas.format, vk.IMAGE_TILING_OPTIMAL,
+		vk.IMAGE_USAGE_COLOR_ATTACHMENT_BIT|vk.IMAGE_USAGE_SAMPLED_BIT|vk.IMAGE_USAGE_TRANSFER_DST_BIT, vk.MEMORY_PROPERTY_DEVICE_LOCAL_BIT)
+	atlas.view = ctx.CreateImageView(atlas.image, atlas.format, vk.IMAGE_ASPECT_COLOR_BIT)
+
+	if atlas.msdfSpread <= 0 {
+		atlas.stencilImage, atlas.stencilMemory, atlas.stencilView = ctx.CreateStencilImage(atlas.extent)
+	}
+
+	samplerCI := vk.SamplerCreateInfo{
+		MagFilter:    vk.FILTER_LINEAR,
+		MinFilter:    vk.FILTER_LINEAR,
+		MipmapMode:   vk.SAMPLER_MIPMAP_MODE_NEAREST,
+		AddressModeU: vk.SAMPLER_ADDRESS_MODE_CLAMP_TO_EDGE,
+		AddressModeV: vk.SAMPLER_ADDRESS_MODE_CLAMP_TO_EDGE,
+		AddressModeW: vk.SAMPLER_ADDRESS_MODE_CLAMP_TO_EDGE,
+		BorderColor:  vk.BORDER_COLOR_FLOAT_TRANSPARENT_BLACK,
+	}
+
+	var r vk.Result
+	if r, atlas.sampler = vk.CreateSampler(ctx.Device, &samplerCI, nil); r != vk.SUCCESS {
+		panic("Could not create atlas sampler: " + r.String())
+	}
+
+	clearColorImage(ctx, atlas.image)
+}
+
+// clearColorImage clears image, and leaves it ready to be sampled. The glyph atlas and the group image of colorGlyphs
+// are drawn into with LOAD_OP_LOAD, so that earlier glyphs are kept, and are cleared this way instead.
+func clearColorImage(ctx *vkctx.Context, image vk.Image) {
+	subresourceRange := vk.ImageSubresourceRange{
+		AspectMask: vk.IMAGE_ASPECT_COLOR_BIT,
+		LevelCount: 1,
+		LayerCount: 1,
+	}
+
+	cb := ctx.BeginOneTimeCommands()
+
+	vk.CmdPipelineBarrier(cb, vk.PIPELINE_STAGE_TOP_OF_PIPE_BIT, vk.PIPELINE_STAGE_TRANSFER_BIT, 0, nil, nil,
+		[]vk.ImageMemoryBarrier{{
+			DstAccessMask:       vk.ACCESS_TRANSFER_WRITE_BIT,
+			OldLayout:           vk.IMAGE_LAYOUT_UNDEFINED,
+			NewLayout:           vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL,
+			SrcQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+			DstQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+			Image:               image,
+			SubresourceRange:    subresourceRange,
+		}},
+	)
+
+	clearColor := vk.ClearColorValue{}
+	clearColor.AsTypeFloat32([4]float32{0, 0, 0, 0})
+	vk.CmdClearColorImage(cb, image, vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL, &clearColor, []vk.ImageSubresourceRange{subresourceRange})
+
+	vk.CmdPipelineBarrier(cb, vk.PIPELINE_STAGE_TRANSFER_BIT, vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT|vk.PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT, 0, nil, nil,
+		[]vk.ImageMemoryBarrier{{
+			SrcAccessMask:       vk.ACCESS_TRANSFER_WRITE_BIT,
+			DstAccessMask:       vk.ACCESS_SHADER_READ_BIT | vk.ACCESS_COLOR_ATTACHMENT_READ_BIT | vk.ACCESS_COLOR_ATTACHMENT_WRITE_BIT,
+			OldLayout:           vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL,
+			NewLayout:           vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL,
+			SrcQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+			DstQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+			Image:               image,
+			SubresourceRange:    subresourceRange,
+		}},
+	)
+
+	ctx.EndOneTimeCommands(cb)
+}
+
+func (atlas *GlyphAtlas) createRenderPass() {
+	// Between batches of glyphs, the atlas is kept in SHADER_READ_ONLY_OPTIMAL so that it can be sampled
+	colorAttachmentDescription := vk.AttachmentDescription{
+		Format:  atlas.format,
+		Samples: vk.SAMPLE_COUNT_1_BIT,
+		LoadOp:  vk.ATTACHMENT_LOAD_OP_LOAD,
+		StoreOp: vk.ATTACHMENT_STORE_OP_STORE,
+
+		StencilLoadOp:  vk.ATTACHMENT_LOAD_OP_DONT_CARE,
+		StencilStoreOp: vk.ATTACHMENT_STORE_OP_DONT_CARE,
+
+		InitialLayout: vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL,
+		FinalLayout:   vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL,
+	}
+
+	// Newly rendered glyphs must be visible to the textured quad pipeline
+	dependencyToSampling := vk.SubpassDependency{
+		SrcSubpass:    1,
+		DstSubpass:    vk.SUBPASS_EXTERNAL,
+		SrcStageMask:  vk.PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT,
+		SrcAccessMask: vk.ACCESS_COLOR_ATTACHMENT_WRITE_BIT,
+		DstStageMask:  vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT,
+		DstAccessMask: vk.ACCESS_SHADER_READ_BIT,
+	}
+
+	atlas.renderPass = atlas.vp.createStencilRenderPass(colorAttachmentDescription, dependencyToSampling)
+
+	framebufferCreateInfo := vk.FramebufferCreateInfo{
+		RenderPass:   atlas.renderPass,
+		PAttachments: []vk.ImageView{atlas.view, atlas.stencilView},
+		Width:        atlas.extent.Width,
+		Height:       atlas.extent.Height,
+		Layers:       1,
+	}
+
+	var r vk.Result
+	if r, atlas.framebuffer = vk.CreateFramebuffer(atlas.vp.ctx.Device, &framebufferCreateInfo, nil); r != vk.SUCCESS {
+		panic(r)
+	}
+}
+
+// Lookup returns the entry for glyph idx at ppem, if it has been added to the atlas.
+func (atlas *GlyphAtlas) Lookup(idx sfnt.GlyphIndex, ppem float64) (entry AtlasEntry, ok bool) {
+	entry, ok = atlas.entries[atlasKey{idx, ppem}]
+	return
+}
+
+// Clear removes every glyph from the atlas, so that glyphs can be rendered again after their outlines change, as when
+// the instance of a variable font changes. The atlas may be in use by frames in flight, so this waits for the device
+// to be idle first.
+func (atlas *GlyphAtlas) Clear() {
+	vk.DeviceWaitIdle(atlas.vp.ctx.Device)
+
+	clearColorImage(atlas.vp.ctx, atlas.image)
+	atlas.packer = newShelfPacker(int(atlas.extent.Width), int(atlas.extent.Height))
+	atlas.entries = make(map[atlasKey]AtlasEntry)
+}
+
+// Add renders every glyph in glyphs that isn't already in the atlas at ppem. All of the new glyphs are rendered in a
+// single pass, or for an MSDF atlas, copied in a single transfer. The atlas may be in use by frames in flight, so this waits for the device to be idle first.
+//
+// If the atlas runs out of space, ErrAtlasFull is returned and none of the new glyphs are added, nor is any space
+// reserved for them.
+func (atlas *GlyphAtlas) Add(glyphs []sfnt.GlyphIndex, ppem float64) error {
+	var b sfnt.Buffer
+	scale := ppem / float64(atlas.fontData.UnitsPerEm())
+
+	var batch sfnt.Segments
+	var fields []msdfField
+	added := make(map[atlasKey]AtlasEntry)
+	// Glyphs are packed into a copy, which replaces the atlas's packer only once the whole batch fits
+	packer := atlas.packer.clone()
+
+	for _, idx := range glyphs {
+		key := atlasKey{idx, ppem}
+		if _, ok := atlas.entries[key]; ok {
+			continue
+		}
+		if _, ok := added[key]; ok {
+			continue
+		}
+
+		segments, err := loadGlyphSegments(atlas.fontData, atlas.outlines, &b, idx)
+		if err != nil {
+			return err
+		}
+		if len(segments) == 0 {
+			added[key] = AtlasEntry{Empty: true}
+			continue
+		}
+
+		bounds := segmentBounds(segments)
+		if atlas.msdfSpread > 0 {
+			// The field extends spread pixels beyond the outline, and its origin is already on a whole pixel
+			opts := sdf.Fit(bounds, scale, atlas.msdfSpread)
+			rect, ok := packer.pack(opts.Width+2*atlasPadding, opts.Height+2*atlasPadding)
+			if !ok {
+				return ErrAtlasFull
+			}
+			rect = rect.Inset(atlasPadding)
+
+			fields = append(fields, msdfField{at: rect.Min, field: sdf.GenerateMSDF(segments, opts)})
+			added[key] = AtlasEntry{
+				UV:     atlas.uv(rect),
+				Offset: vkm.Pt2{float32(-opts.Origin[0]), float32(-opts.Origin[1])},
+				Size:   vkm.Pt2{float32(rect.Dx()), float32(rect.Dy())},
+			}
+			continue
+		}
+
+		// Snap the glyph's rectangle outward to whole pixels
+		x0 := int(math.Floor(float64(bounds.Min.X) / 64 * scale))
+		y0 := int(math.Floor(float64(bounds.Min.Y) / 64 * scale))
+		x1 := int(math.Ceil(float64(bounds.Max.X) / 64 * scale))
+		y1 := int(math.Ceil(float64(bounds.Max.Y) / 64 * scale))
+
+		rect, ok := packer.pack(x1-x0+2*atlasPadding, y1-y0+2*atlasPadding)
+		if !ok {
+			return ErrAtlasFull
+		}
+		rect = rect.Inset(atlasPadding)
+
+		// Move the outline into its rectangle. The segments are in font units, and are only scaled to pixels when the
+		// vertices are built, so convert the whole pixel offset back to font units.
+		offset := fixed.Point26_6{
+			X: fixed.Int26_6(math.Round(float64(rect.Min.X-x0) / scale * 64)),
+			Y: fixed.Int26_6(math.Round(float64(rect.Min.Y-y0) / scale * 64)),
+		}
+		for _, seg := range segments {
+			for i := range seg.Args {
+				seg.Args[i] = seg.Args[i].Add(offset)
+			}
+			batch = append(batch, seg)
+		}
+
+		added[key] = AtlasEntry{
+			UV:     atlas.uv(rect),
+			Offset: vkm.Pt2{float32(x0), float32(y0)},
+			Size:   vkm.Pt2{float32(rect.Dx()), float32(rect.Dy())},
+		}
+	}
+
+	atlas.packer = packer
+	if len(batch) > 0 {
+		atlas.render(batch, float32(scale))
+	}
+	if len(fields) > 0 {
+		atlas.upload(fields)
+	}
+
+	for key, entry := range added {
+		atlas.entries[key] = entry
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"added": len(added),
+		"total": len(atlas.entries),
+		"ppem":  ppem,
+	}).Debug("Glyphs added to atlas")
+
+	return nil
+}
+
+// uv returns rect, in pixels, in normalized texture coordinates as (u0, v0, u1, v1).
+func (atlas *GlyphAtlas) uv(rect image.Rectangle) [4]float32 {
+	w, h := float32(atlas.extent.Width), float32(atlas.extent.Height)
+	return [4]float32{float32(rect.Min.X) / w, float32(rect.Min.Y) / h, float32(rect.Max.X) / w, float32(rect.Max.Y) / h}
+}
+
+// render draws segments, already positioned in the atlas, into the atlas image.
+func (atlas *GlyphAtlas) render(segments sfnt.Segments, scale float32) {
+	ctx := atlas.vp.ctx
+
+	m := convertSegmentsToVerts(segments, scale)
+	quadVertStart, quadIndsStart := len(m.Verts), len(m.Inds)
+	verts := append(m.Verts, m.QuadVerts...)
+	// The buffers only live for this one draw, so indices are left at 32 bits rather than narrowed
+	inds := append(m.Inds, m.QuadInds...)
+
+	vertexBuffer, vertexMemory := createDeviceBuffer(ctx, vk.BUFFER_USAGE_VERTEX_BUFFER_BIT, verts)
+	defer vk.FreeMemory(ctx.Device, vertexMemory, nil)
+	defer vk.DestroyBuffer(ctx.Device, vertexBuffer, nil)
+
+	indexBuffer, indexMemory := createDeviceBuffer(ctx, vk.BUFFER_USAGE_INDEX_BUFFER_BIT, inds)
+	defer vk.FreeMemory(ctx.Device, indexMemory, nil)
+	defer vk.DestroyBuffer(ctx.Device, indexBuffer, nil)
+
+	// Frames in flight may still be sampling the atlas
+	vk.DeviceWaitIdle(ctx.Device)
+
+	stencilCV := vk.ClearValue{}
+	stencilCV.AsDepthStencil(vk.ClearDepthStencilValue{Stencil: 0})
+
+	renderArea := vk.Rect2D{Extent: atlas.extent}
+	rpBeginInfo := vk.RenderPassBeginInfo{
+		RenderPass:  atlas.renderPass,
+		Framebuffer: atlas.framebuffer,
+		RenderArea:  renderArea,
+		// The color attachment is loaded, not cleared, but still needs an entry
+		PClearValues: []vk.ClearValue{{}, stencilCV},
+	}
+
+	transforms := pushConstants{
+		projection: pixelProjection(atlas.extent),
+		model:      vkm.Identity(),
+	}
+
+	cb := ctx.BeginOneTimeCommands()
+
+	vk.CmdBeginRenderPass(cb, &rpBeginInfo, vk.SUBPASS_CONTENTS_INLINE)
+
+	vk.CmdSetViewport(cb, 0, []vk.Viewport{{
+		Width:    float32(atlas.extent.Width),
+		Height:   float32(atlas.extent.Height),
+		MaxDepth: 1.0,
+	}})
+	vk.CmdSetScissor(cb, 0, []vk.Rect2D{renderArea})
+
+	vk.CmdPushConstants(cb, atlas.vp.pipelineLayout, vk.SHADER_STAGE_VERTEX_BIT, 0, transforms.bytes())
+
+	vk.CmdBindVertexBuffers(cb, 0, []vk.Buffer{vertexBuffer}, []vk.DeviceSize{0})
+	vk.CmdBindIndexBuffer(cb, indexBuffer, 0, vk.INDEX_TYPE_UINT32)
+
+	recordOutlineDraws(cb, atlas.pipelines, quadIndsStart, len(inds), quadVertStart, quadIndsStart)
+
+	vk.CmdEndRenderPass(cb)
+
+	ctx.EndOneTimeCommands(cb)
+}
+
+func (atlas *GlyphAtlas) createDescriptorSet() {
+	device := atlas.vp.ctx.Device
+	var r vk.Result
+
+	layoutCI := vk.DescriptorSetLayoutCreateInfo{
+		PBindings: []vk.DescriptorSetLayoutBinding{{
+			Binding:         0,
+			DescriptorType:  vk.DESCRIPTOR_TYPE_COMBINED_IMAGE_SAMPLER,
+			DescriptorCount: 1,
+			StageFlags:      vk.SHADER_STAGE_FRAGMENT_BIT,
+		}},
+	}
+	if r, atlas.descriptorSetLayout = vk.CreateDescriptorSetLayout(device, &layoutCI, nil); r != vk.SUCCESS {
+		panic("Could not create atlas descriptor set layout: " + r.String())
+	}
+
+	poolCI := vk.DescriptorPoolCreateInfo{
+		MaxSets: 1,
+		PPoolSizes: []vk.DescriptorPoolSize{{
+			Typ:             vk.DESCRIPTOR_TYPE_COMBINED_IMAGE_SAMPLER,
+			DescriptorCount: 1,
+		}},
+	}
+	if r, atlas.descriptorPool = vk.CreateDescriptorPool(device, &poolCI, nil); r != vk.SUCCESS {
+		panic("Could not create atlas descriptor pool: " + r.String())
+	}
+
+	allocInfo := vk.DescriptorSetAllocateInfo{
+		DescriptorPool: atlas.descriptorPool,
+		PSetLayouts:    []vk.DescriptorSetLayout{atlas.descriptorSetLayout},
+	}
+	r, sets := vk.AllocateDescriptorSets(device, &allocInfo)
+	if r != vk.SUCCESS {
+		panic("Could not allocate atlas descriptor set: " + r.String())
+	}
+	atlas.descriptorSet = sets[0]
+
+	vk.UpdateDescriptorSets(device, []vk.WriteDescriptorSet{{
+		DstSet:         atlas.descriptorSet,
+		DstBinding:     0,
+		DescriptorType: vk.DESCRIPTOR_TYPE_COMBINED_IMAGE_SAMPLER,
+		PImageInfo: []vk.DescriptorImageInfo{{
+			Sampler:     atlas.sampler,
+			ImageView:   atlas.view,
+			ImageLayout: vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL,
+		}},
+	}}, nil)
+}
+
+// createTextPipeline builds the textured quad pipeline, for the color subpass of the window's render pass.
+func (atlas *GlyphAtlas) createTextPipeline() {
+	vp := atlas.vp
+
+	atlas.textVertShaderModule = vp.createShaderModule("shaders/text_vert.spv")
+	fragSpecialization := &vk.SpecializationInfo{}
+	if atlas.msdfSpread > 0 {
+		atlas.textFragShaderModule = vp.createShaderModule("shaders/msdf_frag.spv")
+
+		// pxRange is the distance range of the fields in texels, from spread pixels outside the outline to spread
+		// pixels inside
+		pxRange := float32(2 * atlas.msdfSpread)
+		fragSpecialization = &vk.SpecializationInfo{
+			PMapEntries: []vk.SpecializationMapEntry{{ConstantID: 0, Size: unsafe.Sizeof(pxRange)}},
+			DataSize:    unsafe.Sizeof(pxRange),
+			PData:       unsafe.Pointer(&pxRange),
+		}
+	} else {
+		atlas.textFragShaderModule = vp.createShaderModule("shaders/text_frag.spv")
+	}
+
+	shaderStages := []vk.PipelineShaderStageCreateInfo{
+		{
+			Stage:               vk.SHADER_STAGE_VERTEX_BIT,
+			Module:              atlas.textVertShaderModule,
+			PName:               "main",
+			PSpecializationInfo: &vk.SpecializationInfo{},
+		},
+		{
+			Stage:               vk.SHADER_STAGE_FRAGMENT_BIT,
+			Module:              atlas.textFragShaderModule,
+			PName:               "main",
+			PSpecializationInfo: fragSpecialization,
+		},
+	}
+
+	// Every attribute is per instance; the quad corners come from gl_VertexIndex
+	bindings := []vk.VertexInputBindingDescription{
+		{
+			Binding:   0,
+			Stride:    uint32(unsafe.Sizeof(atlasInstance{})),
+			InputRate: vk.VERTEX_INPUT_RATE_INSTANCE,
+		},
+	}
+	attrs := []vk.VertexInputAttributeDescription{
+		{
+			Location: 0,
+			Binding:  0,
+			Format:   vk.FORMAT_R32G32_SFLOAT,
+			Offset:   uint32(unsafe.Offsetof(atlasInstance{}.position)),
+		},
+		{
+			Location: 1,
+			Binding:  0,
+			Format:   vk.FORMAT_R32G32_SFLOAT,
+			Offset:   uint32(unsafe.Offsetof(atlasInstance{}.size)),
+		},
+		{
+			Location: 2,
+			Binding:  0,
+			Format:   vk.FORMAT_R32G32B32A32_SFLOAT,
+			Offset:   uint32(unsafe.Offsetof(atlasInstance{}.uv)),
+		},
+	}
+
+	vertexInputCreateInfo := vk.PipelineVertexInputStateCreateInfo{
+		PVertexBindingDescriptions:   bindings,
+		PVertexAttributeDescriptions: attrs,
+	}
+
+	inputAssemblyCreateInfo := vk.PipelineInputAssemblyStateCreateInfo{
+		Topology: vk.PRIMITIVE_TOPOLOGY_TRIANGLE_STRIP,
+	}
+
+	rasterizerCreateInfo := vk.PipelineRasterizationStateCreateInfo{
+		PolygonMode: vk.POLYGON_MODE_FILL,
+		LineWidth:   1.0,
+		CullMode:    vk.CULL_MODE_NONE,
+		FrontFace:   vk.FRONT_FACE_CLOCKWISE,
+	}
+
+	multisampleCreateInfo := vk.PipelineMultisampleStateCreateInfo{
+		RasterizationSamples: vk.SAMPLE_COUNT_1_BIT,
+		MinSampleShading:     1.0,
+	}
+
+	// Coverage from the atlas, or reconstructed from its fields, is blended over whatever is already in the framebuffer
+	colorBlendStateCreateInfo := vk.PipelineColorBlendStateCreateInfo{
+		PAttachments: []vk.PipelineColorBlendAttachmentState{{
+			ColorWriteMask: vk.COLOR_COMPONENT_R_BIT | vk.COLOR_COMPONENT_G_BIT | vk.COLOR_COMPONENT_B_BIT | vk.COLOR_COMPONENT_A_BIT,
+			BlendEnable:    true,
+
+			SrcColorBlendFactor: vk.BLEND_FACTOR_SRC_ALPHA,
+			DstColorBlendFactor: vk.BLEND_FACTOR_ONE_MINUS_SRC_ALPHA,
+			ColorBlendOp:        vk.BLEND_OP_ADD,
+			SrcAlphaBlendFactor: vk.BLEND_FACTOR_ONE,
+			DstAlphaBlendFactor: vk.BLEND_FACTOR_ONE_MINUS_SRC_ALPHA,
+			AlphaBlendOp:        vk.BLEND_OP_ADD,
+		}},
+	}
+
+	// The color subpass has a stencil attachment, but the atlas has already resolved coverage
+	depthStencilStateCreateInfo := vk.PipelineDepthStencilStateCreateInfo{}
+
+	dynamicStateCreateInfo := vk.PipelineDynamicStateCreateInfo{
+		PDynamicStates: []vk.DynamicState{vk.DYNAMIC_STATE_VIEWPORT, vk.DYNAMIC_STATE_SCISSOR},
+	}
+
+	pipelineLayoutCreateInfo := vk.PipelineLayoutCreateInfo{
+		PSetLayouts: []vk.DescriptorSetLayout{atlas.descriptorSetLayout},
+		PPushConstantRanges: []vk.PushConstantRange{
+			{
+				StageFlags: vk.SHADER_STAGE_VERTEX_BIT,
+				Offset:     0,
+				Size:       uint32(unsafe.Sizeof(pushConstants{})),
+			},
+		},
+	}
+
+	var r vk.Result
+	if r, atlas.textPipelineLayout = vk.CreatePipelineLayout(vp.ctx.Device, &pipelineLayoutCreateInfo, nil); r != vk.SUCCESS {
+		panic(r)
+	}
+
+	pipelineCreateInfo := vk.GraphicsPipelineCreateInfo{
+		PStages:             shaderStages,
+		PVertexInputState:   &vertexInputCreateInfo,
+		PInputAssemblyState: &inputAssemblyCreateInfo,
+		PViewportState:      vp.standardViewport(),
+		PRasterizationState: &rasterizerCreateInfo,
+		PMultisampleState:   &multisampleCreateInfo,
+		PColorBlendState:    &colorBlendStateCreateInfo,
+		PDepthStencilState:  &depthStencilStateCreateInfo,
+		PDynamicState:       &dynamicStateCreateInfo,
+
+		Layout:     atlas.textPipelineLayout,
+		RenderPass: vp.renderPass,
+		Subpass:    1,
+	}
+
+	var pipelines []vk.Pipeline
+	if r, pipelines = vk.CreateGraphicsPipelines(vp.ctx.Device, vk.PipelineCache(vk.NULL_HANDLE), []vk.GraphicsPipelineCreateInfo{pipelineCreateInfo}, nil); r != vk.SUCCESS {
+		panic(r)
+	}
+	atlas.textPipeline = pipelines[0]
+}
+
+// recordDraw records a single instanced draw of count glyph quads from instanceBuffer, which holds atlasInstances. The
+// window's render pass must be in its color subpass, with the viewport and scissor already set.
+func (atlas *GlyphAtlas) recordDraw(cb vk.CommandBuffer, instanceBuffer vk.Buffer, count int, transforms *pushConstants) {
+	if count == 0 {
+		return
+	}
+
+	vk.CmdBindPipeline(cb, vk.PIPELINE_BIND_POINT_GRAPHICS, atlas.textPipeline)
+	vk.CmdBindDescriptorSets(cb, vk.PIPELINE_BIND_POINT_GRAPHICS, atlas.textPipelineLayout, 0, []vk.DescriptorSet{atlas.descriptorSet}, nil)
+	vk.CmdPushConstants(cb, atlas.textPipelineLayout, vk.SHADER_STAGE_VERTEX_BIT, 0, transforms.bytes())
+
+	vk.CmdBindVertexBuffers(cb, 0, []vk.Buffer{instanceBuffer}, []vk.DeviceSize{0})
+	vk.CmdDraw(cb, 4, uint32(count), 0, 0)
+}
+
+func (atlas *GlyphAtlas) Teardown() {
+	device := atlas.vp.ctx.Device
+
+	vk.DestroyPipeline(device, atlas.textPipeline, nil)
+	vk.DestroyPipelineLayout(device, atlas.textPipelineLayout, nil)
+	vk.DestroyShaderModule(device, atlas.textVertShaderModule, nil)
+	vk.DestroyShaderModule(device, atlas.textFragShaderModule, nil)
+
+	vk.DestroyDescriptorPool(device, atlas.descriptorPool, nil)
+	vk.DestroyDescriptorSetLayout(device, atlas.descriptorSetLayout, nil)
+
+	for _, p := range atlas.pipelines {
+		vk.DestroyPipeline(device, p, nil)
+	}
+	vk.DestroyFramebuffer(device, atlas.framebuffer, nil)
+	vk.DestroyRenderPass(device, atlas.renderPass, nil)
+
+	vk.DestroySampler(device, atlas.sampler, nil)
+
+	vk.DestroyImageView(device, atlas.stencilView, nil)
+	vk.DestroyImage(device, atlas.stencilImage, nil)
+	vk.FreeMemory(device, atlas.stencilMemory, nil)
+
+	vk.DestroyImageView(device, atlas.view, nil)
+	vk.DestroyImage(device, atlas.image, nil)
+	vk.FreeMemory(device, atlas.memory, nil)
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"image"
+	"unsafe"
+
+	"github.com/bbredesen/go-vk"
+)
+
+// msdfFormat stores the three distance channels of an MSDF atlas, and the always opaque alpha of sdf.GenerateMSDF so
+// that each field can be copied as-is.
+const msdfFormat = vk.FORMAT_R8G8B8A8_UNORM
+
+// msdfField is a field generated on the CPU, waiting to be copied into its rectangle of the atlas.
+type msdfField struct {
+	at    image.Point
+	field *image.RGBA
+}
+
+// upload copies fields into the atlas image in a single transfer, through one staging buffer holding all of them.
+func (atlas *GlyphAtlas) upload(fields []msdfField) {
+	ctx := atlas.vp.ctx
+
+	var pixels []byte
+	regions := make([]vk.BufferImageCopy, 0, len(fields))
+	for _, f := range fields {
+		size := f.field.Rect.Size()
+		regions = append(regions, vk.BufferImageCopy{
+			BufferOffset: vk.DeviceSize(len(pixels)),
+			ImageSubresource: vk.ImageSubresourceLayers{
+				AspectMask: vk.IMAGE_ASPECT_COLOR_BIT,
+				LayerCount: 1,
+			},
+			ImageOffset: vk.Offset3D{X: int32(f.at.X), Y: int32(f.at.Y)},
+			ImageExtent: vk.Extent3D{Width: uint32(size.X), Height: uint32(size.Y), Depth: 1},
+		})
+		// Fields come straight from image.NewRGBA, so their rows are tightly packed
+		pixels = append(pixels, f.field.Pix...)
+	}
+
+	size := vk.DeviceSize(len(pixels))
+	stagingBuffer, stagingMemory := ctx.CreateBuffer(vk.BUFFER_USAGE_TRANSFER_SRC_BIT, size, vk.MEMORY_PROPERTY_HOST_VISIBLE_BIT|vk.MEMORY_PROPERTY_HOST_COHERENT_BIT)
+	defer vk.FreeMemory(ctx.Device, stagingMemory, nil)
+	defer vk.DestroyBuffer(ctx.Device, stagingBuffer, nil)
+
+	r, ptr := vk.MapMemory(ctx.Device, stagingMemory, 0, size, 0)
+	if r != vk.SUCCESS {
+		panic(r)
+	}
+	vk.MemCopySlice(unsafe.Pointer(ptr), pixels)
+	vk.UnmapMemory(ctx.Device, stagingMemory)
+
+	// Frames in flight may still be sampling the atlas
+	vk.DeviceWaitIdle(ctx.Device)
+
+	subresourceRange := vk.ImageSubresourceRange{
+		AspectMask: vk.IMAGE_ASPECT_COLOR_BIT,
+		LevelCount: 1,
+		LayerCount: 1,
+	}
+
+	cb := ctx.BeginOneTimeCommands()
+
+	// Earlier glyphs are kept, so the transition must not discard the image's contents
+	vk.CmdPipelineBarrier(cb, vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT, vk.PIPELINE_STAGE_TRANSFER_BIT, 0, nil, nil,
+		[]vk.ImageMemoryBarrier{{
+			SrcAccessMask:       vk.ACCESS_SHADER_READ_BIT,
+			DstAccessMask:       vk.ACCESS_TRANSFER_WRITE_BIT,
+			OldLayout:           vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL,
+			NewLayout:           vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL,
+			SrcQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+			DstQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+			Image:               atlas.image,
+			SubresourceRange:    subresourceRange,
+		}},
+	)
+
+	vk.CmdCopyBufferToImage(cb, stagingBuffer, atlas.image, vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL, regions)
+
+	vk.CmdPipelineBarrier(cb, vk.PIPELINE_STAGE_TRANSFER_BIT, vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT, 0, nil, nil,
+		[]vk.ImageMemoryBarrier{{
+			SrcAccessMask:       vk.ACCESS_TRANSFER_WRITE_BIT,
+			DstAccessMask:       vk.ACCESS_SHADER_READ_BIT,
+			OldLayout:           vk.IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL,
+			NewLayout:           vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL,
+			SrcQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+			DstQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+			Image:               atlas.image,
+			SubresourceRange:    subresourceRange,
+		}},
+	)
+
+	ctx.EndOneTimeCommands(cb)
+}
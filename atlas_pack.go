@@ -0,0 +1,76 @@
+package main
+
+import "image"
+
+// shelfPacker allocates rectangles in a fixed-size area using shelf packing. Rectangles are placed left to right along
+// horizontal shelves, and a new shelf is opened below the last one when nothing fits. Glyphs at a single size have
+// similar heights, so very little space is wasted above the shorter glyphs on each shelf.
+type shelfPacker struct {
+	width, height int
+	shelves       []shelf
+}
+
+type shelf struct {
+	y, height int
+	// x is the left edge of the free space remaining on this shelf
+	x int
+}
+
+// shelfWaste is the largest fraction of a shelf's height that can be left empty above a rectangle placed on it.
+// Shorter rectangles open a new shelf instead, unless there is no room for one.
+const shelfWaste = 0.3
+
+func newShelfPacker(width, height int) *shelfPacker {
+	return &shelfPacker{width: width, height: height}
+}
+
+// clone returns a copy of p that packs independently of it, so that a batch of rectangles can be packed into the copy
+// and kept only if all of them fit.
+func (p *shelfPacker) clone() *shelfPacker {
+	c := *p
+	c.shelves = append([]shelf(nil), p.shelves...)
+	return &c
+}
+
+// pack reserves a w x h rectangle, and returns its position. ok is false if there is no room left.
+func (p *shelfPacker) pack(w, h int) (r image.Rectangle, ok bool) {
+	if w > p.width || h > p.height {
+		return r, false
+	}
+
+	// Pick the shortest shelf that the rectangle fits on, ignoring shelves that would waste too much space
+	best, fallback := -1, -1
+	for i, s := range p.shelves {
+		if h > s.height || s.x+w > p.width {
+			continue
+		}
+		if fallback < 0 || s.height < p.shelves[fallback].height {
+			fallback = i
+		}
+		if float64(s.height-h) <= shelfWaste*float64(s.height) && (best < 0 || s.height < p.shelves[best].height) {
+			best = i
+		}
+	}
+
+	if best < 0 {
+		// Open a new shelf if there's room, otherwise accept the wasted space
+		top := 0
+		if n := len(p.shelves); n > 0 {
+			top = p.shelves[n-1].y + p.shelves[n-1].height
+		}
+		if top+h <= p.height {
+			p.shelves = append(p.shelves, shelf{y: top, height: h})
+			best = len(p.shelves) - 1
+		} else if fallback >= 0 {
+			best = fallback
+		} else {
+			return r, false
+		}
+	}
+
+	s := &p.shelves[best]
+	r = image.Rect(s.x, s.y, s.x+w, s.y+h)
+	s.x += w
+
+	return r, true
+}
@@ -0,0 +1,347 @@
+// Package bidi implements the Unicode Bidirectional Algorithm (UAX #9), which orders text that mixes left to right
+// scripts, such as Latin, with right to left ones, such as Hebrew and Arabic. It resolves the embedding level of each
+// rune of a paragraph, and splits each line into runs of a single direction in the order they are displayed.
+//
+// Bidi classes and bracket pairs come from golang.org/x/text/unicode/bidi; the algorithm itself, and the mirroring
+// of characters such as parentheses in right to left runs, are implemented here.
+package bidi
+
+import (
+	"golang.org/x/text/unicode/bidi"
+)
+
+// Direction is the direction of a paragraph or a run.
+type Direction int
+
+const (
+	// Auto sets a paragraph's direction from its first strong character, or left to right if it has none
+	Auto Direction = iota
+	LeftToRight
+	RightToLeft
+)
+
+func (d Direction) String() string {
+	switch d {
+	case LeftToRight:
+		return "LeftToRight"
+	case RightToLeft:
+		return "RightToLeft"
+	}
+	return "Auto"
+}
+
+// maxDepth is the deepest embedding level explicit formatting characters can reach (BD2).
+const maxDepth = 125
+
+// Paragraph is a paragraph of text with its embedding levels resolved.
+type Paragraph struct {
+	text      []rune
+	classes   []bidi.Class // Original bidi class of each rune
+	levels    []uint8
+	baseLevel uint8
+}
+
+// Run is a sequence of runes in a single direction, from Start up to End in the paragraph's text.
+type Run struct {
+	Start, End int
+	Level      uint8
+}
+
+// Direction returns the direction of the run, from the parity of its level.
+func (r Run) Direction() Direction {
+	if r.Level%2 == 1 {
+		return RightToLeft
+	}
+	return LeftToRight
+}
+
+// Resolve resolves the embedding level of every rune of text, as a single paragraph in direction dir. Paragraph
+// separators in text, such as '\n', are given the paragraph level but don't start a new paragraph.
+func Resolve(text []rune, dir Direction) *Paragraph {
+	p := &Paragraph{
+		text:    text,
+		classes: make([]bidi.Class, len(text)),
+		levels:  make([]uint8, len(text)),
+	}
+	for i, r := range text {
+		props, _ := bidi.LookupRune(r)
+		p.classes[i] = props.Class()
+	}
+
+	matchingPDI := p.matchIsolates()
+
+	switch dir {
+	case RightToLeft:
+		p.baseLevel = 1
+	case Auto:
+		if p.firstStrong(0, len(text), matchingPDI) == RightToLeft {
+			p.baseLevel = 1
+		}
+	}
+
+	types := p.explicitLevels(matchingPDI)
+	for _, seq := range p.isolatingRunSequences(types, matchingPDI) {
+		seq.resolveWeakTypes()
+		seq.resolveBrackets(p.text, p.classes)
+		seq.resolveNeutralTypes()
+		seq.resolveImplicitLevels(p.levels)
+	}
+	p.assignRemovedLevels(types)
+
+	return p
+}
+
+// Direction returns the direction of the paragraph.
+func (p *Paragraph) Direction() Direction {
+	if p.baseLevel%2 == 1 {
+		return RightToLeft
+	}
+	return LeftToRight
+}
+
+// Levels returns the resolved embedding level of each rune, before any line is reordered.
+func (p *Paragraph) Levels() []uint8 {
+	return p.levels
+}
+
+// Runs returns the runs of the whole paragraph as a single line. See Line.
+func (p *Paragraph) Runs() []Run {
+	return p.Line(0, len(p.text))
+}
+
+// Line returns the runs of the line from start up to end, in the order they are displayed from left to right.
+// Whitespace at the end of the line, and before tabs and paragraph separators, takes the paragraph's direction (L1).
+func (p *Paragraph) Line(start, end int) []Run {
+	if start >= end {
+		return nil
+	}
+
+	levels := append([]uint8(nil), p.levels[start:end]...)
+
+	// L1: trailing whitespace, and isolate formatting characters and characters removed by X9 with it, is reset to
+	// the paragraph level, as are segment and paragraph separators and the whitespace before them
+	trailing := true
+	for i := end - 1; i >= start; i-- {
+		switch c := p.classes[i]; {
+		case c == bidi.S || c == bidi.B:
+			levels[i-start] = p.baseLevel
+			trailing = true
+		case isWhitespaceForL1(c):
+			if trailing {
+				levels[i-start] = p.baseLevel
+			}
+		default:
+			trailing = false
+		}
+	}
+
+	// Level runs, in logical order
+	var runs []Run
+	for i, level := range levels {
+		if len(runs) > 0 && runs[len(runs)-1].Level == level {
+			runs[len(runs)-1].End = start + i + 1
+			continue
+		}
+		runs = append(runs, Run{Start: start + i, End: start + i + 1, Level: level})
+	}
+
+	// L2: from the highest level down to the lowest odd level, reverse every sequence of runs at that level or higher
+	highest, lowestOdd := uint8(0), uint8(maxDepth+2)
+	for _, r := range runs {
+		if r.Level > highest {
+			highest = r.Level
+		}
+		if r.Level%2 == 1 && r.Level < lowestOdd {
+			lowestOdd = r.Level
+		}
+	}
+	for level := highest; level >= lowestOdd && level > 0; level-- {
+		for i := 0; i < len(runs); {
+			if runs[i].Level < level {
+				i++
+				continue
+			}
+			j := i
+			for j < len(runs) && runs[j].Level >= level {
+				j++
+			}
+			for a, b := i, j-1; a < b; a, b = a+1, b-1 {
+				runs[a], runs[b] = runs[b], runs[a]
+			}
+			i = j
+		}
+	}
+
+	return runs
+}
+
+func isWhitespaceForL1(c bidi.Class) bool {
+	switch c {
+	case bidi.WS, bidi.FSI, bidi.LRI, bidi.RLI, bidi.PDI, bidi.BN,
+		bidi.LRE, bidi.RLE, bidi.LRO, bidi.RLO, bidi.PDF:
+		return true
+	}
+	return false
+}
+
+// matchIsolates returns, for each isolate initiator, the index of its matching PDI, or len(text) if it has none (BD9).
+// Every other entry is -1.
+func (p *Paragraph) matchIsolates() []int {
+	matching := make([]int, len(p.text))
+	var open []int
+	for i, c := range p.classes {
+		matching[i] = -1
+		switch c {
+		case bidi.LRI, bidi.RLI, bidi.FSI:
+			open = append(open, i)
+			matching[i] = len(p.text)
+		case bidi.PDI:
+			if len(open) > 0 {
+				matching[open[len(open)-1]] = i
+				open = open[:len(open)-1]
+			}
+		case bidi.B:
+			open = open[:0]
+		}
+	}
+	return matching
+}
+
+// firstStrong returns the direction of the first strong character from start up to end, skipping isolated text, or
+// Auto if there is none (P2, P3).
+func (p *Paragraph) firstStrong(start, end int, matchingPDI []int) Direction {
+	for i := start; i < end; i++ {
+		switch p.classes[i] {
+		case bidi.L:
+			return LeftToRight
+		case bidi.R, bidi.AL:
+			return RightToLeft
+		case bidi.LRI, bidi.RLI, bidi.FSI:
+			i = matchingPDI[i]
+		case bidi.B:
+			return Auto
+		}
+	}
+	return Auto
+}
+
+// isRemoved reports whether a character of class c is removed from consideration by X9.
+func isRemoved(c bidi.Class) bool {
+	switch c {
+	case bidi.LRE, bidi.RLE, bidi.LRO, bidi.RLO, bidi.PDF, bidi.BN:
+		return true
+	}
+	return false
+}
+
+// explicitLevels applies rules X1 to X8, setting the level of every rune from the explicit formatting characters, and
+// returns the class of each rune after directional overrides.
+func (p *Paragraph) explicitLevels(matchingPDI []int) []bidi.Class {
+	types := append([]bidi.Class(nil), p.classes...)
+
+	type status struct {
+		level    uint8
+		override bidi.Class // L or R for an override, ON otherwise
+		isolate  bool
+	}
+	stack := []status{{p.baseLevel, bidi.ON, false}}
+	overflowIsolates, overflowEmbeddings, validIsolates := 0, 0, 0
+
+	for i, c := range p.classes {
+		top := stack[len(stack)-1]
+
+		switch c {
+		case bidi.RLE, bidi.LRE, bidi.RLO, bidi.LRO, bidi.RLI, bidi.LRI, bidi.FSI:
+			isolate := c == bidi.RLI || c == bidi.LRI || c == bidi.FSI
+			rtl := c == bidi.RLE || c == bidi.RLO || c == bidi.RLI
+			if c == bidi.FSI {
+				rtl = p.firstStrong(i+1, matchingPDI[i], matchingPDI) == RightToLeft
+			}
+
+			p.levels[i] = top.level
+			if isolate && top.override != bidi.ON {
+				types[i] = top.override
+			}
+
+			level := (top.level + 2) &^ 1
+			if rtl {
+				level = (top.level + 1) | 1
+			}
+			if level <= maxDepth && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				override := bidi.ON
+				switch c {
+				case bidi.LRO:
+					override = bidi.L
+				case bidi.RLO:
+					override = bidi.R
+				}
+				if isolate {
+					validIsolates++
+				}
+				stack = append(stack, status{level, override, isolate})
+			} else if isolate {
+				overflowIsolates++
+			} else if overflowIsolates == 0 {
+				overflowEmbeddings++
+			}
+
+		case bidi.PDI:
+			if overflowIsolates > 0 {
+				overflowIsolates--
+			} else if validIsolates > 0 {
+				overflowEmbeddings = 0
+				for !stack[len(stack)-1].isolate {
+					stack = stack[:len(stack)-1]
+				}
+				stack = stack[:len(stack)-1]
+				validIsolates--
+			}
+			top = stack[len(stack)-1]
+			p.levels[i] = top.level
+			if top.override != bidi.ON {
+				types[i] = top.override
+			}
+
+		case bidi.PDF:
+			if overflowIsolates > 0 {
+				// Ignored
+			} else if overflowEmbeddings > 0 {
+				overflowEmbeddings--
+			} else if !top.isolate && len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+			p.levels[i] = top.level
+
+		case bidi.B:
+			p.levels[i] = p.baseLevel
+
+		default:
+			p.levels[i] = top.level
+			if top.override != bidi.ON && c != bidi.BN {
+				types[i] = top.override
+			}
+		}
+	}
+
+	return types
+}
+
+// assignRemovedLevels gives each character removed by X9 the level of the character before it, or the paragraph
+// level at the start, so that it stays with its neighbors when lines are reordered.
+func (p *Paragraph) assignRemovedLevels(types []bidi.Class) {
+	level := p.baseLevel
+	for i, c := range types {
+		if isRemoved(c) {
+			p.levels[i] = level
+		} else {
+			level = p.levels[i]
+		}
+	}
+}
+
+// Mirror returns the character whose glyph is the mirror image of r's, such as ')' for '(', if r has one. Characters
+// at an odd embedding level should be displayed with their mirrored glyph (L4).
+func Mirror(r rune) (rune, bool) {
+	m, ok := mirrors[r]
+	return m, ok
+}
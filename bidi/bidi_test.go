@@ -0,0 +1,92 @@
+package bidi
+
+import (
+	"testing"
+
+	"golang.org/x/text/unicode/bidi"
+)
+
+// visual returns the runs of text from start up to end as they are displayed, with right to left runs reversed and
+// mirrored, and formatting characters left out.
+func visual(p *Paragraph, start, end int) string {
+	var out []rune
+	for _, run := range p.Line(start, end) {
+		text := p.text[run.Start:run.End]
+		for k := range text {
+			r := text[k]
+			if run.Direction() == RightToLeft {
+				r = text[len(text)-1-k]
+				if m, ok := Mirror(r); ok {
+					r = m
+				}
+			}
+			if props, _ := bidi.LookupRune(r); isRemoved(props.Class()) || isIsolateControl(props.Class()) {
+				continue
+			}
+			out = append(out, r)
+		}
+	}
+	return string(out)
+}
+
+func TestReorder(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		dir     Direction
+		want    string
+		wantDir Direction
+	}{
+		{"ltr", "abc def", Auto, "abc def", LeftToRight},
+		{"embedded rtl", "abc אבג def", Auto, "abc גבא def", LeftToRight},
+		{"rtl paragraph", "אבג abc", Auto, "abc גבא", RightToLeft},
+		{"numbers in rtl", "אבג 123 דה", Auto, "הד 123 גבא", RightToLeft},
+		{"arabic numbers", "سلام ١٢٣", Auto, "١٢٣ مالس", RightToLeft},
+		{"european number after arabic letter", "س 12,5", Auto, "12,5 س", RightToLeft},
+		{"mirrored brackets", "אב(ג)", Auto, "(ג)בא", RightToLeft},
+		{"brackets take embedding direction", "abc (אבג) def", Auto, "abc (גבא) def", LeftToRight},
+		{"brackets take context direction", "אב (גד) ef", LeftToRight, "(דג) בא ef", LeftToRight},
+		{"forced direction", "abc", RightToLeft, "abc", RightToLeft},
+		{"override", "a‮bcd‬e", Auto, "adcbe", LeftToRight},
+		{"embedding", "‫abc אב‬", LeftToRight, "בא abc", LeftToRight},
+		{"isolate", "אב ⁦abc def⁩ גד", Auto, "דג abc def בא", RightToLeft},
+		{"first strong isolate", "abc ⁨אב cd⁩ ef", Auto, "abc cd בא ef", LeftToRight},
+		{"isolate skipped for paragraph level", "⁧abc⁩ אב", Auto, "בא abc", RightToLeft},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := Resolve([]rune(test.text), test.dir)
+			if got := visual(p, 0, len(p.text)); got != test.want {
+				t.Errorf("got %q, want %q (levels %v)", got, test.want, p.Levels())
+			}
+			if got := p.Direction(); got != test.wantDir {
+				t.Errorf("got direction %v, want %v", got, test.wantDir)
+			}
+		})
+	}
+}
+
+func TestLineTrailingWhitespace(t *testing.T) {
+	// The space is between two right to left words, so it is right to left too, except at the end of a line
+	p := Resolve([]rune("אב גד"), LeftToRight)
+	if got, want := visual(p, 0, 5), "דג בא"; got != want {
+		t.Errorf("whole paragraph: got %q, want %q", got, want)
+	}
+	if got, want := visual(p, 0, 3), "בא "; got != want {
+		t.Errorf("first line: got %q, want %q", got, want)
+	}
+	if got, want := visual(p, 3, 5), "דג"; got != want {
+		t.Errorf("second line: got %q, want %q", got, want)
+	}
+}
+
+func TestBracketsMirror(t *testing.T) {
+	for r := rune(0); r < 0x10000; r++ {
+		if props, _ := bidi.LookupRune(r); props.IsBracket() {
+			if _, ok := Mirror(r); !ok {
+				t.Errorf("bracket %U has no mirror", r)
+			}
+		}
+	}
+}
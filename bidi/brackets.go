@@ -0,0 +1,118 @@
+package bidi
+
+import (
+	"sort"
+
+	"golang.org/x/text/unicode/bidi"
+)
+
+// maxBracketDepth is the number of open brackets BD16 tracks before it gives up on pairing the rest of a sequence.
+const maxBracketDepth = 63
+
+type bracketPair struct {
+	open, close int // Positions in the run sequence
+}
+
+// canonicalBracket maps the angle brackets U+2329 and U+232A to their canonical equivalents, so that either form of
+// each pairs with the other.
+func canonicalBracket(r rune) rune {
+	switch r {
+	case 0x2329:
+		return 0x3008
+	case 0x232A:
+		return 0x3009
+	}
+	return r
+}
+
+// bracketPairs identifies the bracket pairs of the sequence (BD16), in order of their opening brackets. Only brackets
+// whose type is still ON, after the weak rules, take part.
+func (s *runSequence) bracketPairs(text []rune) []bracketPair {
+	type opening struct {
+		closing rune // The closing bracket that would match it
+		pos     int
+	}
+	var stack []opening
+	var pairs []bracketPair
+
+	for k, i := range s.indices {
+		if s.types[k] != bidi.ON {
+			continue
+		}
+		props, _ := bidi.LookupRune(text[i])
+		if !props.IsBracket() {
+			continue
+		}
+
+		r := canonicalBracket(text[i])
+		if props.IsOpeningBracket() {
+			closing, ok := Mirror(r)
+			if !ok {
+				continue
+			}
+			if len(stack) == maxBracketDepth {
+				break
+			}
+			stack = append(stack, opening{canonicalBracket(closing), k})
+			continue
+		}
+
+		for j := len(stack) - 1; j >= 0; j-- {
+			if stack[j].closing == r {
+				pairs = append(pairs, bracketPair{stack[j].pos, k})
+				stack = stack[:j]
+				break
+			}
+		}
+	}
+
+	sort.Slice(pairs, func(a, b int) bool { return pairs[a].open < pairs[b].open })
+	return pairs
+}
+
+// resolveBrackets applies rule N0: a bracket pair takes the embedding direction if the text inside it has a strong
+// type of that direction, or else the opposite direction if both the text inside and the context before it do.
+// Nonspacing marks after a bracket follow the bracket.
+func (s *runSequence) resolveBrackets(text []rune, classes []bidi.Class) {
+	embedding := directionOfLevel(s.level)
+
+	for _, pair := range s.bracketPairs(text) {
+		foundEmbedding, foundOpposite := false, false
+		for k := pair.open + 1; k < pair.close; k++ {
+			switch strongType(s.types[k]) {
+			case embedding:
+				foundEmbedding = true
+			case bidi.ON:
+			default:
+				foundOpposite = true
+			}
+		}
+
+		var resolved bidi.Class
+		switch {
+		case foundEmbedding:
+			resolved = embedding
+		case foundOpposite:
+			context := s.sos
+			for k := pair.open - 1; k >= 0; k-- {
+				if t := strongType(s.types[k]); t != bidi.ON {
+					context = t
+					break
+				}
+			}
+			resolved = embedding
+			if context != embedding {
+				resolved = context
+			}
+		default:
+			continue
+		}
+
+		for _, k := range []int{pair.open, pair.close} {
+			s.types[k] = resolved
+			for k++; k < len(s.indices) && classes[s.indices[k]] == bidi.NSM; k++ {
+				s.types[k] = resolved
+			}
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package bidi
+
+import (
+	"golang.org/x/text/unicode/bidi"
+)
+
+// runSequence is an isolating run sequence (BD13): the runes, in logical order and without those removed by X9, that
+// the weak, neutral and implicit rules are applied to as a unit.
+type runSequence struct {
+	indices  []int        // Index of each rune in the paragraph
+	types    []bidi.Class // Class of each rune, updated as rules are applied
+	level    uint8
+	sos, eos bidi.Class // L or R, for the start and end of the sequence
+}
+
+// isolatingRunSequences splits the paragraph into level runs, and chains runs that are separated by isolated text
+// into isolating run sequences (X10).
+func (p *Paragraph) isolatingRunSequences(types []bidi.Class, matchingPDI []int) []*runSequence {
+	// Level runs, of the characters not removed by X9
+	var runs [][]int
+	var run []int
+	for i, c := range types {
+		if isRemoved(c) {
+			continue
+		}
+		if len(run) > 0 && p.levels[run[0]] != p.levels[i] {
+			runs = append(runs, run)
+			run = nil
+		}
+		run = append(run, i)
+	}
+	if len(run) > 0 {
+		runs = append(runs, run)
+	}
+
+	// Index of the level run starting at each rune, and which PDIs close an isolate
+	runStartingAt := make(map[int]int)
+	for k, run := range runs {
+		runStartingAt[run[0]] = k
+	}
+	matchedPDI := make(map[int]bool)
+	for _, j := range matchingPDI {
+		if j >= 0 && j < len(p.text) {
+			matchedPDI[j] = true
+		}
+	}
+
+	var sequences []*runSequence
+	for _, run := range runs {
+		if first := run[0]; matchedPDI[first] {
+			// Continues the sequence of its isolate initiator
+			continue
+		}
+
+		var indices []int
+		for {
+			indices = append(indices, run...)
+			last := run[len(run)-1]
+			c := p.classes[last]
+			if c != bidi.LRI && c != bidi.RLI && c != bidi.FSI {
+				break
+			}
+			next, ok := runStartingAt[matchingPDI[last]]
+			if !ok {
+				break
+			}
+			run = runs[next]
+		}
+
+		seq := &runSequence{indices: indices, level: p.levels[indices[0]]}
+		seq.types = make([]bidi.Class, len(indices))
+		for j, i := range indices {
+			seq.types[j] = types[i]
+		}
+
+		// sos and eos take the direction of the higher of the sequence's level and that of its neighbor
+		prevLevel, nextLevel := p.baseLevel, p.baseLevel
+		for i := indices[0] - 1; i >= 0; i-- {
+			if !isRemoved(types[i]) {
+				prevLevel = p.levels[i]
+				break
+			}
+		}
+		last := indices[len(indices)-1]
+		if c := p.classes[last]; c != bidi.LRI && c != bidi.RLI && c != bidi.FSI {
+			for i := last + 1; i < len(types); i++ {
+				if !isRemoved(types[i]) {
+					nextLevel = p.levels[i]
+					break
+				}
+			}
+		}
+		seq.sos = directionOfLevel(maxLevel(seq.level, prevLevel))
+		seq.eos = directionOfLevel(maxLevel(seq.level, nextLevel))
+
+		sequences = append(sequences, seq)
+	}
+
+	return sequences
+}
+
+func maxLevel(a, b uint8) uint8 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func directionOfLevel(level uint8) bidi.Class {
+	if level%2 == 1 {
+		return bidi.R
+	}
+	return bidi.L
+}
+
+func isIsolateControl(c bidi.Class) bool {
+	return c == bidi.LRI || c == bidi.RLI || c == bidi.FSI || c == bidi.PDI
+}
+
+// resolveWeakTypes applies rules W1 to W7.
+func (s *runSequence) resolveWeakTypes() {
+	t := s.types
+
+	// W1: a nonspacing mark takes the type of the character before it, or ON after an isolate control
+	for i, c := range t {
+		if c != bidi.NSM {
+			continue
+		}
+		switch {
+		case i == 0:
+			t[i] = s.sos
+		case isIsolateControl(t[i-1]):
+			t[i] = bidi.ON
+		default:
+			t[i] = t[i-1]
+		}
+	}
+
+	// W2: a European number after an Arabic letter is an Arabic number. W3: Arabic letters are then R.
+	lastStrong := s.sos
+	for i, c := range t {
+		switch c {
+		case bidi.L, bidi.R, bidi.AL:
+			lastStrong = c
+		case bidi.EN:
+			if lastStrong == bidi.AL {
+				t[i] = bidi.AN
+			}
+		}
+	}
+	for i, c := range t {
+		if c == bidi.AL {
+			t[i] = bidi.R
+		}
+	}
+
+	// W4: a single separator between two numbers of the same kind joins them
+	for i := 1; i+1 < len(t); i++ {
+		switch {
+		case t[i] == bidi.ES && t[i-1] == bidi.EN && t[i+1] == bidi.EN:
+			t[i] = bidi.EN
+		case t[i] == bidi.CS && t[i-1] == bidi.EN && t[i+1] == bidi.EN:
+			t[i] = bidi.EN
+		case t[i] == bidi.CS && t[i-1] == bidi.AN && t[i+1] == bidi.AN:
+			t[i] = bidi.AN
+		}
+	}
+
+	// W5: terminators next to a European number, such as currency symbols, become European numbers
+	for i := 0; i < len(t); {
+		if t[i] != bidi.ET {
+			i++
+			continue
+		}
+		j := i
+		for j < len(t) && t[j] == bidi.ET {
+			j++
+		}
+		if (i > 0 && t[i-1] == bidi.EN) || (j < len(t) && t[j] == bidi.EN) {
+			for k := i; k < j; k++ {
+				t[k] = bidi.EN
+			}
+		}
+		i = j
+	}
+
+	// W6: any separators and terminators left are neutral
+	for i, c := range t {
+		if c == bidi.ES || c == bidi.ET || c == bidi.CS {
+			t[i] = bidi.ON
+		}
+	}
+
+	// W7: a European number in left to right context is L
+	lastStrong = s.sos
+	for i, c := range t {
+		switch c {
+		case bidi.L, bidi.R:
+			lastStrong = c
+		case bidi.EN:
+			if lastStrong == bidi.L {
+				t[i] = bidi.L
+			}
+		}
+	}
+}
+
+// strongType returns the direction class c counts as for the neutral rules: L, R (including numbers), or ON.
+func strongType(c bidi.Class) bidi.Class {
+	switch c {
+	case bidi.L:
+		return bidi.L
+	case bidi.R, bidi.EN, bidi.AN:
+		return bidi.R
+	}
+	return bidi.ON
+}
+
+func isNeutralOrIsolate(c bidi.Class) bool {
+	switch c {
+	case bidi.B, bidi.S, bidi.WS, bidi.ON, bidi.LRI, bidi.RLI, bidi.FSI, bidi.PDI:
+		return true
+	}
+	return false
+}
+
+// resolveNeutralTypes applies rules N1 and N2: a sequence of neutrals between two strong types of the same direction
+// takes that direction, and otherwise takes the embedding direction.
+func (s *runSequence) resolveNeutralTypes() {
+	t := s.types
+	embedding := directionOfLevel(s.level)
+
+	for i := 0; i < len(t); {
+		if !isNeutralOrIsolate(t[i]) {
+			i++
+			continue
+		}
+		j := i
+		for j < len(t) && isNeutralOrIsolate(t[j]) {
+			j++
+		}
+
+		before, after := s.sos, s.eos
+		if i > 0 {
+			before = strongType(t[i-1])
+		}
+		if j < len(t) {
+			after = strongType(t[j])
+		}
+		resolved := embedding
+		if before == after {
+			resolved = before
+		}
+		for k := i; k < j; k++ {
+			t[k] = resolved
+		}
+		i = j
+	}
+}
+
+// resolveImplicitLevels applies rules I1 and I2, raising levels according to the resolved types.
+func (s *runSequence) resolveImplicitLevels(levels []uint8) {
+	for k, i := range s.indices {
+		level := levels[i]
+		switch c := s.types[k]; {
+		case level%2 == 0 && c == bidi.R:
+			levels[i] = level + 1
+		case level%2 == 0 && (c == bidi.AN || c == bidi.EN):
+			levels[i] = level + 2
+		case level%2 == 1 && (c == bidi.L || c == bidi.EN || c == bidi.AN):
+			levels[i] = level + 1
+		}
+	}
+}
@@ -0,0 +1,779 @@
+package main
+
+import (
+	"math"
+	"unsafe"
+
+	"github.com/bbredesen/go-vk"
+	"github.com/bbredesen/ttf-renderer/colr"
+	"github.com/bbredesen/ttf-renderer/layout"
+	"github.com/bbredesen/ttf-renderer/tess"
+	"github.com/bbredesen/ttf-renderer/ttf"
+	"github.com/bbredesen/vkm"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// textColor is the foreground color the text is drawn in, which COLR layers may also use instead of a palette entry.
+var textColor = colr.Color{R: 1, G: 1, B: 1, A: 1}
+
+// newColorFont reads the COLR and CPAL tables of face i of file. It returns nil if the face has no color glyphs.
+func newColorFont(file *fontFile, i int) (*colr.Font, error) {
+	tables, err := file.collection.Tables(i)
+	if err != nil {
+		return nil, err
+	}
+	if tables["COLR"] == nil {
+		return nil, nil
+	}
+	return colr.Parse(tables["COLR"], tables["CPAL"])
+}
+
+// isColorGlyph reports whether glyph x is drawn by colorGlyphs, in place of its plain outline. colors may be nil.
+func isColorGlyph(colors *colr.Font, x sfnt.GlyphIndex) bool {
+	return colors != nil && colors.HasGlyph(x)
+}
+
+// paintRecord mirrors the Paint struct of paint.frag, in std430 layout. Colors are premultiplied.
+type paintRecord struct {
+	kind, extend         uint32
+	firstStop, stopCount uint32
+	color                [4]float32
+	geometry0, geometry1 [4]float32
+}
+
+// colorStop mirrors the ColorStop struct of paint.frag, which std430 pads to the alignment of its vec4.
+type colorStop struct {
+	color  [4]float32
+	offset float32
+	_      [3]float32
+}
+
+// paintVertex is a corner of a quad filled by paint.frag: its position in pixels, its position in the paint's own
+// space, and the index of its paint record.
+type paintVertex struct {
+	position   vkm.Pt2
+	paintCoord vkm.Pt2
+	paint      uint32
+}
+
+// colorLayer is one layer of a color glyph, in pixels relative to the glyph's origin: either an outline, tessellated,
+// with the quad that covers it, or a group.
+type colorLayer struct {
+	mesh  tess.Mesh
+	cover [4]paintVertex
+
+	group *colorGroup
+}
+
+// bounds returns the rectangle the layer draws in, in pixels relative to the glyph's origin.
+func (l *colorLayer) bounds() (min, max vkm.Pt2) {
+	if l.group != nil {
+		return l.group.min, l.group.max
+	}
+	return l.mesh.Bounds()
+}
+
+// layerDraw is where a placed layer's geometry is in the buffers: its fans and curves in the vertex and index buffers,
+// and its cover quad in the cover buffer. A group is drawn instead as the quad at instance in the group quad buffer.
+type layerDraw struct {
+	firstIndex, fanIndexCount, curveIndexCount int
+	vertexOffset, curveVertexOffset            int
+	cover                                      int
+
+	group    bool
+	instance int
+}
+
+// layerPipelines draw layers in the color subpass of one render pass: their outlines into the stencil, the paints that
+// cover them, and groups, as textured quads.
+type layerPipelines struct {
+	fan, curve, paint, group vk.Pipeline
+}
+
+// colorGlyphs draws the color glyphs of a font with a COLR table, layer by layer, in the color subpass of the window's
+// render pass after the rest of the text. Each layer's outline is drawn into the stencil like any other glyph, and then
+// covered by a quad that fills it with the layer's paint, blended source over, and clears the stencil behind it for the
+// next layer. Since every layer is drawn over the ones before it, drawing them straight into the framebuffer is the
+// same as compositing the glyph on its own first.
+//
+// The layers of a group, whose composite mode isn't simply source over, are drawn the same way but offscreen, into a
+// layer image: its source beside its backdrop. Both are then combined into the group image by the composite pipeline,
+// which applies the mode. Groups are rendered once, as their glyph is first loaded, and are then drawn from the group
+// image as textured quads, blended source over like any other layer, in the window or in an enclosing group.
+type colorGlyphs struct {
+	vp       *VulkanPipeline
+	fontData *sfnt.Font
+	outlines *ttf.Font
+	font     *colr.Font
+	palette  int
+	scale    float32 // pixels per font unit
+	b        sfnt.Buffer
+
+	// glyphs caches the layers of each color glyph seen so far, and paints and stops hold the paint of every cached
+	// layer.
+	glyphs map[sfnt.GlyphIndex][]colorLayer
+	paints []paintRecord
+	stops  []colorStop
+
+	vertexBuffer, indexBuffer, coverBuffer, groupBuffer deviceBuffer
+	paintBuffer, stopBuffer                             deviceBuffer
+	draws                                               []layerDraw
+
+	// pending holds the groups created since the last load, which are yet to be rendered. groupPacker allocates space
+	// in the group image, and is nil when the image is due to be cleared.
+	pending     []*colorGroup
+	groupPacker *shelfPacker
+
+	// Used to render groups
+	layerImage, groupImage                               offscreenImage
+	stencilImage                                         vk.Image
+	stencilMemory                                        vk.DeviceMemory
+	stencilView                                          vk.ImageView
+	sampler                                              vk.Sampler
+	layerPass, groupPass                                 vk.RenderPass
+	layerFramebuffer, groupFramebuffer                   vk.Framebuffer
+	compositePipelineLayout                              vk.PipelineLayout
+	compositePipeline                                    vk.Pipeline
+	compositeVertShaderModule, compositeFragShaderModule vk.ShaderModule
+
+	// Set 0 of the layer pipelines holds the paint and stop buffers, and set 1 the group image; the composite pipeline
+	// samples the layer image instead
+	descriptorSetLayout, imageSetLayout          vk.DescriptorSetLayout
+	descriptorPool                               vk.DescriptorPool
+	descriptorSet, groupImageSet, layerImageSet  vk.DescriptorSet
+	pipelineLayout                               vk.PipelineLayout
+	paintVertShaderModule, paintFragShaderModule vk.ShaderModule
+	groupVertShaderModule, groupFragShaderModule vk.ShaderModule
+	windowPipelines, offscreenPipelines          layerPipelines
+}
+
+// newColorGlyphs prepares to draw the color glyphs of font, at ppem pixels per em in the given palette. Outlines are
+// read as by loadGlyphSegments. vp must already be initialized, since the layers share its shader modules and draw into
+// its render pass.
+func newColorGlyphs(vp *VulkanPipeline, fontData *sfnt.Font, outlines *ttf.Font, font *colr.Font, palette int, ppem float64) *colorGlyphs {
+	c := &colorGlyphs{
+		vp:       vp,
+		fontData: fontData,
+		outlines: outlines,
+		font:     font,
+		palette:  palette,
+		scale:    float32(ppem) / float32(fontData.UnitsPerEm()),
+	}
+	c.clear()
+
+	c.createGroupImages()
+	c.createDescriptorSet()
+	c.createPipelineLayout()
+	c.windowPipelines = c.createPipelines(vp.renderPass)
+	c.offscreenPipelines = c.createPipelines(c.layerPass)
+	c.createCompositePipeline()
+
+	return c
+}
+
+// clear drops every cached layer, so that glyphs are loaded and tessellated again from their current outlines, as when
+// the instance of a variable font changes.
+func (c *colorGlyphs) clear() {
+	c.glyphs = make(map[sfnt.GlyphIndex][]colorLayer)
+	// A storage buffer can't be empty, even before any layer is loaded or if no paint has a color line
+	c.paints = []paintRecord{{}}
+	c.stops = []colorStop{{}}
+	// Groups are rendered again too, into a cleared group image
+	c.pending = nil
+	c.groupPacker = nil
+}
+
+// load places every color glyph in glyphs, skipping the rest, and uploads their layers. Glyphs are positioned in font
+// units, as by layoutText. Groups are rendered as their glyphs are first loaded.
+func (c *colorGlyphs) load(glyphs []layout.Glyph) error {
+	for _, g := range glyphs {
+		if !c.font.HasGlyph(g.ID) {
+			continue
+		}
+		if _, err := c.glyphLayers(g.ID); err != nil {
+			return err
+		}
+	}
+
+	// Frames in flight may still be reading the buffers, or sampling the group image
+	vk.DeviceWaitIdle(c.vp.ctx.Device)
+
+	ctx := c.vp.ctx
+	c.paintBuffer.usage = vk.BUFFER_USAGE_STORAGE_BUFFER_BIT
+	uploadToBuffer(ctx, &c.paintBuffer, c.paints)
+	c.stopBuffer.usage = vk.BUFFER_USAGE_STORAGE_BUFFER_BIT
+	uploadToBuffer(ctx, &c.stopBuffer, c.stops)
+	// The buffers may have been reallocated
+	c.updateDescriptorSet()
+
+	// New groups are drawn with the paints just uploaded
+	if err := c.renderGroups(); err != nil {
+		return err
+	}
+
+	var batch layerBatch
+	for _, g := range glyphs {
+		if !c.font.HasGlyph(g.ID) {
+			continue
+		}
+		origin := g.Origin()
+		batch.add(c.glyphs[g.ID], vkm.Vec2{float32(origin.X) / 64 * c.scale, float32(origin.Y) / 64 * c.scale})
+	}
+
+	c.vertexBuffer.usage = vk.BUFFER_USAGE_VERTEX_BUFFER_BIT
+	uploadToBuffer(ctx, &c.vertexBuffer, batch.verts)
+	c.indexBuffer.usage = vk.BUFFER_USAGE_INDEX_BUFFER_BIT
+	uploadToBuffer(ctx, &c.indexBuffer, batch.inds)
+	c.coverBuffer.usage = vk.BUFFER_USAGE_VERTEX_BUFFER_BIT
+	uploadToBuffer(ctx, &c.coverBuffer, batch.covers)
+	c.groupBuffer.usage = vk.BUFFER_USAGE_VERTEX_BUFFER_BIT
+	uploadToBuffer(ctx, &c.groupBuffer, batch.instances)
+	c.draws = batch.draws
+
+	return nil
+}
+
+// glyphLayers returns the layers of color glyph x, tessellating them on first use. Groups among them are queued to be
+// rendered by renderGroups.
+func (c *colorGlyphs) glyphLayers(x sfnt.GlyphIndex) ([]colorLayer, error) {
+	if layers, ok := c.glyphs[x]; ok {
+		return layers, nil
+	}
+
+	fontLayers, err := c.font.Layers(x, c.palette, textColor)
+	if err != nil {
+		return nil, err
+	}
+	layers, err := c.buildLayers(x, fontLayers)
+	if err != nil {
+		return nil, err
+	}
+
+	c.glyphs[x] = layers
+	return layers, nil
+}
+
+// buildLayers tessellates fontLayers, which belong to color glyph x, and builds groups from their composites. Layers
+// with nothing to draw, such as those of a space or with a paint transform that collapses to a line, are left out.
+func (c *colorGlyphs) buildLayers(x sfnt.GlyphIndex, fontLayers []colr.Layer) ([]colorLayer, error) {
+	var layers []colorLayer
+	for _, l := range fontLayers {
+		if l.Composite != nil {
+			source, err := c.buildLayers(x, l.Composite.Source)
+			if err != nil {
+				return nil, err
+			}
+			backdrop, err := c.buildLayers(x, l.Composite.Backdrop)
+			if err != nil {
+				return nil, err
+			}
+			if g := c.newGroup(l.Composite.Mode, source, backdrop); g != nil {
+				layers = append(layers, colorLayer{group: g})
+			}
+			continue
+		}
+
+		toPaint, ok := l.Paint.Transform.Invert()
+		if !ok {
+			continue
+		}
+
+		var segments sfnt.Segments
+		var err error
+		if l.Unclipped {
+			segments, err = c.clipSegments(x)
+		} else {
+			segments, err = loadGlyphSegments(c.fontData, c.outlines, &c.b, l.Glyph)
+		}
+		if err != nil {
+			return nil, err
+		}
+		m := tess.Tessellate(transformSegments(segments, l.Transform), c.scale, cubicTolerance)
+		if len(m.Verts) == 0 {
+			continue
+		}
+
+		// Each corner of the cover quad is mapped back from pixels to font units, with the Y axis up, and on into
+		// the paint's space, which varies linearly across the quad
+		paint := c.addPaint(l.Paint)
+		min, max := m.Bounds()
+		cover := coverQuad(min[0], min[1], max[0], max[1], paint)
+		for i := range cover {
+			p := toPaint.Apply(colr.Point{X: cover[i].position[0] / c.scale, Y: -cover[i].position[1] / c.scale})
+			cover[i].paintCoord = vkm.Pt2{p.X, p.Y}
+		}
+
+		layers = append(layers, colorLayer{mesh: m, cover: [4]paintVertex(cover)})
+	}
+	return layers, nil
+}
+
+// clipSegments returns the outline that an unclipped layer of color glyph x fills, in font units with the Y axis down,
+// as from loadGlyphSegments: the glyph's clip box or, if the font doesn't give it one, the font's bounding box.
+func (c *colorGlyphs) clipSegments(x sfnt.GlyphIndex) (sfnt.Segments, error) {
+	var r fixed.Rectangle26_6
+	if min, max, ok := c.font.ClipBox(x); ok {
+		r = fixed.Rectangle26_6{
+			Min: fixed.Point26_6{X: fixed.Int26_6(min.X * 64), Y: fixed.Int26_6(-max.Y * 64)},
+			Max: fixed.Point26_6{X: fixed.Int26_6(max.X * 64), Y: fixed.Int26_6(-min.Y * 64)},
+		}
+	} else {
+		bounds, err := c.fontData.Bounds(&c.b, fixed.I(int(c.fontData.UnitsPerEm())), font.HintingNone)
+		if err != nil {
+			return nil, err
+		}
+		r = bounds
+	}
+
+	return sfnt.Segments{
+		{Op: sfnt.SegmentOpMoveTo, Args: [3]fixed.Point26_6{r.Min}},
+		{Op: sfnt.SegmentOpLineTo, Args: [3]fixed.Point26_6{{X: r.Max.X, Y: r.Min.Y}}},
+		{Op: sfnt.SegmentOpLineTo, Args: [3]fixed.Point26_6{r.Max}},
+		{Op: sfnt.SegmentOpLineTo, Args: [3]fixed.Point26_6{{X: r.Min.X, Y: r.Max.Y}}},
+	}, nil
+}
+
+// addPaint adds a paint record for p, and its color stops, returning the record's index.
+func (c *colorGlyphs) addPaint(p colr.Paint) uint32 {
+	rec := paintRecord{
+		kind:      uint32(p.Kind),
+		extend:    uint32(p.Extend),
+		firstStop: uint32(len(c.stops)),
+		stopCount: uint32(len(p.Stops)),
+		color:     premultiplied(p.Color),
+	}
+	switch p.Kind {
+	case colr.PaintLinearGradient:
+		rec.geometry0 = [4]float32{p.P0.X, p.P0.Y, p.P1.X, p.P1.Y}
+	case colr.PaintRadialGradient:
+		rec.geometry0 = [4]float32{p.P0.X, p.P0.Y, p.R0}
+		rec.geometry1 = [4]float32{p.P1.X, p.P1.Y, p.R1}
+	case colr.PaintSweepGradient:
+		rec.geometry0 = [4]float32{p.P0.X, p.P0.Y, p.StartAngle, p.EndAngle}
+	}
+
+	for _, s := range p.Stops {
+		c.stops = append(c.stops, colorStop{color: premultiplied(s.Color), offset: s.Offset})
+	}
+	c.paints = append(c.paints, rec)
+	return uint32(len(c.paints) - 1)
+}
+
+func premultiplied(c colr.Color) [4]float32 {
+	p := c.Premultiplied()
+	return [4]float32{p.R, p.G, p.B, p.A}
+}
+
+// coverQuad returns the corners of a rectangle, in pixels, in triangle strip order, filled with the given paint.
+func coverQuad(minX, minY, maxX, maxY float32, paint uint32) []paintVertex {
+	return []paintVertex{
+		{position: vkm.Pt2{minX, minY}, paint: paint},
+		{position: vkm.Pt2{maxX, minY}, paint: paint},
+		{position: vkm.Pt2{minX, maxY}, paint: paint},
+		{position: vkm.Pt2{maxX, maxY}, paint: paint},
+	}
+}
+
+// transformSegments applies a COLR transform, which works with the Y axis up, to segments from loadGlyphSegments,
+// whose Y axis points down. segments is modified in place.
+func transformSegments(segments sfnt.Segments, t colr.Affine) sfnt.Segments {
+	for i := range segments {
+		for k, pt := range segments[i].Args {
+			p := t.Apply(colr.Point{X: float32(pt.X) / 64, Y: -float32(pt.Y) / 64})
+			segments[i].Args[k] = fixed.Point26_6{
+				X: fixed.Int26_6(math.Round(float64(p.X) * 64)),
+				Y: fixed.Int26_6(math.Round(float64(-p.Y) * 64)),
+			}
+		}
+	}
+	return segments
+}
+
+// recordDraw records the layers of every loaded color glyph. The window's render pass must be in its color subpass,
+// with the viewport and scissor already set.
+func (c *colorGlyphs) recordDraw(cb vk.CommandBuffer, transforms *pushConstants) {
+	if len(c.draws) == 0 {
+		return
+	}
+
+	c.bindLayerBuffers(cb, c.vertexBuffer.handle(), c.indexBuffer.handle(), c.coverBuffer.handle(), c.groupBuffer.handle())
+	c.recordLayerDraws(cb, c.windowPipelines, c.draws, transforms)
+}
+
+// bindLayerBuffers binds the buffers that recordLayerDraws reads: outlines from binding 0, cover quads from binding 1
+// and group quads from binding 2, so that all of them stay bound throughout. Any buffer may be a null handle, if no
+// draw reads from it.
+func (c *colorGlyphs) bindLayerBuffers(cb vk.CommandBuffer, vertexBuffer, indexBuffer, coverBuffer, groupBuffer vk.Buffer) {
+	for binding, buffer := range []vk.Buffer{vertexBuffer, coverBuffer, groupBuffer} {
+		if buffer != vk.Buffer(vk.NULL_HANDLE) {
+			vk.CmdBindVertexBuffers(cb, uint32(binding), []vk.Buffer{buffer}, []vk.DeviceSize{0})
+		}
+	}
+	if indexBuffer != vk.Buffer(vk.NULL_HANDLE) {
+		vk.CmdBindIndexBuffer(cb, indexBuffer, 0, vk.INDEX_TYPE_UINT32)
+	}
+}
+
+// recordLayerDraws records draws with pipelines, which must have been built for the current render pass, in its color
+// subpass. The buffers must already be bound by bindLayerBuffers.
+func (c *colorGlyphs) recordLayerDraws(cb vk.CommandBuffer, pipelines layerPipelines, draws []layerDraw, transforms *pushConstants) {
+	vk.CmdBindDescriptorSets(cb, vk.PIPELINE_BIND_POINT_GRAPHICS, c.pipelineLayout, 0, []vk.DescriptorSet{c.descriptorSet, c.groupImageSet}, nil)
+	// Every pipeline shares the layout, so the transforms only need to be pushed once
+	vk.CmdPushConstants(cb, c.pipelineLayout, vk.SHADER_STAGE_VERTEX_BIT, 0, transforms.bytes())
+
+	for _, l := range draws {
+		if l.group {
+			vk.CmdBindPipeline(cb, vk.PIPELINE_BIND_POINT_GRAPHICS, pipelines.group)
+			vk.CmdDraw(cb, 4, 1, 0, uint32(l.instance))
+			continue
+		}
+
+		vk.CmdBindPipeline(cb, vk.PIPELINE_BIND_POINT_GRAPHICS, pipelines.fan)
+		vk.CmdDrawIndexed(cb, uint32(l.fanIndexCount), 1, uint32(l.firstIndex), int32(l.vertexOffset), 0)
+		vk.CmdBindPipeline(cb, vk.PIPELINE_BIND_POINT_GRAPHICS, pipelines.curve)
+		vk.CmdDrawIndexed(cb, uint32(l.curveIndexCount), 1, uint32(l.firstIndex+l.fanIndexCount), int32(l.curveVertexOffset), 0)
+
+		vk.CmdBindPipeline(cb, vk.PIPELINE_BIND_POINT_GRAPHICS, pipelines.paint)
+		vk.CmdDraw(cb, 4, 1, uint32(l.cover), 0)
+	}
+}
+
+func (c *colorGlyphs) createDescriptorSet() {
+	device := c.vp.ctx.Device
+	var r vk.Result
+
+	layoutCI := vk.DescriptorSetLayoutCreateInfo{
+		PBindings: []vk.DescriptorSetLayoutBinding{
+			{
+				Binding:         0,
+				DescriptorType:  vk.DESCRIPTOR_TYPE_STORAGE_BUFFER,
+				DescriptorCount: 1,
+				StageFlags:      vk.SHADER_STAGE_FRAGMENT_BIT,
+			},
+			{
+				Binding:         1,
+				DescriptorType:  vk.DESCRIPTOR_TYPE_STORAGE_BUFFER,
+				DescriptorCount: 1,
+				StageFlags:      vk.SHADER_STAGE_FRAGMENT_BIT,
+			},
+		},
+	}
+	if r, c.descriptorSetLayout = vk.CreateDescriptorSetLayout(device, &layoutCI, nil); r != vk.SUCCESS {
+		panic("Could not create paint descriptor set layout: " + r.String())
+	}
+
+	imageLayoutCI := vk.DescriptorSetLayoutCreateInfo{
+		PBindings: []vk.DescriptorSetLayoutBinding{{
+			Binding:         0,
+			DescriptorType:  vk.DESCRIPTOR_TYPE_COMBINED_IMAGE_SAMPLER,
+			DescriptorCount: 1,
+			StageFlags:      vk.SHADER_STAGE_FRAGMENT_BIT,
+		}},
+	}
+	if r, c.imageSetLayout = vk.CreateDescriptorSetLayout(device, &imageLayoutCI, nil); r != vk.SUCCESS {
+		panic("Could not create group image descriptor set layout: " + r.String())
+	}
+
+	poolCI := vk.DescriptorPoolCreateInfo{
+		MaxSets: 3,
+		PPoolSizes: []vk.DescriptorPoolSize{
+			{
+				Typ:             vk.DESCRIPTOR_TYPE_STORAGE_BUFFER,
+				DescriptorCount: 2,
+			},
+			{
+				Typ:             vk.DESCRIPTOR_TYPE_COMBINED_IMAGE_SAMPLER,
+				DescriptorCount: 2,
+			},
+		},
+	}
+	if r, c.descriptorPool = vk.CreateDescriptorPool(device, &poolCI, nil); r != vk.SUCCESS {
+		panic("Could not create paint descriptor pool: " + r.String())
+	}
+
+	allocInfo := vk.DescriptorSetAllocateInfo{
+		DescriptorPool: c.descriptorPool,
+		PSetLayouts:    []vk.DescriptorSetLayout{c.descriptorSetLayout, c.imageSetLayout, c.imageSetLayout},
+	}
+	r, sets := vk.AllocateDescriptorSets(device, &allocInfo)
+	if r != vk.SUCCESS {
+		panic("Could not allocate paint descriptor set: " + r.String())
+	}
+	c.descriptorSet, c.groupImageSet, c.layerImageSet = sets[0], sets[1], sets[2]
+
+	// The images never change, so their sets are written once
+	var writes []vk.WriteDescriptorSet
+	for _, w := range []struct {
+		set  vk.DescriptorSet
+		view vk.ImageView
+	}{
+		{c.groupImageSet, c.groupImage.view},
+		{c.layerImageSet, c.layerImage.view},
+	} {
+		writes = append(writes, vk.WriteDescriptorSet{
+			DstSet:         w.set,
+			DstBinding:     0,
+			DescriptorType: vk.DESCRIPTOR_TYPE_COMBINED_IMAGE_SAMPLER,
+			PImageInfo: []vk.DescriptorImageInfo{{
+				Sampler:     c.sampler,
+				ImageView:   w.view,
+				ImageLayout: vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL,
+			}},
+		})
+	}
+	vk.UpdateDescriptorSets(device, writes, nil)
+}
+
+// updateDescriptorSet points the descriptor set at the current paint and stop buffers. The device must be idle.
+func (c *colorGlyphs) updateDescriptorSet() {
+	vk.UpdateDescriptorSets(c.vp.ctx.Device, []vk.WriteDescriptorSet{
+		{
+			DstSet:         c.descriptorSet,
+			DstBinding:     0,
+			DescriptorType: vk.DESCRIPTOR_TYPE_STORAGE_BUFFER,
+			PBufferInfo:    []vk.DescriptorBufferInfo{{Buffer: c.paintBuffer.buffer, Rang: c.paintBuffer.capacity}},
+		},
+		{
+			DstSet:         c.descriptorSet,
+			DstBinding:     1,
+			DescriptorType: vk.DESCRIPTOR_TYPE_STORAGE_BUFFER,
+			PBufferInfo:    []vk.DescriptorBufferInfo{{Buffer: c.stopBuffer.buffer, Rang: c.stopBuffer.capacity}},
+		},
+	}, nil)
+}
+
+// createPipelineLayout creates the layout shared by every layer pipeline, and loads their shaders.
+func (c *colorGlyphs) createPipelineLayout() {
+	vp := c.vp
+
+	c.paintVertShaderModule = vp.createShaderModule("shaders/paint_vert.spv")
+	c.paintFragShaderModule = vp.createShaderModule("shaders/paint_frag.spv")
+	c.groupVertShaderModule = vp.createShaderModule("shaders/text_vert.spv")
+	c.groupFragShaderModule = vp.createShaderModule("shaders/group_frag.spv")
+
+	pipelineLayoutCreateInfo := vk.PipelineLayoutCreateInfo{
+		PSetLayouts: []vk.DescriptorSetLayout{c.descriptorSetLayout, c.imageSetLayout},
+		PPushConstantRanges: []vk.PushConstantRange{
+			{
+				StageFlags: vk.SHADER_STAGE_VERTEX_BIT,
+				Offset:     0,
+				Size:       uint32(unsafe.Sizeof(pushConstants{})),
+			},
+		},
+	}
+
+	var r vk.Result
+	if r, c.pipelineLayout = vk.CreatePipelineLayout(vp.ctx.Device, &pipelineLayoutCreateInfo, nil); r != vk.SUCCESS {
+		panic(r)
+	}
+}
+
+// createPipelines builds the pipelines that draw layers in the color subpass of renderPass, which is either the
+// window's render pass or the one groups are rendered with: the fan and curve stencil pipelines, with color writes
+// masked, the paint pipeline that blends premultiplied paints source over, and the group pipeline that blends groups
+// the same way.
+func (c *colorGlyphs) createPipelines(renderPass vk.RenderPass) layerPipelines {
+	vp := c.vp
+
+	// The stencil pipelines draw nothing but the stencil, though the color subpass has a color attachment
+	fan, curve := vp.outlineStencilCreateInfos(renderPass, c.pipelineLayout)
+	stencilOnly := vk.PipelineColorBlendStateCreateInfo{
+		PAttachments: []vk.PipelineColorBlendAttachmentState{{ColorWriteMask: 0}},
+	}
+	fan.PColorBlendState, curve.PColorBlendState = &stencilOnly, &stencilOnly
+	fan.Subpass, curve.Subpass = 1, 1
+
+	shaderStages := []vk.PipelineShaderStageCreateInfo{
+		{
+			Stage:               vk.SHADER_STAGE_VERTEX_BIT,
+			Module:              c.paintVertShaderModule,
+			PName:               "main",
+			PSpecializationInfo: &vk.SpecializationInfo{},
+		},
+		{
+			Stage:               vk.SHADER_STAGE_FRAGMENT_BIT,
+			Module:              c.paintFragShaderModule,
+			PName:               "main",
+			PSpecializationInfo: &vk.SpecializationInfo{},
+		},
+	}
+
+	// Cover quads are read from binding 1; binding 0 holds the outlines drawn by the stencil pipelines
+	vertexInputCreateInfo := vk.PipelineVertexInputStateCreateInfo{
+		PVertexBindingDescriptions: []vk.VertexInputBindingDescription{
+			{
+				Binding: 1,
+				Stride:  uint32(unsafe.Sizeof(paintVertex{})),
+			},
+		},
+		PVertexAttributeDescriptions: []vk.VertexInputAttributeDescription{
+			{
+				Location: 0,
+				Binding:  1,
+				Format:   vk.FORMAT_R32G32_SFLOAT,
+				Offset:   uint32(unsafe.Offsetof(paintVertex{}.position)),
+			},
+			{
+				Location: 1,
+				Binding:  1,
+				Format:   vk.FORMAT_R32G32_SFLOAT,
+				Offset:   uint32(unsafe.Offsetof(paintVertex{}.paintCoord)),
+			},
+			{
+				Location: 2,
+				Binding:  1,
+				Format:   vk.FORMAT_R32_UINT,
+				Offset:   uint32(unsafe.Offsetof(paintVertex{}.paint)),
+			},
+		},
+	}
+
+	inputAssemblyCreateInfo := vk.PipelineInputAssemblyStateCreateInfo{
+		Topology: vk.PRIMITIVE_TOPOLOGY_TRIANGLE_STRIP,
+	}
+
+	// A layer's paint covers the samples its outline left non-zero, and clears them for the next layer
+	coverStencil := vk.StencilOpState{
+		PassOp:      vk.STENCIL_OP_ZERO,
+		CompareOp:   vk.COMPARE_OP_NOT_EQUAL,
+		CompareMask: 0xFF,
+		WriteMask:   0xFF,
+		Reference:   0,
+	}
+	paintDepthStencil := vk.PipelineDepthStencilStateCreateInfo{
+		StencilTestEnable: true,
+		Front:             coverStencil,
+		Back:              coverStencil,
+	}
+
+	paint := vk.GraphicsPipelineCreateInfo{
+		PStages:             shaderStages,
+		PVertexInputState:   &vertexInputCreateInfo,
+		PInputAssemblyState: &inputAssemblyCreateInfo,
+		PViewportState:      vp.standardViewport(),
+		PRasterizationState: fan.PRasterizationState,
+		PMultisampleState:   fan.PMultisampleState,
+		PDepthStencilState:  &paintDepthStencil,
+		PDynamicState:       fan.PDynamicState,
+
+		Layout:     c.pipelineLayout,
+		RenderPass: renderPass,
+		Subpass:    1,
+	}
+
+	paint.PColorBlendState = &vk.PipelineColorBlendStateCreateInfo{
+		PAttachments: []vk.PipelineColorBlendAttachmentState{{
+			ColorWriteMask: vk.COLOR_COMPONENT_R_BIT | vk.COLOR_COMPONENT_G_BIT | vk.COLOR_COMPONENT_B_BIT | vk.COLOR_COMPONENT_A_BIT,
+			BlendEnable:    true,
+
+			SrcColorBlendFactor: vk.BLEND_FACTOR_ONE,
+			DstColorBlendFactor: vk.BLEND_FACTOR_ONE_MINUS_SRC_ALPHA,
+			ColorBlendOp:        vk.BLEND_OP_ADD,
+			SrcAlphaBlendFactor: vk.BLEND_FACTOR_ONE,
+			DstAlphaBlendFactor: vk.BLEND_FACTOR_ONE_MINUS_SRC_ALPHA,
+			AlphaBlendOp:        vk.BLEND_OP_ADD,
+		}},
+	}
+
+	// Groups are textured quads from the group image, read from binding 2 with the same per-instance layout as the
+	// glyph atlas's quads, and aren't stencilled
+	group := paint
+	group.PStages = []vk.PipelineShaderStageCreateInfo{
+		{
+			Stage:               vk.SHADER_STAGE_VERTEX_BIT,
+			Module:              c.groupVertShaderModule,
+			PName:               "main",
+			PSpecializationInfo: &vk.SpecializationInfo{},
+		},
+		{
+			Stage:               vk.SHADER_STAGE_FRAGMENT_BIT,
+			Module:              c.groupFragShaderModule,
+			PName:               "main",
+			PSpecializationInfo: &vk.SpecializationInfo{},
+		},
+	}
+	group.PVertexInputState = &vk.PipelineVertexInputStateCreateInfo{
+		PVertexBindingDescriptions: []vk.VertexInputBindingDescription{
+			{
+				Binding:   2,
+				Stride:    uint32(unsafe.Sizeof(atlasInstance{})),
+				InputRate: vk.VERTEX_INPUT_RATE_INSTANCE,
+			},
+		},
+		PVertexAttributeDescriptions: []vk.VertexInputAttributeDescription{
+			{
+				Location: 0,
+				Binding:  2,
+				Format:   vk.FORMAT_R32G32_SFLOAT,
+				Offset:   uint32(unsafe.Offsetof(atlasInstance{}.position)),
+			},
+			{
+				Location: 1,
+				Binding:  2,
+				Format:   vk.FORMAT_R32G32_SFLOAT,
+				Offset:   uint32(unsafe.Offsetof(atlasInstance{}.size)),
+			},
+			{
+				Location: 2,
+				Binding:  2,
+				Format:   vk.FORMAT_R32G32B32A32_SFLOAT,
+				Offset:   uint32(unsafe.Offsetof(atlasInstance{}.uv)),
+			},
+		},
+	}
+	group.PDepthStencilState = &vk.PipelineDepthStencilStateCreateInfo{}
+
+	var r vk.Result
+	var pipelines []vk.Pipeline
+	createInfos := []vk.GraphicsPipelineCreateInfo{fan, curve, paint, group}
+	if r, pipelines = vk.CreateGraphicsPipelines(vp.ctx.Device, vk.PipelineCache(vk.NULL_HANDLE), createInfos, nil); r != vk.SUCCESS {
+		panic(r)
+	}
+	return layerPipelines{fan: pipelines[0], curve: pipelines[1], paint: pipelines[2], group: pipelines[3]}
+}
+
+func (c *colorGlyphs) Teardown() {
+	ctx := c.vp.ctx
+	device := ctx.Device
+
+	for _, p := range []layerPipelines{c.windowPipelines, c.offscreenPipelines} {
+		vk.DestroyPipeline(device, p.fan, nil)
+		vk.DestroyPipeline(device, p.curve, nil)
+		vk.DestroyPipeline(device, p.paint, nil)
+		vk.DestroyPipeline(device, p.group, nil)
+	}
+	vk.DestroyPipeline(device, c.compositePipeline, nil)
+	vk.DestroyPipelineLayout(device, c.pipelineLayout, nil)
+	vk.DestroyPipelineLayout(device, c.compositePipelineLayout, nil)
+	for _, m := range []vk.ShaderModule{
+		c.paintVertShaderModule, c.paintFragShaderModule,
+		c.groupVertShaderModule, c.groupFragShaderModule,
+		c.compositeVertShaderModule, c.compositeFragShaderModule,
+	} {
+		vk.DestroyShaderModule(device, m, nil)
+	}
+
+	vk.DestroyDescriptorPool(device, c.descriptorPool, nil)
+	vk.DestroyDescriptorSetLayout(device, c.descriptorSetLayout, nil)
+	vk.DestroyDescriptorSetLayout(device, c.imageSetLayout, nil)
+
+	vk.DestroyFramebuffer(device, c.layerFramebuffer, nil)
+	vk.DestroyFramebuffer(device, c.groupFramebuffer, nil)
+	vk.DestroyRenderPass(device, c.layerPass, nil)
+	vk.DestroyRenderPass(device, c.groupPass, nil)
+	vk.DestroySampler(device, c.sampler, nil)
+	vk.DestroyImageView(device, c.stencilView, nil)
+	vk.DestroyImage(device, c.stencilImage, nil)
+	vk.FreeMemory(device, c.stencilMemory, nil)
+	c.layerImage.destroy(device)
+	c.groupImage.destroy(device)
+
+	c.vertexBuffer.destroy(ctx)
+	c.indexBuffer.destroy(ctx)
+	c.coverBuffer.destroy(ctx)
+	c.groupBuffer.destroy(ctx)
+	c.paintBuffer.destroy(ctx)
+	c.stopBuffer.destroy(ctx)
+}
@@ -0,0 +1,491 @@
+package main
+
+import (
+	"errors"
+	"image"
+	"math"
+	"unsafe"
+
+	"github.com/bbredesen/go-vk"
+	"github.com/bbredesen/ttf-renderer/colr"
+	"github.com/bbredesen/ttf-renderer/tess"
+	"github.com/bbredesen/ttf-renderer/vkctx"
+	"github.com/bbredesen/vkm"
+	"github.com/sirupsen/logrus"
+)
+
+// groupFormat is the format of the images groups are rendered into. Colors are stored premultiplied and, like the
+// framebuffer's blending, linear, so that a group drawn from its image matches the same layers drawn directly.
+const groupFormat = vk.FORMAT_R16G16B16A16_SFLOAT
+
+// groupImageSize is the width and height of the group images, in pixels.
+const groupImageSize = 1024
+
+// ErrGroupsFull is returned by colorGlyphs.load when there is no room left in the group image for a group.
+var ErrGroupsFull = errors.New("color glyph group image is full")
+
+// colorGroup is a group of layers from a colr.Composite, in pixels relative to the glyph's origin. It is rendered once,
+// into the group image, and then drawn from there like a single layer.
+type colorGroup struct {
+	mode             colr.CompositeMode
+	source, backdrop []colorLayer
+
+	// min and max bound every layer of the group, snapped outward to whole pixels
+	min, max vkm.Pt2
+
+	// uv is the group's rectangle in the group image, in normalized texture coordinates, once it has been rendered
+	uv [4]float32
+}
+
+// compositeInstance is the per-instance vertex data of the composite pipeline: one group, drawn as a quad covering
+// position to position+size in the group image, combined from its source and backdrop at sourceUV and backdropUV in
+// the layer image.
+type compositeInstance struct {
+	position, size       vkm.Pt2
+	sourceUV, backdropUV [4]float32
+	mode                 uint32
+}
+
+// offscreenImage is a color image that is both rendered into and sampled.
+type offscreenImage struct {
+	image  vk.Image
+	memory vk.DeviceMemory
+	view   vk.ImageView
+}
+
+func (img *offscreenImage) destroy(device vk.Device) {
+	vk.DestroyImageView(device, img.view, nil)
+	vk.DestroyImage(device, img.image, nil)
+	vk.FreeMemory(device, img.memory, nil)
+}
+
+// layerBatch collects the geometry of placed layers, as recordLayerDraws draws it: outlines in verts and inds, cover
+// quads in covers and group quads in instances.
+type layerBatch struct {
+	verts     []tess.Vertex
+	inds      []uint32
+	covers    []paintVertex
+	instances []atlasInstance
+	draws     []layerDraw
+}
+
+// add places layers with their glyph's origin at offset, in pixels. Any groups among them must already be rendered.
+func (b *layerBatch) add(layers []colorLayer, offset vkm.Vec2) {
+	for _, l := range layers {
+		if g := l.group; g != nil {
+			b.draws = append(b.draws, layerDraw{group: true, instance: len(b.instances)})
+			b.instances = append(b.instances, atlasInstance{
+				position: g.min.Add(offset),
+				size:     vkm.Pt2{g.max[0] - g.min[0], g.max[1] - g.min[1]},
+				uv:       g.uv,
+			})
+			continue
+		}
+
+		d := layerDraw{firstIndex: len(b.inds), vertexOffset: len(b.verts)}
+
+		// Indices stay relative to the layer's own vertices, and are offset when drawn
+		for _, v := range l.mesh.Verts {
+			v.Position = v.Position.Add(offset)
+			b.verts = append(b.verts, v)
+		}
+		b.inds = append(b.inds, l.mesh.Inds...)
+		d.fanIndexCount = len(l.mesh.Inds)
+
+		// The mesh's own cover quad is left out, for the layer's paint quad
+		d.curveVertexOffset = len(b.verts)
+		for _, v := range l.mesh.QuadVerts[:len(l.mesh.QuadVerts)-4] {
+			v.Position = v.Position.Add(offset)
+			b.verts = append(b.verts, v)
+		}
+		b.inds = append(b.inds, l.mesh.QuadInds[:len(l.mesh.QuadInds)-4]...)
+		d.curveIndexCount = len(l.mesh.QuadInds) - 4
+
+		d.cover = len(b.covers)
+		for _, v := range l.cover {
+			v.position = v.position.Add(offset)
+			b.covers = append(b.covers, v)
+		}
+
+		b.draws = append(b.draws, d)
+	}
+}
+
+// newGroup builds a group from the layers of a composite, and queues it to be rendered by renderGroups. Groups nested
+// in its layers were queued first, so they are rendered before it. It returns nil if the group has nothing to draw.
+func (c *colorGlyphs) newGroup(mode colr.CompositeMode, source, backdrop []colorLayer) *colorGroup {
+	g := &colorGroup{mode: mode, source: source, backdrop: backdrop}
+
+	first := true
+	for _, layers := range [][]colorLayer{source, backdrop} {
+		for _, l := range layers {
+			min, max := l.bounds()
+			if first {
+				g.min, g.max = min, max
+				first = false
+				continue
+			}
+			for i := range g.min {
+				if min[i] < g.min[i] {
+					g.min[i] = min[i]
+				}
+				if max[i] > g.max[i] {
+					g.max[i] = max[i]
+				}
+			}
+		}
+	}
+	if first {
+		return nil
+	}
+	g.min = vkm.Pt2{float32(math.Floor(float64(g.min[0]))), float32(math.Floor(float64(g.min[1])))}
+	g.max = vkm.Pt2{float32(math.Ceil(float64(g.max[0]))), float32(math.Ceil(float64(g.max[1])))}
+
+	c.pending = append(c.pending, g)
+	return g
+}
+
+// renderGroups renders every queued group into the group image. The paint and stop buffers must already hold the
+// groups' paints, and the device must be idle.
+func (c *colorGlyphs) renderGroups() error {
+	if c.groupPacker == nil {
+		// Groups are composited into the image with blending disabled, but the padding around them must be empty
+		clearColorImage(c.vp.ctx, c.groupImage.image)
+		c.groupPacker = newShelfPacker(groupImageSize, groupImageSize)
+	}
+
+	for len(c.pending) > 0 {
+		g := c.pending[0]
+		w, h := int(g.max[0]-g.min[0]), int(g.max[1]-g.min[1])
+
+		// The source and backdrop are drawn side by side into the layer image, which is cleared for every group
+		layerPacker := newShelfPacker(groupImageSize, groupImageSize)
+		source, sourceOK := layerPacker.pack(w, h)
+		backdrop, backdropOK := layerPacker.pack(w, h)
+		rect, ok := c.groupPacker.pack(w+2*atlasPadding, h+2*atlasPadding)
+		if !sourceOK || !backdropOK || !ok {
+			return ErrGroupsFull
+		}
+		rect = rect.Inset(atlasPadding)
+
+		var batch layerBatch
+		batch.add(g.source, vkm.Vec2{float32(source.Min.X) - g.min[0], float32(source.Min.Y) - g.min[1]})
+		batch.add(g.backdrop, vkm.Vec2{float32(backdrop.Min.X) - g.min[0], float32(backdrop.Min.Y) - g.min[1]})
+
+		c.renderGroup(&batch, compositeInstance{
+			position:   vkm.Pt2{float32(rect.Min.X), float32(rect.Min.Y)},
+			size:       vkm.Pt2{float32(w), float32(h)},
+			sourceUV:   groupUV(source),
+			backdropUV: groupUV(backdrop),
+			mode:       uint32(g.mode),
+		})
+		g.uv = groupUV(rect)
+		c.pending = c.pending[1:]
+
+		logrus.WithFields(logrus.Fields{
+			"mode":   g.mode,
+			"width":  w,
+			"height": h,
+		}).Debug("Color glyph group rendered")
+	}
+	return nil
+}
+
+// createBatchBuffer creates a buffer holding data, as createDeviceBuffer does, and returns it with a function that
+// destroys it. If data is empty, no buffer is created, and a null handle is returned.
+func createBatchBuffer[T any](ctx *vkctx.Context, usage vk.BufferUsageFlags, data []T) (buffer vk.Buffer, destroy func()) {
+	if len(data) == 0 {
+		return vk.Buffer(vk.NULL_HANDLE), func() {}
+	}
+	buffer, memory := createDeviceBuffer(ctx, usage, data)
+	return buffer, func() {
+		vk.DestroyBuffer(ctx.Device, buffer, nil)
+		vk.FreeMemory(ctx.Device, memory, nil)
+	}
+}
+
+// groupUV returns r, in pixels in a group image, in normalized texture coordinates as (u0, v0, u1, v1).
+func groupUV(r image.Rectangle) [4]float32 {
+	return [4]float32{
+		float32(r.Min.X) / groupImageSize, float32(r.Min.Y) / groupImageSize,
+		float32(r.Max.X) / groupImageSize, float32(r.Max.Y) / groupImageSize,
+	}
+}
+
+// renderGroup draws the source and backdrop of a group, already placed in batch, into the layer image, and then
+// composites them into the group image with composite.
+func (c *colorGlyphs) renderGroup(batch *layerBatch, composite compositeInstance) {
+	ctx := c.vp.ctx
+
+	// The buffers only live for this one group
+	vertexBuffer, destroyVertices := createBatchBuffer(ctx, vk.BUFFER_USAGE_VERTEX_BUFFER_BIT, batch.verts)
+	defer destroyVertices()
+	indexBuffer, destroyIndices := createBatchBuffer(ctx, vk.BUFFER_USAGE_INDEX_BUFFER_BIT, batch.inds)
+	defer destroyIndices()
+	coverBuffer, destroyCovers := createBatchBuffer(ctx, vk.BUFFER_USAGE_VERTEX_BUFFER_BIT, batch.covers)
+	defer destroyCovers()
+	groupBuffer, destroyGroups := createBatchBuffer(ctx, vk.BUFFER_USAGE_VERTEX_BUFFER_BIT, batch.instances)
+	defer destroyGroups()
+	compositeBuffer, destroyComposite := createBatchBuffer(ctx, vk.BUFFER_USAGE_VERTEX_BUFFER_BIT, []compositeInstance{composite})
+	defer destroyComposite()
+
+	extent := vk.Extent2D{Width: groupImageSize, Height: groupImageSize}
+	renderArea := vk.Rect2D{Extent: extent}
+	stencilCV := vk.ClearValue{}
+	stencilCV.AsDepthStencil(vk.ClearDepthStencilValue{Stencil: 0})
+	transforms := pushConstants{
+		projection: pixelProjection(extent),
+		model:      vkm.Identity(),
+	}
+
+	cb := ctx.BeginOneTimeCommands()
+
+	// Nothing is drawn into the stencil subpass of either render pass; layers draw their outlines into the stencil in
+	// the color subpass, as in the window
+	vk.CmdBeginRenderPass(cb, &vk.RenderPassBeginInfo{
+		RenderPass:  c.layerPass,
+		Framebuffer: c.layerFramebuffer,
+		RenderArea:  renderArea,
+		// The layer image is cleared to transparent black
+		PClearValues: []vk.ClearValue{{}, stencilCV},
+	}, vk.SUBPASS_CONTENTS_INLINE)
+	vk.CmdSetViewport(cb, 0, []vk.Viewport{{Width: groupImageSize, Height: groupImageSize, MaxDepth: 1.0}})
+	vk.CmdSetScissor(cb, 0, []vk.Rect2D{renderArea})
+	vk.CmdNextSubpass(cb, vk.SUBPASS_CONTENTS_INLINE)
+
+	c.bindLayerBuffers(cb, vertexBuffer, indexBuffer, coverBuffer, groupBuffer)
+	c.recordLayerDraws(cb, c.offscreenPipelines, batch.draws, &transforms)
+
+	vk.CmdEndRenderPass(cb)
+
+	vk.CmdBeginRenderPass(cb, &vk.RenderPassBeginInfo{
+		RenderPass:  c.groupPass,
+		Framebuffer: c.groupFramebuffer,
+		RenderArea:  renderArea,
+		// The color attachment is loaded, not cleared, but still needs an entry
+		PClearValues: []vk.ClearValue{{}, stencilCV},
+	}, vk.SUBPASS_CONTENTS_INLINE)
+	vk.CmdNextSubpass(cb, vk.SUBPASS_CONTENTS_INLINE)
+
+	vk.CmdBindPipeline(cb, vk.PIPELINE_BIND_POINT_GRAPHICS, c.compositePipeline)
+	vk.CmdBindDescriptorSets(cb, vk.PIPELINE_BIND_POINT_GRAPHICS, c.compositePipelineLayout, 0, []vk.DescriptorSet{c.layerImageSet}, nil)
+	vk.CmdPushConstants(cb, c.compositePipelineLayout, vk.SHADER_STAGE_VERTEX_BIT, 0, transforms.bytes())
+	vk.CmdBindVertexBuffers(cb, 0, []vk.Buffer{compositeBuffer}, []vk.DeviceSize{0})
+	vk.CmdDraw(cb, 4, 1, 0, 0)
+
+	vk.CmdEndRenderPass(cb)
+
+	ctx.EndOneTimeCommands(cb)
+}
+
+// createGroupImages creates the layer image, which the source and backdrop of a group are drawn into, and the group
+// image, which caches every group composited from them, with a render pass and framebuffer for each. They share a
+// stencil attachment, and a sampler.
+func (c *colorGlyphs) createGroupImages() {
+	ctx := c.vp.ctx
+	extent := vk.Extent2D{Width: groupImageSize, Height: groupImageSize}
+
+	for _, img := range []*offscreenImage{&c.layerImage, &c.groupImage} {
+		img.image, img.memory = ctx.CreateImage(extent, groupFormat, vk.IMAGE_TILING_OPTIMAL,
+			vk.IMAGE_USAGE_COLOR_ATTACHMENT_BIT|vk.IMAGE_USAGE_SAMPLED_BIT|vk.IMAGE_USAGE_TRANSFER_DST_BIT, vk.MEMORY_PROPERTY_DEVICE_LOCAL_BIT)
+		img.view = ctx.CreateImageView(img.image, groupFormat, vk.IMAGE_ASPECT_COLOR_BIT)
+	}
+	c.stencilImage, c.stencilMemory, c.stencilView = ctx.CreateStencilImage(extent)
+
+	samplerCI := vk.SamplerCreateInfo{
+		MagFilter:    vk.FILTER_LINEAR,
+		MinFilter:    vk.FILTER_LINEAR,
+		MipmapMode:   vk.SAMPLER_MIPMAP_MODE_NEAREST,
+		AddressModeU: vk.SAMPLER_ADDRESS_MODE_CLAMP_TO_EDGE,
+		AddressModeV: vk.SAMPLER_ADDRESS_MODE_CLAMP_TO_EDGE,
+		AddressModeW: vk.SAMPLER_ADDRESS_MODE_CLAMP_TO_EDGE,
+		BorderColor:  vk.BORDER_COLOR_FLOAT_TRANSPARENT_BLACK,
+	}
+
+	var r vk.Result
+	if r, c.sampler = vk.CreateSampler(ctx.Device, &samplerCI, nil); r != vk.SUCCESS {
+		panic("Could not create group sampler: " + r.String())
+	}
+
+	// Both images are sampled once they have been drawn into: the layer image by the composite pipeline, and the group
+	// image when groups are drawn, in the window or into the layer image of an enclosing group
+	dependencyToSampling := vk.SubpassDependency{
+		SrcSubpass:    1,
+		DstSubpass:    vk.SUBPASS_EXTERNAL,
+		SrcStageMask:  vk.PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT,
+		SrcAccessMask: vk.ACCESS_COLOR_ATTACHMENT_WRITE_BIT,
+		DstStageMask:  vk.PIPELINE_STAGE_FRAGMENT_SHADER_BIT,
+		DstAccessMask: vk.ACCESS_SHADER_READ_BIT,
+	}
+
+	// The two render passes differ only in how the color attachment is loaded, so they are compatible, and the same
+	// pipelines draw into both
+	layerAttachment := vk.AttachmentDescription{
+		Format:  groupFormat,
+		Samples: vk.SAMPLE_COUNT_1_BIT,
+		LoadOp:  vk.ATTACHMENT_LOAD_OP_CLEAR,
+		StoreOp: vk.ATTACHMENT_STORE_OP_STORE,
+
+		StencilLoadOp:  vk.ATTACHMENT_LOAD_OP_DONT_CARE,
+		StencilStoreOp: vk.ATTACHMENT_STORE_OP_DONT_CARE,
+
+		InitialLayout: vk.IMAGE_LAYOUT_UNDEFINED,
+		FinalLayout:   vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL,
+	}
+	c.layerPass = c.vp.createStencilRenderPass(layerAttachment, dependencyToSampling)
+
+	// Between groups, the group image is kept in SHADER_READ_ONLY_OPTIMAL, as the glyph atlas is
+	groupAttachment := layerAttachment
+	groupAttachment.LoadOp = vk.ATTACHMENT_LOAD_OP_LOAD
+	groupAttachment.InitialLayout = vk.IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL
+	c.groupPass = c.vp.createStencilRenderPass(groupAttachment, dependencyToSampling)
+
+	for _, fb := range []struct {
+		renderPass  vk.RenderPass
+		view        vk.ImageView
+		framebuffer *vk.Framebuffer
+	}{
+		{c.layerPass, c.layerImage.view, &c.layerFramebuffer},
+		{c.groupPass, c.groupImage.view, &c.groupFramebuffer},
+	} {
+		framebufferCreateInfo := vk.FramebufferCreateInfo{
+			RenderPass:   fb.renderPass,
+			PAttachments: []vk.ImageView{fb.view, c.stencilView},
+			Width:        groupImageSize,
+			Height:       groupImageSize,
+			Layers:       1,
+		}
+		if r, *fb.framebuffer = vk.CreateFramebuffer(ctx.Device, &framebufferCreateInfo, nil); r != vk.SUCCESS {
+			panic(r)
+		}
+	}
+}
+
+// createCompositePipeline builds the pipeline that composites the source and backdrop of a group from the layer image
+// into the group image. It replaces what is there, rather than blending with it.
+func (c *colorGlyphs) createCompositePipeline() {
+	vp := c.vp
+
+	c.compositeVertShaderModule = vp.createShaderModule("shaders/composite_vert.spv")
+	c.compositeFragShaderModule = vp.createShaderModule("shaders/composite_frag.spv")
+
+	pipelineLayoutCreateInfo := vk.PipelineLayoutCreateInfo{
+		PSetLayouts: []vk.DescriptorSetLayout{c.imageSetLayout},
+		PPushConstantRanges: []vk.PushConstantRange{
+			{
+				StageFlags: vk.SHADER_STAGE_VERTEX_BIT,
+				Offset:     0,
+				Size:       uint32(unsafe.Sizeof(pushConstants{})),
+			},
+		},
+	}
+
+	var r vk.Result
+	if r, c.compositePipelineLayout = vk.CreatePipelineLayout(vp.ctx.Device, &pipelineLayoutCreateInfo, nil); r != vk.SUCCESS {
+		panic(r)
+	}
+
+	shaderStages := []vk.PipelineShaderStageCreateInfo{
+		{
+			Stage:               vk.SHADER_STAGE_VERTEX_BIT,
+			Module:              c.compositeVertShaderModule,
+			PName:               "main",
+			PSpecializationInfo: &vk.SpecializationInfo{},
+		},
+		{
+			Stage:               vk.SHADER_STAGE_FRAGMENT_BIT,
+			Module:              c.compositeFragShaderModule,
+			PName:               "main",
+			PSpecializationInfo: &vk.SpecializationInfo{},
+		},
+	}
+
+	// Every attribute is per instance; the quad corners come from gl_VertexIndex
+	vertexInputCreateInfo := vk.PipelineVertexInputStateCreateInfo{
+		PVertexBindingDescriptions: []vk.VertexInputBindingDescription{
+			{
+				Binding:   0,
+				Stride:    uint32(unsafe.Sizeof(compositeInstance{})),
+				InputRate: vk.VERTEX_INPUT_RATE_INSTANCE,
+			},
+		},
+		PVertexAttributeDescriptions: []vk.VertexInputAttributeDescription{
+			{
+				Location: 0,
+				Binding:  0,
+				Format:   vk.FORMAT_R32G32_SFLOAT,
+				Offset:   uint32(unsafe.Offsetof(compositeInstance{}.position)),
+			},
+			{
+				Location: 1,
+				Binding:  0,
+				Format:   vk.FORMAT_R32G32_SFLOAT,
+				Offset:   uint32(unsafe.Offsetof(compositeInstance{}.size)),
+			},
+			{
+				Location: 2,
+				Binding:  0,
+				Format:   vk.FORMAT_R32G32B32A32_SFLOAT,
+				Offset:   uint32(unsafe.Offsetof(compositeInstance{}.sourceUV)),
+			},
+			{
+				Location: 3,
+				Binding:  0,
+				Format:   vk.FORMAT_R32G32B32A32_SFLOAT,
+				Offset:   uint32(unsafe.Offsetof(compositeInstance{}.backdropUV)),
+			},
+			{
+				Location: 4,
+				Binding:  0,
+				Format:   vk.FORMAT_R32_UINT,
+				Offset:   uint32(unsafe.Offsetof(compositeInstance{}.mode)),
+			},
+		},
+	}
+
+	inputAssemblyCreateInfo := vk.PipelineInputAssemblyStateCreateInfo{
+		Topology: vk.PRIMITIVE_TOPOLOGY_TRIANGLE_STRIP,
+	}
+
+	rasterizerCreateInfo := vk.PipelineRasterizationStateCreateInfo{
+		PolygonMode: vk.POLYGON_MODE_FILL,
+		LineWidth:   1.0,
+		CullMode:    vk.CULL_MODE_NONE,
+		FrontFace:   vk.FRONT_FACE_CLOCKWISE,
+	}
+
+	multisampleCreateInfo := vk.PipelineMultisampleStateCreateInfo{
+		RasterizationSamples: vk.SAMPLE_COUNT_1_BIT,
+		MinSampleShading:     1.0,
+	}
+
+	colorBlendStateCreateInfo := vk.PipelineColorBlendStateCreateInfo{
+		PAttachments: []vk.PipelineColorBlendAttachmentState{{
+			ColorWriteMask: vk.COLOR_COMPONENT_R_BIT | vk.COLOR_COMPONENT_G_BIT | vk.COLOR_COMPONENT_B_BIT | vk.COLOR_COMPONENT_A_BIT,
+		}},
+	}
+
+	pipelineCreateInfo := vk.GraphicsPipelineCreateInfo{
+		PStages:             shaderStages,
+		PVertexInputState:   &vertexInputCreateInfo,
+		PInputAssemblyState: &inputAssemblyCreateInfo,
+		PViewportState:      vp.standardViewport(),
+		PRasterizationState: &rasterizerCreateInfo,
+		PMultisampleState:   &multisampleCreateInfo,
+		PColorBlendState:    &colorBlendStateCreateInfo,
+		PDepthStencilState:  &vk.PipelineDepthStencilStateCreateInfo{},
+		PDynamicState: &vk.PipelineDynamicStateCreateInfo{
+			PDynamicStates: []vk.DynamicState{vk.DYNAMIC_STATE_VIEWPORT, vk.DYNAMIC_STATE_SCISSOR},
+		},
+
+		Layout:     c.compositePipelineLayout,
+		RenderPass: c.groupPass,
+		Subpass:    1,
+	}
+
+	var pipelines []vk.Pipeline
+	if r, pipelines = vk.CreateGraphicsPipelines(vp.ctx.Device, vk.PipelineCache(vk.NULL_HANDLE), []vk.GraphicsPipelineCreateInfo{pipelineCreateInfo}, nil); r != vk.SUCCESS {
+		panic(r)
+	}
+	c.compositePipeline = pipelines[0]
+}
@@ -0,0 +1,229 @@
+// Package colr reads the color glyphs of a font from its COLR and CPAL tables: the stacks of solid colored layers of
+// COLR version 0, and the paint graphs of version 1, with their gradients, transforms and composites. Each color
+// glyph is flattened into a list of Layers, each of which fills the outline of one glyph with a single paint, so that a
+// renderer that can fill an outline can draw it one layer at a time. Composites that can't be drawn that way are kept
+// as groups of layers, which the renderer draws on their own and combines with CompositeMode.Apply. Like packages ttf
+// and shaping, it reads tables directly and has no dependency on the renderer.
+package colr
+
+import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/image/font/sfnt"
+)
+
+// Font holds the color glyphs and palettes of a font.
+type Font struct {
+	colr     []byte
+	palettes [][]Color
+
+	// Version 0: base glyph records, sorted by glyph, each naming a run of layer records
+	baseGlyphs []baseGlyph
+	layers     []layerRecord
+
+	// Version 1: the offset from the start of colr of the root paint of each base glyph, and of each paint in the
+	// layer list
+	basePaints map[sfnt.GlyphIndex]int
+	layerList  []int
+	clips      []clipRecord
+}
+
+type baseGlyph struct {
+	glyph      sfnt.GlyphIndex
+	firstLayer int
+	numLayers  int
+}
+
+type layerRecord struct {
+	glyph        sfnt.GlyphIndex
+	paletteIndex uint16
+}
+
+// clipRecord is the clip box of a run of glyphs, in font units.
+type clipRecord struct {
+	start, end sfnt.GlyphIndex
+	min, max   Point
+}
+
+// Parse reads the COLR table colr, and the CPAL table cpal that holds its colors. cpal may be nil for a font whose
+// glyphs are only drawn in the foreground color.
+func Parse(colr, cpal []byte) (*Font, error) {
+	f := &Font{colr: colr}
+
+	if cpal != nil {
+		palettes, err := parseCPAL(cpal)
+		if err != nil {
+			return nil, fmt.Errorf("CPAL: %w", err)
+		}
+		f.palettes = palettes
+	}
+
+	r := newReader(colr)
+	version := r.U16()
+	numBaseGlyphs := int(r.U16())
+	baseGlyphsOffset := int(r.U32())
+	layersOffset := int(r.U32())
+	numLayers := int(r.U16())
+	if r.Err() != nil {
+		return nil, fmt.Errorf("COLR: %w", r.Err())
+	}
+
+	records := r.At(baseGlyphsOffset)
+	f.baseGlyphs = make([]baseGlyph, numBaseGlyphs)
+	for i := range f.baseGlyphs {
+		f.baseGlyphs[i] = baseGlyph{sfnt.GlyphIndex(records.U16()), int(records.U16()), int(records.U16())}
+		if g := f.baseGlyphs[i]; g.firstLayer+g.numLayers > numLayers {
+			return nil, fmt.Errorf("COLR: %w", ErrInvalidTable)
+		}
+	}
+	records = r.At(layersOffset)
+	f.layers = make([]layerRecord, numLayers)
+	for i := range f.layers {
+		f.layers[i] = layerRecord{sfnt.GlyphIndex(records.U16()), records.U16()}
+	}
+	if records.Err() != nil {
+		return nil, fmt.Errorf("COLR: %w", records.Err())
+	}
+
+	if version >= 1 {
+		if err := f.parseV1(r); err != nil {
+			return nil, fmt.Errorf("COLR: %w", err)
+		}
+	}
+	return f, nil
+}
+
+// parseV1 reads the base glyph list, layer list and clip list of a version 1 table. The variation data is not read, so
+// variable clip boxes are read at their default values.
+func (f *Font) parseV1(r *reader) error {
+	baseGlyphListOffset := int(r.U32())
+	layerListOffset := int(r.U32())
+	clipListOffset := int(r.U32())
+	if r.Err() != nil {
+		return r.Err()
+	}
+
+	f.basePaints = make(map[sfnt.GlyphIndex]int)
+	if baseGlyphListOffset != 0 {
+		list := r.At(baseGlyphListOffset)
+		n := int(list.U32())
+		for i := 0; i < n && list.Err() == nil; i++ {
+			glyph := sfnt.GlyphIndex(list.U16())
+			f.basePaints[glyph] = baseGlyphListOffset + int(list.U32())
+		}
+		if list.Err() != nil {
+			return list.Err()
+		}
+	}
+
+	if layerListOffset != 0 {
+		list := r.At(layerListOffset)
+		n := int(list.U32())
+		if list.Err() != nil || n > len(list.Data())/4 {
+			return ErrInvalidTable
+		}
+		f.layerList = make([]int, n)
+		for i := range f.layerList {
+			f.layerList[i] = layerListOffset + int(list.U32())
+		}
+		if list.Err() != nil {
+			return list.Err()
+		}
+	}
+
+	if clipListOffset != 0 {
+		list := r.At(clipListOffset)
+		list.Skip(1) // format
+		n := int(list.U32())
+		if list.Err() != nil || n > len(list.Data())/7 {
+			return ErrInvalidTable
+		}
+		f.clips = make([]clipRecord, n)
+		for i := range f.clips {
+			start, end := sfnt.GlyphIndex(list.U16()), sfnt.GlyphIndex(list.U16())
+			box := list.At(int(list.U24()))
+			box.Skip(1) // format, and the variation index of format 2 follows the box
+			xMin, yMin, xMax, yMax := box.FWord(), box.FWord(), box.FWord(), box.FWord()
+			if box.Err() != nil {
+				return box.Err()
+			}
+			f.clips[i] = clipRecord{start, end, Point{xMin, yMin}, Point{xMax, yMax}}
+		}
+		if list.Err() != nil {
+			return list.Err()
+		}
+	}
+	return nil
+}
+
+// NumPalettes returns the number of palettes in the CPAL table, which is zero if the font has none.
+func (f *Font) NumPalettes() int {
+	return len(f.palettes)
+}
+
+// HasGlyph reports whether x is a color glyph, with a paint graph or a list of layers.
+func (f *Font) HasGlyph(x sfnt.GlyphIndex) bool {
+	if _, ok := f.basePaints[x]; ok {
+		return true
+	}
+	_, ok := f.findBaseGlyph(x)
+	return ok
+}
+
+// ClipBox returns the clip box of color glyph x from the clip list, in font units with the Y axis pointing up. Nothing
+// is drawn outside it, and Unclipped layers fill it. ok is false if the font gives x no clip box.
+func (f *Font) ClipBox(x sfnt.GlyphIndex) (min, max Point, ok bool) {
+	// Clip records are sorted by glyph, and their runs don't overlap
+	i := sort.Search(len(f.clips), func(i int) bool { return f.clips[i].end >= x })
+	if i < len(f.clips) && f.clips[i].start <= x {
+		return f.clips[i].min, f.clips[i].max, true
+	}
+	return Point{}, Point{}, false
+}
+
+func (f *Font) findBaseGlyph(x sfnt.GlyphIndex) (baseGlyph, bool) {
+	i := sort.Search(len(f.baseGlyphs), func(i int) bool { return f.baseGlyphs[i].glyph >= x })
+	if i < len(f.baseGlyphs) && f.baseGlyphs[i].glyph == x {
+		return f.baseGlyphs[i], true
+	}
+	return baseGlyph{}, false
+}
+
+// Layers returns the layers of color glyph x, in the order they are drawn, with colors from the given palette.
+// foreground is the color the rest of the text is drawn in, which some layers use instead of a palette entry. It
+// returns nil if x isn't a color glyph. A glyph with a version 1 paint graph is drawn from that, in preference to any
+// version 0 layers.
+func (f *Font) Layers(x sfnt.GlyphIndex, palette int, foreground Color) ([]Layer, error) {
+	if palette < 0 || (palette >= len(f.palettes) && len(f.palettes) > 0) {
+		return nil, fmt.Errorf("colr: font has no palette %d", palette)
+	}
+	fl := &flattener{f: f, foreground: foreground}
+	if len(f.palettes) > 0 {
+		fl.palette = f.palettes[palette]
+	}
+
+	if offset, ok := f.basePaints[x]; ok {
+		if err := fl.paint(offset, identity, nil, 0); err != nil {
+			return nil, err
+		}
+		return fl.layers, nil
+	}
+
+	base, ok := f.findBaseGlyph(x)
+	if !ok {
+		return nil, nil
+	}
+	for _, l := range f.layers[base.firstLayer : base.firstLayer+base.numLayers] {
+		c, err := fl.color(l.paletteIndex, 1)
+		if err != nil {
+			return nil, err
+		}
+		fl.layers = append(fl.layers, Layer{
+			Glyph:     l.glyph,
+			Transform: identity,
+			Paint:     Paint{Kind: PaintSolid, Color: c, Transform: identity},
+		})
+	}
+	return fl.layers, nil
+}
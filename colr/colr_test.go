@@ -0,0 +1,435 @@
+package colr
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/bbredesen/ttf-renderer/internal/otbuild"
+	"golang.org/x/image/font/sfnt"
+)
+
+// Palette entries of testPalettes
+var (
+	red     = Color{1, 0, 0, 1}
+	green   = Color{0, 1, 0, 1}
+	blue    = Color{0, 0, 1, 128.0 / 255}
+	magenta = Color{1, 0, 1, 1}
+	white   = Color{1, 1, 1, 1}
+)
+
+// testPalettes is a CPAL table with two palettes of three colors: red, green and translucent blue, then yellow, cyan
+// and magenta. Records are stored blue first.
+func testPalettes() []byte {
+	return otbuild.Table{
+		0, 3, 2, 6, otbuild.Offset32{[]byte{
+			0, 0, 255, 255, 0, 255, 0, 255, 255, 0, 0, 128,
+			0, 255, 255, 255, 255, 255, 0, 255, 255, 0, 255, 255,
+		}},
+		0, 3,
+	}.Bytes()
+}
+
+func solid(index int, alpha float32) otbuild.Table {
+	return otbuild.Table{uint8(formatSolid), index, otbuild.F2Dot14(alpha)}
+}
+
+func glyph(g int, paint otbuild.Table) otbuild.Table {
+	return otbuild.Table{uint8(formatGlyph), otbuild.Offset24(paint), g}
+}
+
+// colorLine returns a color line from red at 0 to palette entry 2 at 1.
+func colorLine(extend int) otbuild.Table {
+	return otbuild.Table{uint8(extend), 2, 0, 0, otbuild.F2Dot14(1), otbuild.F2Dot14(1), 2, otbuild.F2Dot14(1)}
+}
+
+// testFontV1 has three base glyphs with paint graphs. Glyph 30 has a layer for each of the paints in its layer list;
+// glyph 31 is drawn as itself, without end; glyph 32 fills glyph 9 with palette entry 2.
+func testFontV1() []byte {
+	layers := []otbuild.Table{
+		glyph(5, solid(1, 0.5)),
+		{uint8(formatTranslate), otbuild.Offset24(glyph(6, otbuild.Table{
+			uint8(formatLinearGradient), otbuild.Offset24(colorLine(int(ExtendRepeat))), 0, 0, 100, 100, 0, 100,
+		})), 10, 20},
+		{
+			uint8(formatComposite),
+			otbuild.Offset24(glyph(7, solid(0, 1))),
+			uint8(CompositeDestOver),
+			otbuild.Offset24{uint8(formatRotateAroundCenter), otbuild.Offset24(glyph(8, otbuild.Table{
+				uint8(formatRadialGradient), otbuild.Offset24(colorLine(int(ExtendPad))), 0, 0, 0, 0, 0, 100,
+			})), otbuild.F2Dot14(0.5), 100, 0},
+		},
+		{
+			uint8(formatTransform),
+			otbuild.Offset24{uint8(formatColrGlyph), 32},
+			otbuild.Offset24{
+				otbuild.Fixed(2), otbuild.Fixed(0), otbuild.Fixed(0), otbuild.Fixed(2), otbuild.Fixed(0), otbuild.Fixed(0),
+			},
+		},
+		{uint8(formatSkew), otbuild.Offset24(glyph(4, otbuild.Table{
+			uint8(formatSweepGradient), otbuild.Offset24(colorLine(int(ExtendReflect))), 50, 50, 0, otbuild.F2Dot14(1),
+		})), otbuild.F2Dot14(0.25), 0},
+	}
+
+	layerList := otbuild.Table{uint32(len(layers))}
+	for _, l := range layers {
+		layerList = append(layerList, otbuild.Offset32(l))
+	}
+
+	baseGlyphList := otbuild.Table{
+		uint32(3),
+		30, otbuild.Offset32{uint8(formatColrLayers), uint8(len(layers)), uint32(0)},
+		31, otbuild.Offset32{uint8(formatColrGlyph), 31},
+		32, otbuild.Offset32(glyph(9, solid(2, 1))),
+	}
+
+	return otbuild.Table{
+		1, 0, uint32(0), uint32(0), 0,
+		otbuild.Offset32(baseGlyphList), otbuild.Offset32(layerList), uint32(0), uint32(0), uint32(0),
+	}.Bytes()
+}
+
+func near(a, b float32) bool {
+	return math.Abs(float64(a-b)) < 1e-4
+}
+
+func nearColor(a, b Color) bool {
+	return near(a.R, b.R) && near(a.G, b.G) && near(a.B, b.B) && near(a.A, b.A)
+}
+
+func nearAffine(a, b Affine) bool {
+	return near(a.XX, b.XX) && near(a.YX, b.YX) && near(a.XY, b.XY) && near(a.YY, b.YY) && near(a.DX, b.DX) &&
+		near(a.DY, b.DY)
+}
+
+func TestCPAL(t *testing.T) {
+	palettes, err := parseCPAL(testPalettes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(palettes) != 2 || len(palettes[0]) != 3 || len(palettes[1]) != 3 {
+		t.Fatalf("got palettes %v", palettes)
+	}
+	for i, want := range []Color{red, green, blue} {
+		if got := palettes[0][i]; got != want {
+			t.Errorf("palette 0 entry %d is %v, want %v", i, got, want)
+		}
+	}
+	if got := palettes[1][2]; got != magenta {
+		t.Errorf("palette 1 entry 2 is %v, want %v", got, magenta)
+	}
+}
+
+func TestLayersV0(t *testing.T) {
+	colr := otbuild.Table{
+		0, 2,
+		otbuild.Offset32{10, 0, 2, 20, 2, 1},
+		otbuild.Offset32{5, 0, 6, foregroundIndex, 7, 2},
+		3,
+	}.Bytes()
+	f, err := Parse(colr, testPalettes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.NumPalettes() != 2 || !f.HasGlyph(10) || !f.HasGlyph(20) || f.HasGlyph(11) {
+		t.Fatalf("got %d palettes; glyphs 10, 20 and 11 are color glyphs: %v %v %v", f.NumPalettes(), f.HasGlyph(10),
+			f.HasGlyph(20), f.HasGlyph(11))
+	}
+
+	layers, err := f.Layers(10, 0, white)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(layers) != 2 || layers[0].Glyph != 5 || layers[0].Paint.Color != red || layers[1].Glyph != 6 ||
+		layers[1].Paint.Color != white {
+		t.Errorf("got layers %+v", layers)
+	}
+
+	if layers, _ := f.Layers(20, 1, white); len(layers) != 1 || layers[0].Paint.Color != magenta {
+		t.Errorf("got layers %+v in palette 1", layers)
+	}
+	if layers, err := f.Layers(11, 0, white); layers != nil || err != nil {
+		t.Errorf("glyph 11 has layers %+v, %v", layers, err)
+	}
+	if _, err := f.Layers(10, 2, white); err == nil {
+		t.Error("drew in a palette the font doesn't have")
+	}
+}
+
+func TestLayersV1(t *testing.T) {
+	f, err := Parse(testFontV1(), testPalettes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	layers, err := f.Layers(30, 0, white)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type want struct {
+		glyph     int
+		transform Affine
+		kind      PaintKind
+	}
+	rotated := Affine{XX: 0, YX: 1, XY: -1, YY: 0, DX: 100, DY: -100}
+	for i, w := range []want{
+		{5, identity, PaintSolid},
+		{6, translate(10, 20), PaintLinearGradient},
+		// The composite draws its backdrop over its source
+		{7, identity, PaintSolid},
+		{8, rotated, PaintRadialGradient},
+		{9, Affine{XX: 2, YY: 2}, PaintSolid},
+		{4, Affine{XX: 1, XY: -1, YY: 1}, PaintSweepGradient},
+	} {
+		if i >= len(layers) {
+			t.Fatalf("got %d layers, want 6", len(layers))
+		}
+		l := layers[i]
+		if int(l.Glyph) != w.glyph || !nearAffine(l.Transform, w.transform) || l.Paint.Kind != w.kind {
+			t.Errorf("layer %d is glyph %d at %+v, paint %d; want %+v", i, l.Glyph, l.Transform, l.Paint.Kind, w)
+		}
+		if !nearAffine(l.Paint.Transform, w.transform) {
+			t.Errorf("paint of layer %d is at %+v, want %+v", i, l.Paint.Transform, w.transform)
+		}
+	}
+
+	if c := layers[0].Paint.Color; c != (Color{0, 1, 0, 0.5}) {
+		t.Errorf("solid paint is %v, want half transparent green", c)
+	}
+	// The third point turns the gradient from diagonal to horizontal
+	linear := layers[1].Paint
+	if linear.P0 != (Point{0, 0}) || linear.P1 != (Point{100, 0}) || linear.Extend != ExtendRepeat {
+		t.Errorf("linear gradient from %v to %v, extend %d", linear.P0, linear.P1, linear.Extend)
+	}
+	if len(linear.Stops) != 2 || linear.Stops[0] != (ColorStop{0, red}) || linear.Stops[1] != (ColorStop{1, blue}) {
+		t.Errorf("got color line %+v", linear.Stops)
+	}
+	if radial := layers[3].Paint; radial.R0 != 0 || radial.R1 != 100 {
+		t.Errorf("radial gradient has radii %v and %v", radial.R0, radial.R1)
+	}
+	// Sweep angles are biased by half a turn, so 0 and 1 are 180 and 360 degrees
+	if sweep := layers[5].Paint; sweep.P0 != (Point{50, 50}) || sweep.StartAngle != 180 || sweep.EndAngle != 360 ||
+		sweep.Extend != ExtendReflect {
+		t.Errorf("got sweep gradient %+v", sweep)
+	}
+
+	if _, err := f.Layers(31, 0, white); err == nil {
+		t.Error("drew a glyph whose paint graph refers to itself")
+	}
+}
+
+// paintFont returns a COLR version 1 table whose base glyphs 20, 21 and on are drawn by each of paints in turn.
+func paintFont(paints ...otbuild.Table) []byte {
+	baseGlyphList := otbuild.Table{uint32(len(paints))}
+	for i, p := range paints {
+		baseGlyphList = append(baseGlyphList, 20+i, otbuild.Offset32(p))
+	}
+	return otbuild.Table{
+		1, 0, uint32(0), uint32(0), 0,
+		otbuild.Offset32(baseGlyphList), uint32(0), uint32(0), uint32(0), uint32(0),
+	}.Bytes()
+}
+
+func composite(mode CompositeMode, source, backdrop otbuild.Table) otbuild.Table {
+	return otbuild.Table{uint8(formatComposite), otbuild.Offset24(source), uint8(mode), otbuild.Offset24(backdrop)}
+}
+
+// TestComposite flattens the composite modes that only choose which of the source and backdrop are drawn, and in
+// what order.
+func TestComposite(t *testing.T) {
+	source, backdrop := glyph(1, solid(0, 1)), glyph(2, solid(1, 1))
+	modes := []CompositeMode{CompositeClear, CompositeSrc, CompositeDest, CompositeSrcOver, CompositeDestOver}
+	paints := make([]otbuild.Table, len(modes))
+	for i, mode := range modes {
+		paints[i] = composite(mode, source, backdrop)
+	}
+	f, err := Parse(paintFont(paints...), testPalettes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, want := range [][]int{{}, {1}, {2}, {2, 1}, {1, 2}} {
+		layers, err := f.Layers(sfnt.GlyphIndex(20+i), 0, white)
+		if err != nil {
+			t.Fatalf("mode %d: %v", modes[i], err)
+		}
+		var got []int
+		for _, l := range layers {
+			got = append(got, int(l.Glyph))
+		}
+		if len(got) != len(want) || (len(got) > 0 && !reflect.DeepEqual(got, want)) {
+			t.Errorf("mode %d draws glyphs %v, want %v", modes[i], got, want)
+		}
+	}
+}
+
+// TestGroups flattens the composites that can't be drawn one layer over another into groups.
+func TestGroups(t *testing.T) {
+	source, backdrop := glyph(1, solid(0, 1)), glyph(2, solid(1, 1))
+	f, err := Parse(paintFont(
+		composite(CompositeMultiply, source, backdrop),
+		// An order-only composite inside a group is flattened into it
+		composite(CompositeXor, composite(CompositeSrcOver, source, backdrop), backdrop),
+		glyph(1, glyph(2, solid(0, 1))),
+		solid(0, 1),
+	), testPalettes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	glyphs := func(layers []Layer) []int {
+		got := []int{}
+		for _, l := range layers {
+			got = append(got, int(l.Glyph))
+		}
+		return got
+	}
+
+	for i, want := range []struct {
+		mode             CompositeMode
+		source, backdrop []int
+	}{
+		{CompositeMultiply, []int{1}, []int{2}},
+		{CompositeXor, []int{2, 1}, []int{2}},
+		// The inner glyph is the source, kept inside the outer one
+		{CompositeSrcIn, []int{2}, []int{1}},
+	} {
+		layers, err := f.Layers(sfnt.GlyphIndex(20+i), 0, white)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(layers) != 1 || layers[0].Composite == nil {
+			t.Fatalf("glyph %d has layers %+v, want one group", 20+i, layers)
+		}
+		g := layers[0].Composite
+		if g.Mode != want.mode || !reflect.DeepEqual(glyphs(g.Source), want.source) ||
+			!reflect.DeepEqual(glyphs(g.Backdrop), want.backdrop) {
+			t.Errorf("glyph %d: got mode %d, source %v, backdrop %v; want %+v", 20+i, g.Mode, glyphs(g.Source),
+				glyphs(g.Backdrop), want)
+		}
+	}
+
+	layers, err := f.Layers(22, 0, white)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mask := layers[0].Composite.Backdrop[0].Paint; mask.Kind != PaintSolid || mask.Color != white {
+		t.Errorf("outer glyph is filled with %+v, want opaque white", mask)
+	}
+
+	layers, err = f.Layers(23, 0, white)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(layers) != 1 || !layers[0].Unclipped || layers[0].Paint.Color != red {
+		t.Errorf("got layers %+v, want one unclipped red fill", layers)
+	}
+}
+
+func TestClipBox(t *testing.T) {
+	box := func(xMin, yMin, xMax, yMax int) otbuild.Offset24 {
+		return otbuild.Offset24{uint8(1), xMin, yMin, xMax, yMax}
+	}
+	clipList := otbuild.Table{
+		uint8(1), uint32(2),
+		20, 21, box(-10, -20, 100, 200),
+		30, 30, box(0, 0, 50, 50),
+	}
+	colr := otbuild.Table{
+		1, 0, uint32(0), uint32(0), 0,
+		uint32(0), uint32(0), otbuild.Offset32(clipList), uint32(0), uint32(0),
+	}.Bytes()
+	f, err := Parse(colr, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		glyph    sfnt.GlyphIndex
+		min, max Point
+		ok       bool
+	}{
+		{19, Point{}, Point{}, false},
+		{20, Point{-10, -20}, Point{100, 200}, true},
+		{21, Point{-10, -20}, Point{100, 200}, true},
+		{25, Point{}, Point{}, false},
+		{30, Point{0, 0}, Point{50, 50}, true},
+		{31, Point{}, Point{}, false},
+	} {
+		min, max, ok := f.ClipBox(test.glyph)
+		if min != test.min || max != test.max || ok != test.ok {
+			t.Errorf("glyph %d has clip box %v, %v, %v; want %+v", test.glyph, min, max, ok, test)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	halfRed := Color{0.5, 0, 0, 0.5}
+	opaqueBlue := Color{0, 0, 1, 1}
+	gray := Color{0.5, 0.5, 0.5, 1}
+	for _, test := range []struct {
+		mode             CompositeMode
+		source, backdrop Color
+		want             Color
+	}{
+		{CompositeClear, halfRed, opaqueBlue, Color{}},
+		{CompositeSrcOver, halfRed, opaqueBlue, Color{0.5, 0, 0.5, 1}},
+		{CompositeSrcIn, halfRed, Color{0, 0, 0, 0.5}, Color{0.25, 0, 0, 0.25}},
+		{CompositeDestOut, halfRed, opaqueBlue, Color{0, 0, 0.5, 0.5}},
+		{CompositeXor, halfRed, opaqueBlue, Color{0, 0, 0.5, 0.5}},
+		{CompositePlus, halfRed, Color{0.75, 0, 0, 0.75}, Color{1, 0, 0, 1}},
+		{CompositeMultiply, red, gray, Color{0.5, 0, 0, 1}},
+		{CompositeScreen, red, gray, Color{1, 0.5, 0.5, 1}},
+		{CompositeDifference, white, gray, Color{0.5, 0.5, 0.5, 1}},
+		{CompositeDarken, red, gray, Color{0.5, 0, 0, 1}},
+		{CompositeLighten, red, gray, Color{1, 0.5, 0.5, 1}},
+		{CompositeHSLLuminosity, white, red, white},
+		{CompositeHSLColor, gray, red, Color{0.3, 0.3, 0.3, 1}},
+		// Where the backdrop is transparent, a blend mode leaves the source as is
+		{CompositeMultiply, halfRed, Color{}, halfRed},
+		{CompositeHSLHue, halfRed, Color{}, halfRed},
+	} {
+		if got := test.mode.Apply(test.source, test.backdrop); !nearColor(got, test.want) {
+			t.Errorf("mode %d of %v over %v is %v, want %v", test.mode, test.source, test.backdrop, got, test.want)
+		}
+	}
+}
+
+func TestPaintAt(t *testing.T) {
+	opaqueBlue := Color{0, 0, 1, 1}
+	line := []ColorStop{{0, red}, {1, opaqueBlue}}
+	linear := func(extend Extend) Paint {
+		return Paint{Kind: PaintLinearGradient, Stops: line, Extend: extend, P1: Point{100, 0}, Transform: identity}
+	}
+	mid := Color{0.5, 0, 0.5, 1}
+	quarter := Color{0.75, 0, 0.25, 1}
+
+	for _, test := range []struct {
+		name  string
+		paint Paint
+		x, y  float32
+		want  Color
+	}{
+		{"linear", linear(ExtendPad), 50, 77, mid},
+		{"linear pad before", linear(ExtendPad), -10, 0, red},
+		{"linear pad after", linear(ExtendPad), 150, 0, opaqueBlue},
+		{"linear repeat", linear(ExtendRepeat), 125, 0, quarter},
+		{"linear reflect", linear(ExtendReflect), 175, 0, quarter},
+		{"linear reflect before", linear(ExtendReflect), -25, 0, quarter},
+		{"translated", Paint{Kind: PaintLinearGradient, Stops: line, P1: Point{100, 0}, Transform: translate(10, 20)},
+			60, 0, mid},
+		{"radial", Paint{Kind: PaintRadialGradient, Stops: line, R1: 100, Transform: identity}, 0, 50, mid},
+		{"radial outside", Paint{Kind: PaintRadialGradient, Stops: line, R1: 100, Transform: identity}, 0, 200,
+			opaqueBlue},
+		{"sweep", Paint{Kind: PaintSweepGradient, Stops: line, EndAngle: 360, Transform: identity}, 0, 10, quarter},
+		{"premultiplied", Paint{Kind: PaintLinearGradient, Stops: []ColorStop{{0, red}, {1, Color{0, 0, 1, 0}}},
+			P1: Point{100, 0}, Transform: identity}, 50, 0, Color{0.5, 0, 0, 0.5}},
+		// The color line repeats between its own first and last stops
+		{"inner stops", Paint{Kind: PaintLinearGradient, Stops: []ColorStop{{0.25, red}, {0.75, opaqueBlue}},
+			Extend: ExtendRepeat, P1: Point{100, 0}, Transform: identity}, 80, 0, Color{0.9, 0, 0.1, 1}},
+		{"solid", Paint{Kind: PaintSolid, Color: blue}, 0, 0, blue.Premultiplied()},
+	} {
+		if got := test.paint.At(test.x, test.y); !nearColor(got, test.want) {
+			t.Errorf("%s: At(%v, %v) = %v, want %v", test.name, test.x, test.y, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package colr
+
+import "math"
+
+// Composite is a PaintComposite that can't be flattened into layers drawn one over another: its source and backdrop
+// are each drawn on their own, over transparent black, and then combined with Mode into a single layer.
+type Composite struct {
+	Mode             CompositeMode
+	Source, Backdrop []Layer
+}
+
+// Apply combines the premultiplied colors source and backdrop with m, and returns the premultiplied result. Renderers
+// that composite in a shader should match it. The Porter-Duff operators weigh each color by the other's alpha, and
+// plus adds them; the blend modes mix the colors where both are opaque, and are source over where only one is.
+func (m CompositeMode) Apply(source, backdrop Color) Color {
+	as, ab := source.A, backdrop.A
+	var fs, fb float32
+	switch m {
+	case CompositeClear:
+		return Color{}
+	case CompositeSrc:
+		fs, fb = 1, 0
+	case CompositeDest:
+		fs, fb = 0, 1
+	case CompositeSrcOver:
+		fs, fb = 1, 1-as
+	case CompositeDestOver:
+		fs, fb = 1-ab, 1
+	case CompositeSrcIn:
+		fs, fb = ab, 0
+	case CompositeDestIn:
+		fs, fb = 0, as
+	case CompositeSrcOut:
+		fs, fb = 1-ab, 0
+	case CompositeDestOut:
+		fs, fb = 0, 1-as
+	case CompositeSrcAtop:
+		fs, fb = ab, 1-as
+	case CompositeDestAtop:
+		fs, fb = 1-ab, as
+	case CompositeXor:
+		fs, fb = 1-ab, 1-as
+	case CompositePlus:
+		return Color{
+			min32(source.R+backdrop.R, 1), min32(source.G+backdrop.G, 1), min32(source.B+backdrop.B, 1),
+			min32(as+ab, 1),
+		}
+	default:
+		return m.blend(source, backdrop)
+	}
+	return Color{
+		source.R*fs + backdrop.R*fb, source.G*fs + backdrop.G*fb, source.B*fs + backdrop.B*fb, as*fs + ab*fb,
+	}
+}
+
+// blend applies one of the blend modes, from screen on.
+func (m CompositeMode) blend(source, backdrop Color) Color {
+	as, ab := source.A, backdrop.A
+	cs, cb := unpremultiply(source), unpremultiply(backdrop)
+
+	var mixed [3]float32
+	switch m {
+	case CompositeHSLHue:
+		mixed = setLum(setSat(cs, sat(cb)), lum(cb))
+	case CompositeHSLSaturation:
+		mixed = setLum(setSat(cb, sat(cs)), lum(cb))
+	case CompositeHSLColor:
+		mixed = setLum(cs, lum(cb))
+	case CompositeHSLLuminosity:
+		mixed = setLum(cb, lum(cs))
+	default:
+		for i := range mixed {
+			mixed[i] = m.blendChannel(cs[i], cb[i])
+		}
+	}
+
+	// Where only one of the two is opaque, that one shows through as is
+	channel := func(s, b, mixed float32) float32 {
+		return (1-ab)*s + (1-as)*b + as*ab*mixed
+	}
+	return Color{
+		channel(source.R, backdrop.R, mixed[0]),
+		channel(source.G, backdrop.G, mixed[1]),
+		channel(source.B, backdrop.B, mixed[2]),
+		as + ab - as*ab,
+	}
+}
+
+// blendChannel mixes one channel of the unpremultiplied source s over the backdrop b with a separable blend mode.
+func (m CompositeMode) blendChannel(s, b float32) float32 {
+	switch m {
+	case CompositeScreen:
+		return b + s - b*s
+	case CompositeOverlay:
+		return CompositeHardLight.blendChannel(b, s)
+	case CompositeDarken:
+		return min32(s, b)
+	case CompositeLighten:
+		return max32(s, b)
+	case CompositeColorDodge:
+		switch {
+		case b == 0:
+			return 0
+		case s >= 1:
+			return 1
+		}
+		return min32(1, b/(1-s))
+	case CompositeColorBurn:
+		switch {
+		case b >= 1:
+			return 1
+		case s <= 0:
+			return 0
+		}
+		return 1 - min32(1, (1-b)/s)
+	case CompositeHardLight:
+		if s <= 0.5 {
+			return b * 2 * s
+		}
+		return CompositeScreen.blendChannel(2*s-1, b)
+	case CompositeSoftLight:
+		if s <= 0.5 {
+			return b - (1-2*s)*b*(1-b)
+		}
+		d := float32(math.Sqrt(float64(b)))
+		if b <= 0.25 {
+			d = ((16*b-12)*b + 4) * b
+		}
+		return b + (2*s-1)*(d-b)
+	case CompositeDifference:
+		return float32(math.Abs(float64(b - s)))
+	case CompositeExclusion:
+		return b + s - 2*b*s
+	case CompositeMultiply:
+		return b * s
+	}
+	return s
+}
+
+func unpremultiply(c Color) [3]float32 {
+	if c.A == 0 {
+		return [3]float32{}
+	}
+	return [3]float32{c.R / c.A, c.G / c.A, c.B / c.A}
+}
+
+// lum, setLum, clipColor, sat and setSat are the helpers of the non-separable blend modes, as defined by the W3C's
+// Compositing and Blending.
+func lum(c [3]float32) float32 {
+	return 0.3*c[0] + 0.59*c[1] + 0.11*c[2]
+}
+
+func setLum(c [3]float32, l float32) [3]float32 {
+	d := l - lum(c)
+	return clipColor([3]float32{c[0] + d, c[1] + d, c[2] + d})
+}
+
+func clipColor(c [3]float32) [3]float32 {
+	l := lum(c)
+	n := min32(c[0], min32(c[1], c[2]))
+	x := max32(c[0], max32(c[1], c[2]))
+	for i := range c {
+		if n < 0 {
+			c[i] = l + (c[i]-l)*l/(l-n)
+		}
+		if x > 1 {
+			c[i] = l + (c[i]-l)*(1-l)/(x-l)
+		}
+	}
+	return c
+}
+
+func sat(c [3]float32) float32 {
+	return max32(c[0], max32(c[1], c[2])) - min32(c[0], min32(c[1], c[2]))
+}
+
+// setSat scales c so that its greatest channel is s more than its least, which becomes 0.
+func setSat(c [3]float32, s float32) [3]float32 {
+	n := min32(c[0], min32(c[1], c[2]))
+	x := max32(c[0], max32(c[1], c[2]))
+	if x <= n {
+		return [3]float32{}
+	}
+	for i := range c {
+		c[i] = (c[i] - n) * s / (x - n)
+	}
+	return c
+}
+
+func min32(a, b float32) float32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max32(a, b float32) float32 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,48 @@
+package colr
+
+// Color is a non-premultiplied RGBA color, with each component from 0 to 1. Colors are in sRGB, as stored in CPAL.
+type Color struct {
+	R, G, B, A float32
+}
+
+// Premultiplied returns c with its color components multiplied by its alpha.
+func (c Color) Premultiplied() Color {
+	return Color{c.R * c.A, c.G * c.A, c.B * c.A, c.A}
+}
+
+// foregroundIndex is the palette index that stands for the color the text is drawn in, rather than a palette entry.
+const foregroundIndex = 0xFFFF
+
+// parseCPAL reads every palette of a CPAL table. Palettes may share color records, but each is returned as its own
+// slice of numPaletteEntries colors.
+func parseCPAL(cpal []byte) ([][]Color, error) {
+	r := newReader(cpal)
+	r.Skip(2) // version
+	numEntries := int(r.U16())
+	numPalettes := int(r.U16())
+	numRecords := int(r.U16())
+	recordsOffset := int(r.U32())
+	if r.Err() != nil {
+		return nil, r.Err()
+	}
+
+	records := r.At(recordsOffset)
+	colors := make([]Color, numRecords)
+	for i := range colors {
+		b, g, red, a := records.U8(), records.U8(), records.U8(), records.U8()
+		colors[i] = Color{float32(red) / 255, float32(g) / 255, float32(b) / 255, float32(a) / 255}
+	}
+	if records.Err() != nil {
+		return nil, records.Err()
+	}
+
+	palettes := make([][]Color, numPalettes)
+	for i := range palettes {
+		first := int(r.U16())
+		if first+numEntries > numRecords {
+			return nil, ErrInvalidTable
+		}
+		palettes[i] = colors[first : first+numEntries]
+	}
+	return palettes, r.Err()
+}
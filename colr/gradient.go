@@ -0,0 +1,144 @@
+package colr
+
+import "math"
+
+// At returns the premultiplied color of p at (x, y) in the color glyph's space. Renderers that evaluate paints
+// themselves, such as in a shader, should match it: gradients are interpolated between premultiplied colors, and
+// extend past their color line in the stops' own range, not in 0 to 1.
+func (p *Paint) At(x, y float32) Color {
+	if p.Kind == PaintSolid {
+		return p.Color.Premultiplied()
+	}
+
+	inv, ok := p.Transform.Invert()
+	if !ok {
+		return Color{}
+	}
+	q := inv.Apply(Point{x, y})
+
+	var t float32
+	switch p.Kind {
+	case PaintLinearGradient:
+		dx, dy := p.P1.X-p.P0.X, p.P1.Y-p.P0.Y
+		d2 := dx*dx + dy*dy
+		if d2 == 0 {
+			return Color{}
+		}
+		t = ((q.X-p.P0.X)*dx + (q.Y-p.P0.Y)*dy) / d2
+
+	case PaintRadialGradient:
+		if t, ok = radialOffset(p, q); !ok {
+			return Color{}
+		}
+
+	case PaintSweepGradient:
+		angle := float32(math.Atan2(float64(q.Y-p.P0.Y), float64(q.X-p.P0.X)) * 180 / math.Pi)
+		if angle < 0 {
+			angle += 360
+		}
+		switch {
+		case p.EndAngle != p.StartAngle:
+			t = (angle - p.StartAngle) / (p.EndAngle - p.StartAngle)
+		case angle >= p.StartAngle:
+			t = 1
+		}
+	}
+
+	return p.colorAt(t)
+}
+
+// radialOffset returns the offset along the color line of point q of a radial gradient: the largest t whose circle,
+// interpolated between the start and end circles, passes through q with a radius that isn't negative. It returns false
+// if there is no such circle.
+func radialOffset(p *Paint, q Point) (float32, bool) {
+	// Solve |q - c(t)| = r(t), where c(t) = c0 + t*cd and r(t) = r0 + t*dr, as a*t^2 - 2*b*t + c = 0
+	cdx, cdy, dr := p.P1.X-p.P0.X, p.P1.Y-p.P0.Y, p.R1-p.R0
+	qx, qy := q.X-p.P0.X, q.Y-p.P0.Y
+	a := cdx*cdx + cdy*cdy - dr*dr
+	b := qx*cdx + qy*cdy + p.R0*dr
+	c := qx*qx + qy*qy - p.R0*p.R0
+
+	valid := func(t float32) bool { return p.R0+t*dr >= 0 }
+
+	if a == 0 {
+		// The circles grow as fast as they move, leaving one solution
+		if b == 0 {
+			return 0, false
+		}
+		t := c / (2 * b)
+		return t, valid(t)
+	}
+
+	disc := b*b - a*c
+	if disc < 0 {
+		return 0, false
+	}
+	root := float32(math.Sqrt(float64(disc)))
+	t0, t1 := (b+root)/a, (b-root)/a
+	if t0 < t1 {
+		t0, t1 = t1, t0
+	}
+	switch {
+	case valid(t0):
+		return t0, true
+	case valid(t1):
+		return t1, true
+	}
+	return 0, false
+}
+
+// colorAt returns the premultiplied color of the color line at offset t, extended past its first and last stops.
+func (p *Paint) colorAt(t float32) Color {
+	stops := p.Stops
+	if len(stops) == 0 {
+		return Color{}
+	}
+
+	first, last := stops[0].Offset, stops[len(stops)-1].Offset
+	if last > first {
+		u := p.Extend.apply((t - first) / (last - first))
+		t = first + u*(last-first)
+	}
+
+	if t <= first {
+		return stops[0].Color.Premultiplied()
+	}
+	for k := 1; k < len(stops); k++ {
+		s0, s1 := stops[k-1], stops[k]
+		if t < s1.Offset {
+			f := (t - s0.Offset) / (s1.Offset - s0.Offset)
+			return lerp(s0.Color.Premultiplied(), s1.Color.Premultiplied(), f)
+		}
+	}
+	return stops[len(stops)-1].Color.Premultiplied()
+}
+
+// apply maps a position along the color line, where 0 and 1 are its first and last stops, into that range.
+func (e Extend) apply(u float32) float32 {
+	switch e {
+	case ExtendRepeat:
+		return u - float32(math.Floor(float64(u)))
+	case ExtendReflect:
+		u -= 2 * float32(math.Floor(float64(u)/2))
+		if u > 1 {
+			u = 2 - u
+		}
+		return u
+	}
+	if u < 0 {
+		return 0
+	}
+	if u > 1 {
+		return 1
+	}
+	return u
+}
+
+func lerp(a, b Color, f float32) Color {
+	return Color{
+		a.R + (b.R-a.R)*f,
+		a.G + (b.G-a.G)*f,
+		a.B + (b.B-a.B)*f,
+		a.A + (b.A-a.A)*f,
+	}
+}
@@ -0,0 +1,492 @@
+package colr
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"golang.org/x/image/font/sfnt"
+)
+
+// Layer is one layer of a color glyph: the outline of Glyph, moved by Transform, filled with Paint. Each layer is drawn
+// over the layers before it, as source over.
+//
+// A layer with Unclipped set isn't clipped to a glyph, and fills the whole color glyph instead: its clip box, if
+// Font.ClipBox has one, or else as much of it as the renderer draws. A layer with a Composite is a group of layers,
+// composited on their own before the result is drawn over the layers before it. Glyph and Transform are unused by
+// both.
+//
+// Coordinates are in font units with the Y axis pointing up, as in the font. Note that sfnt and package ttf return
+// outlines with the Y axis pointing down, so they must be flipped before Transform applies.
+type Layer struct {
+	Glyph     sfnt.GlyphIndex
+	Transform Affine
+	Paint     Paint
+
+	Unclipped bool
+	Composite *Composite
+}
+
+// PaintKind is the kind of fill of a Paint.
+type PaintKind uint8
+
+const (
+	PaintSolid PaintKind = iota
+	PaintLinearGradient
+	PaintRadialGradient
+	PaintSweepGradient
+)
+
+// Paint fills a layer with a solid color or a gradient. Every color already includes the alpha of the paint, or of
+// the color stop, that it came from.
+type Paint struct {
+	Kind PaintKind
+
+	// Color is the color of a solid paint.
+	Color Color
+
+	// Stops is the color line of a gradient, sorted by offset, and Extend how it continues past its first and last
+	// stops.
+	Stops  []ColorStop
+	Extend Extend
+
+	// P0 and P1 are the start and end of a linear gradient, whose color is constant along lines perpendicular to
+	// P0P1; the font's third point, which sets that angle, has already been applied. For a radial gradient, they are
+	// the centers of its start and end circles, whose radii are R0 and R1. P0 is the center of a sweep gradient, which
+	// goes counter-clockwise from StartAngle to EndAngle, in degrees.
+	P0, P1               Point
+	R0, R1               float32
+	StartAngle, EndAngle float32
+
+	// Transform maps the paint's own space, where the points above are, to the space of the color glyph.
+	Transform Affine
+}
+
+// ColorStop is a color at an offset along a gradient, where 0 is the gradient's start and 1 its end.
+type ColorStop struct {
+	Offset float32
+	Color  Color
+}
+
+// Extend is how a gradient fills the space outside its color line.
+type Extend uint8
+
+const (
+	// ExtendPad continues the colors of the first and last stops.
+	ExtendPad Extend = iota
+	// ExtendRepeat repeats the color line.
+	ExtendRepeat
+	// ExtendReflect repeats the color line, reversing every other repetition.
+	ExtendReflect
+)
+
+// CompositeMode is how a PaintComposite combines its source with its backdrop: one of the Porter-Duff operators, or a
+// blend mode, numbered as in COLR.
+type CompositeMode uint8
+
+const (
+	CompositeClear CompositeMode = iota
+	CompositeSrc
+	CompositeDest
+	CompositeSrcOver
+	CompositeDestOver
+	CompositeSrcIn
+	CompositeDestIn
+	CompositeSrcOut
+	CompositeDestOut
+	CompositeSrcAtop
+	CompositeDestAtop
+	CompositeXor
+	CompositePlus
+	CompositeScreen
+	CompositeOverlay
+	CompositeDarken
+	CompositeLighten
+	CompositeColorDodge
+	CompositeColorBurn
+	CompositeHardLight
+	CompositeSoftLight
+	CompositeDifference
+	CompositeExclusion
+	CompositeMultiply
+	CompositeHSLHue
+	CompositeHSLSaturation
+	CompositeHSLColor
+	CompositeHSLLuminosity
+)
+
+// Point is a position in font units.
+type Point struct {
+	X, Y float32
+}
+
+// Affine is a 2D affine transform, mapping (x, y) to (XX*x + XY*y + DX, YX*x + YY*y + DY), as stored in COLR.
+type Affine struct {
+	XX, YX, XY, YY, DX, DY float32
+}
+
+var identity = Affine{XX: 1, YY: 1}
+
+// Apply returns p transformed by a.
+func (a Affine) Apply(p Point) Point {
+	return Point{a.XX*p.X + a.XY*p.Y + a.DX, a.YX*p.X + a.YY*p.Y + a.DY}
+}
+
+// Mul returns the transform that applies b, then a.
+func (a Affine) Mul(b Affine) Affine {
+	return Affine{
+		XX: a.XX*b.XX + a.XY*b.YX,
+		YX: a.YX*b.XX + a.YY*b.YX,
+		XY: a.XX*b.XY + a.XY*b.YY,
+		YY: a.YX*b.XY + a.YY*b.YY,
+		DX: a.XX*b.DX + a.XY*b.DY + a.DX,
+		DY: a.YX*b.DX + a.YY*b.DY + a.DY,
+	}
+}
+
+// Invert returns the inverse of a, or false if a collapses the plane onto a line or a point.
+func (a Affine) Invert() (Affine, bool) {
+	det := a.XX*a.YY - a.XY*a.YX
+	if det == 0 {
+		return Affine{}, false
+	}
+	inv := Affine{XX: a.YY / det, YX: -a.YX / det, XY: -a.XY / det, YY: a.XX / det}
+	inv.DX = -(inv.XX*a.DX + inv.XY*a.DY)
+	inv.DY = -(inv.YX*a.DX + inv.YY*a.DY)
+	return inv, true
+}
+
+func translate(dx, dy float32) Affine {
+	return Affine{XX: 1, YY: 1, DX: dx, DY: dy}
+}
+
+// aroundCenter returns a applied with (cx, cy) as its origin.
+func aroundCenter(a Affine, cx, cy float32) Affine {
+	return translate(cx, cy).Mul(a).Mul(translate(-cx, -cy))
+}
+
+// Paint table formats. Each variable format follows the format it varies.
+const (
+	formatColrLayers               = 1
+	formatSolid                    = 2
+	formatLinearGradient           = 4
+	formatRadialGradient           = 6
+	formatSweepGradient            = 8
+	formatGlyph                    = 10
+	formatColrGlyph                = 11
+	formatTransform                = 12
+	formatTranslate                = 14
+	formatScale                    = 16
+	formatScaleAroundCenter        = 18
+	formatScaleUniform             = 20
+	formatScaleUniformAroundCenter = 22
+	formatRotate                   = 24
+	formatRotateAroundCenter       = 26
+	formatSkew                     = 28
+	formatSkewAroundCenter         = 30
+	formatComposite                = 32
+)
+
+// maxPaintDepth limits how deeply paints may nest, which also stops a paint graph that refers back to itself.
+const maxPaintDepth = 64
+
+// flattener walks a paint graph, collecting a Layer for every paint that fills a glyph.
+type flattener struct {
+	f          *Font
+	palette    []Color
+	foreground Color
+	layers     []Layer
+}
+
+// clip is the glyph that the paints below a PaintGlyph fill, with the transform in effect where it was set.
+type clip struct {
+	glyph     sfnt.GlyphIndex
+	transform Affine
+}
+
+// paint flattens the paint at offset from the start of COLR. transform maps the paint's space to the color glyph's,
+// and clip is the glyph it fills, if any.
+//
+// The graph is flattened into layers drawn one over another wherever that is exact: a PaintComposite whose mode just
+// chooses which of its source and backdrop are drawn, and in what order, adds their layers in that order. Any other
+// composite becomes a Composite layer, and so does a glyph inside another glyph, which is clipped by both as the
+// source in the outer glyph. Variable paints are drawn at their default values.
+func (fl *flattener) paint(offset int, transform Affine, c *clip, depth int) error {
+	if depth > maxPaintDepth {
+		return fmt.Errorf("colr: paint graph nests more than %d deep", maxPaintDepth)
+	}
+
+	root := newReader(fl.f.colr)
+	r := root.At(offset)
+	p := newReader(r.Data())
+	format := int(r.U8())
+	if r.Err() != nil {
+		return r.Err()
+	}
+	f := format
+	switch {
+	case f == formatColrLayers:
+		n := int(r.U8())
+		first := int(r.U32())
+		if r.Err() != nil || first+n > len(fl.f.layerList) {
+			return ErrInvalidTable
+		}
+		for _, layer := range fl.f.layerList[first : first+n] {
+			if err := fl.paint(layer, transform, c, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case f == formatSolid || f == formatSolid+1:
+		index, alpha := r.U16(), r.F2Dot14()
+		if r.Err() != nil {
+			return r.Err()
+		}
+		color, err := fl.color(index, alpha)
+		if err != nil {
+			return err
+		}
+		return fl.fill(c, Paint{Kind: PaintSolid, Color: color, Transform: transform})
+
+	case f >= formatLinearGradient && f <= formatSweepGradient+1:
+		line := p.At(int(r.U24()))
+		paint := Paint{Transform: transform}
+		switch f &^ 1 {
+		case formatLinearGradient:
+			paint.Kind = PaintLinearGradient
+			p0 := Point{r.FWord(), r.FWord()}
+			p1 := Point{r.FWord(), r.FWord()}
+			p2 := Point{r.FWord(), r.FWord()}
+			paint.P0, paint.P1 = p0, linearEnd(p0, p1, p2)
+		case formatRadialGradient:
+			paint.Kind = PaintRadialGradient
+			paint.P0 = Point{r.FWord(), r.FWord()}
+			paint.R0 = float32(r.U16())
+			paint.P1 = Point{r.FWord(), r.FWord()}
+			paint.R1 = float32(r.U16())
+		default:
+			paint.Kind = PaintSweepGradient
+			paint.P0 = Point{r.FWord(), r.FWord()}
+			// Angles are stored as fractions of half a turn, biased by half a turn so that -1 to 1 covers a whole one
+			paint.StartAngle, paint.EndAngle = 180*(r.F2Dot14()+1), 180*(r.F2Dot14()+1)
+		}
+		if r.Err() != nil {
+			return r.Err()
+		}
+		var err error
+		// The color line of a variable gradient has variable color stops
+		if paint.Stops, paint.Extend, err = fl.colorLine(line, f%2 == 1); err != nil {
+			return err
+		}
+		return fl.fill(c, paint)
+
+	case f == formatGlyph:
+		sub := int(r.U24())
+		glyph := sfnt.GlyphIndex(r.U16())
+		if r.Err() != nil {
+			return r.Err()
+		}
+		inner := &clip{glyph, transform}
+		if c == nil {
+			return fl.paint(offset+sub, transform, inner, depth+1)
+		}
+		// Clipped by both glyphs: the inner glyph's layers are kept where they fall inside an opaque fill of the outer
+		source, err := fl.group(offset+sub, transform, inner, depth+1)
+		if err != nil || len(source) == 0 {
+			return err
+		}
+		mask := Paint{Kind: PaintSolid, Color: Color{1, 1, 1, 1}, Transform: identity}
+		outer := Layer{Glyph: c.glyph, Transform: c.transform, Paint: mask}
+		fl.layers = append(fl.layers, Layer{
+			Transform: identity,
+			Composite: &Composite{Mode: CompositeSrcIn, Source: source, Backdrop: []Layer{outer}},
+		})
+		return nil
+
+	case f == formatColrGlyph:
+		glyph := sfnt.GlyphIndex(r.U16())
+		base, ok := fl.f.basePaints[glyph]
+		if r.Err() != nil || !ok {
+			return ErrInvalidTable
+		}
+		return fl.paint(base, transform, c, depth+1)
+
+	case f == formatTransform || f == formatTransform+1:
+		sub := int(r.U24())
+		m := p.At(int(r.U24()))
+		a := Affine{m.Fixed(), m.Fixed(), m.Fixed(), m.Fixed(), m.Fixed(), m.Fixed()}
+		if r.Err() != nil || m.Err() != nil {
+			return ErrInvalidTable
+		}
+		return fl.paint(offset+sub, transform.Mul(a), c, depth+1)
+
+	case f == formatComposite:
+		source := int(r.U24())
+		compositeMode := CompositeMode(r.U8())
+		backdrop := int(r.U24())
+		if r.Err() != nil || compositeMode > CompositeHSLLuminosity {
+			return ErrInvalidTable
+		}
+		// Each of these modes leaves the source, the backdrop, or both of them drawn one over the other
+		var order []int
+		switch compositeMode {
+		case CompositeClear:
+		case CompositeSrc:
+			order = []int{source}
+		case CompositeDest:
+			order = []int{backdrop}
+		case CompositeSrcOver:
+			order = []int{backdrop, source}
+		case CompositeDestOver:
+			order = []int{source, backdrop}
+		default:
+			return fl.composite(compositeMode, offset+source, offset+backdrop, transform, c, depth+1)
+		}
+		for _, sub := range order {
+			if err := fl.paint(offset+sub, transform, c, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case f >= formatTranslate && f <= formatSkewAroundCenter+1:
+		// The child offset comes first; the transform's parameters follow it
+		sub := int(r.U24())
+		a := fl.simpleTransform(f&^1, r)
+		if r.Err() != nil {
+			return r.Err()
+		}
+		return fl.paint(offset+sub, transform.Mul(a), c, depth+1)
+	}
+
+	return fmt.Errorf("colr: unknown paint format %d", format)
+}
+
+// simpleTransform reads the parameters of the translate, scale, rotate and skew paints, and returns their transform.
+func (fl *flattener) simpleTransform(format int, r *reader) Affine {
+	var a Affine
+	switch format {
+	case formatTranslate:
+		return translate(r.FWord(), r.FWord())
+	case formatScale, formatScaleAroundCenter:
+		sx, sy := r.F2Dot14(), r.F2Dot14()
+		a = Affine{XX: sx, YY: sy}
+	case formatScaleUniform, formatScaleUniformAroundCenter:
+		s := r.F2Dot14()
+		a = Affine{XX: s, YY: s}
+	case formatRotate, formatRotateAroundCenter:
+		// Counter-clockwise, in fractions of half a turn
+		angle := float64(r.F2Dot14()) * math.Pi
+		sin, cos := float32(math.Sin(angle)), float32(math.Cos(angle))
+		a = Affine{XX: cos, YX: sin, XY: -sin, YY: cos}
+	case formatSkew, formatSkewAroundCenter:
+		// Each angle tilts an axis counter-clockwise: x skews the vertical axis, and y the horizontal one
+		x, y := float64(r.F2Dot14())*math.Pi, float64(r.F2Dot14())*math.Pi
+		a = Affine{XX: 1, YX: float32(math.Tan(y)), XY: -float32(math.Tan(x)), YY: 1}
+	}
+	if format == formatScaleAroundCenter || format == formatScaleUniformAroundCenter ||
+		format == formatRotateAroundCenter || format == formatSkewAroundCenter {
+		a = aroundCenter(a, r.FWord(), r.FWord())
+	}
+	return a
+}
+
+// fill adds a layer filling c with paint, or the whole color glyph if c is nil.
+func (fl *flattener) fill(c *clip, paint Paint) error {
+	if c == nil {
+		fl.layers = append(fl.layers, Layer{Transform: identity, Paint: paint, Unclipped: true})
+		return nil
+	}
+	fl.layers = append(fl.layers, Layer{Glyph: c.glyph, Transform: c.transform, Paint: paint})
+	return nil
+}
+
+// composite adds a Composite layer for the paints at source and backdrop, combined with mode.
+func (fl *flattener) composite(mode CompositeMode, source, backdrop int, transform Affine, c *clip, depth int) error {
+	sourceLayers, err := fl.group(source, transform, c, depth)
+	if err != nil {
+		return err
+	}
+	backdropLayers, err := fl.group(backdrop, transform, c, depth)
+	if err != nil {
+		return err
+	}
+	if len(sourceLayers) == 0 && len(backdropLayers) == 0 {
+		return nil
+	}
+	fl.layers = append(fl.layers, Layer{
+		Transform: identity,
+		Composite: &Composite{Mode: mode, Source: sourceLayers, Backdrop: backdropLayers},
+	})
+	return nil
+}
+
+// group flattens the paint at offset as paint does, but returns its layers rather than adding them.
+func (fl *flattener) group(offset int, transform Affine, c *clip, depth int) ([]Layer, error) {
+	layers := fl.layers
+	fl.layers = nil
+	err := fl.paint(offset, transform, c, depth)
+	group := fl.layers
+	fl.layers = layers
+	return group, err
+}
+
+// colorLine reads a color line, or a variable color line, whose stops are larger.
+func (fl *flattener) colorLine(r *reader, variable bool) ([]ColorStop, Extend, error) {
+	extend := Extend(r.U8())
+	n := int(r.U16())
+	if r.Err() != nil {
+		return nil, 0, r.Err()
+	}
+	if extend > ExtendReflect {
+		// Unknown modes fall back to padding
+		extend = ExtendPad
+	}
+
+	stops := make([]ColorStop, n)
+	for i := range stops {
+		offset := r.F2Dot14()
+		index, alpha := r.U16(), r.F2Dot14()
+		if variable {
+			r.Skip(4) // varIndexBase
+		}
+		if r.Err() != nil {
+			return nil, 0, r.Err()
+		}
+		color, err := fl.color(index, alpha)
+		if err != nil {
+			return nil, 0, err
+		}
+		stops[i] = ColorStop{offset, color}
+	}
+	sort.SliceStable(stops, func(i, j int) bool { return stops[i].Offset < stops[j].Offset })
+	return stops, extend, nil
+}
+
+// color returns palette entry index with its alpha multiplied by alpha.
+func (fl *flattener) color(index uint16, alpha float32) (Color, error) {
+	var c Color
+	switch {
+	case index == foregroundIndex:
+		c = fl.foreground
+	case int(index) < len(fl.palette):
+		c = fl.palette[index]
+	default:
+		return Color{}, fmt.Errorf("colr: palette has no entry %d", index)
+	}
+	c.A *= alpha
+	return c, nil
+}
+
+// linearEnd returns the end of the gradient vector of a linear gradient from p0 through p1, rotated so that its
+// color is constant along lines parallel to p0p2: the projection of p1 onto the perpendicular to p0p2 through p0.
+func linearEnd(p0, p1, p2 Point) Point {
+	nx, ny := p2.Y-p0.Y, -(p2.X - p0.X)
+	n2 := nx*nx + ny*ny
+	if n2 == 0 {
+		return p1
+	}
+	k := ((p1.X-p0.X)*nx + (p1.Y-p0.Y)*ny) / n2
+	return Point{p0.X + k*nx, p0.Y + k*ny}
+}
@@ -0,0 +1,18 @@
+package colr
+
+import (
+	"errors"
+
+	"github.com/bbredesen/ttf-renderer/internal/otread"
+)
+
+var ErrInvalidTable = errors.New("colr: invalid COLR or CPAL table")
+
+// reader is a bounds-checked cursor over big-endian table data, shared with packages ttf, shaping and woff. Its first
+// out-of-range read sets its error to ErrInvalidTable. Offsets are relative to the start of the enclosing table or
+// paint, which is the start of its data.
+type reader = otread.Reader
+
+func newReader(b []byte) *reader {
+	return otread.New(b, ErrInvalidTable)
+}
@@ -4,203 +4,175 @@ import (
 	"unsafe"
 
 	"github.com/bbredesen/go-vk"
-	"github.com/bbredesen/vkm"
+	"github.com/bbredesen/ttf-renderer/tess"
+	"github.com/bbredesen/ttf-renderer/vkctx"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/image/font/sfnt"
-	"golang.org/x/image/math/fixed"
 )
 
-const buffer_size = 4096
+// minBufferSize is the smallest vertex or index buffer allocated, so that small changes to the text can reuse the same
+// buffers.
+const minBufferSize = 4096
 
-func (app *App) loadBuffers(segments sfnt.Segments, bounds fixed.Rectangle26_6) {
-	verts, inds, quadVerts, quadInds := convertSegmentsToVerts(segments, bounds)
+// deviceBuffer is a device local buffer that is reused while its contents fit, and reallocated with room to grow when
+// they don't.
+type deviceBuffer struct {
+	usage vk.BufferUsageFlags
 
-	stagingBuffer, stagingMemory := app.createBuffer(vk.BUFFER_USAGE_TRANSFER_SRC_BIT, buffer_size, vk.MEMORY_PROPERTY_HOST_COHERENT_BIT)
+	buffer   vk.Buffer
+	memory   vk.DeviceMemory
+	capacity vk.DeviceSize
+}
 
-	app.vertexBuffer, app.vertexBufferMemory = app.createBuffer(vk.BUFFER_USAGE_VERTEX_BUFFER_BIT|vk.BUFFER_USAGE_TRANSFER_DST_BIT, buffer_size, vk.MEMORY_PROPERTY_DEVICE_LOCAL_BIT)
-	app.indexBuffer, app.indexBufferMemory = app.createBuffer(vk.BUFFER_USAGE_INDEX_BUFFER_BIT|vk.BUFFER_USAGE_TRANSFER_DST_BIT, buffer_size, vk.MEMORY_PROPERTY_DEVICE_LOCAL_BIT)
+// uploadToBuffer copies data to buf through a staging buffer. If data doesn't fit, buf is reallocated first, at double
+// the size needed so that repeated growth stays cheap. The device must be idle, as the buffer is either destroyed or
+// overwritten.
+func uploadToBuffer[T any](ctx *vkctx.Context, buf *deviceBuffer, data []T) {
+	size := sliceSize(data)
+	if size == 0 {
+		return
+	}
 
-	app.quadVertStart = len(verts)
-	app.quadIndsStart = len(inds)
+	if size > buf.capacity {
+		buf.destroy(ctx)
 
-	verts = append(verts, quadVerts...)
-	inds = append(inds, quadInds...)
+		capacity := 2 * size
+		if capacity < minBufferSize {
+			capacity = minBufferSize
+		}
 
-	app.indexCount = len(inds)
+		buf.buffer, buf.memory = ctx.CreateBuffer(buf.usage|vk.BUFFER_USAGE_TRANSFER_DST_BIT, capacity, vk.MEMORY_PROPERTY_DEVICE_LOCAL_BIT)
+		buf.capacity = capacity
 
-	r, ptr := vk.MapMemory(app.Device, stagingMemory, 0, buffer_size, 0)
-	if r != vk.SUCCESS {
-		panic(r)
+		logrus.WithFields(logrus.Fields{
+			"usage":    buf.usage,
+			"capacity": capacity,
+		}).Debug("Device buffer allocated")
 	}
 
-	vk.MemCopySlice(unsafe.Pointer(ptr), inds)
-	app.copyBuffer(stagingBuffer, app.indexBuffer, buffer_size)
-
-	vk.MemCopySlice(unsafe.Pointer(ptr), verts)
-	app.copyBuffer(stagingBuffer, app.vertexBuffer, buffer_size)
-
-	vk.UnmapMemory(app.Device, stagingMemory)
-	vk.DestroyBuffer(app.Device, stagingBuffer, nil)
-	vk.FreeMemory(app.Device, stagingMemory, nil)
+	stageAndCopy(ctx, buf.buffer, data)
+}
 
+// handle returns the buffer, or a null handle if it hasn't been allocated.
+func (buf *deviceBuffer) handle() vk.Buffer {
+	if buf.capacity == 0 {
+		return vk.Buffer(vk.NULL_HANDLE)
+	}
+	return buf.buffer
 }
 
-func int26_6_to_float32(x fixed.Int26_6) float32 {
-	return float32(x>>6) + (float32(x&0x7f) / float32(0x7f))
+func (buf *deviceBuffer) destroy(ctx *vkctx.Context) {
+	if buf.capacity == 0 {
+		return
+	}
+	vk.DestroyBuffer(ctx.Device, buf.buffer, nil)
+	vk.FreeMemory(ctx.Device, buf.memory, nil)
+	buf.capacity = 0
 }
 
-type vertexFormat struct {
-	position   vkm.Pt2
-	baryCoords vkm.Pt3
+// loadBuffers tessellates segments and uploads the geometry with uploadMesh.
+func (app *App) loadBuffers(segments sfnt.Segments, scale float32) {
+	app.uploadMesh(convertSegmentsToVerts(segments, scale))
 }
 
-func convertSegmentsToVerts(segments sfnt.Segments, bounds fixed.Rectangle26_6) (verts []vertexFormat, inds []uint16, quadVerts []vertexFormat, quadInds []uint16) {
-	// verts = append(verts, vkm.Origin2())
-
-	barySign := 0
-
-	getBaryCoord := func() vkm.Pt3 {
-		switch barySign {
-		case -1:
-			barySign *= -1
-			return vkm.Pt3{0, 0, 1}
-		case 0:
-			barySign = 1
-			return vkm.Pt3{0, 1, 0}
-		case 1:
-			barySign *= -1
-			return vkm.Pt3{1, 0, 0}
-		}
-		panic("unexpected barySign ")
-	}
+// uploadMesh uploads m to the app's vertex and index buffers, growing them if needed. Indices are 16 bits unless there
+// are too many vertices to address that way. Safe to call again whenever the text changes.
+func (app *App) uploadMesh(m tess.Mesh) {
+	app.quadVertStart = len(m.Verts)
+	app.quadIndsStart = len(m.Inds)
 
-	pt2FromFixed := func(fp fixed.Point26_6) vkm.Pt2 {
-		return vkm.Pt2{int26_6_to_float32(fp.X), int26_6_to_float32(fp.Y)}
-	}
-	pushRestart := func() {
-		inds = append(inds, 0xFFFF)
-		barySign = 0
-	}
+	verts := append(m.Verts, m.QuadVerts...)
+	inds := append(m.Inds, m.QuadInds...)
+	app.indexCount = len(inds)
 
-	pushVertex := func(fp fixed.Point26_6) {
-		nextIdx := uint16(len(verts))
+	// Frames in flight may still be reading the buffers
+	vk.DeviceWaitIdle(app.Device)
 
-		pt := pt2FromFixed(fp)
-		verts = append(verts, vertexFormat{pt, getBaryCoord()})
-		inds = append(inds, nextIdx)
-	}
+	app.vertexBuffer.usage = vk.BUFFER_USAGE_VERTEX_BUFFER_BIT
+	uploadToBuffer(&app.Context, &app.vertexBuffer, verts)
 
-	// Segments is a list of movement instructions
-	// OpCode MoveTo - Restart primitive and use arg[0] as the first point
-	// OpCode QuadTO - Quadratic curve to arg[1], arg[0] is the control point
-	// OpCode CubeTo - Cubic curve - Not supported
-
-	for _, segment := range segments {
-		switch segment.Op {
-		case sfnt.SegmentOpMoveTo:
-			pushRestart()
-			pushVertex(segment.Args[0])
-
-		case sfnt.SegmentOpLineTo:
-			pushVertex(segment.Args[0])
-
-		case sfnt.SegmentOpQuadTo:
-			pushVertex(segment.Args[1]) // push for rough rendering triangle fans
-
-			vlen := len(verts)
-			// for each quad, need to push last point, this point, control point, with bary coords
-			v0, v1 := verts[vlen-2], verts[vlen-1]
-			v0.baryCoords = vkm.Pt3{1, 0, 0}
-			v1.baryCoords = vkm.Pt3{0, 0, 1}
-
-			qvIdxStart := uint16(len(quadVerts))
-
-			quadVerts = append(quadVerts, v0,
-				vertexFormat{
-					position:   pt2FromFixed(segment.Args[0]),
-					baryCoords: vkm.Pt3{0, 1, 0},
-				},
-				v1,
-			)
-			quadInds = append(quadInds, qvIdxStart, qvIdxStart+1, qvIdxStart+2)
-		}
+	app.indexBuffer.usage = vk.BUFFER_USAGE_INDEX_BUFFER_BIT
+	if m.Fits16() {
+		app.indexType = vk.INDEX_TYPE_UINT16
+		uploadToBuffer(&app.Context, &app.indexBuffer, tess.Narrow(inds))
+	} else {
+		app.indexType = vk.INDEX_TYPE_UINT32
+		uploadToBuffer(&app.Context, &app.indexBuffer, inds)
 	}
-
-	// pushRestart()
-	sidx := uint16(len(quadVerts))
-
-	minX, maxX := int26_6_to_float32(bounds.Min.X), int26_6_to_float32(bounds.Max.X)
-	minY, maxY := int26_6_to_float32(bounds.Min.Y), int26_6_to_float32(bounds.Max.Y)
-
-	logrus.WithFields(logrus.Fields{
-		"minX": minX,
-		"minY": minY,
-		"maxX": maxX,
-		"maxY": maxY,
-	}).Infof("Bounds")
-
-	// Set uniform buffer
-	quadVerts = append(quadVerts, //vkm.Pt2{20, 0}, vkm.Pt2{20, -20}, vkm.Pt2{0, -20})
-		vertexFormat{vkm.Pt2{minX, minY}, vkm.Origin3()},
-		vertexFormat{vkm.Pt2{minX, maxY}, vkm.Origin3()},
-		vertexFormat{vkm.Pt2{maxX, maxY}, vkm.Origin3()},
-		vertexFormat{vkm.Pt2{maxX, minY}, vkm.Origin3()},
-	)
-	quadInds = append(quadInds, sidx, sidx+1, sidx+2, sidx+3)
-
-	return
 }
 
-func (app *App) destroyBuffers() {
-	vk.DestroyBuffer(app.Device, app.indexBuffer, nil)
-	vk.FreeMemory(app.Device, app.indexBufferMemory, nil)
-
-	vk.DestroyBuffer(app.Device, app.vertexBuffer, nil)
-	vk.FreeMemory(app.Device, app.vertexBufferMemory, nil)
+// createDeviceBuffer creates a device local buffer with the given usage, and uploads data to it through a staging
+// buffer.
+func createDeviceBuffer[T any](ctx *vkctx.Context, usage vk.BufferUsageFlags, data []T) (buffer vk.Buffer, memory vk.DeviceMemory) {
+	buffer, memory = ctx.CreateBuffer(usage|vk.BUFFER_USAGE_TRANSFER_DST_BIT, sliceSize(data), vk.MEMORY_PROPERTY_DEVICE_LOCAL_BIT)
+	stageAndCopy(ctx, buffer, data)
 
+	return buffer, memory
 }
 
-func (app *App) copyBuffer(srcBuffer, dstBuffer vk.Buffer, size vk.DeviceSize) {
-	cbuf := app.BeginOneTimeCommands()
+// stageAndCopy copies data to the start of dst through a temporary host visible staging buffer, and waits for the copy
+// to complete.
+func stageAndCopy[T any](ctx *vkctx.Context, dst vk.Buffer, data []T) {
+	size := sliceSize(data)
+
+	stagingBuffer, stagingMemory := ctx.CreateBuffer(vk.BUFFER_USAGE_TRANSFER_SRC_BIT, size, vk.MEMORY_PROPERTY_HOST_VISIBLE_BIT|vk.MEMORY_PROPERTY_HOST_COHERENT_BIT)
+	defer vk.FreeMemory(ctx.Device, stagingMemory, nil)
+	defer vk.DestroyBuffer(ctx.Device, stagingBuffer, nil)
 
-	region := vk.BufferCopy{
-		SrcOffset: 0,
-		DstOffset: 0,
-		Size:      size,
+	r, ptr := vk.MapMemory(ctx.Device, stagingMemory, 0, size, 0)
+	if r != vk.SUCCESS {
+		panic(r)
 	}
+	vk.MemCopySlice(unsafe.Pointer(ptr), data)
+	vk.UnmapMemory(ctx.Device, stagingMemory)
 
-	vk.CmdCopyBuffer(cbuf, srcBuffer, dstBuffer, []vk.BufferCopy{region})
+	ctx.CopyBuffer(stagingBuffer, dst, size)
+}
 
-	app.EndOneTimeCommands(cbuf)
+func sliceSize[T any](data []T) vk.DeviceSize {
+	var zero T
+	return vk.DeviceSize(len(data)) * vk.DeviceSize(unsafe.Sizeof(zero))
 }
 
-func (app *App) createBuffer(usage vk.BufferUsageFlags, size vk.DeviceSize, memProps vk.MemoryPropertyFlags) (buffer vk.Buffer, memory vk.DeviceMemory) {
+// recordOutlineDraws records the stencil and color draws for geometry built by convertSegmentsToVerts, with the fan
+// vertices and indices at the start of the bound vertex and index buffers and the curve geometry following them. Only
+// the first fanIndexCount fan indices are drawn, which is normally all of them (quadIndsStart). The render pass must be
+// in its stencil subpass, and is left in its color subpass.
+func recordOutlineDraws(cb vk.CommandBuffer, pipelines []vk.Pipeline, fanIndexCount, indexCount, quadVertStart, quadIndsStart int) {
+	vk.CmdBindPipeline(cb, vk.PIPELINE_BIND_POINT_GRAPHICS, pipelines[0]) // stencil pipeline
+	vk.CmdDrawIndexed(cb, uint32(fanIndexCount), 1, 0, 0, 0)
 
-	bufferCI := vk.BufferCreateInfo{
-		Size:        size,
-		Usage:       usage,
-		SharingMode: vk.SHARING_MODE_EXCLUSIVE,
-	}
+	vk.CmdBindPipeline(cb, vk.PIPELINE_BIND_POINT_GRAPHICS, pipelines[1]) // stencil quad portion pipeline
+	vk.CmdDrawIndexed(cb, uint32(indexCount-quadIndsStart)-4, 1, uint32(quadIndsStart), int32(quadVertStart), 0)
 
-	var r vk.Result
+	vk.CmdNextSubpass(cb, vk.SUBPASS_CONTENTS_INLINE)
 
-	if r, buffer = vk.CreateBuffer(app.Device, &bufferCI, nil); r != vk.SUCCESS {
-		panic("Could not create buffer: " + r.String())
-	}
+	vk.CmdBindPipeline(cb, vk.PIPELINE_BIND_POINT_GRAPHICS, pipelines[2]) // Color pass
 
-	memReq := vk.GetBufferMemoryRequirements(app.Device, buffer)
+	vk.CmdDrawIndexed(cb, 4, 1, uint32(indexCount)-4, int32(quadVertStart), 0)
+}
 
-	memAllocInfo := vk.MemoryAllocateInfo{
-		AllocationSize:  memReq.Size,
-		MemoryTypeIndex: uint32(app.FindMemoryType(memReq.MemoryTypeBits, memProps)), //vk.MEMORY_PROPERTY_HOST_VISIBLE_BIT|vk.MEMORY_PROPERTY_HOST_COHERENT_BIT)),
-	}
+// vertexFormat is the vertex layout of the stencil pipelines; see tess.Vertex.
+type vertexFormat = tess.Vertex
 
-	if r, memory = vk.AllocateMemory(app.Device, &memAllocInfo, nil); r != vk.SUCCESS {
-		panic("Could not allocate memory for buffer: " + r.String())
-	}
-	if r := vk.BindBufferMemory(app.Device, buffer, memory, 0); r != vk.SUCCESS {
-		panic("Could not bind memory for buffer: " + r.String())
-	}
+// convertSegmentsToVerts tessellates segments, in font units, into the fan and curve geometry drawn by
+// recordOutlineDraws. Every position is multiplied by scale (i.e. ppem / units per em). See tess.Tessellate.
+func convertSegmentsToVerts(segments sfnt.Segments, scale float32) tess.Mesh {
+	m := tess.Tessellate(segments, scale, cubicTolerance)
+
+	min, max := m.Bounds()
+	logrus.WithFields(logrus.Fields{
+		"minX":     min[0],
+		"minY":     min[1],
+		"maxX":     max[0],
+		"maxY":     max[1],
+		"vertices": len(m.Verts) + len(m.QuadVerts),
+	}).Infof("Bounds")
 
-	return
+	return m
+}
+
+func (app *App) destroyBuffers() {
+	app.indexBuffer.destroy(&app.Context)
+	app.vertexBuffer.destroy(&app.Context)
 }
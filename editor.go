@@ -0,0 +1,359 @@
+package main
+
+import (
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bbredesen/ttf-renderer/bidi"
+	"github.com/bbredesen/ttf-renderer/colr"
+	"github.com/bbredesen/ttf-renderer/layout"
+	"github.com/bbredesen/ttf-renderer/shaping"
+	"github.com/bbredesen/ttf-renderer/shared"
+	"github.com/bbredesen/ttf-renderer/tess"
+	"github.com/bbredesen/ttf-renderer/ttf"
+	"github.com/bbredesen/vkm"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// caretBlinkInterval is how long the caret is shown, and then hidden, while no keys are pressed
+const caretBlinkInterval = 530 * time.Millisecond
+
+// textEditor is the editable text drawn in the window, with an insertion point. The outline of each distinct glyph is
+// loaded and tessellated once, at the origin, and cached. When the text changes, only glyphs that haven't been seen
+// before are tessellated, and the text's mesh is joined from the cached meshes. Color glyphs are left out of the mesh,
+// and drawn from placed by colorGlyphs.
+type textEditor struct {
+	fontData *sfnt.Font
+	outlines *ttf.Font
+	shaper   *shaping.Shaper
+	colors   *colr.Font // nil unless the font has color glyphs
+	scale    float32    // pixels per font unit
+
+	text  []rune
+	caret int // insertion point, as an index into text
+
+	// carets are the pen positions of every insertion point in text, from 0 to len(text), as of the last layout
+	carets     []fixed.Point26_6
+	lines      []layout.Line
+	lineHeight fixed.Int26_6
+
+	// placed are the glyphs of the text as of the last call to mesh, including the color glyphs left out of it
+	placed []layout.Glyph
+
+	glyphs    map[sfnt.GlyphIndex]tess.Mesh
+	caretMesh tess.Mesh
+	b         sfnt.Buffer
+
+	// changed is set when the text has been edited since the last call to mesh, and caretMoved when only the caret has
+	// moved
+	changed, caretMoved bool
+
+	caretVisible bool
+	sinceBlink   time.Duration
+}
+
+func newTextEditor(fontData *sfnt.Font, outlines *ttf.Font, shaper *shaping.Shaper, colors *colr.Font, ppem float64, s string) (*textEditor, error) {
+	e := &textEditor{
+		fontData:     fontData,
+		outlines:     outlines,
+		shaper:       shaper,
+		colors:       colors,
+		scale:        float32(ppem) / float32(fontData.UnitsPerEm()),
+		text:         []rune(s),
+		glyphs:       make(map[sfnt.GlyphIndex]tess.Mesh),
+		changed:      true,
+		caretVisible: true,
+	}
+	e.caret = len(e.text)
+
+	metrics, err := fontData.Metrics(&e.b, fixed.I(int(fontData.UnitsPerEm())), font.HintingNone)
+	if err != nil {
+		return nil, err
+	}
+	e.lineHeight = metrics.Height
+
+	// The caret spans the font's ascent and descent, and is a sixtieth of an em wide but at least one pixel
+	caretWidth := fixed.I(int(fontData.UnitsPerEm())) / 60
+	if minWidth := fixed.Int26_6(64 / e.scale); caretWidth < minWidth {
+		caretWidth = minWidth
+	}
+	top, bottom := -metrics.Ascent, metrics.Descent
+	e.caretMesh = tess.Tessellate(sfnt.Segments{
+		{Op: sfnt.SegmentOpMoveTo, Args: [3]fixed.Point26_6{{X: 0, Y: top}}},
+		{Op: sfnt.SegmentOpLineTo, Args: [3]fixed.Point26_6{{X: caretWidth, Y: top}}},
+		{Op: sfnt.SegmentOpLineTo, Args: [3]fixed.Point26_6{{X: caretWidth, Y: bottom}}},
+		{Op: sfnt.SegmentOpLineTo, Args: [3]fixed.Point26_6{{X: 0, Y: bottom}}},
+	}, e.scale, cubicTolerance)
+
+	return e, nil
+}
+
+// handleInput applies a typed character or named key press, as passed to a shared.TextInputFunc.
+func (e *textEditor) handleInput(character rune, key shared.Key) {
+	switch {
+	case character == '\b':
+		if e.caret > 0 {
+			e.text = append(e.text[:e.caret-1], e.text[e.caret:]...)
+			e.caret--
+			e.changed = true
+		}
+	case character == '\r' || character == '\n':
+		e.insert('\n')
+	case character >= 0x20 && character != 0x7f && utf8.ValidRune(character):
+		// Invalid runes, such as lone UTF-16 surrogates, are dropped
+		e.insert(character)
+
+	case key == shared.KeyDelete:
+		if e.caret < len(e.text) {
+			e.text = append(e.text[:e.caret], e.text[e.caret+1:]...)
+			e.changed = true
+		}
+	case key == shared.KeyLeft:
+		e.moveCaret(e.caret - 1)
+	case key == shared.KeyRight:
+		e.moveCaret(e.caret + 1)
+	case key == shared.KeyHome, key == shared.KeyEnd:
+		if e.changed {
+			// The text was edited since the last layout, earlier in the same frame
+			if _, err := e.layout(); err != nil {
+				return
+			}
+		}
+		if line, ok := e.caretLine(); ok {
+			if key == shared.KeyHome {
+				e.moveCaret(line.Start)
+			} else {
+				e.moveCaret(line.End)
+			}
+		}
+	case key == shared.KeyUp, key == shared.KeyDown:
+		if e.changed {
+			// The text was edited since the last layout, earlier in the same frame
+			if _, err := e.layout(); err != nil {
+				return
+			}
+		}
+		y := e.carets[e.caret].Y + e.lineHeight
+		if key == shared.KeyUp {
+			y = e.carets[e.caret].Y - e.lineHeight
+		}
+		e.moveCaret(e.closestOnLine(y))
+	default:
+		// Other control characters, such as tab or escape
+		return
+	}
+
+	// Keep the caret visible while typing
+	e.caretVisible, e.sinceBlink = true, 0
+}
+
+func (e *textEditor) insert(r rune) {
+	e.text = append(e.text, 0)
+	copy(e.text[e.caret+1:], e.text[e.caret:])
+	e.text[e.caret] = r
+	e.caret++
+	e.changed = true
+}
+
+func (e *textEditor) moveCaret(to int) {
+	if to < 0 || to > len(e.text) || to == e.caret {
+		return
+	}
+	e.caret = to
+	e.caretMoved = true
+}
+
+// caretLine returns the line the caret is drawn on. An insertion point where a line wraps is at the start of the next
+// line.
+func (e *textEditor) caretLine() (layout.Line, bool) {
+	y := e.carets[e.caret].Y
+	for _, line := range e.lines {
+		if line.Baseline == y && line.Start <= e.caret && e.caret <= line.End {
+			return line, true
+		}
+	}
+	return layout.Line{}, false
+}
+
+// closestOnLine returns the insertion point on the baseline at y that is horizontally closest to the caret, or the
+// caret itself if there is no such line.
+func (e *textEditor) closestOnLine(y fixed.Int26_6) int {
+	x := e.carets[e.caret].X
+
+	closest, distance := e.caret, fixed.Int26_6(-1)
+	for i, pen := range e.carets {
+		if pen.Y != y {
+			continue
+		}
+		d := pen.X - x
+		if d < 0 {
+			d = -d
+		}
+		if distance < 0 || d < distance {
+			closest, distance = i, d
+		}
+	}
+	return closest
+}
+
+// tick advances the caret's blink by deltaT.
+func (e *textEditor) tick(deltaT time.Duration) {
+	e.sinceBlink += deltaT
+	if e.sinceBlink >= caretBlinkInterval {
+		e.sinceBlink %= caretBlinkInterval
+		e.caretVisible = !e.caretVisible
+	}
+}
+
+// clearGlyphs drops every cached glyph mesh, so that glyphs are loaded and tessellated again from their current
+// outlines, as when the instance of a variable font changes.
+func (e *textEditor) clearGlyphs() {
+	e.glyphs = make(map[sfnt.GlyphIndex]tess.Mesh)
+	e.changed = true
+}
+
+// caretIndexCount is the number of fan indices at the end of the mesh that draw the caret, and are left out while it
+// is hidden.
+func (e *textEditor) caretIndexCount() int {
+	return len(e.caretMesh.Inds)
+}
+
+// layout lays out the text, and updates the insertion points to match.
+func (e *textEditor) layout() (*layout.Text, error) {
+	text, err := layoutText(e.fontData, e.outlines, e.shaper, string(e.text))
+	if err != nil {
+		return nil, err
+	}
+	e.lines = text.Lines
+	e.carets = caretPositions(len(e.text), text)
+	return text, nil
+}
+
+// mesh lays out the text and joins the meshes of its glyphs, followed by the caret, in pixels relative to the start of
+// the first baseline.
+func (e *textEditor) mesh() (tess.Mesh, error) {
+	text, err := e.layout()
+	if err != nil {
+		return tess.Mesh{}, err
+	}
+
+	meshes := make([]tess.Mesh, 0, len(text.Glyphs)+1)
+	offsets := make([]vkm.Vec2, 0, len(text.Glyphs)+1)
+	for _, g := range text.Glyphs {
+		if isColorGlyph(e.colors, g.ID) {
+			continue
+		}
+		m, err := e.glyphMesh(g.ID, g.Rune)
+		if err != nil {
+			return tess.Mesh{}, err
+		}
+		meshes = append(meshes, m)
+		offsets = append(offsets, e.toPixels(g.Origin()))
+	}
+
+	// The caret goes last, so that it can be hidden by drawing fewer fan indices
+	meshes = append(meshes, e.caretMesh)
+	offsets = append(offsets, e.toPixels(e.carets[e.caret]))
+
+	e.placed = text.Glyphs
+	e.changed, e.caretMoved = false, false
+	return tess.Join(meshes, offsets), nil
+}
+
+// glyphMesh returns the mesh of glyph idx at the origin, tessellating it on first use.
+func (e *textEditor) glyphMesh(idx sfnt.GlyphIndex, r rune) (tess.Mesh, error) {
+	if m, ok := e.glyphs[idx]; ok {
+		return m, nil
+	}
+
+	segments, err := loadGlyphSegments(e.fontData, e.outlines, &e.b, idx)
+	if err != nil {
+		return tess.Mesh{}, err
+	}
+	// GlyphBounds only knows the default instance of a variable font
+	if checkBounds && (e.outlines == nil || e.outlines.IsDefaultInstance()) {
+		if err := compareGlyphBounds(e.fontData, &e.b, idx, r, segmentBounds(segments)); err != nil {
+			return tess.Mesh{}, err
+		}
+	}
+
+	m := tess.Tessellate(segments, e.scale, cubicTolerance)
+	e.glyphs[idx] = m
+	return m, nil
+}
+
+func (e *textEditor) toPixels(p fixed.Point26_6) vkm.Vec2 {
+	return vkm.Vec2{float32(p.X) / 64 * e.scale, float32(p.Y) / 64 * e.scale}
+}
+
+// caretPositions returns the pen position of every insertion point in a text of n runes, given its layout. An
+// insertion point before a cluster is at the leading edge of its glyphs, which is the left edge in a left to right run
+// and the right edge in a right to left one, so that it takes kerning into account. One at the end of a line follows
+// the trailing edge of the line's last cluster, except where the line wraps, when it is at the start of the next line.
+// Insertion points inside a cluster, such as between the letters of a ligature, divide its advance evenly.
+func caretPositions(n int, text *layout.Text) []fixed.Point26_6 {
+	carets := make([]fixed.Point26_6, n+1)
+
+	// The horizontal extent of each cluster. Glyphs are in display order, so the glyphs of a cluster are consecutive
+	// but clusters may not be in logical order.
+	type extent struct {
+		left, right fixed.Int26_6
+		rtl         bool
+	}
+
+	for _, line := range text.Lines {
+		// Start every insertion point at the start of its line, which is where they stay on empty lines
+		start := line.Left
+		if line.Direction == bidi.RightToLeft {
+			start = line.Right
+		}
+		for i := line.Start; i <= line.End; i++ {
+			carets[i] = fixed.Point26_6{X: start, Y: line.Baseline}
+		}
+
+		extents := make(map[int]*extent)
+		var clusters []int
+		for _, g := range line.Glyphs {
+			e := extents[g.Cluster]
+			if e == nil {
+				extents[g.Cluster] = &extent{g.Pen.X, g.Pen.X + g.Advance, g.RTL}
+				clusters = append(clusters, g.Cluster)
+				continue
+			}
+			if g.Pen.X < e.left {
+				e.left = g.Pen.X
+			}
+			if g.Pen.X+g.Advance > e.right {
+				e.right = g.Pen.X + g.Advance
+			}
+		}
+		sort.Ints(clusters)
+
+		for ci, cluster := range clusters {
+			e := extents[cluster]
+			start, end := e.left, e.right
+			if e.rtl {
+				start, end = end, start
+			}
+
+			// The cluster covers every rune up to the next cluster, or the end of the line
+			next := cluster + 1
+			for next < line.End && (ci+1 == len(clusters) || next < clusters[ci+1]) {
+				next++
+			}
+
+			n := fixed.Int26_6(next - cluster)
+			for k := fixed.Int26_6(0); k < n; k++ {
+				carets[cluster+int(k)] = fixed.Point26_6{X: start + (end-start)*k/n, Y: line.Baseline}
+			}
+			if next == line.End {
+				carets[next] = fixed.Point26_6{X: end, Y: line.Baseline}
+			}
+		}
+	}
+
+	return carets
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/bbredesen/ttf-renderer/bidi"
+	"github.com/bbredesen/ttf-renderer/layout"
+	"golang.org/x/image/math/fixed"
+)
+
+// glyph returns a glyph of cluster with its pen at x on the baseline at y, advance wide.
+func glyph(cluster int, x, y, advance int, rtl bool) layout.Glyph {
+	return layout.Glyph{Cluster: cluster, Pen: fixed.P(x, y), Advance: fixed.I(advance), RTL: rtl}
+}
+
+func TestCaretPositions(t *testing.T) {
+	tests := []struct {
+		name  string
+		n     int
+		lines []layout.Line
+		want  []fixed.Point26_6
+	}{
+		{
+			name: "left to right",
+			n:    2,
+			lines: []layout.Line{{
+				Start: 0, End: 2, Left: fixed.I(0), Right: fixed.I(22),
+				Glyphs: []layout.Glyph{glyph(0, 0, 0, 10, false), glyph(1, 10, 0, 12, false)},
+			}},
+			want: []fixed.Point26_6{fixed.P(0, 0), fixed.P(10, 0), fixed.P(22, 0)},
+		},
+		{
+			// Glyphs are in display order, so the first rune is the rightmost glyph
+			name: "right to left",
+			n:    2,
+			lines: []layout.Line{{
+				Start: 0, End: 2, Left: fixed.I(0), Right: fixed.I(22), Direction: bidi.RightToLeft,
+				Glyphs: []layout.Glyph{glyph(1, 0, 0, 12, true), glyph(0, 12, 0, 10, true)},
+			}},
+			want: []fixed.Point26_6{fixed.P(22, 0), fixed.P(12, 0), fixed.P(0, 0)},
+		},
+		{
+			// The three runes of an "ffi" ligature divide its advance, and the "x" after it starts its own cluster
+			name: "ligature",
+			n:    4,
+			lines: []layout.Line{{
+				Start: 0, End: 4, Left: fixed.I(0), Right: fixed.I(40),
+				Glyphs: []layout.Glyph{glyph(0, 0, 0, 30, false), glyph(3, 30, 0, 10, false)},
+			}},
+			want: []fixed.Point26_6{fixed.P(0, 0), fixed.P(10, 0), fixed.P(20, 0), fixed.P(30, 0), fixed.P(40, 0)},
+		},
+		{
+			name: "right to left ligature",
+			n:    3,
+			lines: []layout.Line{{
+				Start: 0, End: 3, Left: fixed.I(0), Right: fixed.I(30), Direction: bidi.RightToLeft,
+				Glyphs: []layout.Glyph{glyph(0, 0, 0, 30, true)},
+			}},
+			want: []fixed.Point26_6{fixed.P(30, 0), fixed.P(20, 0), fixed.P(10, 0), fixed.P(0, 0)},
+		},
+		{
+			// A base and a mark shaped into separate glyphs of one cluster, with the mark drawn over the base
+			name: "cluster of several glyphs",
+			n:    3,
+			lines: []layout.Line{{
+				Start: 0, End: 3, Left: fixed.I(0), Right: fixed.I(20),
+				Glyphs: []layout.Glyph{glyph(0, 0, 0, 10, false), glyph(0, 10, 0, 0, false), glyph(2, 10, 0, 10, false)},
+			}},
+			want: []fixed.Point26_6{fixed.P(0, 0), fixed.P(5, 0), fixed.P(10, 0), fixed.P(20, 0)},
+		},
+		{
+			// "ab cd" wrapped after the space: the end of the first line is the start of the second
+			name: "wrapped",
+			n:    5,
+			lines: []layout.Line{
+				{
+					Start: 0, End: 3, Left: fixed.I(0), Right: fixed.I(20),
+					Glyphs: []layout.Glyph{glyph(0, 0, 0, 10, false), glyph(1, 10, 0, 10, false), glyph(2, 20, 0, 5, false)},
+				},
+				{
+					Start: 3, End: 5, Baseline: fixed.I(30), Left: fixed.I(0), Right: fixed.I(20),
+					Glyphs: []layout.Glyph{glyph(3, 0, 30, 10, false), glyph(4, 10, 30, 10, false)},
+				},
+			},
+			want: []fixed.Point26_6{fixed.P(0, 0), fixed.P(10, 0), fixed.P(20, 0), fixed.P(0, 30), fixed.P(10, 30), fixed.P(20, 30)},
+		},
+		{
+			// "a\n\nb": the newlines end their lines, and the empty line keeps its insertion point at its start
+			name: "hard line breaks",
+			n:    4,
+			lines: []layout.Line{
+				{
+					Start: 0, End: 1, Left: fixed.I(0), Right: fixed.I(10),
+					Glyphs: []layout.Glyph{glyph(0, 0, 0, 10, false)},
+				},
+				{Start: 2, End: 2, Baseline: fixed.I(30)},
+				{
+					Start: 3, End: 4, Baseline: fixed.I(60), Left: fixed.I(0), Right: fixed.I(10),
+					Glyphs: []layout.Glyph{glyph(3, 0, 60, 10, false)},
+				},
+			},
+			want: []fixed.Point26_6{fixed.P(0, 0), fixed.P(10, 0), fixed.P(0, 30), fixed.P(0, 60), fixed.P(10, 60)},
+		},
+		{
+			// A right aligned, right to left line starts at its right edge, even when empty
+			name: "empty right to left line",
+			n:    0,
+			lines: []layout.Line{
+				{Start: 0, End: 0, Left: fixed.I(100), Right: fixed.I(100), Direction: bidi.RightToLeft},
+			},
+			want: []fixed.Point26_6{fixed.P(100, 0)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			text := &layout.Text{Lines: test.lines}
+			for _, line := range test.lines {
+				text.Glyphs = append(text.Glyphs, line.Glyphs...)
+			}
+
+			got := caretPositions(test.n, text)
+			if len(got) != len(test.want) {
+				t.Fatalf("got %d insertion points, want %d", len(got), len(test.want))
+			}
+			for i := range got {
+				if got[i] != test.want[i] {
+					t.Errorf("insertion point %d is at %v, want %v", i, got[i], test.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/bbredesen/ttf-renderer/ttf"
+	"golang.org/x/image/font/sfnt"
+)
+
+// fontFile is a font file, which is either a single face or a collection (.ttc or .otc) of several. faces reads each
+// face with sfnt, and collection locates each face's tables for the ttf and shaping packages.
+type fontFile struct {
+	faces      *sfnt.Collection
+	collection *ttf.Collection
+}
+
+func parseFontFile(src []byte) (*fontFile, error) {
+	collection, err := ttf.ParseCollection(src)
+	if err != nil {
+		return nil, err
+	}
+	// sfnt.ParseCollection also accepts a single font, as a collection of one
+	faces, err := sfnt.ParseCollection(src)
+	if err != nil {
+		return nil, err
+	}
+	if faces.NumFonts() != collection.NumFaces() {
+		return nil, fmt.Errorf("collection has %d faces, but sfnt found %d", collection.NumFaces(), faces.NumFonts())
+	}
+	return &fontFile{faces: faces, collection: collection}, nil
+}
+
+// findFace returns the index of the face selected by the -face flag: either its index in the collection, or its
+// PostScript name. An empty selection picks the first face.
+func (ff *fontFile) findFace(selection string) (int, error) {
+	if selection == "" {
+		return 0, nil
+	}
+	if i, err := strconv.Atoi(selection); err == nil {
+		if i < 0 || i >= ff.collection.NumFaces() {
+			return 0, fmt.Errorf("face %d out of range; the file has %d faces", i, ff.collection.NumFaces())
+		}
+		return i, nil
+	}
+
+	var b sfnt.Buffer
+	for i := 0; i < ff.collection.NumFaces(); i++ {
+		f, err := ff.faces.Font(i)
+		if err != nil {
+			return 0, err
+		}
+		if name, err := f.Name(&b, sfnt.NameIDPostScript); err == nil && name == selection {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("no face with PostScript name %q", selection)
+}
+
+// listFaces writes the index, PostScript name, family and style of every face in the file to w, one per line, followed
+// by the axes and named instances of any that are variable, and the number of palettes of any with color glyphs.
+func (ff *fontFile) listFaces(w io.Writer) error {
+	var b sfnt.Buffer
+	for i := 0; i < ff.collection.NumFaces(); i++ {
+		f, err := ff.faces.Font(i)
+		if err != nil {
+			return err
+		}
+
+		// Prefer the typographic family and style, which group more than the four styles of a legacy family
+		family := faceName(f, &b, sfnt.NameIDTypographicFamily, sfnt.NameIDFamily)
+		style := faceName(f, &b, sfnt.NameIDTypographicSubfamily, sfnt.NameIDSubfamily)
+		postScript := faceName(f, &b, sfnt.NameIDPostScript)
+
+		if _, err := fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", i, postScript, family, style); err != nil {
+			return err
+		}
+
+		// Faces without glyf outlines can't be varied, so their axes aren't listed
+		if outlines, err := ff.collection.Face(i); err == nil {
+			if err := listAxes(w, f, &b, outlines); err != nil {
+				return err
+			}
+		}
+
+		// Color fonts list how many palettes -palette can choose from
+		if colors, err := newColorFont(ff, i); err == nil && colors != nil {
+			if _, err := fmt.Fprintf(w, "\tpalettes\t%d\n", colors.NumPalettes()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// listAxes writes the axes and named instances of a variable font to w, indented under its face, with each instance
+// in the form taken by the -axis flag.
+func listAxes(w io.Writer, f *sfnt.Font, b *sfnt.Buffer, outlines *ttf.Font) error {
+	for _, a := range outlines.Axes {
+		name := faceName(f, b, sfnt.NameID(a.NameID))
+		if _, err := fmt.Fprintf(w, "\taxis\t%s\t%g\t%g\t%g\t%s\n", a.Tag, a.Min, a.Default, a.Max, name); err != nil {
+			return err
+		}
+	}
+
+	for _, in := range outlines.Instances {
+		values := make([]string, len(in.Coords))
+		for k, v := range in.Coords {
+			values[k] = fmt.Sprintf("%s=%g", strings.TrimRight(outlines.Axes[k].Tag, " "), v)
+		}
+		name := faceName(f, b, sfnt.NameID(in.SubfamilyNameID))
+		if _, err := fmt.Fprintf(w, "\tinstance\t%s\t%s\n", name, strings.Join(values, ",")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// faceName returns the first of the given name table entries that the face has, or "?" if it has none of them.
+func faceName(f *sfnt.Font, b *sfnt.Buffer, ids ...sfnt.NameID) string {
+	for _, id := range ids {
+		if name, err := f.Name(b, id); err == nil && name != "" {
+			return name
+		}
+	}
+	return "?"
+}
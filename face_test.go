@@ -0,0 +1,58 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bbredesen/ttf-renderer/internal/otbuild"
+	"github.com/bbredesen/ttf-renderer/ttf"
+)
+
+// testCollection returns a font file holding Go-Regular and Go-Mono, in that order.
+func testCollection(t *testing.T) *fontFile {
+	t.Helper()
+	var faces []map[string][]byte
+	for _, name := range []string{"Go-Regular.ttf", "Go-Mono.ttf"} {
+		src, err := os.ReadFile(filepath.Join("testdata/fonts", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tables, err := ttf.ReadTables(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		faces = append(faces, tables)
+	}
+
+	file, err := parseFontFile(otbuild.Collection(faces...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestFindFace(t *testing.T) {
+	file := testCollection(t)
+
+	for _, test := range []struct {
+		selection string
+		want      int
+	}{
+		{"", 0},
+		{"0", 0},
+		{"1", 1},
+		{"GoRegular", 0},
+		{"GoMono", 1},
+	} {
+		if got, err := file.findFace(test.selection); err != nil || got != test.want {
+			t.Errorf("findFace(%q) = %d, %v; want %d", test.selection, got, err, test.want)
+		}
+	}
+
+	for _, selection := range []string{"2", "-1", "GoBold", "gomono"} {
+		if i, err := file.findFace(selection); err == nil {
+			t.Errorf("findFace(%q) = %d, want an error", selection, i)
+		}
+	}
+}
@@ -7,15 +7,17 @@ go 1.20
 // replace github.com/bbredesen/win32-toolkit v0.0.1 => C:\Users\benbr\go\src\github.com\bbredesen\win32-toolkit
 
 require (
+	github.com/andybalholm/brotli v1.0.5
 	github.com/bbredesen/go-vk v0.0.0-20230217143317-ce5fce0dc2f2
 	github.com/bbredesen/vkm v0.2.0
 	github.com/bbredesen/win32-toolkit v0.0.0-20230303234304-25b01e7ba2d4
 	github.com/sirupsen/logrus v1.9.0
 	golang.org/x/image v0.5.0
 	golang.org/x/sys v0.4.0
+	golang.org/x/text v0.7.0
 )
 
-require (
-	github.com/chewxy/math32 v1.10.1 // indirect
-	golang.org/x/text v0.7.0 // indirect
-)
+require github.com/chewxy/math32 v1.10.1 // indirect
+
+// The pinned go-vk doesn't compile outside of Windows; see third_party/go-vk/README.md
+replace github.com/bbredesen/go-vk => ./third_party/go-vk
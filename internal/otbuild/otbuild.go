@@ -0,0 +1,144 @@
+// Package otbuild encodes OpenType tables from Go literals, so that tests can build the exact font data they need
+// instead of depending on a font file that happens to exercise it.
+package otbuild
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// Table is a table under construction. Its items are encoded big-endian, in order:
+//
+//   - an int as a uint16, so negative values become int16
+//   - a uint8 as a byte, and a uint32 as is
+//   - a string, such as a tag, or a []byte as its bytes
+//   - a nested Table as a 16-bit offset to it, and an Offset24 or Offset32 as a 24 or 32-bit one
+//
+// Nested tables are laid out after their parent, in order, and their offsets are from the start of the parent.
+type Table []any
+
+type (
+	Offset24 Table
+	Offset32 Table
+)
+
+// Bytes encodes the table and every table nested in it. It panics on an item of any other type, or an offset that
+// doesn't fit.
+func (t Table) Bytes() []byte {
+	size := 0
+	for _, item := range t {
+		switch v := item.(type) {
+		case uint8:
+			size++
+		case Offset24:
+			size += 3
+		case uint32, Offset32:
+			size += 4
+		case string:
+			size += len(v)
+		case []byte:
+			size += len(v)
+		case int, Table:
+			size += 2
+		default:
+			panic(fmt.Sprintf("otbuild: can't encode %T", item))
+		}
+	}
+
+	out := make([]byte, size)
+	p := 0
+	for _, item := range t {
+		switch v := item.(type) {
+		case int:
+			binary.BigEndian.PutUint16(out[p:], uint16(v))
+			p += 2
+		case uint8:
+			out[p] = v
+			p++
+		case uint32:
+			binary.BigEndian.PutUint32(out[p:], v)
+			p += 4
+		case string:
+			p += copy(out[p:], v)
+		case []byte:
+			p += copy(out[p:], v)
+		case Table:
+			n := len(out)
+			if n > 0xFFFF {
+				panic(fmt.Sprintf("otbuild: offset %d doesn't fit in 16 bits", n))
+			}
+			binary.BigEndian.PutUint16(out[p:], uint16(n))
+			out = append(out, v.Bytes()...)
+			p += 2
+		case Offset24:
+			n := len(out)
+			if n > 0xFFFFFF {
+				panic(fmt.Sprintf("otbuild: offset %d doesn't fit in 24 bits", n))
+			}
+			out[p], out[p+1], out[p+2] = byte(n>>16), byte(n>>8), byte(n)
+			out = append(out, Table(v).Bytes()...)
+			p += 3
+		case Offset32:
+			binary.BigEndian.PutUint32(out[p:], uint32(len(out)))
+			out = append(out, Table(v).Bytes()...)
+			p += 4
+		}
+	}
+	return out
+}
+
+// F2Dot14 encodes v as a 2.14 fixed-point number, to be written as an int.
+func F2Dot14(v float32) int { return int(int16(v * (1 << 14))) }
+
+// Fixed encodes v as a 16.16 fixed-point number.
+func Fixed(v float32) uint32 { return uint32(int32(v * (1 << 16))) }
+
+// Font writes tables into a TrueType font file, in tag order and without checksums.
+func Font(tables map[string][]byte) []byte {
+	_, data := faces(0, []map[string][]byte{tables})
+	return data
+}
+
+// Collection writes a TrueType collection with a face for each set of tables. The table directories of all of the faces
+// come first, followed by every table, so each face is at a different offset and table offsets are from the start of
+// the file.
+func Collection(tables ...map[string][]byte) []byte {
+	headerSize := 12 + 4*len(tables)
+	offsets, data := faces(headerSize, tables)
+
+	header := Table{"ttcf", uint32(0x00010000), uint32(len(tables))}
+	for _, offset := range offsets {
+		header = append(header, offset)
+	}
+	return append(header.Bytes(), data...)
+}
+
+// faces lays out the table directory of each face, in tag order, followed by the tables, for a file in which they
+// start at offset start. It returns the offset of each directory.
+func faces(start int, tables []map[string][]byte) (offsets []uint32, data []byte) {
+	dirSize := 0
+	for _, t := range tables {
+		dirSize += 12 + 16*len(t)
+	}
+
+	var body []byte
+	for _, t := range tables {
+		tags := make([]string, 0, len(t))
+		for tag := range t {
+			tags = append(tags, tag)
+		}
+		sort.Strings(tags)
+
+		offsets = append(offsets, uint32(start+len(data)))
+		data = append(data, Table{uint32(0x00010000), len(tags), 0, 0, 0}.Bytes()...)
+		for _, tag := range tags {
+			data = append(data, Table{tag, uint32(0), uint32(start + dirSize + len(body)), uint32(len(t[tag]))}.Bytes()...)
+			body = append(body, t[tag]...)
+			for len(body)%4 != 0 {
+				body = append(body, 0)
+			}
+		}
+	}
+	return offsets, append(data, body...)
+}
@@ -0,0 +1,136 @@
+// Package otread reads the big-endian data of OpenType tables, for the packages that parse them directly: ttf,
+// shaping, colr and woff.
+package otread
+
+import "encoding/binary"
+
+// Reader is a bounds-checked cursor over big-endian table data. The first out-of-range read sets its error, and every
+// read after that returns zero, so callers can decode a whole record and check Err once. Offsets are relative to the
+// start of the data, which is normally the start of the enclosing table or subtable.
+type Reader struct {
+	b   []byte
+	p   int
+	err error
+
+	// invalid is the error set by a failed read, so that each package reports its own
+	invalid error
+}
+
+// New returns a Reader at the start of b, which fails with invalid.
+func New(b []byte, invalid error) *Reader {
+	return &Reader{b: b, invalid: invalid}
+}
+
+// Err returns the error set by the first failed read, or nil.
+func (r *Reader) Err() error {
+	return r.err
+}
+
+// Fail sets the reader's error, for data that is in range but malformed. Every read after it returns zero.
+func (r *Reader) Fail() {
+	if r.err == nil {
+		r.err = r.invalid
+	}
+}
+
+// Data returns all of the reader's data, including what has already been read.
+func (r *Reader) Data() []byte {
+	return r.b
+}
+
+// Offset returns the position of the next read, from the start of the data.
+func (r *Reader) Offset() int {
+	return r.p
+}
+
+// Seek moves the next read to offset from the start of the data.
+func (r *Reader) Seek(offset int) {
+	if r.err != nil || offset < 0 || offset > len(r.b) {
+		r.Fail()
+		return
+	}
+	r.p = offset
+}
+
+// Bytes reads n bytes. The result shares the reader's data.
+func (r *Reader) Bytes(n int) []byte {
+	if r.err != nil || n < 0 || r.p+n > len(r.b) {
+		r.Fail()
+		return nil
+	}
+	v := r.b[r.p : r.p+n]
+	r.p += n
+	return v
+}
+
+func (r *Reader) Skip(n int) {
+	r.Bytes(n)
+}
+
+func (r *Reader) U8() uint8 {
+	if b := r.Bytes(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (r *Reader) U16() uint16 {
+	if b := r.Bytes(2); b != nil {
+		return binary.BigEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (r *Reader) U24() uint32 {
+	if b := r.Bytes(3); b != nil {
+		return uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+	}
+	return 0
+}
+
+func (r *Reader) U32() uint32 {
+	if b := r.Bytes(4); b != nil {
+		return binary.BigEndian.Uint32(b)
+	}
+	return 0
+}
+
+// U16s reads an array of n uint16 values.
+func (r *Reader) U16s(n int) []uint16 {
+	b := r.Bytes(2 * n)
+	if r.err != nil {
+		return nil
+	}
+	v := make([]uint16, n)
+	for i := range v {
+		v[i] = binary.BigEndian.Uint16(b[2*i:])
+	}
+	return v
+}
+
+// FWord reads a signed distance in font units.
+func (r *Reader) FWord() float32 {
+	return float32(int16(r.U16()))
+}
+
+// F2Dot14 reads a signed 2.14 fixed-point number.
+func (r *Reader) F2Dot14() float32 {
+	return float32(int16(r.U16())) / (1 << 14)
+}
+
+// Fixed reads a signed 16.16 fixed-point number.
+func (r *Reader) Fixed() float32 {
+	return float32(int32(r.U32())) / (1 << 16)
+}
+
+// At returns a reader over the data at offset from the start of r's data, which fails with the same error. If r has
+// already failed, or offset is out of range, the returned reader fails on its first read.
+func (r *Reader) At(offset int) *Reader {
+	if r.err == nil && (offset < 0 || offset > len(r.b)) {
+		r.Fail()
+	}
+	if r.err != nil {
+		return &Reader{err: r.err, invalid: r.invalid}
+	}
+	return &Reader{b: r.b[offset:], invalid: r.invalid}
+}
@@ -0,0 +1,77 @@
+package otread
+
+import (
+	"errors"
+	"testing"
+)
+
+var errTest = errors.New("test: invalid table")
+
+func TestRead(t *testing.T) {
+	r := New([]byte{
+		0x01,
+		0xFF, 0xFE,
+		0x01, 0x02, 0x03,
+		0x80, 0x00, 0x00, 0x00,
+		0xC0, 0x00,
+		0xFF, 0xFF, 0x80, 0x00,
+		0x00, 0x01, 0x00, 0x02,
+	}, errTest)
+
+	if v := r.U8(); v != 1 {
+		t.Errorf("U8 = %d, want 1", v)
+	}
+	if v := r.FWord(); v != -2 {
+		t.Errorf("FWord = %v, want -2", v)
+	}
+	if v := r.U24(); v != 0x010203 {
+		t.Errorf("U24 = %#x, want 0x010203", v)
+	}
+	if v := r.U32(); v != 0x80000000 {
+		t.Errorf("U32 = %#x, want 0x80000000", v)
+	}
+	if v := r.F2Dot14(); v != -1 {
+		t.Errorf("F2Dot14 = %v, want -1", v)
+	}
+	if v := r.Fixed(); v != -0.5 {
+		t.Errorf("Fixed = %v, want -0.5", v)
+	}
+	if v := r.U16s(2); len(v) != 2 || v[0] != 1 || v[1] != 2 {
+		t.Errorf("U16s = %v, want [1 2]", v)
+	}
+	if r.Err() != nil {
+		t.Fatalf("Err = %v after reading every byte", r.Err())
+	}
+
+	// A sub-reader's offsets start at its own data
+	if v := r.At(3).U24(); v != 0x010203 {
+		t.Errorf("At(3).U24 = %#x, want 0x010203", v)
+	}
+}
+
+func TestFail(t *testing.T) {
+	r := New([]byte{0x12, 0x34, 0x56}, errTest)
+
+	if v := r.U32(); v != 0 || !errors.Is(r.Err(), errTest) {
+		t.Fatalf("U32 past the end = %#x, %v; want 0, %v", v, r.Err(), errTest)
+	}
+	// Every read after the first failure returns zero, even one that would fit
+	if v := r.U16(); v != 0 {
+		t.Errorf("U16 after a failure = %#x, want 0", v)
+	}
+	if sub := r.At(0); sub.U8() != 0 || sub.Err() != errTest {
+		t.Errorf("At after a failure: %v, want errTest", sub.Err())
+	}
+
+	r = New([]byte{0x12, 0x34}, errTest)
+	if sub := r.At(3); sub.U8() != 0 || sub.Err() != errTest || r.Err() != errTest {
+		t.Errorf("At past the end: sub %v, reader %v; want errTest for both", sub.Err(), r.Err())
+	}
+
+	r = New([]byte{0x12, 0x34}, errTest)
+	r.Skip(1)
+	r.Fail()
+	if v := r.U8(); v != 0 || r.Err() != errTest {
+		t.Errorf("U8 after Fail = %#x, %v; want 0, errTest", v, r.Err())
+	}
+}
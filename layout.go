@@ -0,0 +1,185 @@
+package main
+
+import (
+	"math"
+
+	"github.com/bbredesen/ttf-renderer/colr"
+	"github.com/bbredesen/ttf-renderer/layout"
+	"github.com/bbredesen/ttf-renderer/shaping"
+	"github.com/bbredesen/ttf-renderer/ttf"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// layoutText lays out s with package layout, in font units: at a size of the font's units per em, wrapped at the
+// -max-width flag (converted from pixels at the -size flag) and aligned as set by the -align flag. Positions are
+// relative to the start of the first baseline, and scaled to pixels by the caller. If outlines is at an instance of a
+// variable font other than the default, advances are measured at that instance.
+func layoutText(fontData *sfnt.Font, outlines *ttf.Font, shaper *shaping.Shaper, s string) (*layout.Text, error) {
+	upem := float64(fontData.UnitsPerEm())
+	opts := layout.Options{
+		Size:     fixed.I(int(fontData.UnitsPerEm())),
+		MaxWidth: fixed.Int26_6(math.Round(maxWidth * upem / ppem * 64)),
+		Align:    textAlign,
+		Shaping:  shapingOptions,
+	}
+	if outlines != nil && !outlines.IsDefaultInstance() {
+		opts.Advances = outlines.GlyphAdvance
+	}
+	return layout.Layout(fontData, shaper, s, opts)
+}
+
+// newShaper reads the layout tables of face i of file.
+func newShaper(file *fontFile, i int) (*shaping.Shaper, error) {
+	tables, err := file.collection.Tables(i)
+	if err != nil {
+		return nil, err
+	}
+	return shaping.New(tables)
+}
+
+// parseShapingFlags returns the shaping options set by the -script, -lang and -features flags.
+func parseShapingFlags() (opts shaping.Options, err error) {
+	if scriptTag != "" {
+		opts.Script = shaping.MakeTag(scriptTag)
+	}
+	if languageTag != "" {
+		opts.Language = shaping.MakeTag(languageTag)
+	}
+	opts.Features, err = shaping.ParseFeatures(featureList)
+	return opts, err
+}
+
+// loadGlyphSegments returns the outline of glyph idx, unscaled, in font units. If outlines is non-nil, glyph geometry is
+// read directly from the glyf table; otherwise (e.g. for CFF fonts) it comes from sfnt at a ppem equal to the font's
+// units per em. The returned segments are never backed by b, so they remain valid after b is reused.
+func loadGlyphSegments(fontData *sfnt.Font, outlines *ttf.Font, b *sfnt.Buffer, idx sfnt.GlyphIndex) (sfnt.Segments, error) {
+	if outlines != nil {
+		glyph, err := outlines.LoadGlyph(idx)
+		if err != nil {
+			return nil, err
+		}
+		return glyph.Segments(), nil
+	}
+
+	// LoadGlyph reuses the buffer's storage for the returned segments, so they must be copied out before the next call
+	segments, err := fontData.LoadGlyph(b, idx, fixed.I(int(fontData.UnitsPerEm())), nil)
+	if err != nil {
+		return nil, err
+	}
+	return append(sfnt.Segments(nil), segments...), nil
+}
+
+// layoutString loads the outline of every glyph shaped from s, and positions it with layoutText. The returned segments
+// are translated into place, in font units. Color glyphs in colors, which may be nil, are left out of the segments, to
+// be drawn from the returned layout by colorGlyphs.
+//
+// If checkBounds is set, the bounds of each glyph's outline are compared against sfnt's GlyphBounds, and any
+// discrepancy is logged.
+func layoutString(fontData *sfnt.Font, outlines *ttf.Font, shaper *shaping.Shaper, colors *colr.Font, s string) (segments sfnt.Segments, text *layout.Text, err error) {
+	var b sfnt.Buffer
+
+	text, err = layoutText(fontData, outlines, shaper, s)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, g := range text.Glyphs {
+		if isColorGlyph(colors, g.ID) {
+			continue
+		}
+
+		glyphSegments, err := loadGlyphSegments(fontData, outlines, &b, g.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// GlyphBounds only knows the default instance of a variable font
+		if checkBounds && (outlines == nil || outlines.IsDefaultInstance()) {
+			if err := compareGlyphBounds(fontData, &b, g.ID, g.Rune, segmentBounds(glyphSegments)); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		origin := g.Origin()
+		for _, seg := range glyphSegments {
+			for i := range seg.Args {
+				seg.Args[i] = seg.Args[i].Add(origin)
+			}
+			segments = append(segments, seg)
+		}
+
+		logrus.Debugf("glyph loaded; %d segments for rune %+v at %v", len(glyphSegments), g.Rune, origin)
+	}
+
+	return segments, text, nil
+}
+
+// segmentBounds returns the bounding box of every on-curve and control point in segments.
+func segmentBounds(segments sfnt.Segments) (bounds fixed.Rectangle26_6) {
+	first := true
+	for _, seg := range segments {
+		n := 1
+		switch seg.Op {
+		case sfnt.SegmentOpQuadTo:
+			n = 2
+		case sfnt.SegmentOpCubeTo:
+			n = 3
+		}
+
+		for _, pt := range seg.Args[:n] {
+			if first {
+				bounds.Min, bounds.Max = pt, pt
+				first = false
+				continue
+			}
+			if pt.X < bounds.Min.X {
+				bounds.Min.X = pt.X
+			}
+			if pt.X > bounds.Max.X {
+				bounds.Max.X = pt.X
+			}
+			if pt.Y < bounds.Min.Y {
+				bounds.Min.Y = pt.Y
+			}
+			if pt.Y > bounds.Max.Y {
+				bounds.Max.Y = pt.Y
+			}
+		}
+	}
+	return bounds
+}
+
+// compareGlyphBounds logs a warning if sfnt's GlyphBounds for glyph idx disagrees with the bounds computed from the
+// glyph's outline. Rendering no longer depends on GlyphBounds, but this helps to track down fonts where it is missing or
+// too small.
+func compareGlyphBounds(fontData *sfnt.Font, b *sfnt.Buffer, idx sfnt.GlyphIndex, r rune, outlineBounds fixed.Rectangle26_6) error {
+	unitsPerEm := fixed.I(int(fontData.UnitsPerEm()))
+
+	reported, _, err := fontData.GlyphBounds(b, idx, unitsPerEm, font.HintingNone)
+	if err != nil {
+		return err
+	}
+
+	// GlyphBounds rounds outward to whole font units, so allow for that before reporting
+	const slack = fixed.Int26_6(1 << 6)
+	differs := func(a, b fixed.Int26_6) bool {
+		return a-b > slack || b-a > slack
+	}
+
+	if !differs(outlineBounds.Min.X, reported.Min.X) && !differs(outlineBounds.Min.Y, reported.Min.Y) &&
+		!differs(outlineBounds.Max.X, reported.Max.X) && !differs(outlineBounds.Max.Y, reported.Max.Y) {
+		return nil
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"rune":          string(r),
+		"glyphIndex":    idx,
+		"outlineBounds": outlineBounds,
+		"glyphBounds":   reported,
+	}).Warn("GlyphBounds disagrees with the glyph outline")
+
+	return nil
+}
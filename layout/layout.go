@@ -0,0 +1,291 @@
+// Package layout lays out a string as a block of text: it splits the string into paragraphs at hard line breaks,
+// wraps each paragraph into lines no wider than a maximum width at the break opportunities found by package linebreak,
+// orders each line with package bidi, shapes it with package shaping, and aligns it. The result is a list of
+// positioned glyphs, which the renderer turns into geometry.
+package layout
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bbredesen/ttf-renderer/bidi"
+	"github.com/bbredesen/ttf-renderer/linebreak"
+	"github.com/bbredesen/ttf-renderer/shaping"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// Align is the horizontal alignment of lines within the width of the text.
+type Align int
+
+const (
+	AlignLeft Align = iota
+	AlignRight
+	AlignCenter
+	// AlignJustify stretches the spaces of every line but the last of each paragraph to fill the width. The last line
+	// is aligned to the paragraph's starting side: left, or right for a right to left paragraph.
+	AlignJustify
+)
+
+func (a Align) String() string {
+	switch a {
+	case AlignRight:
+		return "right"
+	case AlignCenter:
+		return "center"
+	case AlignJustify:
+		return "justify"
+	}
+	return "left"
+}
+
+// ParseAlign parses an alignment name: left, right, center or justify.
+func ParseAlign(s string) (Align, error) {
+	for a := AlignLeft; a <= AlignJustify; a++ {
+		if strings.EqualFold(s, a.String()) {
+			return a, nil
+		}
+	}
+	return AlignLeft, fmt.Errorf("layout: invalid alignment %q", s)
+}
+
+// Options control how text is laid out.
+type Options struct {
+	// Size is the font size, in pixels per em. Laying out at the font's units per em gives positions in font units.
+	Size fixed.Int26_6
+
+	// MaxWidth is the width lines wrap at. If zero, lines only break at hard line breaks, and are aligned within the
+	// width of the widest line.
+	MaxWidth fixed.Int26_6
+
+	Align Align
+
+	// Shaping is passed to the shaper for every run of text. Its RightToLeft field is set for each run as needed.
+	Shaping shaping.Options
+
+	// Advances, if set, returns the advance width of a glyph in font units, in place of the font's own. It lets text be
+	// laid out at an instance of a variable font other than the default, whose advances sfnt doesn't know.
+	Advances func(sfnt.GlyphIndex) (int32, error)
+}
+
+// Glyph is a positioned glyph. Positions are relative to the start of the first line's baseline, with the Y axis
+// pointing down.
+type Glyph struct {
+	ID sfnt.GlyphIndex
+
+	// Cluster is the index, in the string's runes, of the first rune the glyph was shaped from, and Rune is that rune
+	Cluster int
+	Rune    rune
+
+	// Pen is the pen position before the glyph, and Offset is where the font places the glyph relative to it, which
+	// is non-zero for glyphs such as marks that are positioned relative to others
+	Pen, Offset fixed.Point26_6
+	Advance     fixed.Int26_6
+
+	// RTL is set for glyphs in a right to left run
+	RTL bool
+}
+
+// Origin returns the point the glyph's outline is drawn from.
+func (g Glyph) Origin() fixed.Point26_6 {
+	return g.Pen.Add(g.Offset)
+}
+
+// Line is a line of text, after wrapping and alignment.
+type Line struct {
+	// Start and End are the indices, in the string's runes, of the line's text. A hard line break that ends the line
+	// is after End.
+	Start, End int
+
+	// Glyphs are the line's glyphs in Text.Glyphs, from the first to be displayed (leftmost) to the last
+	Glyphs []Glyph
+
+	// Baseline is the Y position of the line's baseline
+	Baseline fixed.Int26_6
+
+	// Left and Right are the extent of the line's text, leaving out the whitespace at its end
+	Left, Right fixed.Int26_6
+
+	// Direction is the direction of the paragraph the line is part of
+	Direction bidi.Direction
+}
+
+// Text is a laid out string.
+type Text struct {
+	Glyphs []Glyph
+	Lines  []Line
+
+	// Ascent, Descent and LineGap are the font's metrics at the layout size. LineHeight, their sum, is the distance
+	// between consecutive baselines.
+	Ascent, Descent, LineGap, LineHeight fixed.Int26_6
+
+	// Width is the width lines are aligned within: Options.MaxWidth, or the width of the widest line
+	Width fixed.Int26_6
+}
+
+// Layout lays out s in the font f, shaping it with shaper.
+func Layout(f *sfnt.Font, shaper *shaping.Shaper, s string, opts Options) (*Text, error) {
+	l := &layouter{font: f, shaper: shaper, opts: opts}
+
+	metrics, err := f.Metrics(&l.b, opts.Size, font.HintingNone)
+	if err != nil {
+		return nil, err
+	}
+	t := &Text{
+		Ascent:     metrics.Ascent,
+		Descent:    metrics.Descent,
+		LineGap:    metrics.Height - metrics.Ascent - metrics.Descent,
+		LineHeight: metrics.Height,
+	}
+
+	runes := []rune(s)
+	breaks := linebreak.Breaks(runes)
+
+	// Wrap and position every line, then align them once the width of the widest is known
+	var lines []positionedLine
+	for start := 0; ; {
+		// A paragraph ends at the next mandatory break, and its text leaves out the characters that cause it
+		next := start + 1
+		for next < len(runes) && breaks[next] != linebreak.Mandatory {
+			next++
+		}
+		if next > len(runes) {
+			next = len(runes)
+		}
+		end := next
+		for end > start && isHardBreak(runes[end-1]) {
+			end--
+		}
+
+		paragraphLines, err := l.layoutParagraph(runes, breaks, start, end)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, paragraphLines...)
+
+		// Text that ends with a hard line break ends with an empty line
+		if next == len(runes) && (end == next || start == next) {
+			break
+		}
+		start = next
+	}
+
+	t.Width = opts.MaxWidth
+	if t.Width == 0 {
+		for _, pl := range lines {
+			if w := pl.right - pl.left; w > t.Width {
+				t.Width = w
+			}
+		}
+	}
+
+	for k, pl := range lines {
+		baseline := fixed.Int26_6(k) * t.LineHeight
+		t.Lines = append(t.Lines, pl.align(opts.Align, t.Width, baseline))
+	}
+
+	// Lines keep slices of a single list of every glyph
+	total := 0
+	for _, line := range t.Lines {
+		total += len(line.Glyphs)
+	}
+	t.Glyphs = make([]Glyph, 0, total)
+	for k := range t.Lines {
+		first := len(t.Glyphs)
+		t.Glyphs = append(t.Glyphs, t.Lines[k].Glyphs...)
+		t.Lines[k].Glyphs = t.Glyphs[first:len(t.Glyphs):len(t.Glyphs)]
+	}
+
+	return t, nil
+}
+
+// isHardBreak reports whether r is a hard line break, such as a newline, which ends a paragraph.
+func isHardBreak(r rune) bool {
+	switch linebreak.Lookup(r) {
+	case linebreak.BK, linebreak.CR, linebreak.LF, linebreak.NL:
+		return true
+	}
+	return false
+}
+
+// positionedLine is a line whose glyphs have been positioned from x = 0, before alignment.
+type positionedLine struct {
+	start, end int
+	glyphs     []Glyph
+	direction  bidi.Direction
+
+	// left and right are the extent of the text, without trailing whitespace
+	left, right fixed.Int26_6
+
+	// last is set for the last line of a paragraph, which isn't justified
+	last bool
+}
+
+// align moves the line's glyphs into place for alignment a within width, on the given baseline.
+func (pl positionedLine) align(a Align, width, baseline fixed.Int26_6) Line {
+	content := pl.right - pl.left
+
+	var shift fixed.Int26_6
+	switch a {
+	case AlignRight:
+		shift = width - pl.right
+	case AlignCenter:
+		shift = (width-content)/2 - pl.left
+	case AlignJustify:
+		if pl.last || !pl.justify(width-content) {
+			// Aligned to the paragraph's starting side
+			shift = -pl.left
+			if pl.direction == bidi.RightToLeft {
+				shift = width - pl.right
+			}
+		} else {
+			shift = -pl.left
+			content = width
+		}
+	default:
+		shift = -pl.left
+	}
+
+	line := Line{
+		Start:     pl.start,
+		End:       pl.end,
+		Glyphs:    pl.glyphs,
+		Baseline:  baseline,
+		Left:      pl.left + shift,
+		Right:     pl.left + shift + content,
+		Direction: pl.direction,
+	}
+	for i := range line.Glyphs {
+		line.Glyphs[i].Pen.X += shift
+		line.Glyphs[i].Pen.Y += baseline
+	}
+	return line
+}
+
+// justify spreads extra among the spaces between words, in display order, and reports whether there were any.
+func (pl positionedLine) justify(extra fixed.Int26_6) bool {
+	var spaces []int
+	for i, g := range pl.glyphs {
+		if linebreak.Lookup(g.Rune) == linebreak.SP && g.Pen.X >= pl.left && g.Pen.X < pl.right {
+			spaces = append(spaces, i)
+		}
+	}
+	if len(spaces) == 0 || extra <= 0 {
+		return false
+	}
+
+	var added fixed.Int26_6
+	next := 0
+	for i := range pl.glyphs {
+		pl.glyphs[i].Pen.X += added
+		if next < len(spaces) && spaces[next] == i {
+			// Share the extra space out as evenly as 26.6 fixed point allows
+			share := extra*fixed.Int26_6(next+1)/fixed.Int26_6(len(spaces)) - extra*fixed.Int26_6(next)/fixed.Int26_6(len(spaces))
+			pl.glyphs[i].Advance += share
+			added += share
+			next++
+		}
+	}
+	return true
+}
@@ -0,0 +1,185 @@
+package layout
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bbredesen/ttf-renderer/shaping"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+const fontDir = "../testdata/fonts"
+
+func loadFont(t *testing.T, name string) (*sfnt.Font, *shaping.Shaper) {
+	t.Helper()
+	src, err := os.ReadFile(filepath.Join(fontDir, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := sfnt.Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shaper, err := shaping.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f, shaper
+}
+
+func lineTexts(s string, text *Text) []string {
+	runes := []rune(s)
+	var lines []string
+	for _, line := range text.Lines {
+		lines = append(lines, string(runes[line.Start:line.End]))
+	}
+	return lines
+}
+
+const sample = "The quick brown fox jumps over the lazy dog, and then it naps."
+
+func TestWrap(t *testing.T) {
+	f, shaper := loadFont(t, "Go-Regular.ttf")
+
+	opts := Options{Size: fixed.I(20), MaxWidth: fixed.I(200)}
+	text, err := Layout(f, shaper, sample+"\n\nSecond paragraph.", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := lineTexts(sample+"\n\nSecond paragraph.", text)
+	if len(lines) < 4 {
+		t.Fatalf("got %d lines %q, want the first paragraph wrapped, an empty line and the second paragraph", len(lines), lines)
+	}
+	if got := lines[len(lines)-2]; got != "" {
+		t.Errorf("got %q for the empty line between paragraphs", got)
+	}
+	if got := lines[len(lines)-1]; got != "Second paragraph." {
+		t.Errorf("got %q for the last line", got)
+	}
+
+	for k, line := range text.Lines {
+		if line.Right-line.Left > opts.MaxWidth {
+			t.Errorf("line %d %q is %v wide, more than %v", k, lines[k], line.Right-line.Left, opts.MaxWidth)
+		}
+		if want := fixed.Int26_6(k) * text.LineHeight; line.Baseline != want {
+			t.Errorf("line %d has baseline %v, want %v", k, line.Baseline, want)
+		}
+		for _, g := range line.Glyphs {
+			if g.Pen.Y != line.Baseline {
+				t.Errorf("line %d has a glyph at y = %v, off its baseline", k, g.Pen.Y)
+			}
+		}
+	}
+
+	// Each wrapped line would have been too wide with the next word
+	for k := 0; k+1 < len(lines)-2; k++ {
+		if lines[k] == "" || lines[k][len(lines[k])-1] != ' ' {
+			t.Errorf("line %d %q doesn't end with the space it wrapped at", k, lines[k])
+		}
+	}
+
+	if text.LineHeight != text.Ascent+text.Descent+text.LineGap {
+		t.Errorf("line height %v isn't ascent %v + descent %v + line gap %v", text.LineHeight, text.Ascent, text.Descent, text.LineGap)
+	}
+}
+
+func TestNoWrap(t *testing.T) {
+	f, shaper := loadFont(t, "Go-Regular.ttf")
+
+	text, err := Layout(f, shaper, "one\ntwo three\n", Options{Size: fixed.I(20)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := lineTexts("one\ntwo three\n", text)
+	if want := []string{"one", "two three", ""}; len(lines) != len(want) || lines[0] != want[0] || lines[1] != want[1] || lines[2] != want[2] {
+		t.Errorf("got lines %q, want %q", lines, want)
+	}
+	if text.Width != text.Lines[1].Right-text.Lines[1].Left {
+		t.Errorf("width %v isn't that of the widest line", text.Width)
+	}
+}
+
+func TestAlign(t *testing.T) {
+	f, shaper := loadFont(t, "Go-Regular.ttf")
+	maxWidth := fixed.I(200)
+
+	for _, align := range []Align{AlignLeft, AlignRight, AlignCenter, AlignJustify} {
+		text, err := Layout(f, shaper, sample, Options{Size: fixed.I(20), MaxWidth: maxWidth, Align: align})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for k, line := range text.Lines {
+			last := k == len(text.Lines)-1
+			var wantLeft, wantRight fixed.Int26_6 = -1, -1
+			switch {
+			case align == AlignLeft, align == AlignJustify && last:
+				wantLeft = 0
+			case align == AlignRight:
+				wantRight = maxWidth
+			case align == AlignCenter:
+				if d := line.Left - (maxWidth - line.Right); d < -1 || d > 1 {
+					t.Errorf("%v: line %d isn't centered: %v to %v", align, k, line.Left, line.Right)
+				}
+			case align == AlignJustify:
+				wantLeft, wantRight = 0, maxWidth
+			}
+			if wantLeft >= 0 && line.Left != wantLeft {
+				t.Errorf("%v: line %d starts at %v, want %v", align, k, line.Left, wantLeft)
+			}
+			if wantRight >= 0 && line.Right != wantRight {
+				t.Errorf("%v: line %d ends at %v, want %v", align, k, line.Right, wantRight)
+			}
+			if first := line.Glyphs[0]; first.Pen.X != line.Left {
+				t.Errorf("%v: line %d's first glyph is at %v, not its left edge %v", align, k, first.Pen.X, line.Left)
+			}
+		}
+
+		if align == AlignJustify {
+			// The last visible glyph of a justified line ends at the right edge
+			line := text.Lines[0]
+			end := line.Glyphs[0].Pen.X
+			for _, g := range line.Glyphs {
+				if g.Rune != ' ' {
+					end = g.Pen.X + g.Advance
+				}
+			}
+			if end != maxWidth {
+				t.Errorf("justified line ends at %v, want %v", end, maxWidth)
+			}
+		}
+	}
+}
+
+func TestAdvances(t *testing.T) {
+	f, shaper := loadFont(t, "Go-Regular.ttf")
+
+	// At a size of the font's units per em, positions are in font units
+	opts := Options{
+		Size:     fixed.I(int(f.UnitsPerEm())),
+		Advances: func(sfnt.GlyphIndex) (int32, error) { return 1000, nil },
+	}
+	text, err := Layout(f, shaper, "iii", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, g := range text.Glyphs {
+		if want := fixed.I(1000 * i); g.Pen.X != want || g.Advance != fixed.I(1000) {
+			t.Errorf("glyph %d is at %v with advance %v, want %v and 1000", i, g.Pen.X, g.Advance, want)
+		}
+	}
+}
+
+func TestParseAlign(t *testing.T) {
+	for _, s := range []string{"left", "Right", "CENTER", "justify"} {
+		if _, err := ParseAlign(s); err != nil {
+			t.Errorf("ParseAlign(%q): %v", s, err)
+		}
+	}
+	if _, err := ParseAlign("middle"); err == nil {
+		t.Error("ParseAlign(\"middle\") succeeded")
+	}
+}
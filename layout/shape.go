@@ -0,0 +1,232 @@
+package layout
+
+import (
+	"unicode"
+
+	"github.com/bbredesen/ttf-renderer/bidi"
+	"github.com/bbredesen/ttf-renderer/linebreak"
+	"github.com/bbredesen/ttf-renderer/shaping"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// layouter holds the state shared by the paragraphs of a single call to Layout.
+type layouter struct {
+	font   *sfnt.Font
+	shaper *shaping.Shaper
+	opts   Options
+	b      sfnt.Buffer
+}
+
+// layoutParagraph wraps the paragraph runes[start:end] into lines, and positions the glyphs of each from x = 0.
+func (l *layouter) layoutParagraph(runes []rune, breaks []linebreak.Opportunity, start, end int) ([]positionedLine, error) {
+	text := runes[start:end]
+	paragraph := bidi.Resolve(text, bidi.Auto)
+
+	lineEnds := []int{len(text)}
+	if l.opts.MaxWidth > 0 && len(text) > 0 {
+		widths, err := l.measure(text, paragraph)
+		if err != nil {
+			return nil, err
+		}
+		lineEnds = wrap(text, widths, breaks[start:end+1], l.opts.MaxWidth)
+	}
+
+	lines := make([]positionedLine, 0, len(lineEnds))
+	lineStart := 0
+	for k, lineEnd := range lineEnds {
+		var glyphs []Glyph
+		var pen fixed.Point26_6
+		for _, run := range paragraph.Line(lineStart, lineEnd) {
+			var err error
+			if glyphs, pen, err = l.positionRun(glyphs, pen, text, run, start); err != nil {
+				return nil, err
+			}
+		}
+
+		// Whitespace at the end of the line doesn't count towards its extent. The bidi algorithm puts it at the end of
+		// the line in the paragraph's direction.
+		var trailing fixed.Int26_6
+		wsStart := lineEnd
+		for wsStart > lineStart && unicode.IsSpace(text[wsStart-1]) {
+			wsStart--
+		}
+		for _, g := range glyphs {
+			if g.Cluster >= start+wsStart && g.Cluster < start+lineEnd {
+				trailing += g.Advance
+			}
+		}
+
+		pl := positionedLine{
+			start:     start + lineStart,
+			end:       start + lineEnd,
+			glyphs:    glyphs,
+			direction: paragraph.Direction(),
+			left:      0,
+			right:     pen.X - trailing,
+			last:      k == len(lineEnds)-1,
+		}
+		if pl.direction == bidi.RightToLeft {
+			pl.left, pl.right = trailing, pen.X
+		}
+		lines = append(lines, pl)
+
+		lineStart = lineEnd
+	}
+
+	return lines, nil
+}
+
+// measure returns the width each rune of a paragraph takes up on a line: the advances of the glyphs of its cluster, if
+// it is the first rune of one, and zero otherwise.
+func (l *layouter) measure(text []rune, paragraph *bidi.Paragraph) ([]fixed.Int26_6, error) {
+	widths := make([]fixed.Int26_6, len(text))
+	for _, run := range paragraph.Runs() {
+		glyphs, pen, err := l.positionRun(nil, fixed.Point26_6{}, text, run, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		// Each glyph takes up the space up to the next, which includes any kerning between them
+		for i, g := range glyphs {
+			next := pen.X
+			if i+1 < len(glyphs) {
+				next = glyphs[i+1].Pen.X
+			}
+			widths[g.Cluster] += next - g.Pen.X
+		}
+	}
+	return widths, nil
+}
+
+// wrap returns the end of each line of a paragraph, breaking at the last opportunity before the text gets wider than
+// maxWidth. Whitespace at the end of a line is allowed past maxWidth. A word wider than maxWidth on its own overflows.
+func wrap(text []rune, widths []fixed.Int26_6, breaks []linebreak.Opportunity, maxWidth fixed.Int26_6) []int {
+	var ends []int
+	lineStart, segmentStart := 0, 0
+	var lineWidth fixed.Int26_6
+
+	for i := 1; i <= len(text); i++ {
+		if i < len(text) && breaks[i] == linebreak.None {
+			continue
+		}
+
+		// The segment from the last break opportunity to this one either fits on the line, or starts a new one
+		var width, trailing fixed.Int26_6
+		for k := segmentStart; k < i; k++ {
+			width += widths[k]
+		}
+		for k := i - 1; k >= segmentStart && unicode.IsSpace(text[k]); k-- {
+			trailing += widths[k]
+		}
+		if lineWidth+width-trailing > maxWidth && segmentStart > lineStart {
+			ends = append(ends, segmentStart)
+			lineStart, lineWidth = segmentStart, 0
+		}
+		lineWidth += width
+		segmentStart = i
+	}
+
+	return append(ends, len(text))
+}
+
+// scale converts a distance in font units to 26.6 fixed point at the layout size.
+func (l *layouter) scale(v int32) fixed.Int26_6 {
+	return fixed.Int26_6(int64(v) * int64(l.opts.Size) / int64(l.font.UnitsPerEm()))
+}
+
+// positionRun shapes a single run of a paragraph, whose first rune is the string's rune at index textStart, and
+// appends its glyphs to glyphs in display order. It returns the pen position after the run.
+func (l *layouter) positionRun(glyphs []Glyph, pen fixed.Point26_6, text []rune, run bidi.Run, textStart int) ([]Glyph, fixed.Point26_6, error) {
+	// Requesting sizes at one pixel per font unit makes sfnt return values in (unhinted) font units
+	unitsPerEm := fixed.I(int(l.font.UnitsPerEm()))
+
+	rtl := run.Direction() == bidi.RightToLeft
+	runText := text[run.Start:run.End]
+
+	ids := make([]sfnt.GlyphIndex, len(runText))
+	for i, r := range runText {
+		var err error
+		if rtl {
+			// Characters such as parentheses are drawn with the glyph of their mirror image, if the font has one
+			if m, ok := bidi.Mirror(r); ok {
+				if ids[i], err = l.font.GlyphIndex(&l.b, m); err == nil && ids[i] != 0 {
+					continue
+				}
+			}
+		}
+		if ids[i], err = l.font.GlyphIndex(&l.b, r); err != nil {
+			return nil, pen, err
+		}
+	}
+
+	opts := l.opts.Shaping
+	opts.RightToLeft = rtl
+	shaped := l.shaper.Shape(runText, ids, opts)
+
+	advances := make([]int32, len(shaped))
+	for i, g := range shaped {
+		if l.opts.Advances != nil {
+			advance, err := l.opts.Advances(g.ID)
+			if err != nil {
+				return nil, pen, err
+			}
+			advances[i] = advance
+			continue
+		}
+
+		advance, err := l.font.GlyphAdvance(&l.b, g.ID, unitsPerEm, font.HintingNone)
+		if err != nil {
+			return nil, pen, err
+		}
+		advances[i] = int32(advance.Round())
+	}
+
+	// If the font has a GPOS table, its lookups adjust each glyph's advance and offset; otherwise, the legacy kern
+	// table adjusts the space between pairs of glyphs
+	var placements []shaping.Position
+	if l.shaper.HasPositioning() {
+		placements = l.shaper.Position(runText, shaped, advances, opts)
+	}
+
+	for k := range shaped {
+		// Glyphs are shaped in logical order, and displayed in reverse in a right to left run
+		i := k
+		if rtl {
+			i = len(shaped) - 1 - k
+		}
+		g := shaped[i]
+
+		var offset fixed.Point26_6
+		advance := l.scale(advances[i])
+
+		if placements != nil {
+			// GPOS values have the Y axis pointing up
+			p := placements[i]
+			advance = l.scale(p.XAdvance)
+			offset = fixed.Point26_6{X: l.scale(p.XOffset), Y: -l.scale(p.YOffset)}
+			pen.Y -= l.scale(p.YAdvance)
+		} else if k > 0 {
+			kern, err := l.font.Kern(&l.b, glyphs[len(glyphs)-1].ID, g.ID, unitsPerEm, font.HintingNone)
+			if err != nil && err != sfnt.ErrNotFound {
+				return nil, pen, err
+			}
+			pen.X += l.scale(int32(kern.Round()))
+		}
+
+		glyphs = append(glyphs, Glyph{
+			ID:      g.ID,
+			Cluster: textStart + run.Start + g.Cluster,
+			Rune:    runText[g.Cluster],
+			Pen:     pen,
+			Offset:  offset,
+			Advance: advance,
+			RTL:     rtl,
+		})
+
+		pen.X += advance
+	}
+
+	return glyphs, pen, nil
+}
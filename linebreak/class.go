@@ -0,0 +1,172 @@
+package linebreak
+
+import "unicode"
+
+// Class is a Line_Break property value.
+type Class uint8
+
+// Line_Break classes, named as in UAX #14
+const (
+	XX  Class = iota // Unknown
+	BK               // Mandatory break
+	CR               // Carriage return
+	LF               // Line feed
+	NL               // Next line
+	SP               // Space
+	ZW               // Zero width space
+	GL               // Non-breaking ("glue")
+	CM               // Combining mark
+	WJ               // Word joiner
+	ZWJ              // Zero width joiner
+	BA               // Break after
+	HY               // Hyphen
+	B2               // Break on either side, but not between two
+	BB               // Break before
+	CB               // Contingent break
+	CL               // Close punctuation
+	CP               // Close parenthesis
+	OP               // Open punctuation
+	QU               // Quotation
+	EX               // Exclamation or interrogation
+	IS               // Infix numeric separator
+	NS               // Nonstarter
+	SY               // Symbols allowing a break after
+	IN               // Inseparable
+	NU               // Numeric
+	PR               // Prefix numeric
+	PO               // Postfix numeric
+	AL               // Alphabetic
+	HL               // Hebrew letter
+	ID               // Ideographic
+	CJ               // Conditional Japanese starter
+	H2               // Hangul LV syllable
+	H3               // Hangul LVT syllable
+	JL               // Hangul L jamo
+	JV               // Hangul V jamo
+	JT               // Hangul T jamo
+	RI               // Regional indicator
+	EB               // Emoji base
+	EM               // Emoji modifier
+	SA               // Complex context (South East Asian)
+	AI               // Ambiguous
+	SG               // Surrogate
+)
+
+// specialClasses are the classes of characters that can't be told apart by their general category.
+var specialClasses = map[rune]Class{
+	0x0009: BA, 0x000A: LF, 0x000B: BK, 0x000C: BK, 0x000D: CR, 0x0085: NL, 0x2028: BK, 0x2029: BK,
+	0x0020: SP, 0x200B: ZW, 0x200D: ZWJ, 0x2060: WJ, 0xFEFF: WJ,
+	0x00A0: GL, 0x034F: GL, 0x0F0C: GL, 0x180E: GL, 0x2007: GL, 0x2011: GL, 0x202F: GL,
+
+	'!': EX, '?': EX, '"': QU, '\'': QU, '$': PR, '%': PO, '+': PR, '\\': PR, ',': IS, '.': IS, ':': IS, ';': IS,
+	'-': HY, '/': SY, '|': BA, '}': CL, 0x00A2: PO, 0x00B0: PO, 0x2030: PO, 0x2031: PO, 0x2032: PO, 0x2033: PO,
+	0x2103: PO, 0x2109: PO, 0x00B1: PR, 0x2116: PR, 0x2212: PR, 0x2213: PR,
+
+	0x00AD: BA, 0x058A: BA, 0x05BE: BA, 0x2010: BA, 0x2012: BA, 0x2013: BA, 0x2014: B2, 0x2024: IN, 0x2025: IN,
+	0x2026: IN, 0x2027: BA, 0x203C: NS, 0x203D: NS, 0x2047: NS, 0x2048: NS, 0x2049: NS, 0x2044: IS, 0x00B4: BB,
+	0x02C8: BB, 0x02CC: BB, 0x0F01: BB, 0xFFFC: CB,
+
+	0x3001: CL, 0x3002: CL, 0xFE10: IS, 0xFE11: CL, 0xFE12: CL, 0xFF0C: CL, 0xFF0E: CL, 0xFF61: CL, 0xFF64: CL,
+	0x3005: NS, 0x301C: NS, 0x303B: NS, 0x303C: NS, 0x309B: NS, 0x309C: NS, 0x309D: NS, 0x309E: NS, 0x30A0: NS,
+	0x30FB: NS, 0x30FD: NS, 0x30FE: NS, 0xFF1A: NS, 0xFF1B: NS, 0xFF65: NS, 0xFF01: EX, 0xFF1F: EX, 0x30FC: CJ,
+	0xFF70: CJ, 0x3000: BA,
+}
+
+// smallKana are the small hiragana and katakana letters, which are conditional Japanese starters.
+var smallKana = []rune{
+	0x3041, 0x3043, 0x3045, 0x3047, 0x3049, 0x3063, 0x3083, 0x3085, 0x3087, 0x308E, 0x3095, 0x3096,
+	0x30A1, 0x30A3, 0x30A5, 0x30A7, 0x30A9, 0x30C3, 0x30E3, 0x30E5, 0x30E7, 0x30EE, 0x30F5, 0x30F6,
+}
+
+// Lookup returns the Line_Break class of r. The classes of punctuation and other special characters are listed
+// individually; everything else is derived from the general category and script, which matches LineBreak.txt for
+// letters, digits, marks and ideographs but not for every symbol.
+func Lookup(r rune) Class {
+	if c, ok := specialClasses[r]; ok {
+		return c
+	}
+	for _, k := range smallKana {
+		if r == k {
+			return CJ
+		}
+	}
+
+	switch {
+	case r >= 0xAC00 && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return H2
+		}
+		return H3
+	case r >= 0x1100 && r <= 0x115F, r >= 0xA960 && r <= 0xA97C:
+		return JL
+	case r >= 0x1160 && r <= 0x11A7, r >= 0xD7B0 && r <= 0xD7C6:
+		return JV
+	case r >= 0x11A8 && r <= 0x11FF, r >= 0xD7CB && r <= 0xD7FB:
+		return JT
+	case r >= 0x1F1E6 && r <= 0x1F1FF:
+		return RI
+	case r >= 0x1F3FB && r <= 0x1F3FF:
+		return EM
+	case r >= 0xD800 && r <= 0xDFFF:
+		return SG
+	}
+
+	switch {
+	case unicode.In(r, unicode.Mn, unicode.Mc, unicode.Me):
+		if unicode.In(r, unicode.Thai, unicode.Lao, unicode.Myanmar, unicode.Khmer) {
+			return SA
+		}
+		return CM
+	case unicode.Is(unicode.Cc, r):
+		return CM
+	case unicode.Is(unicode.Nd, r):
+		return NU
+	case unicode.Is(unicode.Ps, r):
+		return OP
+	case unicode.Is(unicode.Pe, r):
+		if r == ')' || r == ']' {
+			return CP
+		}
+		return CL
+	case unicode.In(r, unicode.Pi, unicode.Pf):
+		return QU
+	case unicode.Is(unicode.Zs, r):
+		return BA
+	case unicode.Is(unicode.Sc, r):
+		return PR
+	case unicode.Is(unicode.Hebrew, r) && unicode.IsLetter(r):
+		return HL
+	case unicode.In(r, unicode.Thai, unicode.Lao, unicode.Myanmar, unicode.Khmer):
+		return SA
+	case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Yi, unicode.Bopomofo),
+		r >= 0x2E80 && r <= 0x2FFF, r >= 0x3000 && r <= 0x33FF, r >= 0xFE30 && r <= 0xFE4F,
+		r >= 0xFF00 && r <= 0xFF60, r >= 0xFFE0 && r <= 0xFFE6,
+		r >= 0x1F000 && r <= 0x1FAFF, r >= 0x20000 && r <= 0x3FFFD:
+		return ID
+	}
+
+	return AL
+}
+
+// resolve maps the classes that UAX #14 leaves to tailoring to the ones they default to (LB1).
+func resolve(c Class, r rune) Class {
+	switch c {
+	case AI, SG, XX:
+		return AL
+	case SA:
+		if unicode.In(r, unicode.Mn, unicode.Mc) {
+			return CM
+		}
+		return AL
+	case CJ:
+		return NS
+	}
+	return c
+}
+
+// isEastAsianWide reports whether r is wide or fullwidth, for LB30. This covers the CJK punctuation and fullwidth
+// forms that open and close punctuation are drawn from.
+func isEastAsianWide(r rune) bool {
+	return r >= 0x2E80 && r <= 0x303E || r >= 0xFE30 && r <= 0xFE4F || r >= 0xFF00 && r <= 0xFF60 ||
+		r >= 0xFFE0 && r <= 0xFFE6
+}
@@ -0,0 +1,203 @@
+// Package linebreak finds the positions in text where a line may be broken, following the Unicode Line Breaking
+// Algorithm (UAX #14): after spaces and hyphens, between ideographs, but not before closing punctuation or inside a
+// number such as "1,000.00".
+package linebreak
+
+// Opportunity is whether a line may break at a position in text.
+type Opportunity uint8
+
+const (
+	// None means the line must not break here
+	None Opportunity = iota
+	// Allowed means the line may break here
+	Allowed
+	// Mandatory means the line must break here, such as after a newline
+	Mandatory
+)
+
+func (o Opportunity) String() string {
+	switch o {
+	case Allowed:
+		return "Allowed"
+	case Mandatory:
+		return "Mandatory"
+	}
+	return "None"
+}
+
+// Breaks returns the break opportunity before each rune of text, and at its end: element i is whether a line may
+// break between text[i-1] and text[i]. The first element is always None, and the last, at len(text), is always
+// Mandatory (LB2, LB3).
+func Breaks(text []rune) []Opportunity {
+	breaks := make([]Opportunity, len(text)+1)
+	if len(text) == 0 {
+		return breaks
+	}
+	breaks[len(text)] = Mandatory
+
+	classes := make([]Class, len(text))
+	for i, r := range text {
+		classes[i] = resolve(Lookup(r), r)
+	}
+
+	// a is the class before the position being considered, after combining marks have been attached to their base
+	// (LB9, LB10), and ra is that base. prevA is the class before that, beforeSpaces is the last class before any
+	// spaces, and riCount is the number of regional indicators in a row up to a.
+	a, ra := classes[0], text[0]
+	if a == CM || a == ZWJ {
+		a = AL
+	}
+	prevA, beforeSpaces, riCount := XX, a, 0
+	if a == RI {
+		riCount = 1
+	}
+
+	for i := 1; i < len(text); i++ {
+		c := classes[i]
+		breaks[i] = opportunity(a, prevA, beforeSpaces, classes[i-1], c, riCount, ra, text[i])
+
+		if (c == CM || c == ZWJ) && !isBreakOrSpace(a) {
+			// Attached to the character before it, whose class it takes on (LB9)
+			continue
+		}
+		if c == CM || c == ZWJ {
+			c = AL
+		}
+		prevA, a, ra = a, c, text[i]
+		if a != SP {
+			beforeSpaces = a
+		}
+		if a == RI {
+			riCount++
+		} else {
+			riCount = 0
+		}
+	}
+
+	return breaks
+}
+
+func isBreakOrSpace(c Class) bool {
+	switch c {
+	case BK, CR, LF, NL, SP, ZW:
+		return true
+	}
+	return false
+}
+
+func isAlphabetic(c Class) bool {
+	return c == AL || c == HL
+}
+
+func isHangul(c Class) bool {
+	switch c {
+	case JL, JV, JT, H2, H3:
+		return true
+	}
+	return false
+}
+
+// opportunity applies rules LB4 to LB31 to the position between a character of class a and one of class c. rawPrev is
+// the class of the character immediately before the position, which differs from a if it is a combining mark. ra is
+// the character of class a, and rc the character after the position.
+func opportunity(a, prevA, beforeSpaces, rawPrev, c Class, riCount int, ra, rc rune) Opportunity {
+	// Hard line breaks, and characters that are never broken before
+	switch {
+	case a == BK:
+		return Mandatory
+	case a == CR && c == LF:
+		return None
+	case a == CR || a == LF || a == NL:
+		return Mandatory
+	case c == BK || c == CR || c == LF || c == NL:
+		return None
+	case c == SP || c == ZW:
+		return None
+	case beforeSpaces == ZW:
+		return Allowed
+	case rawPrev == ZWJ:
+		return None
+	case (c == CM || c == ZWJ) && !isBreakOrSpace(a):
+		return None
+	}
+
+	if c == CM || c == ZWJ {
+		c = AL
+	}
+
+	switch {
+	// LB11 to LB13: word joiners, glue, and punctuation that is never at the start of a line
+	case a == WJ || c == WJ:
+		return None
+	case a == GL:
+		return None
+	case c == GL && a != SP && a != BA && a != HY:
+		return None
+	case c == CL || c == CP || c == EX || c == IS || c == SY:
+		return None
+
+	// LB14 to LB17: punctuation that holds on to what follows it, even across spaces
+	case beforeSpaces == OP:
+		return None
+	case beforeSpaces == QU && c == OP:
+		return None
+	case (beforeSpaces == CL || beforeSpaces == CP) && c == NS:
+		return None
+	case beforeSpaces == B2 && c == B2:
+		return None
+
+	// LB18: break after spaces
+	case a == SP:
+		return Allowed
+
+	// LB19 to LB22
+	case a == QU || c == QU:
+		return None
+	case a == CB || c == CB:
+		return Allowed
+	case c == BA || c == HY || c == NS || a == BB:
+		return None
+	case prevA == HL && (a == HY || a == BA):
+		return None
+	case a == SY && c == HL:
+		return None
+	case c == IN:
+		return None
+
+	// LB23 to LB25: letters, numbers and the prefixes and suffixes around numbers
+	case isAlphabetic(a) && c == NU, a == NU && isAlphabetic(c):
+		return None
+	case a == PR && (c == ID || c == EB || c == EM), (a == ID || a == EB || a == EM) && c == PO:
+		return None
+	case (a == PR || a == PO) && isAlphabetic(c), isAlphabetic(a) && (c == PR || c == PO):
+		return None
+	case (a == CL || a == CP || a == NU) && (c == PO || c == PR),
+		(a == PO || a == PR) && (c == OP || c == NU),
+		(a == HY || a == IS || a == NU || a == SY) && c == NU:
+		return None
+
+	// LB26, LB27: Korean syllables
+	case a == JL && (c == JL || c == JV || c == H2 || c == H3),
+		(a == JV || a == H2) && (c == JV || c == JT),
+		(a == JT || a == H3) && c == JT:
+		return None
+	case isHangul(a) && c == PO, a == PR && isHangul(c):
+		return None
+
+	// LB28 to LB30b
+	case isAlphabetic(a) && isAlphabetic(c):
+		return None
+	case a == IS && isAlphabetic(c):
+		return None
+	case (isAlphabetic(a) || a == NU) && c == OP && !isEastAsianWide(rc),
+		a == CP && (isAlphabetic(c) || c == NU) && !isEastAsianWide(ra):
+		return None
+	case a == RI && c == RI && riCount%2 == 1:
+		return None
+	case a == EB && c == EM:
+		return None
+	}
+
+	// LB31
+	return Allowed
+}
@@ -0,0 +1,80 @@
+package linebreak
+
+import (
+	"strings"
+	"testing"
+)
+
+// segments splits text at every break opportunity, marking mandatory breaks with a trailing '!'.
+func segments(text string) []string {
+	runes := []rune(text)
+	breaks := Breaks(runes)
+
+	var out []string
+	start := 0
+	for i := 1; i <= len(runes); i++ {
+		if breaks[i] == None {
+			continue
+		}
+		s := string(runes[start:i])
+		if breaks[i] == Mandatory && i < len(runes) {
+			s += "!"
+		}
+		out = append(out, s)
+		start = i
+	}
+	return out
+}
+
+func TestBreaks(t *testing.T) {
+	tests := []struct {
+		text string
+		want string // Segments separated by '|'
+	}{
+		{"hello world", "hello |world"},
+		{"two  spaces", "two  |spaces"},
+		{"line\nbreak", "line\n!|break"},
+		{"crlf\r\nbreak", "crlf\r\n!|break"},
+		{"well-known", "well-|known"},
+		{"price $1,000.00 (approx.)", "price |$1,000.00 |(approx.)"},
+		{"50% off!", "50% |off!"},
+		{"say \"hi\" now", "say |\"hi\" |now"},
+		{"a\u00a0b c", "a\u00a0b |c"},
+		{"a\u200bb", "a\u200b|b"},
+		{"été fin", "été |fin"},
+		{"日本語の文章。", "日|本|語|の|文|章。"},
+		{"한국어 문장", "한|국|어 |문|장"},
+		{"עברית-טקסט", "עברית-טקסט"},
+		{"path/to/file", "path/|to/|file"},
+		{"end.", "end."},
+		{"f(x)y", "f(x)y"},
+		{"注）a", "注）|a"},
+	}
+
+	for _, test := range tests {
+		got := strings.Join(segments(test.text), "|")
+		if got != test.want {
+			t.Errorf("%q: got %q, want %q", test.text, got, test.want)
+		}
+	}
+}
+
+// Lookup classes fullwidth closing parentheses as CL, so LB30's exception for wide CP characters is tested directly.
+func TestWideCloseParenthesis(t *testing.T) {
+	if got := opportunity(CP, AL, CP, CP, AL, 0, '\uFF09', 'a'); got != Allowed {
+		t.Errorf("fullwidth ) before a letter: got %v, want Allowed", got)
+	}
+	if got := opportunity(CP, AL, CP, CP, AL, 0, ')', 'a'); got != None {
+		t.Errorf(") before a letter: got %v, want None", got)
+	}
+}
+
+func TestBreaksEnds(t *testing.T) {
+	if got := Breaks(nil); len(got) != 1 || got[0] != None {
+		t.Errorf("empty text: got %v", got)
+	}
+	breaks := Breaks([]rune("ab"))
+	if breaks[0] != None || breaks[2] != Mandatory {
+		t.Errorf("got %v, want None at the start and Mandatory at the end", breaks)
+	}
+}
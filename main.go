@@ -2,35 +2,90 @@ package main
 
 import (
 	"flag"
+	"image/png"
+	"math"
 	"os"
+	"time"
 
 	"github.com/bbredesen/go-vk"
+	"github.com/bbredesen/ttf-renderer/colr"
+	"github.com/bbredesen/ttf-renderer/layout"
+	"github.com/bbredesen/ttf-renderer/shaping"
 	"github.com/bbredesen/ttf-renderer/shared"
+	"github.com/bbredesen/ttf-renderer/ttf"
 	"github.com/bbredesen/ttf-renderer/vkctx"
+	"github.com/bbredesen/ttf-renderer/woff"
+	"github.com/bbredesen/vkm"
 	"github.com/sirupsen/logrus"
-	"golang.org/x/image/font"
 	"golang.org/x/image/font/sfnt"
-	"golang.org/x/image/math/fixed"
-	"golang.org/x/sys/windows"
 )
 
 func init() {
 	flag.StringVar(&fontFilename, "font", `C:\WINDOWS\FONTS\ELEPHNT.TTF`, "filename to render")
 	// flag.StringVar(&fontFilename, "font", `C:\WINDOWS\FONTS\BKANT.TTF`BAHNSCHRIFT, "filename to render")
-	flag.StringVar(&renderString, "char", "R", "single character to render")
-
-	flag.Parse()
+	flag.StringVar(&renderString, "char", "R", "string to render")
+	flag.BoolVar(&checkBounds, "check-bounds", false, "log glyphs where sfnt's GlyphBounds disagrees with the outline geometry")
+	flag.Float64Var(&cubicTolerance, "cubic-tolerance", 0.25, "maximum error, in pixels, when approximating cubic (CFF) curves with quadratics")
+	flag.Float64Var(&ppem, "size", 160, "text size, in pixels per em")
+	flag.Float64Var(&textX, "x", 20, "x position of the start of the baseline, in pixels from the left of the window")
+	flag.Float64Var(&textY, "y", 200, "y position of the baseline, in pixels from the top of the window")
+	flag.StringVar(&headlessOutput, "headless", "", "render a single frame offscreen, without opening a window, and write it to this PNG file")
+	flag.UintVar(&width, "width", 800, "window or offscreen image width, in pixels")
+	flag.UintVar(&height, "height", 800, "window or offscreen image height, in pixels")
+	flag.BoolVar(&useAtlas, "atlas", false, "rasterize each glyph once into a texture atlas, and draw the string as textured quads")
+	flag.Float64Var(&msdfSpread, "msdf", 0, "store atlas glyphs as multi-channel signed distance fields with this spread, in pixels, drawn with shaders/msdf.frag; implies -atlas")
+	flag.StringVar(&scriptTag, "script", "", "OpenType script tag to shape with, e.g. latn or arab; detected from the string if empty")
+	flag.StringVar(&languageTag, "lang", "", "OpenType language system tag to shape with, e.g. TRK; the script's default if empty")
+	flag.StringVar(&featureList, "features", "", "comma separated OpenType features to turn on, or off with a '-' prefix, e.g. smcp,-liga")
+	flag.Float64Var(&maxWidth, "max-width", 0, "width, in pixels, to wrap lines at; lines only break at newlines if zero")
+	flag.StringVar(&faceSelection, "face", "", "face to render from a font collection (.ttc or .otc), by index or PostScript name; the first if empty")
+	flag.BoolVar(&listFaces, "list-faces", false, "print the index, PostScript name, family and style of every face in the font file, with the axes and instances of variable fonts, and exit")
+	flag.StringVar(&alignName, "align", "left", "alignment of lines: left, right, center or justify")
+	flag.StringVar(&axisList, "axis", "", "comma separated axis values selecting an instance of a variable font, e.g. wght=700,wdth=85")
+	flag.StringVar(&sweepList, "sweep", "", "comma separated axis ranges of a variable font to animate back and forth in the window, e.g. wght=100:900")
+	flag.DurationVar(&sweepPeriod, "sweep-period", 4*time.Second, "time taken by -sweep to go from the start of each range to its end and back")
+	flag.IntVar(&paletteIndex, "palette", 0, "index of the CPAL palette to draw the color glyphs of a COLR font in")
 }
 
 var (
 	fontFilename, renderString string
-)
+	faceSelection              string
+	listFaces                  bool
+	cubicTolerance             float64
+	checkBounds                bool
+
+	ppem, textX, textY float64
+
+	headlessOutput string
+	width, height  uint
+
+	useAtlas   bool
+	msdfSpread float64
 
-const (
-	ppem = 640
+	scriptTag, languageTag, featureList string
+	shapingOptions                      shaping.Options
+
+	maxWidth  float64
+	alignName string
+	textAlign layout.Align
+
+	axisList    string
+	sweepList   string
+	sweepPeriod time.Duration
+
+	paletteIndex int
 )
 
+// atlasSize is the width and height of the glyph atlas texture, in pixels
+const atlasSize = 1024
+
 func main() {
+	// Parsed here rather than in init, which also runs under go test, before the testing flags are registered
+	flag.Parse()
+	if msdfSpread > 0 {
+		useAtlas = true
+	}
+
 	fontBytes, err := os.ReadFile(fontFilename)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
@@ -40,7 +95,18 @@ func main() {
 		os.Exit(1)
 	}
 
-	fontData, err := sfnt.Parse(fontBytes)
+	// Web fonts are decoded to the sfnt data they wrap, and from then on read like any other font file
+	if woff.IsWOFF(fontBytes) {
+		if fontBytes, err = woff.Decode(fontBytes); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"filename": fontFilename,
+				"error":    err,
+			}).Error("Failed to decode web font")
+			os.Exit(1)
+		}
+	}
+
+	file, err := parseFontFile(fontBytes)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{
 			"filename": fontFilename,
@@ -49,37 +115,220 @@ func main() {
 		os.Exit(1)
 	}
 
-	var b sfnt.Buffer
-	idx, err := fontData.GlyphIndex(&b, rune(renderString[0]))
+	if listFaces {
+		if err := file.listFaces(os.Stdout); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"filename": fontFilename,
+				"error":    err,
+			}).Error("Failed to list faces")
+			os.Exit(1)
+		}
+		return
+	}
+
+	face, err := file.findFace(faceSelection)
 	if err != nil {
-		panic(err)
+		logrus.WithFields(logrus.Fields{
+			"filename": fontFilename,
+			"face":     faceSelection,
+			"error":    err,
+		}).Error("Failed to find face")
+		os.Exit(1)
+	}
+
+	fontData, err := file.faces.Font(face)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"filename": fontFilename,
+			"face":     face,
+			"error":    err,
+		}).Error("Failed to parse font data")
+		os.Exit(1)
+	}
+
+	// Prefer reading TrueType outlines directly, so that geometry is in exact font units. Fonts with CFF outlines fall
+	// back to sfnt.
+	outlines, err := file.collection.Face(face)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"filename": fontFilename,
+			"error":    err,
+		}).Info("Not reading glyf outlines directly, falling back to sfnt")
+		outlines = nil
+	}
+
+	// A variable font is drawn at the instance set by -axis. Only glyf outlines are varied; sfnt, and so CFF fonts, only
+	// know the default instance.
+	var axisValues map[string]float32
+	if axisList != "" {
+		if axisValues, err = ttf.ParseVariation(axisList); err != nil {
+			logrus.WithField("error", err).Error("Invalid axis values")
+			os.Exit(1)
+		}
+		if outlines == nil {
+			logrus.WithField("filename", fontFilename).Warn("Font has no glyf outlines to vary, drawing the default instance")
+		} else if err := outlines.SetVariation(axisValues); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"filename": fontFilename,
+				"axes":     axisList,
+				"error":    err,
+			}).Error("Failed to set variation")
+			os.Exit(1)
+		}
 	}
 
-	segments, err := fontData.LoadGlyph(&b, idx, fixed.I(ppem), nil)
+	// -sweep animates some of the axes from the first frame, starting from the instance set by -axis for the rest
+	var sweep *variationSweep
+	if sweepList != "" {
+		axes, err := parseSweep(sweepList)
+		if err != nil {
+			logrus.WithField("error", err).Error("Invalid axis sweep")
+			os.Exit(1)
+		}
+		switch {
+		case headlessOutput != "":
+			logrus.Warn("Not sweeping axes in a single headless frame")
+		case outlines == nil:
+			logrus.WithField("filename", fontFilename).Warn("Font has no glyf outlines to vary, not sweeping axes")
+		default:
+			if sweep, err = newVariationSweep(outlines, axisValues, axes, sweepPeriod); err != nil {
+				logrus.WithFields(logrus.Fields{
+					"filename": fontFilename,
+					"sweep":    sweepList,
+					"error":    err,
+				}).Error("Failed to set variation")
+				os.Exit(1)
+			}
+		}
+	}
+
+	// Shaping reads the GSUB and GDEF tables directly, which works for any outline format
+	shaper, err := newShaper(file, face)
 	if err != nil {
-		panic(err)
+		logrus.WithFields(logrus.Fields{
+			"filename": fontFilename,
+			"error":    err,
+		}).Warn("Failed to read the font's layout tables, not shaping text")
+		shaper, _ = shaping.New(nil)
+	}
+	if shapingOptions, err = parseShapingFlags(); err != nil {
+		logrus.WithField("error", err).Error("Invalid shaping options")
+		os.Exit(1)
+	}
+	if textAlign, err = layout.ParseAlign(alignName); err != nil {
+		logrus.WithField("error", err).Error("Invalid alignment")
+		os.Exit(1)
 	}
 
-	logrus.Infof("glyph loaded; %d segments for rune %+v\n", len(segments), rune(renderString[0]))
+	// Color glyphs are drawn layer by layer in the -palette colors, and left out of the plain outlines
+	colors, err := newColorFont(file, face)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"filename": fontFilename,
+			"error":    err,
+		}).Warn("Failed to read the font's color glyphs, drawing their outlines")
+		colors = nil
+	}
+	switch {
+	case colors == nil && paletteIndex != 0:
+		logrus.WithField("filename", fontFilename).Warn("Font has no color glyphs, ignoring -palette")
+	case colors != nil && (paletteIndex < 0 || (paletteIndex >= colors.NumPalettes() && colors.NumPalettes() > 0)):
+		logrus.WithFields(logrus.Fields{
+			"palette":     paletteIndex,
+			"numPalettes": colors.NumPalettes(),
+		}).Error("Palette out of range")
+		os.Exit(1)
+	}
 
-	bounds, _, err := fontData.GlyphBounds(&b, idx, fixed.I(ppem), font.HintingFull)
+	// The atlas loads glyph outlines itself, so only needs their positions
+	var segments sfnt.Segments
+	var text *layout.Text
+	// In a window, the text is editable and laid out by a textEditor instead
+	var editor *textEditor
+	if useAtlas {
+		text, err = layoutText(fontData, outlines, shaper, renderString)
+	} else if headlessOutput != "" {
+		segments, text, err = layoutString(fontData, outlines, shaper, colors, renderString)
+	} else {
+		editor, err = newTextEditor(fontData, outlines, shaper, colors, ppem, renderString)
+	}
 	if err != nil {
-		panic(err)
+		logrus.WithFields(logrus.Fields{
+			"string": renderString,
+			"error":  err,
+		}).Error("Failed to lay out string")
+		os.Exit(1)
+	}
+
+	// The editor lays out its text itself, when it is first uploaded
+	if text != nil {
+		logrus.Infof("string laid out; %d glyphs for %q", len(text.Glyphs), renderString)
 	}
 
 	app := NewApp()
 	app.Initialize()
+	app.transforms.model = textModel(float32(textX), float32(textY))
+	app.sweep, app.shaper = sweep, shaper
+	if colors != nil {
+		app.color = newColorGlyphs(&app.VulkanPipeline, fontData, outlines, colors, paletteIndex, ppem)
+	}
 
-	app.loadBuffers(segments, bounds)
+	if useAtlas {
+		if err := app.loadAtlasText(fontData, outlines, text.Glyphs); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"string": renderString,
+				"error":  err,
+			}).Error("Failed to add glyphs to the atlas")
+			app.Teardown()
+			os.Exit(1)
+		}
+	} else if editor != nil {
+		app.editor = editor
+		if err := app.updateText(); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"string": renderString,
+				"error":  err,
+			}).Error("Failed to lay out string")
+			app.Teardown()
+			os.Exit(1)
+		}
+	} else {
+		app.loadBuffers(segments, float32(ppem)/float32(fontData.UnitsPerEm()))
+		if err := app.loadColorGlyphs(text.Glyphs); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"string": renderString,
+				"error":  err,
+			}).Error("Failed to load color glyphs")
+			app.Teardown()
+			os.Exit(1)
+		}
+	}
 
-	app.winapp.DefaultMainLoop(shared.DefaultIgnoreInput, shared.DefaultIgnoreTick, app.drawFrame)
+	if headlessOutput != "" {
+		if err := app.renderToFile(headlessOutput); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"filename": headlessOutput,
+				"error":    err,
+			}).Error("Failed to write rendered image")
+			app.Teardown()
+			os.Exit(1)
+		}
+	} else {
+		if app.editor != nil {
+			shared.DefaultMainLoop(app.window, shared.DefaultIgnoreInput, app.editor.handleInput, app.tick, app.drawFrame, app.onResize)
+		} else if app.sweep != nil {
+			shared.DefaultMainLoop(app.window, shared.DefaultIgnoreInput, shared.DefaultIgnoreText, app.tick, app.drawFrame, app.onResize)
+		} else {
+			shared.DefaultMainLoop(app.window, shared.DefaultIgnoreInput, shared.DefaultIgnoreText, shared.DefaultIgnoreTick, app.drawFrame, app.onResize)
+		}
+	}
 
 	app.Teardown()
 	// Safe exit
 }
 
 type App struct {
-	winapp   *shared.Win32App
+	window   shared.Window
 	messages chan shared.WindowMessage
 
 	vkctx.Context
@@ -87,55 +336,190 @@ type App struct {
 
 	currentImage uint32
 
-	vertexBuffer, indexBuffer             vk.Buffer
-	vertexBufferMemory, indexBufferMemory vk.DeviceMemory
+	// minimized is set while the window has a zero-sized client area, when there is no valid swapchain extent to draw to
+	minimized bool
+
+	transforms pushConstants
+
+	vertexBuffer, indexBuffer deviceBuffer
+	// indexType is UINT16 unless the text has too many vertices to address with 16 bits
+	indexType vk.IndexType
 
 	indexCount int
 
 	quadVertStart, quadIndsStart int
+
+	// Set when the text is editable, in a window without -atlas
+	editor *textEditor
+
+	// Set when drawing from a glyph atlas (-atlas) instead of drawing outlines directly
+	atlas          *GlyphAtlas
+	instanceBuffer deviceBuffer
+	instanceCount  int
+
+	// Set when animating the axes of a variable font (-sweep). The shaper is kept to lay the text out again at each
+	// instance when drawing from the atlas.
+	sweep  *variationSweep
+	shaper *shaping.Shaper
+
+	// Set when the font has color glyphs, which are drawn after the rest of the text
+	color *colorGlyphs
 }
 
 func NewApp() *App {
 	c := make(chan shared.WindowMessage, 32)
 
 	return &App{
-		winapp:   shared.NewWin32App(c),
+		window:   shared.NewWindow(c),
 		messages: c,
 	}
 }
 
 func (app *App) Initialize() {
-	app.winapp.ClassName = "ttf-renderer"
-	app.winapp.Width, app.winapp.Height = 800, 800
-	app.winapp.Initialize("ttf-renderer")
+	// Validation layers are not always installed on headless build machines
+	if headlessOutput == "" || vkctx.HasApiLayer("VK_LAYER_KHRONOS_validation") {
+		app.EnableApiLayers = append(app.EnableApiLayers, "VK_LAYER_KHRONOS_validation")
+	}
+
+	if headlessOutput != "" {
+		app.Context.InitializeHeadless(vk.Extent2D{Width: uint32(width), Height: uint32(height)})
+	} else {
+		app.window.Initialize("ttf-renderer", uint32(width), uint32(height))
 
-	app.EnableApiLayers = append(app.EnableApiLayers, "VK_LAYER_KHRONOS_validation")
-	app.EnableInstanceExtensions = app.winapp.GetRequiredInstanceExtensions()
-	app.EnableDeviceExtensions = append(app.EnableDeviceExtensions, vk.KHR_SWAPCHAIN_EXTENSION_NAME)
+		app.EnableInstanceExtensions = app.window.GetRequiredInstanceExtensions()
+		app.EnableDeviceExtensions = append(app.EnableDeviceExtensions, vk.KHR_SWAPCHAIN_EXTENSION_NAME)
 
-	app.Context.Initialize(windows.Handle(app.winapp.HInstance), windows.HWND(app.winapp.HWnd))
+		app.Context.Initialize(app.window)
+	}
 
 	app.VulkanPipeline.Initialize(&app.Context)
+
+	app.transforms = pushConstants{
+		projection: pixelProjection(app.SwapchainExtent),
+		model:      vkm.Identity(),
+	}
 }
 
 func (app *App) Teardown() {
 	vk.DeviceWaitIdle(app.ctx.Device)
 
 	app.destroyBuffers()
+	if app.atlas != nil {
+		app.instanceBuffer.destroy(&app.Context)
+		app.atlas.Teardown()
+	}
+	if app.color != nil {
+		app.color.Teardown()
+	}
 
 	app.VulkanPipeline.Teardown()
 	app.Context.Teardown()
 }
 
+// onResize is called by the main loop once the window has been resized (or when a resizing drag has finished).
+func (app *App) onResize(width, height uint32) {
+	if width == 0 || height == 0 {
+		app.minimized = true
+		return
+	}
+	app.minimized = false
+
+	if width == app.SwapchainExtent.Width && height == app.SwapchainExtent.Height {
+		return
+	}
+	app.recreateSwapchain()
+}
+
+// recreateSwapchain rebuilds the swapchain and its dependent attachments and framebuffers at the window's current
+// size, and updates the projection so that text stays the same size in pixels.
+func (app *App) recreateSwapchain() {
+	vk.DeviceWaitIdle(app.ctx.Device)
+
+	app.VulkanPipeline.RecreateSwapchain()
+	app.transforms.projection = pixelProjection(app.SwapchainExtent)
+}
+
+// tick advances any axis sweep, blinks the caret, and rebuilds and uploads the text's geometry if it was edited since
+// the last frame. Edits are applied once per frame, so a burst of key presses only uploads once.
+func (app *App) tick(deltaT time.Duration) {
+	if app.sweep != nil {
+		app.tickSweep(deltaT)
+	}
+	if app.editor == nil {
+		return
+	}
+
+	app.editor.tick(deltaT)
+
+	if app.editor.changed || app.editor.caretMoved {
+		if err := app.updateText(); err != nil {
+			// Keep drawing the previous geometry
+			logrus.WithField("error", err).Warn("Failed to lay out edited text")
+		}
+	}
+}
+
+// tickSweep moves the font along the axis sweep, to a new instance with new outlines and advances. The editor's glyph
+// meshes are dropped, to be tessellated again when its text is uploaded in tick; the atlas is cleared, and the text
+// laid out and rendered into it again. Either way, color glyph layers are tessellated again as they are loaded.
+func (app *App) tickSweep(deltaT time.Duration) {
+	if err := app.sweep.tick(deltaT); err != nil {
+		logrus.WithField("error", err).Warn("Failed to set variation")
+		return
+	}
+	if app.color != nil {
+		app.color.clear()
+	}
+
+	if app.editor != nil {
+		app.editor.clearGlyphs()
+		return
+	}
+
+	text, err := layoutText(app.atlas.fontData, app.atlas.outlines, app.shaper, renderString)
+	if err == nil {
+		app.atlas.Clear()
+		err = app.loadAtlasText(app.atlas.fontData, app.atlas.outlines, text.Glyphs)
+	}
+	if err != nil {
+		// Keep drawing the previous instances; glyphs missing from the atlas are left out
+		logrus.WithField("error", err).Warn("Failed to draw the swept instance")
+	}
+}
+
+// updateText uploads the editor's current mesh, and its color glyphs.
+func (app *App) updateText() error {
+	m, err := app.editor.mesh()
+	if err != nil {
+		return err
+	}
+	app.uploadMesh(m)
+	return app.loadColorGlyphs(app.editor.placed)
+}
+
+// loadColorGlyphs uploads the layers of the color glyphs among positioned, if the font has any.
+func (app *App) loadColorGlyphs(positioned []layout.Glyph) error {
+	if app.color == nil {
+		return nil
+	}
+	return app.color.load(positioned)
+}
+
 func (app *App) drawFrame() {
+	if app.minimized {
+		return
+	}
+
 	vk.WaitForFences(app.ctx.Device, []vk.Fence{app.ctx.InFlightFence}, true, ^uint64(0))
 
 	var r vk.Result
 	if r, app.currentImage = vk.AcquireNextImageKHR(app.ctx.Device, app.ctx.Swapchain, ^uint64(0), app.ctx.ImageAvailableSemaphore, vk.Fence(vk.NULL_HANDLE)); r != vk.SUCCESS {
-		if r == vk.SUBOPTIMAL_KHR || r == vk.ERROR_OUT_OF_DATE_KHR {
-			// app.vp.recreateSwapchain()
+		if r == vk.ERROR_OUT_OF_DATE_KHR {
+			// The fence has not been reset, so it is safe to return without submitting
+			app.recreateSwapchain()
 			return
-		} else {
+		} else if r != vk.SUBOPTIMAL_KHR {
+			// A suboptimal image can still be presented; the swapchain is rebuilt after presenting below
 			panic("Could not acquire next image! " + r.String())
 		}
 	}
@@ -165,12 +549,100 @@ func (app *App) drawFrame() {
 		PImageIndices:   []uint32{app.currentImage},
 	}
 
-	if r := vk.QueuePresentKHR(app.ctx.PresentQueue, &presentInfo); r != vk.SUCCESS && r != vk.SUBOPTIMAL_KHR && r != vk.ERROR_OUT_OF_DATE_KHR {
+	if r := vk.QueuePresentKHR(app.ctx.PresentQueue, &presentInfo); r == vk.SUBOPTIMAL_KHR || r == vk.ERROR_OUT_OF_DATE_KHR {
+		app.recreateSwapchain()
+	} else if r != vk.SUCCESS {
 		panic("Could not submit to presentation queue! " + r.String())
 	}
 
 }
 
+// loadAtlasText renders every glyph in positioned into the glyph atlas, creating it on first use, and uploads one
+// textured quad instance for each glyph. Color glyphs are drawn from their layers instead.
+func (app *App) loadAtlasText(fontData *sfnt.Font, outlines *ttf.Font, positioned []layout.Glyph) error {
+	if app.atlas == nil {
+		app.atlas = NewGlyphAtlas(&app.VulkanPipeline, fontData, outlines, atlasSize, msdfSpread)
+	}
+	if err := app.loadColorGlyphs(positioned); err != nil {
+		return err
+	}
+
+	var colors *colr.Font
+	if app.color != nil {
+		colors = app.color.font
+	}
+	glyphs := make([]sfnt.GlyphIndex, 0, len(positioned))
+	for _, g := range positioned {
+		if !isColorGlyph(colors, g.ID) {
+			glyphs = append(glyphs, g.ID)
+		}
+	}
+	if err := app.atlas.Add(glyphs, ppem); err != nil {
+		return err
+	}
+
+	scale := ppem / float64(fontData.UnitsPerEm())
+
+	var instances []atlasInstance
+	for _, g := range positioned {
+		entry, ok := app.atlas.Lookup(g.ID, ppem)
+		if !ok || entry.Empty {
+			continue
+		}
+
+		// Glyphs were rasterized with their origin on a whole pixel, so keep it that way here to map atlas texels
+		// directly onto the framebuffer
+		origin := g.Origin()
+		x := float32(math.Round(float64(origin.X)/64*scale)) + entry.Offset[0]
+		y := float32(math.Round(float64(origin.Y)/64*scale)) + entry.Offset[1]
+
+		instances = append(instances, atlasInstance{
+			position: vkm.Pt2{x, y},
+			size:     entry.Size,
+			uv:       entry.UV,
+		})
+	}
+
+	// Frames in flight may still be reading the instances
+	vk.DeviceWaitIdle(app.Device)
+
+	app.instanceCount = len(instances)
+	app.instanceBuffer.usage = vk.BUFFER_USAGE_VERTEX_BUFFER_BIT
+	uploadToBuffer(&app.Context, &app.instanceBuffer, instances)
+
+	return nil
+}
+
+// renderToFile draws a single frame into the headless offscreen image, reads it back and writes it to filename as a
+// PNG.
+func (app *App) renderToFile(filename string) error {
+	app.currentImage = 0
+	cb := app.ctx.CommandBuffers[app.currentImage]
+
+	vk.ResetFences(app.ctx.Device, []vk.Fence{app.ctx.InFlightFence})
+	app.recordRenderingCommands(cb)
+
+	submitInfo := vk.SubmitInfo{
+		PCommandBuffers: []vk.CommandBuffer{cb},
+	}
+	if r := vk.QueueSubmit(app.ctx.GraphicsQueue, []vk.SubmitInfo{submitInfo}, app.ctx.InFlightFence); r != vk.SUCCESS {
+		panic("Could not submit to graphics queue! " + r.String())
+	}
+	vk.WaitForFences(app.ctx.Device, []vk.Fence{app.ctx.InFlightFence}, true, ^uint64(0))
+
+	img := app.ReadOffscreenImage()
+
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
 func (app *App) recordRenderingCommands(cb vk.CommandBuffer) {
 	cbBeginInfo := vk.CommandBufferBeginInfo{
 		Flags: vk.COMMAND_BUFFER_USAGE_ONE_TIME_SUBMIT_BIT,
@@ -200,24 +672,36 @@ func (app *App) recordRenderingCommands(cb vk.CommandBuffer) {
 
 	vk.CmdBeginRenderPass(cb, &rpBeginInfo, vk.SUBPASS_CONTENTS_INLINE)
 
-	// bind vert, index bufs
-	vk.CmdBindVertexBuffers(cb, 0, []vk.Buffer{app.vertexBuffer}, []vk.DeviceSize{0})
-	vk.CmdBindIndexBuffer(cb, app.indexBuffer, 0, vk.INDEX_TYPE_UINT16)
-
-	vk.CmdBindPipeline(cb, vk.PIPELINE_BIND_POINT_GRAPHICS, app.graphicsPipelines[0]) // stencil pipeline
-	vk.CmdDrawIndexed(cb, uint32(app.quadIndsStart), 1, 0, 0, 0)
-
-	vk.CmdBindPipeline(cb, vk.PIPELINE_BIND_POINT_GRAPHICS, app.graphicsPipelines[1]) // stencil quad portion pipeline
-	vk.CmdDrawIndexed(cb, uint32(app.indexCount-app.quadIndsStart)-4, 1, uint32(app.quadIndsStart), int32(app.quadVertStart), 0)
-
-	vk.CmdNextSubpass(cb, vk.SUBPASS_CONTENTS_INLINE)
-
-	vk.CmdBindPipeline(cb, vk.PIPELINE_BIND_POINT_GRAPHICS, app.graphicsPipelines[2]) // Color pass
+	// Viewport and scissor are dynamic state, so that the pipelines don't need to be rebuilt when the window is resized
+	viewportState := app.standardViewport()
+	vk.CmdSetViewport(cb, 0, viewportState.PViewports)
+	vk.CmdSetScissor(cb, 0, viewportState.PScissors)
+
+	if app.atlas != nil {
+		// Glyphs were already rasterized into the atlas, so there is nothing to draw into the stencil
+		vk.CmdNextSubpass(cb, vk.SUBPASS_CONTENTS_INLINE)
+		app.atlas.recordDraw(cb, app.instanceBuffer.buffer, app.instanceCount, &app.transforms)
+	} else {
+		// bind vert, index bufs
+		vk.CmdBindVertexBuffers(cb, 0, []vk.Buffer{app.vertexBuffer.buffer}, []vk.DeviceSize{0})
+		vk.CmdBindIndexBuffer(cb, app.indexBuffer.buffer, 0, app.indexType)
+
+		// All three pipelines share a layout, so the transforms only need to be pushed once
+		vk.CmdPushConstants(cb, app.pipelineLayout, vk.SHADER_STAGE_VERTEX_BIT, 0, app.transforms.bytes())
+
+		fanIndexCount := app.quadIndsStart
+		if app.editor != nil && !app.editor.caretVisible {
+			// The caret's fan is last, so leaving out its indices hides it
+			fanIndexCount -= app.editor.caretIndexCount()
+		}
 
-	// vk.CmdDrawIndexed(cb, 5, 1, uint32(app.indexCount)-5, 0, 0)
-	vk.CmdDrawIndexed(cb, 4, 1, uint32(app.indexCount)-4, int32(app.quadVertStart), 0)
+		recordOutlineDraws(cb, app.graphicsPipelines, fanIndexCount, app.indexCount, app.quadVertStart, app.quadIndsStart)
+	}
 
-	// draw
+	// Either way, the render pass is now in its color subpass
+	if app.color != nil {
+		app.color.recordDraw(cb, &app.transforms)
+	}
 
 	vk.CmdEndRenderPass(cb)
 	vk.EndCommandBuffer(cb)
@@ -1,9 +1,17 @@
 package main
 
-//go:generate glslc.exe shaders/quad_shader.vert -o shaders/quad_vert.spv
-//go:generate glslc.exe shaders/shader.vert -o shaders/vert.spv
-//go:generate glslc.exe shaders/quad_shader.frag -o shaders/quad_frag.spv
-//go:generate glslc.exe shaders/shader.frag -o shaders/frag.spv
+//go:generate glslc shaders/quad_shader.vert -o shaders/quad_vert.spv
+//go:generate glslc shaders/shader.vert -o shaders/vert.spv
+//go:generate glslc shaders/quad_shader.frag -o shaders/quad_frag.spv
+//go:generate glslc shaders/shader.frag -o shaders/frag.spv
+//go:generate glslc shaders/text.vert -o shaders/text_vert.spv
+//go:generate glslc shaders/text.frag -o shaders/text_frag.spv
+//go:generate glslc shaders/msdf.frag -o shaders/msdf_frag.spv
+//go:generate glslc shaders/paint.vert -o shaders/paint_vert.spv
+//go:generate glslc shaders/paint.frag -o shaders/paint_frag.spv
+//go:generate glslc shaders/group.frag -o shaders/group_frag.spv
+//go:generate glslc shaders/composite.vert -o shaders/composite_vert.spv
+//go:generate glslc shaders/composite.frag -o shaders/composite_frag.spv
 
 import (
 	"os"
@@ -31,11 +39,7 @@ type VulkanPipeline struct {
 
 func (vp *VulkanPipeline) Initialize(ctx *vkctx.Context) {
 	vp.ctx = ctx
-	vp.stencilImage, vp.stencilMemory = ctx.CreateImage(ctx.SwapchainExtent, vk.FORMAT_S8_UINT, vk.IMAGE_TILING_OPTIMAL, vk.IMAGE_USAGE_DEPTH_STENCIL_ATTACHMENT_BIT, vk.MEMORY_PROPERTY_DEVICE_LOCAL_BIT)
-	vp.stencilImageView = ctx.CreateImageView(vp.stencilImage, vk.FORMAT_S8_UINT, vk.IMAGE_ASPECT_STENCIL_BIT)
-
-	vp.colorImage, vp.colorMemory = ctx.CreateImage(ctx.SwapchainExtent, vk.FORMAT_R32G32B32A32_SFLOAT, vk.IMAGE_TILING_OPTIMAL, vk.IMAGE_USAGE_COLOR_ATTACHMENT_BIT, vk.MEMORY_PROPERTY_DEVICE_LOCAL_BIT)
-	vp.colorImageView = ctx.CreateImageView(vp.colorImage, vk.FORMAT_R32G32B32A32_SFLOAT, vk.IMAGE_ASPECT_COLOR_BIT)
+	vp.createAttachments()
 
 	vp.CreateRenderPass()
 
@@ -44,6 +48,39 @@ func (vp *VulkanPipeline) Initialize(ctx *vkctx.Context) {
 	vp.CreateGraphicsPipelines()
 }
 
+// createAttachments creates the stencil and color images, sized to match the current swapchain extent.
+func (vp *VulkanPipeline) createAttachments() {
+	ctx := vp.ctx
+	vp.stencilImage, vp.stencilMemory, vp.stencilImageView = ctx.CreateStencilImage(ctx.SwapchainExtent)
+
+	vp.colorImage, vp.colorMemory = ctx.CreateImage(ctx.SwapchainExtent, vk.FORMAT_R32G32B32A32_SFLOAT, vk.IMAGE_TILING_OPTIMAL, vk.IMAGE_USAGE_COLOR_ATTACHMENT_BIT, vk.MEMORY_PROPERTY_DEVICE_LOCAL_BIT)
+	vp.colorImageView = ctx.CreateImageView(vp.colorImage, vk.FORMAT_R32G32B32A32_SFLOAT, vk.IMAGE_ASPECT_COLOR_BIT)
+}
+
+func (vp *VulkanPipeline) destroyAttachments() {
+	vk.DestroyImageView(vp.ctx.Device, vp.colorImageView, nil)
+	vk.DestroyImageView(vp.ctx.Device, vp.stencilImageView, nil)
+
+	vk.DestroyImage(vp.ctx.Device, vp.colorImage, nil)
+	vk.DestroyImage(vp.ctx.Device, vp.stencilImage, nil)
+
+	vk.FreeMemory(vp.ctx.Device, vp.colorMemory, nil)
+	vk.FreeMemory(vp.ctx.Device, vp.stencilMemory, nil)
+}
+
+// RecreateSwapchain rebuilds the swapchain along with everything sized to it: the stencil and color attachments and the
+// framebuffers. The pipelines use dynamic viewport and scissor state, so they do not need to be rebuilt. The caller
+// must ensure the device is idle.
+func (vp *VulkanPipeline) RecreateSwapchain() {
+	vp.destroyFramebuffers()
+	vp.destroyAttachments()
+
+	vp.ctx.RecreateSwapchain()
+
+	vp.createAttachments()
+	vp.CreateFramebuffers()
+}
+
 func (vp *VulkanPipeline) standardViewport() *vk.PipelineViewportStateCreateInfo {
 
 	viewport := vk.Viewport{
@@ -68,10 +105,7 @@ func (vp *VulkanPipeline) standardViewport() *vk.PipelineViewportStateCreateInfo
 }
 
 func (vp *VulkanPipeline) CreateGraphicsPipelines() {
-	// Two pipelines to build, three bindings
-	// 1) Triangle fans for rough outline of shapes in stencil
-	// 2) Triangles for quad curves in stencil
-	// 3) Re-bind tri fans for color render
+	// Two pipelines to build, three bindings; see createStencilPipelines
 
 	vp.vertShaderModule = vp.createShaderModule("shaders/vert.spv")
 	vp.fragShaderModule = vp.createShaderModule("shaders/frag.spv")
@@ -79,6 +113,84 @@ func (vp *VulkanPipeline) CreateGraphicsPipelines() {
 	vp.quadVertShaderModule = vp.createShaderModule("shaders/quad_vert.spv")
 	vp.quadFragShaderModule = vp.createShaderModule("shaders/quad_frag.spv")
 
+	pipelineLayoutCreateInfo := vk.PipelineLayoutCreateInfo{
+		PSetLayouts: []vk.DescriptorSetLayout{},
+		PPushConstantRanges: []vk.PushConstantRange{
+			{
+				StageFlags: vk.SHADER_STAGE_VERTEX_BIT,
+				Offset:     0,
+				Size:       uint32(unsafe.Sizeof(pushConstants{})),
+			},
+		},
+	}
+
+	var r vk.Result
+	if r, vp.pipelineLayout = vk.CreatePipelineLayout(vp.ctx.Device, &pipelineLayoutCreateInfo, nil); r != vk.SUCCESS {
+		panic(r)
+	}
+
+	vp.graphicsPipelines = vp.createStencilPipelines(vp.renderPass)
+}
+
+// createStencilPipelines builds the three pipelines used to draw glyph outlines, against subpasses 0 (stencil) and 1
+// (color) of renderPass:
+// 1) Triangle fans for rough outline of shapes in stencil
+// 2) Triangles for quad curves in stencil
+// 3) Re-bind tri fans for color render
+//
+// Besides the window's render pass, these are also used to render glyphs into a GlyphAtlas.
+func (vp *VulkanPipeline) createStencilPipelines(renderPass vk.RenderPass) (pipelines []vk.Pipeline) {
+	pipelineCreateInfo, p1CreateInfo := vp.outlineStencilCreateInfos(renderPass, vp.pipelineLayout)
+
+	var r vk.Result
+	var tmp []vk.Pipeline
+	if r, tmp = vk.CreateGraphicsPipelines(
+		vp.ctx.Device,
+		vk.PipelineCache(vk.NULL_HANDLE),
+		[]vk.GraphicsPipelineCreateInfo{pipelineCreateInfo, p1CreateInfo},
+		nil,
+	); r != vk.SUCCESS {
+		panic(r)
+	}
+
+	pipelines = append(pipelines, tmp...)
+
+	depthStencilStateCreateInfo := *pipelineCreateInfo.PDepthStencilState
+	depthStencilStateCreateInfo.Front = vk.StencilOpState{
+		// FailOp: vk.STENCIL_OP_REPLACE,
+		// DepthFailOp: vk.STENCIL_OP_KEEP,
+
+		// Covered samples are cleared as they are drawn, so that anything drawn later in the subpass, such as the
+		// layers of color glyphs, starts from an empty stencil
+		PassOp: vk.STENCIL_OP_ZERO,
+
+		CompareOp:   vk.COMPARE_OP_NOT_EQUAL,
+		CompareMask: 0xFF,
+		WriteMask:   0xFF,
+		Reference:   0,
+	}
+	depthStencilStateCreateInfo.Back = depthStencilStateCreateInfo.Front
+	pipelineCreateInfo.PDepthStencilState = &depthStencilStateCreateInfo
+	pipelineCreateInfo.Subpass = 1
+
+	if r, tmp = vk.CreateGraphicsPipelines(
+		vp.ctx.Device,
+		vk.PipelineCache(vk.NULL_HANDLE),
+		[]vk.GraphicsPipelineCreateInfo{pipelineCreateInfo},
+		nil,
+	); r != vk.SUCCESS {
+		panic(r)
+	}
+
+	pipelines = append(pipelines, tmp[0])
+
+	return pipelines
+}
+
+// outlineStencilCreateInfos returns the create infos of the fan and curve stencil pipelines, for subpass 0 of
+// renderPass with the given layout, whose push constants must match pushConstants. createStencilPipelines builds them
+// as they are; the layers of color glyphs are drawn into the stencil in the color subpass, with color writes masked.
+func (vp *VulkanPipeline) outlineStencilCreateInfos(renderPass vk.RenderPass, layout vk.PipelineLayout) (fan, curve vk.GraphicsPipelineCreateInfo) {
 	p0_vertShaderStageCreateInfo := vk.PipelineShaderStageCreateInfo{
 		Stage:               vk.SHADER_STAGE_VERTEX_BIT,
 		Module:              vp.vertShaderModule,
@@ -101,7 +213,7 @@ func (vp *VulkanPipeline) CreateGraphicsPipelines() {
 	bindings := []vk.VertexInputBindingDescription{
 		{
 			Binding: 0,
-			Stride:  uint32(5 * unsafe.Sizeof(float32(0))),
+			Stride:  uint32(unsafe.Sizeof(vertexFormat{})),
 		},
 	}
 	attrs := []vk.VertexInputAttributeDescription{
@@ -117,6 +229,12 @@ func (vp *VulkanPipeline) CreateGraphicsPipelines() {
 			Format:   vk.FORMAT_R32G32B32_SFLOAT,
 			Offset:   uint32(2 * unsafe.Sizeof(float32(0))),
 		},
+		{
+			Location: 2,
+			Binding:  0,
+			Format:   vk.FORMAT_R32_SFLOAT,
+			Offset:   uint32(5 * unsafe.Sizeof(float32(0))),
+		},
 	}
 
 	vertexInputCreateInfo := vk.PipelineVertexInputStateCreateInfo{
@@ -181,33 +299,12 @@ func (vp *VulkanPipeline) CreateGraphicsPipelines() {
 	// }
 	// depthStencilStateCreateInfo.Back = depthStencilStateCreateInfo.Front
 
-	viewport := vk.Viewport{
-		X:        0.0,
-		Y:        0.0,
-		Width:    float32(vp.ctx.SwapchainExtent.Width),
-		Height:   float32(vp.ctx.SwapchainExtent.Height),
-		MinDepth: 0.0,
-		MaxDepth: 1.0,
-	}
-
-	scissor := vk.Rect2D{
-		Offset: vk.Offset2D{X: 0, Y: 0},
-		Extent: vp.ctx.SwapchainExtent,
-	}
+	// Viewport and scissor are dynamic, and set from the current swapchain extent when recording commands, so the
+	// pipelines survive a window resize. The values here only establish the count.
+	viewportStateCreateInfo := vp.standardViewport()
 
-	viewportStateCreateInfo := vk.PipelineViewportStateCreateInfo{
-		PViewports: []vk.Viewport{viewport},
-		PScissors:  []vk.Rect2D{scissor},
-	}
-
-	pipelineLayoutCreateInfo := vk.PipelineLayoutCreateInfo{
-		PSetLayouts:         []vk.DescriptorSetLayout{},
-		PPushConstantRanges: []vk.PushConstantRange{},
-	}
-
-	var r vk.Result
-	if r, vp.pipelineLayout = vk.CreatePipelineLayout(vp.ctx.Device, &pipelineLayoutCreateInfo, nil); r != vk.SUCCESS {
-		panic(r)
+	dynamicStateCreateInfo := vk.PipelineDynamicStateCreateInfo{
+		PDynamicStates: []vk.DynamicState{vk.DYNAMIC_STATE_VIEWPORT, vk.DYNAMIC_STATE_SCISSOR},
 	}
 
 	/* Rendering method:
@@ -215,6 +312,10 @@ func (vp *VulkanPipeline) CreateGraphicsPipelines() {
 	for determining the "interior" of a glyph. The stencil operation below increments the stencil value on for front faces
 	(i.e. drawn clockwise) and decrements the value for back faces. For the second subpass, the stencil test rule is changed
 	to pass any fragments with a non-zero stencil value.
+
+	Curve triangles are always emitted with the same winding as their contour, so they use the same stencil operations.
+	Whether a curve adds or removes coverage is handled by convertSegmentsToVerts and the orientation sign carried in the
+	vertex data; see quad_shader.frag.
 	*/
 
 	depthStencilStateCreateInfo := vk.PipelineDepthStencilStateCreateInfo{
@@ -239,15 +340,16 @@ func (vp *VulkanPipeline) CreateGraphicsPipelines() {
 		// Fixed function stage information
 		PVertexInputState:   &vertexInputCreateInfo,
 		PInputAssemblyState: &inputAssemblyCreateInfo,
-		PViewportState:      &viewportStateCreateInfo,
+		PViewportState:      viewportStateCreateInfo,
 		PRasterizationState: &rasterizerCreateInfo,
 		PMultisampleState:   &multisampleCreateInfo,
 		PColorBlendState:    &colorBlendStateCreateInfo,
 
 		PDepthStencilState: &depthStencilStateCreateInfo,
+		PDynamicState:      &dynamicStateCreateInfo,
 
-		Layout:     vp.pipelineLayout,
-		RenderPass: vp.renderPass,
+		Layout:     layout,
+		RenderPass: renderPass,
 		Subpass:    0,
 	}
 
@@ -263,48 +365,17 @@ func (vp *VulkanPipeline) CreateGraphicsPipelines() {
 	p1CreateInfo.PStages[0].Module = vp.quadVertShaderModule
 	p1CreateInfo.PStages[1].Module = vp.quadFragShaderModule
 
-	var tmp []vk.Pipeline
-	if r, tmp = vk.CreateGraphicsPipelines(
-		vp.ctx.Device,
-		vk.PipelineCache(vk.NULL_HANDLE),
-		[]vk.GraphicsPipelineCreateInfo{pipelineCreateInfo, p1CreateInfo},
-		nil,
-	); r != vk.SUCCESS {
-		panic(r)
-	}
-
-	vp.graphicsPipelines = append(vp.graphicsPipelines, tmp...)
-
-	depthStencilStateCreateInfo.DepthTestEnable = false
-	// depthStencilStateCreateInfo.StencilTestEnable = false
-	depthStencilStateCreateInfo.Front = vk.StencilOpState{
-		// FailOp: vk.STENCIL_OP_REPLACE,
-		// DepthFailOp: vk.STENCIL_OP_KEEP,
-		// PassOp: vk.STENCIL_OP_KEEP,
-
-		CompareOp:   vk.COMPARE_OP_NOT_EQUAL,
-		CompareMask: 0xFF,
-		// WriteMask:   0xFF,
-		Reference: 0,
-	}
-	depthStencilStateCreateInfo.Back = depthStencilStateCreateInfo.Front
-	pipelineCreateInfo.Subpass = 1
-
-	if r, tmp = vk.CreateGraphicsPipelines(
-		vp.ctx.Device,
-		vk.PipelineCache(vk.NULL_HANDLE),
-		[]vk.GraphicsPipelineCreateInfo{pipelineCreateInfo},
-		nil,
-	); r != vk.SUCCESS {
-		panic(r)
-	}
-
-	vp.graphicsPipelines = append(vp.graphicsPipelines, tmp[0])
-
+	return pipelineCreateInfo, p1CreateInfo
 }
 
 func (vp *VulkanPipeline) CreateRenderPass() {
 
+	// In headless mode the color image is copied back to the host, rather than presented
+	colorFinalLayout := vk.IMAGE_LAYOUT_PRESENT_SRC_KHR
+	if vp.ctx.Headless {
+		colorFinalLayout = vk.IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL
+	}
+
 	colorAttachmentDescription := vk.AttachmentDescription{
 		Format:  vp.ctx.SwapchainImageFormat,
 		Samples: vk.SAMPLE_COUNT_1_BIT,
@@ -315,16 +386,23 @@ func (vp *VulkanPipeline) CreateRenderPass() {
 		StencilStoreOp: vk.ATTACHMENT_STORE_OP_DONT_CARE,
 
 		InitialLayout: vk.IMAGE_LAYOUT_UNDEFINED,
-		FinalLayout:   vk.IMAGE_LAYOUT_PRESENT_SRC_KHR,
+		FinalLayout:   colorFinalLayout,
 	}
 
+	vp.renderPass = vp.createStencilRenderPass(colorAttachmentDescription)
+}
+
+// createStencilRenderPass creates a render pass with a stencil subpass followed by a color subpass, as used by the
+// pipelines from createStencilPipelines. Attachment 0 is described by colorAttachmentDescription, and attachment 1 is
+// a stencil attachment. Any extra dependencies are added to the two internal to the render pass.
+func (vp *VulkanPipeline) createStencilRenderPass(colorAttachmentDescription vk.AttachmentDescription, extraDependencies ...vk.SubpassDependency) (renderPass vk.RenderPass) {
 	colorAttachmentRef := vk.AttachmentReference{
 		Attachment: 0,
 		Layout:     vk.IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL,
 	}
 
 	stencilAttachmentDescription := vk.AttachmentDescription{
-		Format:  vk.FORMAT_S8_UINT,
+		Format:  vp.ctx.StencilFormat,
 		Samples: vk.SAMPLE_COUNT_1_BIT,
 
 		// Applies to depth component
@@ -377,19 +455,22 @@ func (vp *VulkanPipeline) CreateRenderPass() {
 		SrcStageMask:  vk.PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT | vk.PIPELINE_STAGE_EARLY_FRAGMENT_TESTS_BIT,
 		SrcAccessMask: 0,
 		DstStageMask:  vk.PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT | vk.PIPELINE_STAGE_EARLY_FRAGMENT_TESTS_BIT,
-		DstAccessMask: vk.ACCESS_COLOR_ATTACHMENT_WRITE_BIT | vk.ACCESS_DEPTH_STENCIL_ATTACHMENT_READ_BIT,
+		// The color subpass also writes the stencil, clearing it behind each cover and drawing color glyph layers
+		DstAccessMask: vk.ACCESS_COLOR_ATTACHMENT_WRITE_BIT | vk.ACCESS_DEPTH_STENCIL_ATTACHMENT_READ_BIT |
+			vk.ACCESS_DEPTH_STENCIL_ATTACHMENT_WRITE_BIT,
 	}
 
 	renderPassCreateInfo := vk.RenderPassCreateInfo{
 		PAttachments:  []vk.AttachmentDescription{colorAttachmentDescription, stencilAttachmentDescription},
 		PSubpasses:    []vk.SubpassDescription{stencilSubpassDescription, colorSubpassDescription},
-		PDependencies: []vk.SubpassDependency{dependencyToStencil, dependencyToColor},
+		PDependencies: append([]vk.SubpassDependency{dependencyToStencil, dependencyToColor}, extraDependencies...),
 	}
 
 	var r vk.Result
-	if r, vp.renderPass = vk.CreateRenderPass(vp.ctx.Device, &renderPassCreateInfo, nil); r != vk.SUCCESS {
+	if r, renderPass = vk.CreateRenderPass(vp.ctx.Device, &renderPassCreateInfo, nil); r != vk.SUCCESS {
 		panic(r)
 	}
+	return renderPass
 }
 
 func (vp *VulkanPipeline) CreateFramebuffers() {
@@ -427,14 +508,7 @@ func (vp *VulkanPipeline) Teardown() {
 	vk.DestroyShaderModule(vp.ctx.Device, vp.quadVertShaderModule, nil)
 	vk.DestroyShaderModule(vp.ctx.Device, vp.quadFragShaderModule, nil)
 
-	vk.DestroyImageView(vp.ctx.Device, vp.colorImageView, nil)
-	vk.DestroyImageView(vp.ctx.Device, vp.stencilImageView, nil)
-
-	vk.DestroyImage(vp.ctx.Device, vp.colorImage, nil)
-	vk.DestroyImage(vp.ctx.Device, vp.stencilImage, nil)
-
-	vk.FreeMemory(vp.ctx.Device, vp.colorMemory, nil)
-	vk.FreeMemory(vp.ctx.Device, vp.stencilMemory, nil)
+	vp.destroyAttachments()
 
 	vp.destroyFramebuffers()
 
@@ -0,0 +1,273 @@
+package raster_test
+
+import (
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bbredesen/ttf-renderer/raster"
+	"github.com/bbredesen/ttf-renderer/tess"
+	"github.com/bbredesen/ttf-renderer/ttf"
+	"github.com/bbredesen/vkm"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+var update = flag.Bool("update", false, "regenerate the golden images in testdata/golden instead of comparing against them")
+
+const (
+	fontDir    = "../testdata/fonts"
+	goldenDir  = "testdata/golden"
+	failureDir = "testdata/failed"
+
+	// margin is the empty border, in pixels, around each rendered glyph
+	margin = 2
+	// cubicTolerance matches the default of the -cubic-tolerance flag
+	cubicTolerance = 0.25
+)
+
+// Perceptual tolerance. Both images are blurred before comparing, so that an edge moving by a fraction of a pixel (e.g.
+// from a different floating point rounding) only produces a small difference, while a missing or extra stroke still
+// produces a large one. The test fails if more than maxDifferingFraction of the pixels differ by more than
+// pixelThreshold after blurring.
+const (
+	pixelThreshold       = 0.35
+	maxDifferingFraction = 0.002
+)
+
+var goldenFonts = []struct {
+	file  string
+	runes string
+}{
+	{"Go-Regular.ttf", "AaBbegQRS&@%$8Wwxy"},
+	{"Go-Bold-Italic.ttf", "AagQRS&@8fjW"},
+	{"Go-Mono.ttf", "0Oil{}#@m"},
+	// CFF outlines, exercising the cubic to quadratic approximation
+	{"CFFTest.otf", "01Q中"},
+}
+
+var goldenSizes = []float32{16, 48}
+
+// TestGolden renders every glyph in goldenFonts at every size in goldenSizes through tess and Rasterize, and compares
+// the result with the matching PNG in testdata/golden. Run with -update to regenerate the goldens after an intended
+// change in rendering. On failure, the rendered image and a diff are written to testdata/failed: white pixels are
+// covered in both, red only in the rendered image, and blue only in the golden.
+func TestGolden(t *testing.T) {
+	for _, gf := range goldenFonts {
+		gf := gf
+		t.Run(gf.file, func(t *testing.T) {
+			src, err := os.ReadFile(filepath.Join(fontDir, gf.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			f, err := sfnt.Parse(src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Only TrueType fonts have a glyf table; CFF fonts fall back to sfnt, as in the renderer
+			outlines, err := ttf.Parse(src)
+			if err != nil {
+				outlines = nil
+			}
+
+			for _, r := range gf.runes {
+				for _, ppem := range goldenSizes {
+					name := fmt.Sprintf("%s_%g_U+%04X", gf.file[:len(gf.file)-len(filepath.Ext(gf.file))], ppem, r)
+
+					t.Run(fmt.Sprintf("%c@%g", r, ppem), func(t *testing.T) {
+						got := renderGlyph(t, f, outlines, r, ppem)
+						checkGolden(t, name, got)
+					})
+				}
+			}
+		})
+	}
+}
+
+// renderGlyph rasterizes the glyph for r at ppem, in an image just large enough to hold it plus margin.
+func renderGlyph(t *testing.T, f *sfnt.Font, outlines *ttf.Font, r rune, ppem float32) *image.Alpha {
+	t.Helper()
+
+	var b sfnt.Buffer
+	idx, err := f.GlyphIndex(&b, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if idx == 0 {
+		t.Fatalf("no glyph for %q", r)
+	}
+
+	var segments sfnt.Segments
+	if outlines != nil {
+		glyph, err := outlines.LoadGlyph(idx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		segments = glyph.Segments()
+	} else {
+		s, err := f.LoadGlyph(&b, idx, fixed.I(int(f.UnitsPerEm())), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		segments = append(segments, s...)
+	}
+
+	m := tess.Tessellate(segments, ppem/float32(f.UnitsPerEm()), cubicTolerance)
+
+	min, max := m.Bounds()
+	x0, y0 := math.Floor(float64(min[0])), math.Floor(float64(min[1]))
+	width := int(math.Ceil(float64(max[0]))-x0) + 2*margin
+	height := int(math.Ceil(float64(max[1]))-y0) + 2*margin
+
+	// Whole pixel offsets only, so the sample positions relative to the outline don't depend on its bounds
+	return raster.Rasterize(m, width, height, vkm.Pt2{float32(margin - x0), float32(margin - y0)})
+}
+
+func checkGolden(t *testing.T, name string, got *image.Alpha) {
+	t.Helper()
+
+	goldenPath := filepath.Join(goldenDir, name+".png")
+
+	if *update {
+		if err := writePNG(goldenPath, got); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := readPNG(goldenPath)
+	if err != nil {
+		t.Fatalf("%v; run with -update to create it", err)
+	}
+
+	if differing, ok := compare(got, want); !ok {
+		gotPath := filepath.Join(failureDir, name+".got.png")
+		diffPath := filepath.Join(failureDir, name+".diff.png")
+		if err := writePNG(gotPath, got); err != nil {
+			t.Error(err)
+		}
+		if err := writePNG(diffPath, diffImage(got, want)); err != nil {
+			t.Error(err)
+		}
+
+		if got.Bounds() != want.Bounds() {
+			t.Errorf("rendered size %v differs from golden %v; see %s and %s", got.Bounds().Size(), want.Bounds().Size(), gotPath, diffPath)
+		} else {
+			t.Errorf("%d pixels differ from the golden image; see %s and %s", differing, gotPath, diffPath)
+		}
+	}
+}
+
+// compare returns how many pixels differ perceptibly between got and want, and whether that is within tolerance.
+func compare(got, want *image.Alpha) (differing int, ok bool) {
+	if got.Bounds() != want.Bounds() {
+		return 0, false
+	}
+
+	a, b := blur(got), blur(want)
+	for i := range a {
+		if math.Abs(a[i]-b[i]) > pixelThreshold {
+			differing++
+		}
+	}
+
+	allowed := int(maxDifferingFraction * float64(len(a)))
+	return differing, differing <= allowed
+}
+
+// blur returns the 3x3 box-filtered coverage of img, from 0 to 1, treating pixels beyond the edge as empty.
+func blur(img *image.Alpha) []float64 {
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	out := make([]float64, w*h)
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var sum float64
+			for dy := -1; dy <= 1; dy++ {
+				for dx := -1; dx <= 1; dx++ {
+					if sx, sy := x+dx, y+dy; sx >= 0 && sy >= 0 && sx < w && sy < h {
+						sum += float64(img.AlphaAt(sx, sy).A) / 255
+					}
+				}
+			}
+			out[y*w+x] = sum / 9
+		}
+	}
+
+	return out
+}
+
+// diffImage overlays got and want. If their sizes differ, only the overlapping area is compared.
+func diffImage(got, want *image.Alpha) *image.RGBA {
+	bounds := got.Bounds().Union(want.Bounds())
+	diff := image.NewRGBA(bounds)
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			g, w := got.AlphaAt(x, y).A, want.AlphaAt(x, y).A
+			diff.SetRGBA(x, y, color.RGBA{R: g, G: min8(g, w), B: w, A: 0xFF})
+		}
+	}
+
+	return diff
+}
+
+func min8(a, b uint8) uint8 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func readPNG(path string) (*image.Alpha, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	img, err := png.Decode(f)
+	if err != nil {
+		return nil, err
+	}
+
+	alpha := image.NewAlpha(img.Bounds())
+	for y := img.Bounds().Min.Y; y < img.Bounds().Max.Y; y++ {
+		for x := img.Bounds().Min.X; x < img.Bounds().Max.X; x++ {
+			// Goldens are stored as grayscale, with coverage as brightness
+			alpha.SetAlpha(x, y, color.Alpha{A: color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y})
+		}
+	}
+
+	return alpha, nil
+}
+
+// writePNG writes img to path, creating the directory if needed. Coverage images are stored as grayscale, so that they
+// are visible in an image viewer rather than being transparent.
+func writePNG(path string, img image.Image) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	if a, ok := img.(*image.Alpha); ok {
+		gray := image.NewGray(a.Bounds())
+		copy(gray.Pix, a.Pix)
+		img = gray
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,195 @@
+// Package raster is a CPU reference for the stencil rendering done by the Vulkan pipelines. It draws a tess.Mesh with the
+// same primitive assembly, sample positions, stencil operations and curve test as the GPU, so that tessellation can be
+// checked, and GPU output compared against it, without a GPU.
+package raster
+
+import (
+	"image"
+	"math"
+
+	"github.com/bbredesen/ttf-renderer/tess"
+	"github.com/bbredesen/vkm"
+)
+
+// subpixelBits is the precision vertex positions are snapped to before rasterization. Vulkan only requires 4 bits, but
+// 8 is what common implementations use.
+const subpixelBits = 8
+
+// Rasterize draws m into a width x height coverage image, with the mesh's (0, 0) at origin, in pixels from the top left.
+// This is the translation applied by the model transform on the GPU.
+//
+// The passes match the stencil pipelines:
+//
+//  1. The triangle fans in m.Inds are drawn with primitive restart. Each covered sample increments an 8-bit stencil
+//     value for clockwise (front facing) triangles and decrements it for counter-clockwise ones, wrapping on overflow.
+//  2. The curve triangles are drawn the same way, except that samples failing the test in quad_shader.frag are
+//     discarded before they reach the stencil.
+//  3. The covering quad is drawn, and every sample it covers with a non-zero stencil value is fully opaque.
+//
+// Samples are taken at pixel centers, and samples exactly on an edge shared by two triangles are only covered by one
+// of them, following the top-left rule.
+func Rasterize(m tess.Mesh, width, height int, origin vkm.Pt2) *image.Alpha {
+	r := &rasterizer{
+		width:   width,
+		height:  height,
+		origin:  origin,
+		stencil: make([]uint8, width*height),
+	}
+
+	fanOp, curveOp := r.stencilOp(nil), r.stencilOp(curveTest)
+
+	// Pass 1: triangle fans, one per contour
+	var fan []tess.Vertex
+	for _, idx := range m.Inds {
+		if idx == tess.RestartIndex {
+			fan = fan[:0]
+			continue
+		}
+		fan = append(fan, m.Verts[idx])
+		if n := len(fan); n >= 3 {
+			r.triangle(fan[0], fan[n-2], fan[n-1], fanOp)
+		}
+	}
+
+	// Pass 2: curve triangles, as a list
+	quadEnd := len(m.QuadInds) - 4
+	for i := 0; i+2 < quadEnd; i += 3 {
+		v0, v1, v2 := m.QuadVerts[m.QuadInds[i]], m.QuadVerts[m.QuadInds[i+1]], m.QuadVerts[m.QuadInds[i+2]]
+		r.triangle(v0, v1, v2, curveOp)
+	}
+
+	// Pass 3: the covering quad, as a fan, tested against the stencil
+	img := image.NewAlpha(image.Rect(0, 0, width, height))
+	cover := func(x, y int, front bool, bary vkm.Pt3, orientation float32) {
+		if r.stencil[y*width+x] != 0 {
+			img.Pix[y*img.Stride+x] = 0xFF
+		}
+	}
+	c := m.QuadInds[quadEnd:]
+	for i := 2; i < len(c); i++ {
+		r.triangle(m.QuadVerts[c[0]], m.QuadVerts[c[i-1]], m.QuadVerts[c[i]], cover)
+	}
+
+	return img
+}
+
+// curveTest is the fragment test from quad_shader.frag, returning false where the fragment is discarded.
+func curveTest(bary vkm.Pt3, orientation float32) bool {
+	s, t := bary[1], bary[0]
+	comp := (s/2 + t) * (s/2 + t)
+	return !(orientation*(comp-t) > 0)
+}
+
+// fragmentFunc is called for every sample covered by a triangle, with whether the triangle is front facing and the
+// interpolated vertex attributes.
+type fragmentFunc func(x, y int, front bool, bary vkm.Pt3, orientation float32)
+
+type rasterizer struct {
+	width, height int
+	origin        vkm.Pt2
+	stencil       []uint8
+}
+
+// stencilOp returns a fragmentFunc implementing the stencil pipelines: INCREMENT_AND_WRAP for front faces and
+// DECREMENT_AND_WRAP for back faces. If test is non-nil, fragments it rejects are discarded.
+func (r *rasterizer) stencilOp(test func(bary vkm.Pt3, orientation float32) bool) fragmentFunc {
+	return func(x, y int, front bool, bary vkm.Pt3, orientation float32) {
+		if test != nil && !test(bary, orientation) {
+			return
+		}
+		if front {
+			r.stencil[y*r.width+x]++
+		} else {
+			r.stencil[y*r.width+x]--
+		}
+	}
+}
+
+// triangle calls frag for every pixel center covered by the triangle (v0, v1, v2).
+func (r *rasterizer) triangle(v0, v1, v2 tess.Vertex, frag fragmentFunc) {
+	verts := [3]tess.Vertex{v0, v1, v2}
+	var p [3][2]float64
+	for i, v := range verts {
+		p[i] = [2]float64{r.snap(v.Position[0] + r.origin[0]), r.snap(v.Position[1] + r.origin[1])}
+	}
+
+	// Twice the signed area. With the Y axis pointing down, a positive area is clockwise on screen, which is the front
+	// face for FRONT_FACE_CLOCKWISE.
+	area := edgeFunction(p[0], p[1], p[2])
+	if area == 0 {
+		return
+	}
+	front := area > 0
+	if !front {
+		// Swap to a consistent winding, so the inside is where every edge function is positive
+		p[1], p[2] = p[2], p[1]
+		verts[1], verts[2] = verts[2], verts[1]
+		area = -area
+	}
+
+	minX := math.Min(p[0][0], math.Min(p[1][0], p[2][0]))
+	maxX := math.Max(p[0][0], math.Max(p[1][0], p[2][0]))
+	minY := math.Min(p[0][1], math.Min(p[1][1], p[2][1]))
+	maxY := math.Max(p[0][1], math.Max(p[1][1], p[2][1]))
+
+	x0, x1 := clampInt(int(math.Floor(minX-0.5)), 0, r.width), clampInt(int(math.Ceil(maxX-0.5))+1, 0, r.width)
+	y0, y1 := clampInt(int(math.Floor(minY-0.5)), 0, r.height), clampInt(int(math.Ceil(maxY-0.5))+1, 0, r.height)
+
+	// Each weight belongs to the edge opposite its vertex
+	owns := [3]bool{ownsEdge(p[1], p[2]), ownsEdge(p[2], p[0]), ownsEdge(p[0], p[1])}
+
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			s := [2]float64{float64(x) + 0.5, float64(y) + 0.5}
+			w := [3]float64{edgeFunction(p[1], p[2], s), edgeFunction(p[2], p[0], s), edgeFunction(p[0], p[1], s)}
+
+			covered := true
+			for i := range w {
+				if w[i] < 0 || (w[i] == 0 && !owns[i]) {
+					covered = false
+					break
+				}
+			}
+			if !covered {
+				continue
+			}
+
+			var bary vkm.Pt3
+			for i, v := range verts {
+				for j := range bary {
+					bary[j] += float32(w[i]/area) * v.BaryCoords[j]
+				}
+			}
+			// Orientation is flat shaded, taken from the provoking (first) vertex
+			frag(x, y, front, bary, v0.Orientation)
+		}
+	}
+}
+
+func (r *rasterizer) snap(v float32) float64 {
+	const scale = 1 << subpixelBits
+	return math.Round(float64(v)*scale) / scale
+}
+
+// edgeFunction is positive when c is on the inside of the edge a->b, for a clockwise triangle on a Y-down screen.
+func edgeFunction(a, b, c [2]float64) float64 {
+	return (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+}
+
+// ownsEdge implements the top-left rule for an edge a->b of a triangle wound so that edgeFunction is positive inside:
+// samples exactly on the edge are covered only if it is a top edge (horizontal, with the inside below it) or a left
+// edge (with the inside to its right).
+func ownsEdge(a, b [2]float64) bool {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	return (dy == 0 && dx > 0) || dy < 0
+}
+
+func clampInt(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
@@ -0,0 +1,322 @@
+package sdf
+
+import (
+	"math"
+
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// edge is one segment of a closed contour, in pixel coordinates.
+type edge interface {
+	// point returns the position along the edge at parameter t in [0, 1].
+	point(t float64) vec
+	// direction returns the tangent of the edge at t, which is not normalized.
+	direction(t float64) vec
+	// closest returns the parameter of the point on the edge nearest to p, and the distance to it.
+	closest(p vec) (t, dist float64)
+	// bounds returns a box containing the whole edge.
+	bounds() (min, max vec)
+	// flatten appends a polyline approximating the edge to within tolerance, excluding the start point.
+	flatten(pts []vec, tolerance float64) []vec
+	// reverse returns the same edge, traversed from its end to its start.
+	reverse() edge
+	// splitThirds divides the edge into three edges of equal parameter range.
+	splitThirds() [3]edge
+}
+
+type lineEdge struct {
+	p0, p1 vec
+}
+
+type quadEdge struct {
+	p0, p1, p2 vec
+}
+
+type cubicEdge struct {
+	p0, p1, p2, p3 vec
+}
+
+// maxFlattenPieces caps how finely a single curve is flattened for the winding test.
+const maxFlattenPieces = 64
+
+func (e lineEdge) point(t float64) vec {
+	return e.p0.lerp(e.p1, t)
+}
+
+func (e lineEdge) direction(t float64) vec {
+	return e.p1.sub(e.p0)
+}
+
+func (e lineEdge) closest(p vec) (t, dist float64) {
+	d := e.p1.sub(e.p0)
+	if l2 := d.dot(d); l2 > 0 {
+		t = clamp(p.sub(e.p0).dot(d)/l2, 0, 1)
+	}
+	return t, p.sub(e.point(t)).length()
+}
+
+func (e lineEdge) bounds() (min, max vec) {
+	return boundsOf(e.p0, e.p1)
+}
+
+func (e lineEdge) flatten(pts []vec, tolerance float64) []vec {
+	return append(pts, e.p1)
+}
+
+func (e lineEdge) reverse() edge {
+	return lineEdge{e.p1, e.p0}
+}
+
+func (e lineEdge) splitThirds() [3]edge {
+	a, b := e.point(1.0/3), e.point(2.0/3)
+	return [3]edge{lineEdge{e.p0, a}, lineEdge{a, b}, lineEdge{b, e.p1}}
+}
+
+func (e quadEdge) point(t float64) vec {
+	mt := 1 - t
+	return e.p0.scale(mt * mt).add(e.p1.scale(2 * mt * t)).add(e.p2.scale(t * t))
+}
+
+// direction falls back to the chord if a control point coincides with the end point, where the derivative vanishes.
+func (e quadEdge) direction(t float64) vec {
+	d := e.p1.sub(e.p0).lerp(e.p2.sub(e.p1), t)
+	if d == (vec{}) {
+		return e.p2.sub(e.p0)
+	}
+	return d
+}
+
+// closest solves for the stationary points of the squared distance, which for a quadratic curve is the cubic
+// (B(t) - p) · B'(t) = 0, and compares them against the end points.
+func (e quadEdge) closest(p vec) (t, dist float64) {
+	q0 := e.p0.sub(p)
+	q1 := e.p1.sub(e.p0)
+	q2 := e.p2.sub(e.p1.scale(2)).add(e.p0)
+
+	var buf [3]float64
+	roots := solveCubic(buf[:0], q2.dot(q2), 3*q1.dot(q2), 2*q1.dot(q1)+q0.dot(q2), q0.dot(q1))
+
+	t, dist = 0, q0.length()
+	if d := p.sub(e.p2).length(); d < dist {
+		t, dist = 1, d
+	}
+	for _, r := range roots {
+		if r <= 0 || r >= 1 {
+			continue
+		}
+		if d := p.sub(e.point(r)).length(); d < dist {
+			t, dist = r, d
+		}
+	}
+	return t, dist
+}
+
+func (e quadEdge) bounds() (min, max vec) {
+	return boundsOf(e.p0, e.p1, e.p2)
+}
+
+func (e quadEdge) reverse() edge {
+	return quadEdge{e.p2, e.p1, e.p0}
+}
+
+// splitThirds uses the fact that the control point of the sub-curve over [t0, t1] is B(t0) + (t1-t0)/2 B'(t0).
+func (e quadEdge) splitThirds() [3]edge {
+	var parts [3]edge
+	for i := range parts {
+		t0, t1 := float64(i)/3, float64(i+1)/3
+		d := e.p1.sub(e.p0).lerp(e.p2.sub(e.p1), t0).scale(2)
+		start := e.point(t0)
+		parts[i] = quadEdge{start, start.add(d.scale((t1 - t0) / 2)), e.point(t1)}
+	}
+	return parts
+}
+
+// flatten splits the curve into equal parameter ranges. A chord over a range of length h deviates from the curve by at
+// most h²/8 times the magnitude of the second derivative, which is the constant 2(p0 - 2p1 + p2).
+func (e quadEdge) flatten(pts []vec, tolerance float64) []vec {
+	dd := e.p0.sub(e.p1.scale(2)).add(e.p2).length()
+	n := flattenPieces(2*dd, tolerance)
+	for i := 1; i < n; i++ {
+		pts = append(pts, e.point(float64(i)/float64(n)))
+	}
+	return append(pts, e.p2)
+}
+
+func (e cubicEdge) point(t float64) vec {
+	mt := 1 - t
+	return e.p0.scale(mt * mt * mt).add(e.p1.scale(3 * mt * mt * t)).add(e.p2.scale(3 * mt * t * t)).add(e.p3.scale(t * t * t))
+}
+
+func (e cubicEdge) derivatives(t float64) (d1, d2 vec) {
+	mt := 1 - t
+	a, b, c := e.p1.sub(e.p0), e.p2.sub(e.p1), e.p3.sub(e.p2)
+	d1 = a.scale(3 * mt * mt).add(b.scale(6 * mt * t)).add(c.scale(3 * t * t))
+	d2 = b.sub(a).scale(6 * mt).add(c.sub(b).scale(6 * t))
+	return d1, d2
+}
+
+// direction falls back to the next control point if one coincides with the end point, where the derivative vanishes.
+func (e cubicEdge) direction(t float64) vec {
+	d, _ := e.derivatives(t)
+	if d != (vec{}) {
+		return d
+	}
+	switch t {
+	case 0:
+		if e.p2 != e.p0 {
+			return e.p2.sub(e.p0)
+		}
+	case 1:
+		if e.p1 != e.p3 {
+			return e.p3.sub(e.p1)
+		}
+	}
+	return e.p3.sub(e.p0)
+}
+
+// cubicSearchStarts and cubicSearchSteps control the search for the closest point on a cubic curve. The stationary
+// points of the squared distance are the roots of a quintic, so instead of solving it, Newton's method is started from
+// evenly spaced parameters and the best result is kept.
+const (
+	cubicSearchStarts = 8
+	cubicSearchSteps  = 4
+)
+
+func (e cubicEdge) closest(p vec) (t, dist float64) {
+	t, dist = 0, p.sub(e.p0).length()
+	if d := p.sub(e.p3).length(); d < dist {
+		t, dist = 1, d
+	}
+
+	for i := 0; i <= cubicSearchStarts; i++ {
+		s := float64(i) / cubicSearchStarts
+		for j := 0; j < cubicSearchSteps; j++ {
+			q := e.point(s).sub(p)
+			d1, d2 := e.derivatives(s)
+			den := d1.dot(d1) + q.dot(d2)
+			if den == 0 {
+				break
+			}
+			s = clamp(s-q.dot(d1)/den, 0, 1)
+		}
+		if d := p.sub(e.point(s)).length(); d < dist {
+			t, dist = s, d
+		}
+	}
+	return t, dist
+}
+
+func (e cubicEdge) bounds() (min, max vec) {
+	return boundsOf(e.p0, e.p1, e.p2, e.p3)
+}
+
+func (e cubicEdge) reverse() edge {
+	return cubicEdge{e.p3, e.p2, e.p1, e.p0}
+}
+
+// splitThirds uses the fact that the inner control points of the sub-curve over [t0, t1] are B(t0) + (t1-t0)/3 B'(t0)
+// and B(t1) - (t1-t0)/3 B'(t1).
+func (e cubicEdge) splitThirds() [3]edge {
+	var parts [3]edge
+	for i := range parts {
+		t0, t1 := float64(i)/3, float64(i+1)/3
+		d0, _ := e.derivatives(t0)
+		d1, _ := e.derivatives(t1)
+		start, end := e.point(t0), e.point(t1)
+		parts[i] = cubicEdge{start, start.add(d0.scale((t1 - t0) / 3)), end.sub(d1.scale((t1 - t0) / 3)), end}
+	}
+	return parts
+}
+
+// flatten splits the curve into equal parameter ranges. The second derivative is largest at one of the end points,
+// where it is six times the second difference of the control points.
+func (e cubicEdge) flatten(pts []vec, tolerance float64) []vec {
+	dd := math.Max(
+		e.p0.sub(e.p1.scale(2)).add(e.p2).length(),
+		e.p1.sub(e.p2.scale(2)).add(e.p3).length(),
+	)
+	n := flattenPieces(6*dd, tolerance)
+	for i := 1; i < n; i++ {
+		pts = append(pts, e.point(float64(i)/float64(n)))
+	}
+	return append(pts, e.p3)
+}
+
+// flattenPieces returns how many equal parameter ranges a curve with second derivative at most dd must be split into
+// for each chord to stay within tolerance.
+func flattenPieces(dd, tolerance float64) int {
+	n := int(math.Ceil(math.Sqrt(dd / (8 * tolerance))))
+	if n < 1 {
+		return 1
+	}
+	if n > maxFlattenPieces {
+		return maxFlattenPieces
+	}
+	return n
+}
+
+func boundsOf(pts ...vec) (min, max vec) {
+	min, max = pts[0], pts[0]
+	for _, p := range pts[1:] {
+		min.x, min.y = math.Min(min.x, p.x), math.Min(min.y, p.y)
+		max.x, max.y = math.Max(max.x, p.x), math.Max(max.y, p.y)
+	}
+	return min, max
+}
+
+// contour is a closed loop of edges, each starting where the previous one ends.
+type contour []edge
+
+// buildContours converts segments to contours in pixel coordinates, mapping each point p to p*scale + origin. Contours
+// that are not explicitly closed get a closing line, as they would be filled that way anyway. Zero-length lines are
+// dropped.
+func buildContours(segments sfnt.Segments, scale float64, origin vec) (contours []contour) {
+	toPixels := func(p fixed.Point26_6) vec {
+		return vec{float64(p.X)/64*scale + origin.x, float64(p.Y)/64*scale + origin.y}
+	}
+
+	var current contour
+	var start, pen vec
+
+	closeContour := func() {
+		if pen != start {
+			current = append(current, lineEdge{pen, start})
+		}
+		if len(current) > 0 {
+			contours = append(contours, current)
+		}
+		current = nil
+	}
+
+	for _, seg := range segments {
+		switch seg.Op {
+		case sfnt.SegmentOpMoveTo:
+			closeContour()
+			start = toPixels(seg.Args[0])
+			pen = start
+			continue
+
+		case sfnt.SegmentOpLineTo:
+			p := toPixels(seg.Args[0])
+			if p == pen {
+				continue
+			}
+			current = append(current, lineEdge{pen, p})
+			pen = p
+
+		case sfnt.SegmentOpQuadTo:
+			p1, p2 := toPixels(seg.Args[0]), toPixels(seg.Args[1])
+			current = append(current, quadEdge{pen, p1, p2})
+			pen = p2
+
+		case sfnt.SegmentOpCubeTo:
+			p1, p2, p3 := toPixels(seg.Args[0]), toPixels(seg.Args[1]), toPixels(seg.Args[2])
+			current = append(current, cubicEdge{pen, p1, p2, p3})
+			pen = p3
+		}
+	}
+	closeContour()
+
+	return contours
+}
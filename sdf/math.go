@@ -0,0 +1,87 @@
+package sdf
+
+import "math"
+
+// vec is a point or direction in pixel coordinates, with Y increasing down.
+type vec struct {
+	x, y float64
+}
+
+func (a vec) add(b vec) vec             { return vec{a.x + b.x, a.y + b.y} }
+func (a vec) sub(b vec) vec             { return vec{a.x - b.x, a.y - b.y} }
+func (a vec) scale(s float64) vec       { return vec{a.x * s, a.y * s} }
+func (a vec) dot(b vec) float64         { return a.x*b.x + a.y*b.y }
+func (a vec) cross(b vec) float64       { return a.x*b.y - a.y*b.x }
+func (a vec) length() float64           { return math.Hypot(a.x, a.y) }
+func (a vec) lerp(b vec, t float64) vec { return a.add(b.sub(a).scale(t)) }
+
+// epsilon is the magnitude below which a polynomial coefficient is treated as zero. Coefficients are built from pixel
+// coordinates, so anything this small is rounding error rather than geometry.
+const epsilon = 1e-12
+
+// solveQuadratic appends the real roots of ax² + bx + c = 0 to roots.
+func solveQuadratic(roots []float64, a, b, c float64) []float64 {
+	if math.Abs(a) < epsilon {
+		if math.Abs(b) < epsilon {
+			return roots
+		}
+		return append(roots, -c/b)
+	}
+
+	disc := b*b - 4*a*c
+	switch {
+	case disc > 0:
+		// Avoid cancellation by computing the larger-magnitude root first
+		q := -(b + math.Copysign(math.Sqrt(disc), b)) / 2
+		roots = append(roots, q/a)
+		if q != 0 {
+			roots = append(roots, c/q)
+		}
+		return roots
+	case disc == 0:
+		return append(roots, -b/(2*a))
+	}
+	return roots
+}
+
+// solveCubic appends the real roots of ax³ + bx² + cx + d = 0 to roots, falling back to solveQuadratic when the
+// leading coefficient vanishes.
+func solveCubic(roots []float64, a, b, c, d float64) []float64 {
+	if math.Abs(a) < epsilon {
+		return solveQuadratic(roots, b, c, d)
+	}
+
+	// Reduce to the depressed cubic t³ + pt + q = 0, with x = t - b/3a
+	b, c, d = b/a, c/a, d/a
+	p := c - b*b/3
+	q := 2*b*b*b/27 - b*c/3 + d
+	shift := -b / 3
+
+	disc := q*q/4 + p*p*p/27
+	if disc > 0 {
+		// One real root, by Cardano's formula
+		s := math.Sqrt(disc)
+		return append(roots, math.Cbrt(-q/2+s)+math.Cbrt(-q/2-s)+shift)
+	}
+	if p == 0 {
+		return append(roots, shift)
+	}
+
+	// Three real roots, by the trigonometric method
+	m := 2 * math.Sqrt(-p/3)
+	theta := math.Acos(clamp(3*q/(p*m), -1, 1)) / 3
+	for k := 0.0; k < 3; k++ {
+		roots = append(roots, m*math.Cos(theta-2*math.Pi*k/3)+shift)
+	}
+	return roots
+}
+
+func clamp(v, lo, hi float64) float64 {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
@@ -0,0 +1,415 @@
+package sdf
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"golang.org/x/image/font/sfnt"
+)
+
+// A multi-channel signed distance field stores a separate distance in each of the red, green and blue channels. Every
+// edge of the outline is assigned to two or three channels, and the edges meeting at a sharp corner never share all of
+// theirs. Each channel is then a plain SDF of a subset of the edges, and taking the median of the three channels at
+// any point recovers the sharp corner that a single-channel field would round off, even after bilinear filtering.
+//
+// This follows the approach of Viktor Chlumský's msdfgen: simple edge coloring, per-channel pseudo-distances, and a
+// final pass that flattens pixels whose channels would interpolate into artifacts.
+
+// channels is the set of color channels that an edge contributes to.
+type channels uint8
+
+const (
+	redChannel channels = 1 << iota
+	greenChannel
+	blueChannel
+
+	cyan    = greenChannel | blueChannel
+	magenta = redChannel | blueChannel
+	yellow  = redChannel | greenChannel
+	white   = redChannel | greenChannel | blueChannel
+)
+
+type coloredEdge struct {
+	edge
+	color channels
+}
+
+// cornerAngle is the smallest change in direction, in radians, between consecutive edges that is treated as a sharp
+// corner. Smooth joins below this keep the same color on both sides.
+const cornerAngle = 3.0
+
+// edgeThreshold scales the difference between neighboring pixels, relative to the difference of one pixel in
+// distance, above which their channels are considered to clash.
+const edgeThreshold = 1.001
+
+// GenerateMSDF computes the multi-channel signed distance field of segments. Each channel is encoded the same way as
+// Generate, and the shape is reconstructed by thresholding the median of the red, green and blue channels at 0.5; see
+// shaders/msdf.frag. The alpha channel is always opaque. Spread must be positive.
+func GenerateMSDF(segments sfnt.Segments, opts Options) *image.RGBA {
+	if opts.Spread <= 0 {
+		panic("sdf: GenerateMSDF requires a positive Spread")
+	}
+
+	contours := buildContours(segments, opts.Scale, vec{opts.Origin[0], opts.Origin[1]})
+	windings := newWindingScanner(contours)
+	orientContours(contours, windings)
+
+	var edges []coloredEdge
+	for _, c := range colorContours(contours) {
+		edges = append(edges, c...)
+	}
+	boxes := make([][2]vec, len(edges))
+	for i, e := range edges {
+		boxes[i][0], boxes[i][1] = e.bounds()
+	}
+
+	field := make([][3]float64, opts.Width*opts.Height)
+
+	for y := 0; y < opts.Height; y++ {
+		inside := windings.row(float64(y)+0.5, opts.Width)
+
+		for x := 0; x < opts.Width; x++ {
+			p := vec{float64(x) + 0.5, float64(y) + 0.5}
+
+			var best [3]signedDistance
+			var bestEdge [3]edge
+			for c := range best {
+				best[c].dist = math.Inf(1)
+			}
+
+			for i, e := range edges {
+				// Skip edges that can't beat the current best distance of any channel they contribute to
+				reach := 0.0
+				for c := range best {
+					if e.color&(1<<c) != 0 {
+						reach = math.Max(reach, math.Abs(best[c].dist))
+					}
+				}
+				if boxDistance(p, boxes[i]) > reach {
+					continue
+				}
+
+				sd := edgeDistance(e.edge, p)
+				for c := range best {
+					if e.color&(1<<c) != 0 && sd.less(best[c]) {
+						best[c], bestEdge[c] = sd, e.edge
+					}
+				}
+			}
+
+			px := &field[y*opts.Width+x]
+			for c := range px {
+				d := -opts.Spread
+				if bestEdge[c] != nil {
+					d = pseudoDistance(bestEdge[c], p, best[c])
+				}
+				px[c] = 0.5 + d/(2*opts.Spread)
+			}
+
+			// Where the channels disagree with the true inside/outside, e.g. inside overlapping contours, fall back to
+			// the opposite of every channel
+			if (median(px[0], px[1], px[2]) > 0.5) != inside[x] {
+				for c := range px {
+					px[c] = 1 - px[c]
+				}
+			}
+		}
+	}
+
+	correctClashes(field, opts.Width, opts.Height, edgeThreshold/(2*opts.Spread))
+
+	img := image.NewRGBA(image.Rect(0, 0, opts.Width, opts.Height))
+	for i, px := range field {
+		img.SetRGBA(i%opts.Width, i/opts.Width, color.RGBA{
+			R: uint8(math.Round(clamp(px[0], 0, 1) * 255)),
+			G: uint8(math.Round(clamp(px[1], 0, 1) * 255)),
+			B: uint8(math.Round(clamp(px[2], 0, 1) * 255)),
+			A: 255,
+		})
+	}
+
+	return img
+}
+
+// signedDistance is the distance from a point to an edge, positive on the inside of the outline. Where two edges are
+// equally close, which happens at the point where they meet, dot breaks the tie.
+type signedDistance struct {
+	dist float64
+	// t is the parameter of the closest point on the edge
+	t float64
+	// dot is |cos| of the angle between the edge direction and the vector to the point. The edge that the point is
+	// most perpendicular to is the one whose side the point is on.
+	dot float64
+}
+
+// distanceTolerance is how close, in pixels, two distances must be to count as a tie.
+const distanceTolerance = 1e-9
+
+func (a signedDistance) less(b signedDistance) bool {
+	da, db := math.Abs(a.dist), math.Abs(b.dist)
+	if da < db-distanceTolerance {
+		return true
+	}
+	return da <= db+distanceTolerance && a.dot < b.dot
+}
+
+// edgeDistance returns the signed distance from p to e. Contours have been oriented so that the inside of the outline
+// is on the side where cross(direction, p - closest) is positive.
+func edgeDistance(e edge, p vec) signedDistance {
+	t, dist := e.closest(p)
+	dir := normalize(e.direction(t))
+	w := p.sub(e.point(t))
+
+	sd := signedDistance{dist: dist, t: t}
+	if dir.cross(w) < 0 {
+		sd.dist = -dist
+	}
+	if l := w.length(); l > 0 {
+		sd.dot = math.Abs(dir.dot(w.scale(1 / l)))
+	}
+	return sd
+}
+
+// pseudoDistance extends the closest edge along its tangent past whichever end point p is beyond, and returns the
+// signed distance to that line instead, if it is closer. Without this, the distance around a convex corner grows
+// radially, and the channels assigned to either side of the corner no longer cross 0.5 at the same place.
+func pseudoDistance(e edge, p vec, sd signedDistance) float64 {
+	var end vec
+	var dir vec
+	switch sd.t {
+	case 0:
+		end, dir = e.point(0), normalize(e.direction(0))
+		if p.sub(end).dot(dir) >= 0 {
+			return sd.dist
+		}
+	case 1:
+		end, dir = e.point(1), normalize(e.direction(1))
+		if p.sub(end).dot(dir) <= 0 {
+			return sd.dist
+		}
+	default:
+		return sd.dist
+	}
+
+	if pd := dir.cross(p.sub(end)); math.Abs(pd) <= math.Abs(sd.dist) {
+		return pd
+	}
+	return sd.dist
+}
+
+func normalize(v vec) vec {
+	if l := v.length(); l > 0 {
+		return v.scale(1 / l)
+	}
+	return v
+}
+
+func median(a, b, c float64) float64 {
+	return math.Max(math.Min(a, b), math.Min(math.Max(a, b), c))
+}
+
+// orientContours reverses any contour whose inside, according to the nonzero winding rule, is on the wrong side for
+// edgeDistance. TrueType and CFF outlines wind in opposite directions, and fonts don't always follow their own
+// convention, so each contour is checked by probing either side of its longest edge.
+func orientContours(contours []contour, windings *windingScanner) {
+	for i, c := range contours {
+		var probe edge
+		longest := -1.0
+		for _, e := range c {
+			if l := e.point(0).sub(e.point(1)).length(); l > longest {
+				probe, longest = e, l
+			}
+		}
+
+		mid := probe.point(0.5)
+		dir := normalize(probe.direction(0.5))
+		left := vec{-dir.y, dir.x}.scale(probeDistance)
+
+		leftInside := windings.winding(mid.add(left)) != 0
+		rightInside := windings.winding(mid.sub(left)) != 0
+		if leftInside || !rightInside {
+			continue
+		}
+
+		reversed := make(contour, len(c))
+		for j, e := range c {
+			reversed[len(c)-1-j] = e.reverse()
+		}
+		contours[i] = reversed
+	}
+}
+
+// probeDistance is how far either side of an edge, in pixels, orientContours tests the winding number. It is larger
+// than flattenTolerance so that the polyline can't be crossed by accident.
+const probeDistance = 4 * flattenTolerance
+
+// colorContours assigns channels to every edge so that the two edges meeting at each corner have different colors. A
+// contour with no corners is entirely white, as a plain SDF already represents it perfectly. A contour with only one
+// corner, like a teardrop, is split into three runs of edges on either side of a white middle.
+func colorContours(contours []contour) [][]coloredEdge {
+	crossThreshold := math.Sin(cornerAngle)
+	colored := make([][]coloredEdge, len(contours))
+
+	for ci, c := range contours {
+		var corners []int
+		prev := normalize(c[len(c)-1].direction(1))
+		for i, e := range c {
+			dir := normalize(e.direction(0))
+			if isCorner(prev, dir, crossThreshold) {
+				corners = append(corners, i)
+			}
+			prev = normalize(e.direction(1))
+		}
+
+		switch len(corners) {
+		case 0:
+			for _, e := range c {
+				colored[ci] = append(colored[ci], coloredEdge{e, white})
+			}
+
+		case 1:
+			colored[ci] = colorTeardrop(c, corners[0])
+
+		default:
+			edges := make([]coloredEdge, len(c))
+			current := cyan
+			first := current
+			spline := 0
+			for i := range c {
+				index := (corners[0] + i) % len(c)
+				if spline+1 < len(corners) && corners[spline+1] == index {
+					spline++
+					// The last run must also differ from the first, which it meets at corners[0]
+					banned := channels(0)
+					if spline == len(corners)-1 {
+						banned = first
+					}
+					current = switchColor(current, banned)
+				}
+				edges[index] = coloredEdge{c[index], current}
+			}
+			colored[ci] = edges
+		}
+	}
+
+	return colored
+}
+
+// colorTeardrop colors a contour with a single corner, starting at edge corner. There must be at least three edges
+// for the corner to be kept, so shorter contours are split first.
+func colorTeardrop(c contour, corner int) []coloredEdge {
+	var edges []edge
+	for i := range c {
+		edges = append(edges, c[(corner+i)%len(c)])
+	}
+	if len(edges) < 3 {
+		var split []edge
+		for _, e := range edges {
+			parts := e.splitThirds()
+			split = append(split, parts[:]...)
+		}
+		edges = split
+	}
+
+	colors := [3]channels{magenta, white, yellow}
+	colored := make([]coloredEdge, len(edges))
+	for i, e := range edges {
+		colored[i] = coloredEdge{e, colors[1+trichotomy(i, len(edges))]}
+	}
+	return colored
+}
+
+// trichotomy divides positions 0..n-1 into three symmetric groups, returning -1, 0 or 1. Positions that fall exactly
+// between two groups are rounded away from the middle on either side, so that position i and n-1-i always mirror each
+// other.
+func trichotomy(position, n int) int {
+	return int(math.Round(2.875*float64(position)/float64(n-1) - 1.4375))
+}
+
+// isCorner reports whether the change in direction from a to b, both normalized, is sharp enough to keep.
+func isCorner(a, b vec, crossThreshold float64) bool {
+	return a.dot(b) <= 0 || math.Abs(a.cross(b)) > crossThreshold
+}
+
+// switchColor returns the next two-channel color after c, skipping banned.
+func switchColor(c, banned channels) channels {
+	order := [3]channels{cyan, magenta, yellow}
+	for i, o := range order {
+		if o != c {
+			continue
+		}
+		next := order[(i+1)%3]
+		if next == banned {
+			next = order[(i+2)%3]
+		}
+		return next
+	}
+	return cyan
+}
+
+// correctClashes finds neighboring pixels whose channels change by more than threshold between them in a way that
+// bilinear filtering would turn into a spurious edge, and replaces every channel of the offending pixel with the
+// median, making it a plain SDF sample.
+func correctClashes(field [][3]float64, width, height int, threshold float64) {
+	var clashes []int
+
+	diagonal := threshold * math.Sqrt2
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			i := y*width + x
+			a := field[i]
+
+			clash := false
+			for _, n := range [...]struct {
+				dx, dy    int
+				threshold float64
+			}{
+				{-1, 0, threshold}, {1, 0, threshold}, {0, -1, threshold}, {0, 1, threshold},
+				{-1, -1, diagonal}, {1, -1, diagonal}, {-1, 1, diagonal}, {1, 1, diagonal},
+			} {
+				nx, ny := x+n.dx, y+n.dy
+				if nx < 0 || ny < 0 || nx >= width || ny >= height {
+					continue
+				}
+				if detectClash(a, field[ny*width+nx], n.threshold) {
+					clash = true
+					break
+				}
+			}
+
+			if clash {
+				clashes = append(clashes, i)
+			}
+		}
+	}
+
+	for _, i := range clashes {
+		m := median(field[i][0], field[i][1], field[i][2])
+		field[i] = [3]float64{m, m, m}
+	}
+}
+
+// detectClash reports whether pixel a clashes with its neighbor b. The channels are paired up and sorted by how much
+// they change between the pixels. A single channel changing quickly is normal near a corner, but two channels both
+// changing by more than threshold would put the median on the wrong side between the pixels. Only the pixel that is
+// further from the edge in the remaining channel is flagged.
+func detectClash(a, b [3]float64, threshold float64) bool {
+	if math.Abs(b[0]-a[0]) < math.Abs(b[1]-a[1]) {
+		a[0], a[1] = a[1], a[0]
+		b[0], b[1] = b[1], b[0]
+	}
+	if math.Abs(b[1]-a[1]) < math.Abs(b[2]-a[2]) {
+		a[1], a[2] = a[2], a[1]
+		b[1], b[2] = b[2], b[1]
+		if math.Abs(b[0]-a[0]) < math.Abs(b[1]-a[1]) {
+			a[0], a[1] = a[1], a[0]
+			b[0], b[1] = b[1], b[0]
+		}
+	}
+
+	return math.Abs(b[0]-a[0]) >= threshold && math.Abs(b[1]-a[1]) >= threshold &&
+		// A neighbor that has already been flattened to its median can't clash
+		!(b[0] == b[1] && b[0] == b[2]) &&
+		math.Abs(a[2]-0.5) >= math.Abs(b[2]-0.5)
+}
@@ -0,0 +1,188 @@
+package sdf
+
+import (
+	"image"
+	"math"
+	"testing"
+
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// sample interpolates img bilinearly at (x, y), with pixel centers at half-integer positions like a texture sampler,
+// and returns each of the red, green and blue channels between 0 and 1.
+func sample(img *image.RGBA, x, y float64) (rgb [3]float64) {
+	x, y = x-0.5, y-0.5
+	x0, y0 := int(math.Floor(x)), int(math.Floor(y))
+	fx, fy := x-float64(x0), y-float64(y0)
+
+	for _, p := range [...]struct {
+		dx, dy int
+		w      float64
+	}{
+		{0, 0, (1 - fx) * (1 - fy)}, {1, 0, fx * (1 - fy)}, {0, 1, (1 - fx) * fy}, {1, 1, fx * fy},
+	} {
+		c := img.RGBAAt(x0+p.dx, y0+p.dy)
+		rgb[0] += float64(c.R) / 255 * p.w
+		rgb[1] += float64(c.G) / 255 * p.w
+		rgb[2] += float64(c.B) / 255 * p.w
+	}
+	return rgb
+}
+
+func sampleGray(img *image.Gray, x, y float64) (v float64) {
+	x, y = x-0.5, y-0.5
+	x0, y0 := int(math.Floor(x)), int(math.Floor(y))
+	fx, fy := x-float64(x0), y-float64(y0)
+
+	v += float64(img.GrayAt(x0, y0).Y) * (1 - fx) * (1 - fy)
+	v += float64(img.GrayAt(x0+1, y0).Y) * fx * (1 - fy)
+	v += float64(img.GrayAt(x0, y0+1).Y) * (1 - fx) * fy
+	v += float64(img.GrayAt(x0+1, y0+1).Y) * fx * fy
+	return v / 255
+}
+
+func TestMSDFMatchesSDF(t *testing.T) {
+	opts := squareOptions(4)
+	msdf := GenerateMSDF(rect(0, 0, 20, 20, false), opts)
+	sdf := Generate(rect(0, 0, 20, 20, false), opts)
+
+	for y := 0; y < opts.Height; y++ {
+		for x := 0; x < opts.Width; x++ {
+			// Beyond a corner, each channel holds the distance to the extension of an edge rather than to the corner
+			outsideX, outsideY := x < 5 || x >= 25, y < 5 || y >= 25
+			if outsideX && outsideY {
+				continue
+			}
+
+			c := msdf.RGBAAt(x, y)
+			m := median(float64(c.R), float64(c.G), float64(c.B))
+			if want := float64(sdf.GrayAt(x, y).Y); math.Abs(m-want) > 1 {
+				t.Errorf("median at (%d, %d) is %v, want %v", x, y, m, want)
+			}
+		}
+	}
+}
+
+func TestMSDFSharpCorner(t *testing.T) {
+	opts := squareOptions(4)
+	msdf := GenerateMSDF(rect(0, 0, 20, 20, false), opts)
+	sdf := Generate(rect(0, 0, 20, 20, false), opts)
+
+	// Along the diagonal through the top left corner of the square, at (5, 5), reconstructed the way msdf.frag does
+	for _, s := range []float64{-0.5, -0.25, -0.1, 0.1, 0.25, 0.5} {
+		rgb := sample(msdf, 5+s, 5+s)
+		if got, inside := median(rgb[0], rgb[1], rgb[2]), s > 0; (got > 0.5) != inside {
+			t.Errorf("%v along the diagonal from the corner, the median is %.3f, want it on the %v side of 0.5", s, got, inside)
+		}
+	}
+
+	// The corner itself is on the outline, where a single-channel field has already rounded it off
+	rgb := sample(msdf, 5, 5)
+	if got := median(rgb[0], rgb[1], rgb[2]); math.Abs(got-0.5) > 1.0/255 {
+		t.Errorf("the median at the corner is %.3f, want 0.5", got)
+	}
+	if got := sampleGray(sdf, 5.1, 5.1); got > 0.5 {
+		t.Errorf("the single-channel field is %.3f just inside the corner, expected it to round the corner off", got)
+	}
+}
+
+func TestColorContours(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		segments sfnt.Segments
+		corners  int
+	}{
+		{"circle", circle(16, 16, 10), 0},
+		// A teardrop: a round end, with both sides meeting at a point
+		{"teardrop", sfnt.Segments{
+			{Op: sfnt.SegmentOpMoveTo, Args: [3]fixed.Point26_6{fixed.P(0, 0)}},
+			{Op: sfnt.SegmentOpQuadTo, Args: [3]fixed.Point26_6{fixed.P(20, 0), fixed.P(20, 10)}},
+			{Op: sfnt.SegmentOpQuadTo, Args: [3]fixed.Point26_6{fixed.P(20, 20), fixed.P(0, 0)}},
+		}, 1},
+		{"triangle", sfnt.Segments{
+			{Op: sfnt.SegmentOpMoveTo, Args: [3]fixed.Point26_6{fixed.P(0, 0)}},
+			{Op: sfnt.SegmentOpLineTo, Args: [3]fixed.Point26_6{fixed.P(20, 0)}},
+			{Op: sfnt.SegmentOpLineTo, Args: [3]fixed.Point26_6{fixed.P(10, 20)}},
+			{Op: sfnt.SegmentOpLineTo, Args: [3]fixed.Point26_6{fixed.P(0, 0)}},
+		}, 3},
+		{"square", rect(0, 0, 20, 20, false), 4},
+		{"two squares", append(rect(0, 0, 20, 20, false), rect(5, 5, 15, 15, true)...), 4},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			contours := buildContours(test.segments, 1, vec{})
+			colored := colorContours(contours)
+			if len(colored) != len(contours) {
+				t.Fatalf("got %d colored contours from %d", len(colored), len(contours))
+			}
+
+			for ci, c := range colored {
+				switch test.corners {
+				case 0:
+					for i, e := range c {
+						if e.color != white {
+							t.Errorf("edge %d of a smooth contour is %03b, want white", i, e.color)
+						}
+					}
+
+				case 1:
+					// The teardrop's two edges are split into thirds, and run from magenta through white to yellow
+					if len(c) < 3 {
+						t.Fatalf("got %d edges, want at least 3 to keep the corner", len(c))
+					}
+					if c[0].color != magenta || c[len(c)-1].color != yellow {
+						t.Errorf("the edges either side of the corner are %03b and %03b, want magenta and yellow", c[len(c)-1].color, c[0].color)
+					}
+					if c[0].point(0) != c[len(c)-1].point(1) {
+						t.Errorf("the colored edges don't start and end at the corner")
+					}
+
+				default:
+					if len(c) != test.corners {
+						t.Fatalf("contour %d has %d edges, want %d", ci, len(c), test.corners)
+					}
+					// Every edge meets the next at a corner, so no two neighbors may have the same color
+					for i, e := range c {
+						next := c[(i+1)%len(c)]
+						if e.color == next.color {
+							t.Errorf("edges %d and %d of contour %d meet at a corner and are both %03b", i, (i+1)%len(c), ci, e.color)
+						}
+						if e.color != cyan && e.color != magenta && e.color != yellow {
+							t.Errorf("edge %d of contour %d is %03b, want two channels", i, ci, e.color)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestTrichotomy(t *testing.T) {
+	for _, test := range []struct {
+		n    int
+		want []int
+	}{
+		{3, []int{-1, 0, 1}},
+		{4, []int{-1, 0, 0, 1}},
+		{5, []int{-1, -1, 0, 1, 1}},
+		{6, []int{-1, -1, 0, 0, 1, 1}},
+	} {
+		for i, want := range test.want {
+			if got := trichotomy(i, test.n); got != want {
+				t.Errorf("trichotomy(%d, %d) = %d, want %d", i, test.n, got, want)
+			}
+		}
+	}
+
+	// Longer contours are divided symmetrically, with the ends always on the outside
+	for n := 3; n < 50; n++ {
+		for i := 0; i < n; i++ {
+			if a, b := trichotomy(i, n), trichotomy(n-1-i, n); a != -b {
+				t.Errorf("trichotomy(%d, %d) = %d, but trichotomy(%d, %d) = %d", i, n, a, n-1-i, n, b)
+			}
+		}
+		if trichotomy(0, n) != -1 || trichotomy(n-1, n) != 1 {
+			t.Errorf("the ends of %d edges aren't in the outer groups", n)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package sdf
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// rect returns a rectangular contour from (x0, y0) to (x1, y1), clockwise on screen, or counterclockwise when reversed
+// is set.
+func rect(x0, y0, x1, y1 int, reversed bool) sfnt.Segments {
+	pts := []fixed.Point26_6{fixed.P(x0, y0), fixed.P(x1, y0), fixed.P(x1, y1), fixed.P(x0, y1)}
+	if reversed {
+		pts[1], pts[3] = pts[3], pts[1]
+	}
+	s := sfnt.Segments{{Op: sfnt.SegmentOpMoveTo, Args: [3]fixed.Point26_6{pts[0]}}}
+	for _, p := range append(pts[1:], pts[0]) {
+		s = append(s, sfnt.Segment{Op: sfnt.SegmentOpLineTo, Args: [3]fixed.Point26_6{p}})
+	}
+	return s
+}
+
+// circle returns a circle of radius r around (cx, cy), as eight quadratic curves whose control points are on the
+// tangents at their end points. Midway along each curve, it bulges out from a true circle by r/300.
+func circle(cx, cy, r float64) sfnt.Segments {
+	p := func(x, y float64) fixed.Point26_6 {
+		return fixed.Point26_6{X: fixed.Int26_6(math.Round(x * 64)), Y: fixed.Int26_6(math.Round(y * 64))}
+	}
+	const n = 8
+	ctrl := r / math.Cos(math.Pi/n)
+
+	s := sfnt.Segments{{Op: sfnt.SegmentOpMoveTo, Args: [3]fixed.Point26_6{p(cx+r, cy)}}}
+	for i := 0; i < n; i++ {
+		mid, end := (float64(i)+0.5)*2*math.Pi/n, float64(i+1)*2*math.Pi/n
+		s = append(s, sfnt.Segment{Op: sfnt.SegmentOpQuadTo, Args: [3]fixed.Point26_6{
+			p(cx+ctrl*math.Cos(mid), cy+ctrl*math.Sin(mid)),
+			p(cx+r*math.Cos(end), cy+r*math.Sin(end)),
+		}})
+	}
+	return s
+}
+
+// squareOptions places a 20px square, from (0, 0) to (20, 20) in outline units, 5px from the top left of a 30px
+// bitmap.
+func squareOptions(spread float64) Options {
+	return Options{Width: 30, Height: 30, Scale: 1, Origin: [2]float64{5, 5}, Spread: spread}
+}
+
+func TestSquareEdge(t *testing.T) {
+	img := Generate(rect(0, 0, 20, 20, false), squareOptions(4))
+
+	// Across the left edge at x = 5 on the middle row, pixel centers are at distances -4.5, -0.5, 0.5, 2.5 and 4.5
+	for _, test := range []struct {
+		x    int
+		want uint8
+	}{
+		{0, 0},
+		{4, 112},
+		{5, 143},
+		{7, 207},
+		{9, 255},
+	} {
+		if got := img.GrayAt(test.x, 15).Y; got != test.want {
+			t.Errorf("pixel %d of the middle row is %d, want %d", test.x, got, test.want)
+		}
+	}
+
+	// Every edge is the same distance from the center, so the field is symmetric under rotation
+	for y := 0; y < 30; y++ {
+		for x := 0; x < 30; x++ {
+			if a, b := img.GrayAt(x, y).Y, img.GrayAt(29-y, x).Y; a != b {
+				t.Fatalf("pixel (%d, %d) is %d, but rotated a quarter turn to (%d, %d) it is %d", x, y, a, 29-y, x, b)
+			}
+		}
+	}
+}
+
+func TestCircle(t *testing.T) {
+	const cx, cy, r = 16.0, 16.0, 10.0
+	opts := Options{Width: 32, Height: 32, Scale: 1, Spread: 4}
+
+	field := distances(circle(cx, cy, r), opts)
+	for y := 0; y < opts.Height; y++ {
+		for x := 0; x < opts.Width; x++ {
+			want := r - math.Hypot(float64(x)+0.5-cx, float64(y)+0.5-cy)
+			want = math.Max(-opts.Spread, math.Min(opts.Spread, want))
+			if got := field[y*opts.Width+x]; math.Abs(got-want) > 0.04 {
+				t.Errorf("distance at (%d, %d) is %.3f, want %.3f", x, y, got, want)
+			}
+		}
+	}
+}
+
+func TestNonzeroWinding(t *testing.T) {
+	opts := squareOptions(0)
+
+	// (x, y) of pixels, with whether each is inside
+	type probe struct {
+		x, y   int
+		inside bool
+	}
+
+	for _, test := range []struct {
+		name     string
+		segments sfnt.Segments
+		probes   []probe
+	}{
+		{
+			// The overlap has a winding number of two, which is still inside
+			name:     "overlapping",
+			segments: append(rect(0, 0, 12, 12, false), rect(8, 8, 20, 20, false)...),
+			probes:   []probe{{15, 15, true}, {7, 7, true}, {22, 22, true}, {22, 7, false}},
+		},
+		{
+			name:     "overlapping reversed",
+			segments: append(rect(0, 0, 12, 12, true), rect(8, 8, 20, 20, true)...),
+			probes:   []probe{{15, 15, true}, {7, 7, true}, {22, 22, true}, {22, 7, false}},
+		},
+		{
+			// A contour wound against the outer one cancels it out, leaving a hole
+			name:     "hole",
+			segments: append(rect(0, 0, 20, 20, false), rect(5, 5, 15, 15, true)...),
+			probes:   []probe{{15, 15, false}, {7, 7, true}, {22, 22, true}, {2, 2, false}},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			img := Generate(test.segments, opts)
+			for _, p := range test.probes {
+				want := uint8(0)
+				if p.inside {
+					want = 255
+				}
+				if got := img.GrayAt(p.x, p.y).Y; got != want {
+					t.Errorf("pixel (%d, %d) is %d, want %d", p.x, p.y, got, want)
+				}
+			}
+		})
+	}
+
+	// Winding a contour the other way round changes nothing on its own
+	a, b := Generate(rect(0, 0, 20, 20, false), squareOptions(4)), Generate(rect(0, 0, 20, 20, true), squareOptions(4))
+	for i := range a.Pix {
+		if a.Pix[i] != b.Pix[i] {
+			t.Fatalf("pixel (%d, %d) is %d, but %d with the contour reversed", i%30, i/30, a.Pix[i], b.Pix[i])
+		}
+	}
+}
+
+func TestCoverageMask(t *testing.T) {
+	img := Generate(circle(16, 16, 10), Options{Width: 32, Height: 32, Scale: 1})
+
+	for y := 0; y < 32; y++ {
+		for x := 0; x < 32; x++ {
+			d := 10 - math.Hypot(float64(x)+0.5-16, float64(y)+0.5-16)
+			got := img.GrayAt(x, y).Y
+			if got != 0 && got != 255 {
+				t.Fatalf("pixel (%d, %d) is %d, want fully inside or outside", x, y, got)
+			}
+			// Pixels right on the outline could go either way
+			if math.Abs(d) > 0.04 && (got == 255) != (d > 0) {
+				t.Errorf("pixel (%d, %d) is %d, %.3f from the outline", x, y, got, d)
+			}
+		}
+	}
+}
+
+func TestFit(t *testing.T) {
+	bounds := fixed.Rectangle26_6{Min: fixed.P(-10, -30), Max: fixed.P(50, 5)}
+	opts := Fit(bounds, 0.5, 3)
+
+	// 30 x 17.5 pixels, rounded out to 30 x 18, with a margin of 3 on every side
+	if opts.Width != 36 || opts.Height != 24 {
+		t.Errorf("got a %dx%d bitmap, want 36x24", opts.Width, opts.Height)
+	}
+	if want := [2]float64{8, 18}; opts.Origin != want {
+		t.Errorf("got origin %v, want %v", opts.Origin, want)
+	}
+}
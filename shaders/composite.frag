@@ -0,0 +1,209 @@
+#version 450
+
+// Combines the source and backdrop of a group with its composite mode, as colr.CompositeMode.Apply does on the CPU.
+// Colors are premultiplied.
+
+layout(set=0, binding=0) uniform sampler2D layers;
+
+layout(location=0) in vec2 inSourceUV;
+layout(location=1) in vec2 inBackdropUV;
+layout(location=2) flat in uint inMode;
+
+layout(location=0) out vec4 outColor;
+
+// colr.CompositeMode
+const uint CLEAR = 0u;
+const uint SRC = 1u;
+const uint DEST = 2u;
+const uint SRC_OVER = 3u;
+const uint DEST_OVER = 4u;
+const uint SRC_IN = 5u;
+const uint DEST_IN = 6u;
+const uint SRC_OUT = 7u;
+const uint DEST_OUT = 8u;
+const uint SRC_ATOP = 9u;
+const uint DEST_ATOP = 10u;
+const uint XOR = 11u;
+const uint PLUS = 12u;
+const uint SCREEN = 13u;
+const uint OVERLAY = 14u;
+const uint DARKEN = 15u;
+const uint LIGHTEN = 16u;
+const uint COLOR_DODGE = 17u;
+const uint COLOR_BURN = 18u;
+const uint HARD_LIGHT = 19u;
+const uint SOFT_LIGHT = 20u;
+const uint DIFFERENCE = 21u;
+const uint EXCLUSION = 22u;
+const uint MULTIPLY = 23u;
+const uint HSL_HUE = 24u;
+const uint HSL_SATURATION = 25u;
+const uint HSL_COLOR = 26u;
+const uint HSL_LUMINOSITY = 27u;
+
+float screen(float s, float b) {
+    return b + s - b * s;
+}
+
+float hardLight(float s, float b) {
+    return s <= 0.5 ? b * 2.0 * s : screen(2.0 * s - 1.0, b);
+}
+
+// Mixes one channel of the unpremultiplied source s over the backdrop b with a separable blend mode
+float blendChannel(uint mode, float s, float b) {
+    switch (mode) {
+    case SCREEN:
+        return screen(s, b);
+    case OVERLAY:
+        return hardLight(b, s);
+    case DARKEN:
+        return min(s, b);
+    case LIGHTEN:
+        return max(s, b);
+    case COLOR_DODGE:
+        if (b == 0.0) {
+            return 0.0;
+        }
+        return s >= 1.0 ? 1.0 : min(1.0, b / (1.0 - s));
+    case COLOR_BURN:
+        if (b >= 1.0) {
+            return 1.0;
+        }
+        return s <= 0.0 ? 0.0 : 1.0 - min(1.0, (1.0 - b) / s);
+    case HARD_LIGHT:
+        return hardLight(s, b);
+    case SOFT_LIGHT:
+        if (s <= 0.5) {
+            return b - (1.0 - 2.0 * s) * b * (1.0 - b);
+        }
+        float d = b <= 0.25 ? ((16.0 * b - 12.0) * b + 4.0) * b : sqrt(b);
+        return b + (2.0 * s - 1.0) * (d - b);
+    case DIFFERENCE:
+        return abs(b - s);
+    case EXCLUSION:
+        return b + s - 2.0 * b * s;
+    case MULTIPLY:
+        return b * s;
+    }
+    return s;
+}
+
+// lum, setLum, clipColor, sat and setSat are the helpers of the non-separable blend modes, as defined by the W3C's
+// Compositing and Blending
+float lum(vec3 c) {
+    return dot(c, vec3(0.3, 0.59, 0.11));
+}
+
+vec3 clipColor(vec3 c) {
+    float l = lum(c);
+    float n = min(c.r, min(c.g, c.b));
+    float x = max(c.r, max(c.g, c.b));
+    if (n < 0.0) {
+        c = l + (c - l) * l / (l - n);
+    }
+    if (x > 1.0) {
+        c = l + (c - l) * (1.0 - l) / (x - l);
+    }
+    return c;
+}
+
+vec3 setLum(vec3 c, float l) {
+    return clipColor(c + (l - lum(c)));
+}
+
+float sat(vec3 c) {
+    return max(c.r, max(c.g, c.b)) - min(c.r, min(c.g, c.b));
+}
+
+vec3 setSat(vec3 c, float s) {
+    float n = min(c.r, min(c.g, c.b));
+    float x = max(c.r, max(c.g, c.b));
+    if (x <= n) {
+        return vec3(0);
+    }
+    return (c - n) * s / (x - n);
+}
+
+vec3 unpremultiply(vec4 c) {
+    return c.a == 0.0 ? vec3(0) : c.rgb / c.a;
+}
+
+vec4 blend(uint mode, vec4 source, vec4 backdrop) {
+    vec3 cs = unpremultiply(source), cb = unpremultiply(backdrop);
+
+    vec3 mixed;
+    switch (mode) {
+    case HSL_HUE:
+        mixed = setLum(setSat(cs, sat(cb)), lum(cb));
+        break;
+    case HSL_SATURATION:
+        mixed = setLum(setSat(cb, sat(cs)), lum(cb));
+        break;
+    case HSL_COLOR:
+        mixed = setLum(cs, lum(cb));
+        break;
+    case HSL_LUMINOSITY:
+        mixed = setLum(cb, lum(cs));
+        break;
+    default:
+        mixed = vec3(blendChannel(mode, cs.r, cb.r), blendChannel(mode, cs.g, cb.g), blendChannel(mode, cs.b, cb.b));
+    }
+
+    // Where only one of the two is opaque, that one shows through as is
+    float sa = source.a, ba = backdrop.a;
+    return vec4((1.0 - ba) * source.rgb + (1.0 - sa) * backdrop.rgb + sa * ba * mixed, sa + ba - sa * ba);
+}
+
+void main() {
+    vec4 source = texture(layers, inSourceUV);
+    vec4 backdrop = texture(layers, inBackdropUV);
+    float sa = source.a, ba = backdrop.a;
+
+    // The Porter-Duff operators weigh the source and backdrop by these factors
+    vec2 f;
+    switch (inMode) {
+    case CLEAR:
+        outColor = vec4(0);
+        return;
+    case SRC:
+        f = vec2(1, 0);
+        break;
+    case DEST:
+        f = vec2(0, 1);
+        break;
+    case SRC_OVER:
+        f = vec2(1, 1.0 - sa);
+        break;
+    case DEST_OVER:
+        f = vec2(1.0 - ba, 1);
+        break;
+    case SRC_IN:
+        f = vec2(ba, 0);
+        break;
+    case DEST_IN:
+        f = vec2(0, sa);
+        break;
+    case SRC_OUT:
+        f = vec2(1.0 - ba, 0);
+        break;
+    case DEST_OUT:
+        f = vec2(0, 1.0 - sa);
+        break;
+    case SRC_ATOP:
+        f = vec2(ba, 1.0 - sa);
+        break;
+    case DEST_ATOP:
+        f = vec2(1.0 - ba, sa);
+        break;
+    case XOR:
+        f = vec2(1.0 - ba, 1.0 - sa);
+        break;
+    case PLUS:
+        outColor = min(source + backdrop, vec4(1));
+        return;
+    default:
+        outColor = blend(inMode, source, backdrop);
+        return;
+    }
+    outColor = source * f.x + backdrop * f.y;
+}
@@ -0,0 +1,27 @@
+#version 450
+
+layout(push_constant) uniform Transforms {
+    mat4 projection;
+    mat4 model;
+} transforms;
+
+// Per-instance data: one group, composited from its source and backdrop into the group image
+layout(location=0) in vec2 inPosition;   // Top left in the group image, in pixels
+layout(location=1) in vec2 inSize;       // In pixels
+layout(location=2) in vec4 inSourceUV;   // Source in the layer image, as (u0, v0, u1, v1)
+layout(location=3) in vec4 inBackdropUV; // Backdrop in the layer image
+layout(location=4) in uint inMode;       // colr.CompositeMode
+
+layout(location=0) out vec2 outSourceUV;
+layout(location=1) out vec2 outBackdropUV;
+layout(location=2) flat out uint outMode;
+
+void main() {
+    // Drawn as a 4 vertex triangle strip, so the corners are (0,0), (1,0), (0,1), (1,1)
+    vec2 corner = vec2(gl_VertexIndex & 1, gl_VertexIndex >> 1);
+
+    outSourceUV = mix(inSourceUV.xy, inSourceUV.zw, corner);
+    outBackdropUV = mix(inBackdropUV.xy, inBackdropUV.zw, corner);
+    outMode = inMode;
+    gl_Position = transforms.projection * transforms.model * vec4(inPosition + corner * inSize, 0.0, 1.0);
+}
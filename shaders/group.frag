@@ -0,0 +1,12 @@
+#version 450
+
+layout(set=1, binding=0) uniform sampler2D groups;
+
+layout(location=0) in vec2 inUV;
+
+layout(location=0) out vec4 outColor;
+
+void main() {
+    // Groups are stored composited, with premultiplied colors
+    outColor = texture(groups, inUV);
+}
@@ -0,0 +1,30 @@
+#version 450
+
+// Distance range of the field in texels: twice the Spread passed to sdf.GenerateMSDF
+layout(constant_id=0) const float pxRange = 8.0;
+
+layout(binding=0) uniform sampler2D msdf;
+
+layout(location=0) in vec2 inUV;
+
+layout(location=0) out vec4 outColor;
+
+float median(float r, float g, float b) {
+    return max(min(r, g), min(max(r, g), b));
+}
+
+void main() {
+    // Each channel is a distance field of a different subset of the edges. Their median is the distance to the
+    // outline, with sharp corners preserved through bilinear filtering.
+    vec3 s = texture(msdf, inUV).rgb;
+    float sd = median(s.r, s.g, s.b) - 0.5;
+
+    // Convert from field units to screen pixels, so the edge is antialiased over about one pixel at any scale
+    vec2 unitRange = vec2(pxRange) / vec2(textureSize(msdf, 0));
+    vec2 screenTexSize = vec2(1.0) / fwidth(inUV);
+    float screenPxRange = max(0.5 * dot(unitRange, screenTexSize), 1.0);
+
+    float coverage = clamp(screenPxRange * sd + 0.5, 0.0, 1.0);
+
+    outColor = vec4(1, 1, 1, coverage);
+}
\ No newline at end of file
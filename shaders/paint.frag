@@ -0,0 +1,150 @@
+#version 450
+
+// Fills a layer of a color glyph with its paint, as colr.Paint.At does on the CPU. Colors are premultiplied.
+
+// Mirrors paintRecord in color.go
+struct Paint {
+    uint kind;      // colr.PaintKind
+    uint extend;    // colr.Extend
+    uint firstStop; // Color line, in stops
+    uint stopCount;
+    vec4 color;     // Solid color
+    vec4 geometry0; // Linear: start and end; radial: start center and radius; sweep: center, start and end angles
+    vec4 geometry1; // Radial: end center and radius
+};
+
+// Mirrors colorStop in color.go
+struct ColorStop {
+    vec4 color;
+    float offset;
+};
+
+layout(std430, set=0, binding=0) readonly buffer Paints {
+    Paint paints[];
+};
+layout(std430, set=0, binding=1) readonly buffer Stops {
+    ColorStop stops[];
+};
+
+layout(location=0) in vec2 inPaintCoord; // In the paint's own space, in font units with Y up
+layout(location=1) flat in uint inPaint;
+
+layout(location=0) out vec4 outColor;
+
+const uint SOLID = 0u;
+const uint LINEAR = 1u;
+const uint RADIAL = 2u;
+const uint SWEEP = 3u;
+
+const uint REPEAT = 1u;
+const uint REFLECT = 2u;
+
+// Maps a position along the color line, where 0 and 1 are its first and last stops, into that range
+float extendOffset(uint extend, float u) {
+    if (extend == REPEAT) {
+        return fract(u);
+    }
+    if (extend == REFLECT) {
+        u = mod(u, 2.0);
+        return u > 1.0 ? 2.0 - u : u;
+    }
+    return clamp(u, 0.0, 1.0);
+}
+
+vec4 colorAt(Paint p, float t) {
+    if (p.stopCount == 0u) {
+        return vec4(0);
+    }
+    ColorStop first = stops[p.firstStop];
+    ColorStop last = stops[p.firstStop + p.stopCount - 1u];
+
+    // Extend within the stops' own range
+    if (last.offset > first.offset) {
+        float u = extendOffset(p.extend, (t - first.offset) / (last.offset - first.offset));
+        t = first.offset + u * (last.offset - first.offset);
+    }
+
+    if (t <= first.offset) {
+        return first.color;
+    }
+    for (uint k = 1u; k < p.stopCount; k++) {
+        ColorStop s0 = stops[p.firstStop + k - 1u];
+        ColorStop s1 = stops[p.firstStop + k];
+        if (t < s1.offset) {
+            return mix(s0.color, s1.color, (t - s0.offset) / (s1.offset - s0.offset));
+        }
+    }
+    return last.color;
+}
+
+// The largest t whose circle, between the start and end circles, passes through q with a radius that isn't negative
+bool radialOffset(Paint p, vec2 q, out float t) {
+    vec2 c0 = p.geometry0.xy, cd = p.geometry1.xy - c0;
+    float r0 = p.geometry0.z, dr = p.geometry1.z - r0;
+    vec2 d = q - c0;
+
+    // a*t^2 - 2*b*t + c = 0
+    float a = dot(cd, cd) - dr * dr;
+    float b = dot(d, cd) + r0 * dr;
+    float c = dot(d, d) - r0 * r0;
+
+    if (a == 0.0) {
+        if (b == 0.0) {
+            return false;
+        }
+        t = c / (2.0 * b);
+        return r0 + t * dr >= 0.0;
+    }
+
+    float disc = b * b - a * c;
+    if (disc < 0.0) {
+        return false;
+    }
+    float root = sqrt(disc);
+    float t0 = (b + root) / a, t1 = (b - root) / a;
+    t = max(t0, t1);
+    if (r0 + t * dr >= 0.0) {
+        return true;
+    }
+    t = min(t0, t1);
+    return r0 + t * dr >= 0.0;
+}
+
+void main() {
+    Paint p = paints[inPaint];
+    if (p.kind == SOLID) {
+        outColor = p.color;
+        return;
+    }
+
+    vec2 q = inPaintCoord;
+    float t = 0.0;
+    if (p.kind == LINEAR) {
+        vec2 d = p.geometry0.zw - p.geometry0.xy;
+        float d2 = dot(d, d);
+        if (d2 == 0.0) {
+            outColor = vec4(0);
+            return;
+        }
+        t = dot(q - p.geometry0.xy, d) / d2;
+    } else if (p.kind == RADIAL) {
+        if (!radialOffset(p, q, t)) {
+            outColor = vec4(0);
+            return;
+        }
+    } else if (p.kind == SWEEP) {
+        vec2 d = q - p.geometry0.xy;
+        float angle = degrees(atan(d.y, d.x));
+        if (angle < 0.0) {
+            angle += 360.0;
+        }
+        float start = p.geometry0.z, end = p.geometry0.w;
+        if (end != start) {
+            t = (angle - start) / (end - start);
+        } else if (angle >= start) {
+            t = 1.0;
+        }
+    }
+
+    outColor = colorAt(p, t);
+}
@@ -0,0 +1,19 @@
+#version 450
+
+layout(push_constant) uniform Transforms {
+    mat4 projection; // Pixel coordinates to clip space
+    mat4 model;      // Places the text, which is built relative to the start of its baseline
+} transforms;
+
+layout(location=0) in vec2 inPosition;
+layout(location=1) in vec2 inPaintCoord;
+layout(location=2) in uint inPaint;
+
+layout(location=0) out vec2 outPaintCoord;
+layout(location=1) flat out uint outPaint;
+
+void main() {
+    gl_Position = transforms.projection * transforms.model * vec4(inPosition, 0.0, 1.0);
+    outPaintCoord = inPaintCoord;
+    outPaint = inPaint;
+}
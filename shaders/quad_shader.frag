@@ -1,6 +1,7 @@
 #version 450
 
 layout(location=0) in vec3 baryCoords;
+layout(location=1) flat in float orientation;
 
 layout(location=0) out vec4 outColor;
 
@@ -10,10 +11,13 @@ void main() {
     float t = baryCoords.x;
     float comp = (s/2+t)*(s/2+t);
 
-    if (comp > t) {
+    // Convex curves (orientation +1) fill the region between the chord and the curve. Concave curves (orientation -1)
+    // are drawn with the fan running through the control point, so they fill the region between the curve and the
+    // control point instead.
+    if (orientation * (comp - t) > 0) {
         // Vertex shader is sending full triangles composed of two anchors and their control points. If this fragment is
-        // outside of the curve, then discard it. Comment this section out to see the "block" rendering of the full
-        // triangle, instead of the glyph curves.
+        // on the wrong side of the curve, then discard it. Comment this section out to see the "block" rendering of the
+        // full triangle, instead of the glyph curves.
         discard;
     }
 
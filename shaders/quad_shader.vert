@@ -1,12 +1,20 @@
 #version 450
 
+layout(push_constant) uniform Transforms {
+    mat4 projection; // Pixel coordinates to clip space
+    mat4 model;      // Places the text, which is built relative to the start of its baseline
+} transforms;
+
 layout(location=0) in vec2 inPosition;
 layout(location=1) in vec3 inBary;
+layout(location=2) in float inOrientation;
 
 layout(location=0) out vec3 outBary;
+layout(location=1) flat out float outOrientation;
 
 
 void main() {
-    gl_Position = vec4(inPosition[0]/320-0.8, inPosition[1]/320+0.8, 0.0, 1.0);
+    gl_Position = transforms.projection * transforms.model * vec4(inPosition, 0.0, 1.0);
     outBary = inBary;
+    outOrientation = inOrientation;
 }
\ No newline at end of file
@@ -1,7 +1,12 @@
 #version 450
 
+layout(push_constant) uniform Transforms {
+    mat4 projection; // Pixel coordinates to clip space
+    mat4 model;      // Places the text, which is built relative to the start of its baseline
+} transforms;
+
 layout(location=0) in vec2 inPosition;
 
 void main() {
-    gl_Position = vec4(inPosition[0]/320-0.8, inPosition[1]/320+0.8, 0.0, 1.0);
+    gl_Position = transforms.projection * transforms.model * vec4(inPosition, 0.0, 1.0);
 }
\ No newline at end of file
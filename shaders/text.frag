@@ -0,0 +1,14 @@
+#version 450
+
+layout(binding=0) uniform sampler2D atlas;
+
+layout(location=0) in vec2 inUV;
+
+layout(location=0) out vec4 outColor;
+
+void main() {
+    // The atlas only stores coverage, in the red channel
+    float coverage = texture(atlas, inUV).r;
+
+    outColor = vec4(1, 1, 1, coverage);
+}
\ No newline at end of file
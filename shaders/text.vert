@@ -0,0 +1,21 @@
+#version 450
+
+layout(push_constant) uniform Transforms {
+    mat4 projection;
+    mat4 model;
+} transforms;
+
+// Per-instance data: one textured quad for each glyph
+layout(location=0) in vec2 inPosition; // Top left, in pixels
+layout(location=1) in vec2 inSize;     // In pixels
+layout(location=2) in vec4 inUVRect;   // (u0, v0, u1, v1)
+
+layout(location=0) out vec2 outUV;
+
+void main() {
+    // Drawn as a 4 vertex triangle strip, so the corners are (0,0), (1,0), (0,1), (1,1)
+    vec2 corner = vec2(gl_VertexIndex & 1, gl_VertexIndex >> 1);
+
+    outUV = mix(inUVRect.xy, inUVRect.zw, corner);
+    gl_Position = transforms.projection * transforms.model * vec4(inPosition + corner * inSize, 0.0, 1.0);
+}
\ No newline at end of file
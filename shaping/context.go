@@ -0,0 +1,142 @@
+package shaping
+
+// lookupContext walks the glyph buffer for one lookup, skipping the glyphs that the lookup's flags ignore.
+type lookupContext struct {
+	gdef   *gdef
+	glyphs []Glyph
+
+	flag, markFilteringSet uint16
+}
+
+func (c *lookupContext) ignored(i int) bool {
+	return c.gdef.ignores(c.glyphs[i].ID, c.flag, c.markFilteringSet)
+}
+
+// next returns the index of the first glyph after i that is not ignored, or -1 if there is none.
+func (c *lookupContext) next(i int) int {
+	for i++; i < len(c.glyphs); i++ {
+		if !c.ignored(i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// prev returns the index of the last glyph before i that is not ignored, or -1 if there is none.
+func (c *lookupContext) prev(i int) int {
+	for i--; i >= 0; i-- {
+		if !c.ignored(i) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Sequences of a context rule, passed to a sequenceTest
+const (
+	backtrackSequence = iota
+	inputSequence
+	lookaheadSequence
+)
+
+// sequenceTest reports whether glyph g matches the k-th glyph of a rule's backtrack, input or lookahead sequence. Input
+// glyphs are numbered from the start of the input, so k is never 0 for the input when the first glyph was already
+// matched. Backtrack glyphs are numbered outward from the first input glyph.
+type sequenceTest func(sequence, k int, g GlyphIndex) bool
+
+// matchSequence matches a rule with the given sequence lengths, with its first input glyph at i, which has already been
+// tested. It returns the indices of every input glyph.
+func (c *lookupContext) matchSequence(i, backtrackLen, inputLen, lookaheadLen int, test sequenceTest) ([]int, bool) {
+	positions := make([]int, 1, inputLen)
+	positions[0] = i
+
+	p := i
+	for k := 1; k < inputLen; k++ {
+		if p = c.next(p); p < 0 || !test(inputSequence, k, c.glyphs[p].ID) {
+			return nil, false
+		}
+		positions = append(positions, p)
+	}
+	for k := 0; k < lookaheadLen; k++ {
+		if p = c.next(p); p < 0 || !test(lookaheadSequence, k, c.glyphs[p].ID) {
+			return nil, false
+		}
+	}
+
+	p = i
+	for k := 0; k < backtrackLen; k++ {
+		if p = c.prev(p); p < 0 || !test(backtrackSequence, k, c.glyphs[p].ID) {
+			return nil, false
+		}
+	}
+
+	return positions, true
+}
+
+// match finds the first rule of st that matches with its first input glyph at i. It returns the indices of the matched
+// input glyphs, and the lookups to apply to them.
+func (st *contextSubtable) match(c *lookupContext, i int) ([]int, []lookupRecord, bool) {
+	g := c.glyphs[i].ID
+
+	switch st.format {
+	case 1, 2:
+		covIndex, ok := st.cov.index(g)
+		if !ok {
+			return nil, nil, false
+		}
+		set := covIndex
+		if st.format == 2 {
+			set = int(st.inputClasses.class(g))
+		}
+		if set >= len(st.ruleSets) {
+			return nil, nil, false
+		}
+
+		for _, rule := range st.ruleSets[set] {
+			rule := rule
+			test := func(sequence, k int, g GlyphIndex) bool {
+				var want uint16
+				var classes classDef
+				switch sequence {
+				case backtrackSequence:
+					want, classes = rule.backtrack[k], st.backtrackClasses
+				case inputSequence:
+					want, classes = rule.input[k-1], st.inputClasses
+				case lookaheadSequence:
+					want, classes = rule.lookahead[k], st.lookaheadClasses
+				}
+				if st.format == 1 {
+					return GlyphIndex(want) == g
+				}
+				return classes.class(g) == want
+			}
+
+			if positions, ok := c.matchSequence(i, len(rule.backtrack), len(rule.input)+1, len(rule.lookahead), test); ok {
+				return positions, rule.records, true
+			}
+		}
+
+	case 3:
+		if _, ok := st.inputCov[0].index(g); !ok {
+			return nil, nil, false
+		}
+		test := func(sequence, k int, g GlyphIndex) bool {
+			var ok bool
+			switch sequence {
+			case backtrackSequence:
+				_, ok = st.backtrackCov[k].index(g)
+			case inputSequence:
+				_, ok = st.inputCov[k].index(g)
+			case lookaheadSequence:
+				_, ok = st.lookaheadCov[k].index(g)
+			}
+			return ok
+		}
+
+		if positions, ok := c.matchSequence(i, len(st.backtrackCov), len(st.inputCov), len(st.lookaheadCov), test); ok {
+			return positions, st.records, true
+		}
+	}
+
+	return nil, nil, false
+}
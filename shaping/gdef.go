@@ -0,0 +1,95 @@
+package shaping
+
+// Glyph classes from GDEF
+const (
+	classBase      = 1
+	classLigature  = 2
+	classMark      = 3
+	classComponent = 4
+)
+
+// gdef holds the parts of the GDEF table used to decide which glyphs a lookup skips.
+type gdef struct {
+	glyphClasses      classDef
+	markAttachClasses classDef
+	markGlyphSets     []coverage
+}
+
+func parseGDEF(b []byte) (*gdef, error) {
+	r := newReader(b)
+	major, minor := r.U16(), r.U16()
+	glyphClassOffset := r.U16()
+	r.U16() // attachListOffset
+	r.U16() // ligCaretListOffset
+	markAttachClassOffset := r.U16()
+	var markGlyphSetsOffset uint16
+	if minor >= 2 {
+		markGlyphSetsOffset = r.U16()
+	}
+	if r.Err() != nil {
+		return nil, r.Err()
+	}
+	if major != 1 {
+		return nil, ErrInvalidTable
+	}
+
+	g := &gdef{}
+	if glyphClassOffset != 0 {
+		g.glyphClasses = parseClassDef(r.At(int(glyphClassOffset)))
+	}
+	if markAttachClassOffset != 0 {
+		g.markAttachClasses = parseClassDef(r.At(int(markAttachClassOffset)))
+	}
+	if markGlyphSetsOffset != 0 {
+		mr := r.At(int(markGlyphSetsOffset))
+		if format := mr.U16(); format != 1 {
+			return nil, ErrInvalidTable
+		}
+		n := int(mr.U16())
+		for i := 0; i < n; i++ {
+			g.markGlyphSets = append(g.markGlyphSets, parseCoverage(mr.At(int(mr.U32()))))
+		}
+		if mr.Err() != nil {
+			return nil, mr.Err()
+		}
+	}
+
+	return g, r.Err()
+}
+
+// glyphClass returns the GDEF class of glyph g, or 0 if the font has no GDEF table or doesn't classify g.
+func (g *gdef) glyphClass(id GlyphIndex) uint16 {
+	if g == nil {
+		return 0
+	}
+	return g.glyphClasses.class(id)
+}
+
+// ignores reports whether a lookup with the given flag and mark filtering set skips glyph id.
+func (g *gdef) ignores(id GlyphIndex, flag, markFilteringSet uint16) bool {
+	if g == nil {
+		return false
+	}
+
+	switch g.glyphClasses.class(id) {
+	case classBase:
+		return flag&flagIgnoreBaseGlyphs != 0
+	case classLigature:
+		return flag&flagIgnoreLigatures != 0
+	case classMark:
+		if flag&flagIgnoreMarks != 0 {
+			return true
+		}
+		if flag&flagUseMarkFilteringSet != 0 {
+			if int(markFilteringSet) >= len(g.markGlyphSets) {
+				return true
+			}
+			_, ok := g.markGlyphSets[markFilteringSet].index(id)
+			return !ok
+		}
+		if markType := flag & flagMarkAttachmentType >> 8; markType != 0 {
+			return g.markAttachClasses.class(id) != markType
+		}
+	}
+	return false
+}
@@ -0,0 +1,551 @@
+package shaping
+
+import "sort"
+
+// GPOS lookup types
+const (
+	gposSingle          = 1
+	gposPair            = 2
+	gposCursive         = 3
+	gposMarkToBase      = 4
+	gposMarkToLigature  = 5
+	gposMarkToMark      = 6
+	gposContext         = 7
+	gposChainingContext = 8
+	gposExtension       = 9
+)
+
+// defaultPositionFeatures are applied to every script, unless turned off in Options.
+var defaultPositionFeatures = []string{"kern", "mark", "mkmk", "curs", "dist", "abvm", "blwm"}
+
+// Position is the placement of a shaped glyph, in font units with the Y axis pointing up, as in the font. The glyph
+// is drawn at the pen position plus its offset, and the pen then moves by its advance.
+type Position struct {
+	XAdvance, YAdvance int32
+	XOffset, YOffset   int32
+}
+
+// gposSubtable is a positioning adjustment at a single position in the glyph buffer.
+type gposSubtable interface {
+	// apply adjusts the glyph at i, and possibly the glyphs around it, if the subtable covers it. It returns the index
+	// of the glyph processing continues from, and whether anything was adjusted.
+	apply(p *positioner, i int) (next int, ok bool)
+}
+
+// valueRecord is a GPOS value record. Device and variation table offsets are read but not applied.
+type valueRecord struct {
+	xPlacement, yPlacement, xAdvance, yAdvance int16
+}
+
+func parseValueRecord(r *reader, format uint16) (v valueRecord) {
+	if format&0x1 != 0 {
+		v.xPlacement = int16(r.U16())
+	}
+	if format&0x2 != 0 {
+		v.yPlacement = int16(r.U16())
+	}
+	if format&0x4 != 0 {
+		v.xAdvance = int16(r.U16())
+	}
+	if format&0x8 != 0 {
+		v.yAdvance = int16(r.U16())
+	}
+	for bit := uint16(0x10); bit <= 0x80; bit <<= 1 {
+		if format&bit != 0 {
+			r.U16()
+		}
+	}
+	return v
+}
+
+func (v valueRecord) applyTo(pos *Position) {
+	pos.XOffset += int32(v.xPlacement)
+	pos.YOffset += int32(v.yPlacement)
+	pos.XAdvance += int32(v.xAdvance)
+	pos.YAdvance += int32(v.yAdvance)
+}
+
+// anchor is an attachment point, in font units. Contour point and device table refinements are ignored.
+type anchor struct {
+	x, y int32
+}
+
+// parseAnchor reads the anchor at offset from the start of r, or returns nil if offset is zero.
+func parseAnchor(r *reader, offset uint16) *anchor {
+	if offset == 0 {
+		return nil
+	}
+	ar := r.At(int(offset))
+	format := ar.U16()
+	a := &anchor{int32(int16(ar.U16())), int32(int16(ar.U16()))}
+	if format < 1 || format > 3 {
+		ar.Fail()
+	}
+	if ar.Err() != nil {
+		r.Fail()
+	}
+	return a
+}
+
+// markRecord is a mark's class and its attachment anchor.
+type markRecord struct {
+	class  uint16
+	anchor *anchor
+}
+
+func parseMarkArray(r *reader) []markRecord {
+	marks := make([]markRecord, r.U16())
+	for i := range marks {
+		marks[i].class = r.U16()
+		marks[i].anchor = parseAnchor(r, r.U16())
+	}
+	return marks
+}
+
+// parseAnchorMatrix reads rows of classCount anchor offsets, as used by the base and mark2 arrays.
+func parseAnchorMatrix(r *reader, classCount int) [][]*anchor {
+	rows := make([][]*anchor, r.U16())
+	for i := range rows {
+		rows[i] = make([]*anchor, classCount)
+		for j := range rows[i] {
+			rows[i][j] = parseAnchor(r, r.U16())
+		}
+	}
+	return rows
+}
+
+func parseGPOSSubtable(kind uint16, r *reader) (gposSubtable, error) {
+	switch kind {
+	case gposSingle:
+		format := r.U16()
+		st := &singlePos{cov: parseCoverage(r.At(int(r.U16())))}
+		valueFormat := r.U16()
+		switch format {
+		case 1:
+			st.values = []valueRecord{parseValueRecord(r, valueFormat)}
+		case 2:
+			st.values = make([]valueRecord, r.U16())
+			for i := range st.values {
+				st.values[i] = parseValueRecord(r, valueFormat)
+			}
+		default:
+			return nil, ErrInvalidTable
+		}
+		return st, r.Err()
+
+	case gposPair:
+		format := r.U16()
+		st := &pairPos{format: format, cov: parseCoverage(r.At(int(r.U16())))}
+		st.valueFormat1, st.valueFormat2 = r.U16(), r.U16()
+		switch format {
+		case 1:
+			offsets := r.U16s(int(r.U16()))
+			st.pairSets = make([][]pairValue, len(offsets))
+			for i, offset := range offsets {
+				sr := r.At(int(offset))
+				st.pairSets[i] = make([]pairValue, sr.U16())
+				for j := range st.pairSets[i] {
+					st.pairSets[i][j] = pairValue{
+						second: GlyphIndex(sr.U16()),
+						value1: parseValueRecord(sr, st.valueFormat1),
+						value2: parseValueRecord(sr, st.valueFormat2),
+					}
+				}
+				if sr.Err() != nil {
+					return nil, sr.Err()
+				}
+			}
+		case 2:
+			st.classes1 = parseClassDef(r.At(int(r.U16())))
+			st.classes2 = parseClassDef(r.At(int(r.U16())))
+			st.class1Count, st.class2Count = int(r.U16()), int(r.U16())
+			st.classValues = make([]pairValue, st.class1Count*st.class2Count)
+			for i := range st.classValues {
+				st.classValues[i].value1 = parseValueRecord(r, st.valueFormat1)
+				st.classValues[i].value2 = parseValueRecord(r, st.valueFormat2)
+			}
+		default:
+			return nil, ErrInvalidTable
+		}
+		return st, r.Err()
+
+	case gposCursive:
+		if format := r.U16(); format != 1 {
+			return nil, ErrInvalidTable
+		}
+		st := &cursivePos{cov: parseCoverage(r.At(int(r.U16())))}
+		st.entryExits = make([][2]*anchor, r.U16())
+		for i := range st.entryExits {
+			st.entryExits[i][0] = parseAnchor(r, r.U16())
+			st.entryExits[i][1] = parseAnchor(r, r.U16())
+		}
+		return st, r.Err()
+
+	case gposMarkToBase, gposMarkToLigature, gposMarkToMark:
+		if format := r.U16(); format != 1 {
+			return nil, ErrInvalidTable
+		}
+		st := &markPos{kind: kind}
+		st.markCov = parseCoverage(r.At(int(r.U16())))
+		st.baseCov = parseCoverage(r.At(int(r.U16())))
+		classCount := int(r.U16())
+		st.marks = parseMarkArray(r.At(int(r.U16())))
+
+		br := r.At(int(r.U16()))
+		if kind == gposMarkToLigature {
+			// Each ligature has a matrix of anchors, one row per component. Marks attach to the last component, as
+			// ligature components aren't tracked through substitution.
+			offsets := br.U16s(int(br.U16()))
+			st.bases = make([][]*anchor, len(offsets))
+			for i, offset := range offsets {
+				components := parseAnchorMatrix(br.At(int(offset)), classCount)
+				if len(components) > 0 {
+					st.bases[i] = components[len(components)-1]
+				}
+			}
+		} else {
+			st.bases = parseAnchorMatrix(br, classCount)
+		}
+		if br.Err() != nil {
+			return nil, br.Err()
+		}
+		return st, r.Err()
+
+	case gposContext, gposChainingContext:
+		st, err := parseContext(r, kind == gposChainingContext)
+		if err != nil {
+			return nil, err
+		}
+		return &contextPos{st}, nil
+	}
+
+	return nil, ErrInvalidTable
+}
+
+// Kinds of attachment, recorded during positioning and resolved once every lookup has been applied
+const (
+	attachNone = iota
+	attachMark
+	attachCursive
+)
+
+type attachment struct {
+	kind   int
+	parent int
+
+	// dx, dy is the offset from the parent's origin (marks), or the vertical offset from the parent (cursive)
+	dx, dy int32
+}
+
+// positioner applies GPOS lookups to a shaped glyph buffer.
+type positioner struct {
+	lookupContext
+	lookups []lookup[gposSubtable]
+	depth   int
+
+	// rightToLeft is set if the glyphs, in logical order, are drawn from right to left
+	rightToLeft bool
+
+	positions   []Position
+	attachments []attachment
+}
+
+func (p *positioner) applyLookup(index int) {
+	l := &p.lookups[index]
+	p.flag, p.markFilteringSet = l.flag, l.markFilteringSet
+
+	for i := 0; i < len(p.glyphs); {
+		if p.ignored(i) {
+			i++
+			continue
+		}
+		if next, ok := p.applySubtables(l, i); ok && next > i {
+			i = next
+		} else {
+			i++
+		}
+	}
+}
+
+func (p *positioner) applySubtables(l *lookup[gposSubtable], i int) (next int, ok bool) {
+	for _, st := range l.subtables {
+		if next, ok := st.apply(p, i); ok {
+			return next, true
+		}
+	}
+	return 0, false
+}
+
+// applyNested applies lookup index at the single position i, on behalf of a contextual lookup.
+func (p *positioner) applyNested(index, i int) {
+	if index >= len(p.lookups) || i >= len(p.glyphs) || p.depth >= maxNesting {
+		return
+	}
+
+	flag, markFilteringSet := p.flag, p.markFilteringSet
+	defer func() { p.flag, p.markFilteringSet = flag, markFilteringSet }()
+
+	l := &p.lookups[index]
+	p.flag, p.markFilteringSet = l.flag, l.markFilteringSet
+	if p.ignored(i) {
+		return
+	}
+
+	p.depth++
+	p.applySubtables(l, i)
+	p.depth--
+}
+
+// resolve turns attachments into offsets. A mark is offset from its base by the difference of their anchors, less the
+// advances between them; a glyph in a cursive chain is offset vertically from the glyph it is attached to.
+func (p *positioner) resolve() {
+	resolved := make([]bool, len(p.positions))
+
+	var resolveGlyph func(i, depth int)
+	resolveGlyph = func(i, depth int) {
+		a := p.attachments[i]
+		if resolved[i] || a.kind == attachNone || depth > len(p.positions) {
+			resolved[i] = true
+			return
+		}
+		resolved[i] = true
+		resolveGlyph(a.parent, depth+1)
+
+		parent := p.positions[a.parent]
+		switch a.kind {
+		case attachMark:
+			p.positions[i].XOffset = parent.XOffset + a.dx
+			p.positions[i].YOffset = parent.YOffset + a.dy
+
+			// The distance from the parent's pen position to the child's, given the order the glyphs are drawn in
+			var from, to int
+			sign := int32(1)
+			switch {
+			case !p.rightToLeft && a.parent < i:
+				from, to = a.parent, i
+			case !p.rightToLeft:
+				from, to, sign = i, a.parent, -1
+			case a.parent < i:
+				from, to, sign = a.parent+1, i+1, -1
+			default:
+				from, to = i+1, a.parent+1
+			}
+			for k := from; k < to; k++ {
+				p.positions[i].XOffset -= sign * p.positions[k].XAdvance
+				p.positions[i].YOffset -= sign * p.positions[k].YAdvance
+			}
+		case attachCursive:
+			p.positions[i].YOffset = parent.YOffset + a.dy
+		}
+	}
+
+	for i := range p.positions {
+		resolveGlyph(i, 0)
+	}
+}
+
+type singlePos struct {
+	cov    coverage
+	values []valueRecord // One for format 1, one per covered glyph for format 2
+}
+
+func (st *singlePos) apply(p *positioner, i int) (int, bool) {
+	index, ok := st.cov.index(p.glyphs[i].ID)
+	if !ok {
+		return 0, false
+	}
+	if len(st.values) == 1 {
+		index = 0
+	}
+	if index >= len(st.values) {
+		return 0, false
+	}
+	st.values[index].applyTo(&p.positions[i])
+	return i + 1, true
+}
+
+type pairValue struct {
+	second         GlyphIndex
+	value1, value2 valueRecord
+}
+
+type pairPos struct {
+	format                     uint16
+	cov                        coverage
+	valueFormat1, valueFormat2 uint16
+
+	// Format 1: pairs for each covered first glyph, sorted by second glyph
+	pairSets [][]pairValue
+
+	// Format 2: values for each pair of classes, in class1 major order
+	classes1, classes2       classDef
+	class1Count, class2Count int
+	classValues              []pairValue
+}
+
+// apply adjusts the pair of glyph i and the next glyph that isn't ignored. If the second glyph's value is non-empty,
+// it isn't considered as the first glyph of another pair.
+func (st *pairPos) apply(p *positioner, i int) (int, bool) {
+	index, ok := st.cov.index(p.glyphs[i].ID)
+	if !ok {
+		return 0, false
+	}
+	j := p.next(i)
+	if j < 0 {
+		return 0, false
+	}
+	second := p.glyphs[j].ID
+
+	var pair *pairValue
+	switch st.format {
+	case 1:
+		if index >= len(st.pairSets) {
+			return 0, false
+		}
+		set := st.pairSets[index]
+		k := sort.Search(len(set), func(k int) bool { return set[k].second >= second })
+		if k == len(set) || set[k].second != second {
+			return 0, false
+		}
+		pair = &set[k]
+	case 2:
+		c1, c2 := int(st.classes1.class(p.glyphs[i].ID)), int(st.classes2.class(second))
+		if c1 >= st.class1Count || c2 >= st.class2Count {
+			return 0, false
+		}
+		pair = &st.classValues[c1*st.class2Count+c2]
+	}
+
+	pair.value1.applyTo(&p.positions[i])
+	pair.value2.applyTo(&p.positions[j])
+	if st.valueFormat2 != 0 {
+		return j + 1, true
+	}
+	return j, true
+}
+
+type cursivePos struct {
+	cov        coverage
+	entryExits [][2]*anchor // Entry and exit anchors for each covered glyph
+}
+
+// apply connects the exit anchor of glyph i to the entry anchor of the next glyph. The advance of whichever glyph is
+// drawn first is adjusted so that the anchors meet horizontally, and one of the two is attached to the other
+// vertically: the second glyph to the first, or the reverse if the lookup has the RightToLeft flag.
+func (st *cursivePos) apply(p *positioner, i int) (int, bool) {
+	index, ok := st.cov.index(p.glyphs[i].ID)
+	if !ok || index >= len(st.entryExits) || st.entryExits[index][1] == nil {
+		return 0, false
+	}
+	exit := st.entryExits[index][1]
+
+	j := p.next(i)
+	if j < 0 {
+		return 0, false
+	}
+	nextIndex, ok := st.cov.index(p.glyphs[j].ID)
+	if !ok || nextIndex >= len(st.entryExits) || st.entryExits[nextIndex][0] == nil {
+		return 0, false
+	}
+	entry := st.entryExits[nextIndex][0]
+
+	pi, pj := &p.positions[i], &p.positions[j]
+	if p.rightToLeft {
+		d := exit.x + pi.XOffset
+		pi.XAdvance -= d
+		pi.XOffset -= d
+		pj.XAdvance = entry.x + pj.XOffset
+	} else {
+		pi.XAdvance = exit.x + pi.XOffset
+		d := entry.x + pj.XOffset
+		pj.XAdvance -= d
+		pj.XOffset -= d
+	}
+
+	child, parent, dy := j, i, exit.y-entry.y
+	if p.flag&flagRightToLeft != 0 {
+		child, parent, dy = i, j, -dy
+	}
+	p.attachments[child] = attachment{kind: attachCursive, parent: parent, dy: dy}
+
+	return j, true
+}
+
+// markPos is a mark-to-base, mark-to-ligature or mark-to-mark subtable. For mark-to-mark, the base coverage and anchors
+// are those of the mark being attached to.
+type markPos struct {
+	kind             uint16
+	markCov, baseCov coverage
+	marks            []markRecord
+	bases            [][]*anchor // Anchors for each covered base, by mark class
+}
+
+func (st *markPos) apply(p *positioner, i int) (int, bool) {
+	markIndex, ok := st.markCov.index(p.glyphs[i].ID)
+	if !ok || markIndex >= len(st.marks) {
+		return 0, false
+	}
+
+	base := p.findBase(i, st.kind == gposMarkToMark)
+	if base < 0 {
+		return 0, false
+	}
+	baseIndex, ok := st.baseCov.index(p.glyphs[base].ID)
+	if !ok || baseIndex >= len(st.bases) {
+		return 0, false
+	}
+
+	mark := st.marks[markIndex]
+	if int(mark.class) >= len(st.bases[baseIndex]) || mark.anchor == nil {
+		return 0, false
+	}
+	baseAnchor := st.bases[baseIndex][mark.class]
+	if baseAnchor == nil {
+		return 0, false
+	}
+
+	p.attachments[i] = attachment{
+		kind:   attachMark,
+		parent: base,
+		dx:     baseAnchor.x - mark.anchor.x,
+		dy:     baseAnchor.y - mark.anchor.y,
+	}
+	return i + 1, true
+}
+
+// findBase returns the index of the glyph that the mark at i attaches to, or -1. For mark-to-mark, that is the previous
+// glyph that the lookup doesn't ignore, which must itself be a mark. Otherwise, it is the previous glyph that isn't a
+// mark, whatever the lookup's flags; without GDEF, the immediately preceding glyph is used.
+func (p *positioner) findBase(i int, toMark bool) int {
+	if toMark {
+		j := p.prev(i)
+		if j < 0 || (p.gdef != nil && p.gdef.glyphClass(p.glyphs[j].ID) != classMark) {
+			return -1
+		}
+		return j
+	}
+
+	for j := i - 1; j >= 0; j-- {
+		if p.gdef == nil || p.gdef.glyphClass(p.glyphs[j].ID) != classMark {
+			return j
+		}
+	}
+	return -1
+}
+
+type contextPos struct {
+	*contextSubtable
+}
+
+func (st *contextPos) apply(p *positioner, i int) (int, bool) {
+	positions, records, ok := st.match(&p.lookupContext, i)
+	if !ok {
+		return 0, false
+	}
+	for _, record := range records {
+		if seq := int(record.sequenceIndex); seq < len(positions) {
+			p.applyNested(int(record.lookupIndex), positions[seq])
+		}
+	}
+	return positions[len(positions)-1] + 1, true
+}
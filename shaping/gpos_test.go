@@ -0,0 +1,166 @@
+package shaping
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bbredesen/ttf-renderer/internal/otbuild"
+)
+
+func position(t *testing.T, gpos, gdef []byte, glyphs []GlyphIndex, advances []int32, opts Options) []Position {
+	t.Helper()
+
+	tables := map[string][]byte{"GPOS": gpos}
+	if gdef != nil {
+		tables["GDEF"] = gdef
+	}
+	s, err := New(tables)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]Glyph, len(glyphs))
+	for i, id := range glyphs {
+		buf[i] = Glyph{ID: id, Cluster: i}
+	}
+	return s.Position([]rune("abc"), buf, advances, opts)
+}
+
+func anchorAt(x, y int) otbuild.Table {
+	return otbuild.Table{1, x, y}
+}
+
+// markGDEF classes gA as a base and gMark and gY as marks
+var markGDEF = otbuild.Table{
+	1, 0, otbuild.Table{2, 3, gA, gA, classBase, gMark, gMark, classMark, gY, gY, classMark}, 0, 0, 0,
+}.Bytes()
+
+func TestPairAdjustment(t *testing.T) {
+	gpos := buildLayoutTable("latn", []string{"kern"}, [][]int{{0, 1}}, []testLookup{
+		// Format 1: A X kerns by -80, with the second glyph raised by 10
+		{kind: 2, subtable: otbuild.Table{1, coverageOf(gA), 0x4, 0x2, 1,
+			otbuild.Table{1, gX, -80, 10},
+		}},
+		// Format 2: class 1 (F, I) followed by class 1 (L) kerns by -30. Each class pair has a single value, since
+		// the second value format is empty.
+		{kind: 2, subtable: otbuild.Table{2, coverageOf(gF, gI), 0x4, 0,
+			otbuild.Table{1, gF, 2, 1, 1},
+			otbuild.Table{1, gL, 1, 1},
+			2, 2,
+			0, 0,
+			0, -30,
+		}},
+	})
+
+	advances := []int32{500, 500, 300, 300, 200}
+	got := position(t, gpos, nil, []GlyphIndex{gA, gX, gF, gL, gA}, advances, Options{})
+	want := []Position{
+		{XAdvance: 420},
+		{XAdvance: 500, YOffset: 10},
+		{XAdvance: 270},
+		{XAdvance: 300},
+		{XAdvance: 200},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	features, _ := ParseFeatures("-kern")
+	got = position(t, gpos, nil, []GlyphIndex{gA, gX}, advances[:2], Options{Features: features})
+	if want := []Position{{XAdvance: 500}, {XAdvance: 500}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("with kern off, got %v, want %v", got, want)
+	}
+}
+
+func TestMarkAttachment(t *testing.T) {
+	gpos := buildLayoutTable("latn", []string{"kern", "mark", "mkmk"}, [][]int{{0}, {1}, {2}}, []testLookup{
+		// A X kerns by -100
+		{kind: 2, subtable: otbuild.Table{1, coverageOf(gA), 0x4, 0, 1, otbuild.Table{1, gX, -100}}},
+		// gMark attaches to the top of A (250, 700) and X (200, 650) by its bottom (50, 0)
+		{kind: 4, subtable: otbuild.Table{1, coverageOf(gMark), coverageOf(gA, gX), 1,
+			otbuild.Table{1, 0, anchorAt(50, 0)},
+			otbuild.Table{2, anchorAt(250, 700), anchorAt(200, 650)},
+		}},
+		// gY attaches to the top of gMark (50, 200) by its bottom (20, -10)
+		{kind: 6, subtable: otbuild.Table{1, coverageOf(gY), coverageOf(gMark), 1,
+			otbuild.Table{1, 0, anchorAt(20, -10)},
+			otbuild.Table{1, anchorAt(50, 200)},
+		}},
+	})
+
+	glyphs := []GlyphIndex{gA, gMark, gY, gX, gMark}
+	advances := []int32{600, 0, 0, 500, 0}
+	got := position(t, gpos, markGDEF, glyphs, advances, Options{})
+	want := []Position{
+		{XAdvance: 600},
+		{XOffset: 200 - 600, YOffset: 700},
+		{XOffset: 200 - 600 + 30, YOffset: 700 + 210},
+		{XAdvance: 500},
+		{XOffset: 150 - 500, YOffset: 650},
+	}
+	// The kern between A and X is blocked by the marks in between, as the lookup doesn't ignore them
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// Without marks, the kern applies, and a mark on X is unaffected since its offset is from X
+	got = position(t, gpos, markGDEF, []GlyphIndex{gA, gX, gMark}, []int32{600, 500, 0}, Options{})
+	want = []Position{
+		{XAdvance: 500},
+		{XAdvance: 500},
+		{XOffset: 150 - 500, YOffset: 650},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("after kerning, got %v, want %v", got, want)
+	}
+
+	// Right to left, a mark with an advance is drawn before its base, so its offset covers its own advance instead
+	got = position(t, gpos, markGDEF, []GlyphIndex{gA, gMark}, []int32{600, 100}, Options{RightToLeft: true})
+	want = []Position{
+		{XAdvance: 600},
+		{XAdvance: 100, XOffset: 200 + 100, YOffset: 700},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("right to left, got %v, want %v", got, want)
+	}
+}
+
+func TestCursiveAttachment(t *testing.T) {
+	// Each glyph enters at (0, 100) and exits at (400, 150), except gX which has no entry
+	gpos := buildLayoutTable("latn", []string{"curs"}, [][]int{{0}}, []testLookup{
+		{kind: 3, subtable: otbuild.Table{1, coverageOf(gA, gX), 2,
+			anchorAt(0, 100), anchorAt(400, 150),
+			0, anchorAt(300, 100),
+		}},
+	})
+
+	got := position(t, gpos, nil, []GlyphIndex{gA, gA, gA, gX}, []int32{500, 500, 500, 500}, Options{})
+	want := []Position{
+		{XAdvance: 400},
+		{XAdvance: 400, YOffset: 50},
+		{XAdvance: 500, YOffset: 100},
+		{XAdvance: 500},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestContextPositioning(t *testing.T) {
+	// Raise X by 20 only when it follows A, with a chaining context format 3 subtable
+	gpos := buildLayoutTable("latn", []string{"kern"}, [][]int{{0}}, []testLookup{
+		{kind: 8, subtable: otbuild.Table{3,
+			1, coverageOf(gA),
+			1, coverageOf(gX),
+			0,
+			1, 0, 1,
+		}},
+		{kind: 1, subtable: otbuild.Table{1, coverageOf(gX), 0x2, 20}},
+	})
+
+	got := position(t, gpos, nil, []GlyphIndex{gA, gX, gX}, []int32{500, 500, 500}, Options{})
+	want := []Position{{XAdvance: 500}, {XAdvance: 500, YOffset: 20}, {XAdvance: 500}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,342 @@
+package shaping
+
+// GSUB lookup types
+const (
+	gsubSingle                = 1
+	gsubMultiple              = 2
+	gsubAlternate             = 3
+	gsubLigature              = 4
+	gsubContext               = 5
+	gsubChainingContext       = 6
+	gsubExtension             = 7
+	gsubReverseChainingSingle = 8
+)
+
+// maxNesting limits how deeply contextual lookups may apply other contextual lookups, so that a malformed font can't
+// recurse forever.
+const maxNesting = 8
+
+// gsubSubtable is a substitution at a single position in the glyph buffer.
+type gsubSubtable interface {
+	// apply substitutes the glyph at i, and whatever follows it, if the subtable covers it. It returns the index of the
+	// first glyph after the substituted ones, and whether anything was substituted.
+	apply(s *substituter, i int) (next int, ok bool)
+}
+
+func parseGSUBSubtable(kind uint16, r *reader) (gsubSubtable, error) {
+	switch kind {
+	case gsubSingle:
+		st := &singleSubst{}
+		format := r.U16()
+		st.cov = parseCoverage(r.At(int(r.U16())))
+		switch format {
+		case 1:
+			st.delta = int16(r.U16())
+		case 2:
+			st.substitutes = glyphs(r, int(r.U16()))
+		default:
+			return nil, ErrInvalidTable
+		}
+		return st, r.Err()
+
+	case gsubMultiple, gsubAlternate:
+		// Both are a coverage and a glyph sequence for each covered glyph
+		if format := r.U16(); format != 1 {
+			return nil, ErrInvalidTable
+		}
+		cov := parseCoverage(r.At(int(r.U16())))
+		offsets := r.U16s(int(r.U16()))
+		sequences := make([][]GlyphIndex, len(offsets))
+		for i, offset := range offsets {
+			sr := r.At(int(offset))
+			sequences[i] = glyphs(sr, int(sr.U16()))
+			if sr.Err() != nil {
+				return nil, sr.Err()
+			}
+		}
+		if kind == gsubAlternate {
+			return &alternateSubst{cov, sequences}, r.Err()
+		}
+		return &multipleSubst{cov, sequences}, r.Err()
+
+	case gsubLigature:
+		if format := r.U16(); format != 1 {
+			return nil, ErrInvalidTable
+		}
+		st := &ligatureSubst{cov: parseCoverage(r.At(int(r.U16())))}
+		setOffsets := r.U16s(int(r.U16()))
+		st.sets = make([][]ligature, len(setOffsets))
+		for i, offset := range setOffsets {
+			sr := r.At(int(offset))
+			for _, lo := range sr.U16s(int(sr.U16())) {
+				lr := sr.At(int(lo))
+				lig := ligature{glyph: GlyphIndex(lr.U16())}
+				lig.components = glyphs(lr, int(lr.U16())-1)
+				if lr.Err() != nil {
+					return nil, lr.Err()
+				}
+				st.sets[i] = append(st.sets[i], lig)
+			}
+		}
+		return st, r.Err()
+
+	case gsubContext, gsubChainingContext:
+		st, err := parseContext(r, kind == gsubChainingContext)
+		if err != nil {
+			return nil, err
+		}
+		return &contextSubst{st}, nil
+
+	case gsubReverseChainingSingle:
+		if format := r.U16(); format != 1 {
+			return nil, ErrInvalidTable
+		}
+		st := &reverseChainSubst{cov: parseCoverage(r.At(int(r.U16())))}
+		for _, offset := range r.U16s(int(r.U16())) {
+			st.backtrack = append(st.backtrack, parseCoverage(r.At(int(offset))))
+		}
+		for _, offset := range r.U16s(int(r.U16())) {
+			st.lookahead = append(st.lookahead, parseCoverage(r.At(int(offset))))
+		}
+		st.substitutes = glyphs(r, int(r.U16()))
+		return st, r.Err()
+	}
+
+	return nil, ErrInvalidTable
+}
+
+// substituter applies GSUB lookups to a glyph buffer.
+type substituter struct {
+	lookupContext
+	lookups []lookup[gsubSubtable]
+	depth   int
+}
+
+// applyLookup applies lookup index across the whole buffer, to every glyph for which enabled returns true.
+func (s *substituter) applyLookup(index int, enabled func(g *Glyph) bool) {
+	l := &s.lookups[index]
+	s.flag, s.markFilteringSet = l.flag, l.markFilteringSet
+
+	if l.kind == gsubReverseChainingSingle {
+		// Applied from the end of the buffer backwards; substitutions never change its length
+		for i := len(s.glyphs) - 1; i >= 0; i-- {
+			if enabled(&s.glyphs[i]) && !s.ignored(i) {
+				s.applySubtables(l, i)
+			}
+		}
+		return
+	}
+
+	for i := 0; i < len(s.glyphs); {
+		if !enabled(&s.glyphs[i]) || s.ignored(i) {
+			i++
+			continue
+		}
+		if next, ok := s.applySubtables(l, i); ok {
+			i = next
+		} else {
+			i++
+		}
+	}
+}
+
+// applySubtables tries each subtable of l at i, until one of them applies.
+func (s *substituter) applySubtables(l *lookup[gsubSubtable], i int) (next int, ok bool) {
+	for _, st := range l.subtables {
+		if next, ok := st.apply(s, i); ok {
+			return next, true
+		}
+	}
+	return 0, false
+}
+
+// applyNested applies lookup index at the single position i, on behalf of a contextual lookup.
+func (s *substituter) applyNested(index, i int) {
+	if index >= len(s.lookups) || i >= len(s.glyphs) || s.depth >= maxNesting {
+		return
+	}
+
+	flag, markFilteringSet := s.flag, s.markFilteringSet
+	defer func() { s.flag, s.markFilteringSet = flag, markFilteringSet }()
+
+	l := &s.lookups[index]
+	s.flag, s.markFilteringSet = l.flag, l.markFilteringSet
+	if s.ignored(i) || l.kind == gsubReverseChainingSingle {
+		return
+	}
+
+	s.depth++
+	s.applySubtables(l, i)
+	s.depth--
+}
+
+// replace replaces the glyph at i with ids, each in the same cluster as the glyph replaced.
+func (s *substituter) replace(i int, ids []GlyphIndex) {
+	original := s.glyphs[i]
+
+	replacement := make([]Glyph, len(ids))
+	for j, id := range ids {
+		replacement[j] = original
+		replacement[j].ID = id
+	}
+
+	s.glyphs = append(s.glyphs[:i], append(replacement, s.glyphs[i+1:]...)...)
+}
+
+type singleSubst struct {
+	cov         coverage
+	delta       int16        // Format 1
+	substitutes []GlyphIndex // Format 2
+}
+
+func (st *singleSubst) apply(s *substituter, i int) (int, bool) {
+	index, ok := st.cov.index(s.glyphs[i].ID)
+	if !ok {
+		return 0, false
+	}
+	if st.substitutes == nil {
+		// Addition is modulo 65536
+		s.glyphs[i].ID = GlyphIndex(int(s.glyphs[i].ID) + int(st.delta))
+	} else if index < len(st.substitutes) {
+		s.glyphs[i].ID = st.substitutes[index]
+	} else {
+		return 0, false
+	}
+	return i + 1, true
+}
+
+type multipleSubst struct {
+	cov       coverage
+	sequences [][]GlyphIndex
+}
+
+func (st *multipleSubst) apply(s *substituter, i int) (int, bool) {
+	index, ok := st.cov.index(s.glyphs[i].ID)
+	if !ok || index >= len(st.sequences) {
+		return 0, false
+	}
+	s.replace(i, st.sequences[index])
+	return i + len(st.sequences[index]), true
+}
+
+// alternateSubst always chooses the first alternate. Choosing others needs a feature value, which Options doesn't
+// provide.
+type alternateSubst struct {
+	cov        coverage
+	alternates [][]GlyphIndex
+}
+
+func (st *alternateSubst) apply(s *substituter, i int) (int, bool) {
+	index, ok := st.cov.index(s.glyphs[i].ID)
+	if !ok || index >= len(st.alternates) || len(st.alternates[index]) == 0 {
+		return 0, false
+	}
+	s.glyphs[i].ID = st.alternates[index][0]
+	return i + 1, true
+}
+
+type ligature struct {
+	glyph      GlyphIndex
+	components []GlyphIndex // Every component after the first
+}
+
+type ligatureSubst struct {
+	cov  coverage
+	sets [][]ligature
+}
+
+// apply replaces the first ligature in the set for glyph i whose components all follow it. Ignored glyphs, such as
+// marks, between the components are kept, after the ligature.
+func (st *ligatureSubst) apply(s *substituter, i int) (int, bool) {
+	index, ok := st.cov.index(s.glyphs[i].ID)
+	if !ok || index >= len(st.sets) {
+		return 0, false
+	}
+
+next:
+	for _, lig := range st.sets[index] {
+		positions := make([]int, 0, len(lig.components))
+		p := i
+		for _, component := range lig.components {
+			if p = s.next(p); p < 0 || s.glyphs[p].ID != component {
+				continue next
+			}
+			positions = append(positions, p)
+		}
+
+		s.glyphs[i].ID = lig.glyph
+		for j := len(positions) - 1; j >= 0; j-- {
+			p := positions[j]
+			s.glyphs = append(s.glyphs[:p], s.glyphs[p+1:]...)
+		}
+		return i + 1, true
+	}
+
+	return 0, false
+}
+
+type contextSubst struct {
+	*contextSubtable
+}
+
+// apply matches a rule at i, and applies its lookups to the matched input glyphs in order. Nested lookups may change
+// the length of the buffer, so the positions of the input glyphs after each one are shifted to match.
+func (st *contextSubst) apply(s *substituter, i int) (int, bool) {
+	positions, records, ok := st.match(&s.lookupContext, i)
+	if !ok {
+		return 0, false
+	}
+
+	for _, record := range records {
+		seq := int(record.sequenceIndex)
+		if seq >= len(positions) {
+			continue
+		}
+
+		before := len(s.glyphs)
+		s.applyNested(int(record.lookupIndex), positions[seq])
+		if delta := len(s.glyphs) - before; delta != 0 {
+			for j := seq + 1; j < len(positions); j++ {
+				positions[j] += delta
+			}
+		}
+	}
+
+	next := positions[len(positions)-1] + 1
+	if next <= i {
+		next = i + 1
+	}
+	if next > len(s.glyphs) {
+		next = len(s.glyphs)
+	}
+	return next, true
+}
+
+type reverseChainSubst struct {
+	cov                  coverage
+	backtrack, lookahead []coverage
+	substitutes          []GlyphIndex
+}
+
+func (st *reverseChainSubst) apply(s *substituter, i int) (int, bool) {
+	index, ok := st.cov.index(s.glyphs[i].ID)
+	if !ok || index >= len(st.substitutes) {
+		return 0, false
+	}
+
+	test := func(sequence, k int, g GlyphIndex) bool {
+		var ok bool
+		if sequence == backtrackSequence {
+			_, ok = st.backtrack[k].index(g)
+		} else {
+			_, ok = st.lookahead[k].index(g)
+		}
+		return ok
+	}
+	if _, ok := s.matchSequence(i, len(st.backtrack), 1, len(st.lookahead), test); !ok {
+		return 0, false
+	}
+
+	s.glyphs[i].ID = st.substitutes[index]
+	return i, true
+}
@@ -0,0 +1,261 @@
+package shaping
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bbredesen/ttf-renderer/internal/otbuild"
+)
+
+func coverageOf(glyphs ...int) otbuild.Table {
+	t := otbuild.Table{1, len(glyphs)}
+	for _, g := range glyphs {
+		t = append(t, g)
+	}
+	return t
+}
+
+type testLookup struct {
+	kind, flag int
+	subtable   otbuild.Table
+}
+
+// buildLayoutTable returns a GSUB or GPOS table with a single script, whose default language system has one feature
+// for each entry of features, in order. Each feature maps to lookups by index.
+func buildLayoutTable(script string, features []string, featureLookups [][]int, lookups []testLookup) []byte {
+	langSys := otbuild.Table{0, 0xFFFF, len(features)}
+	for i := range features {
+		langSys = append(langSys, i)
+	}
+
+	featureList := otbuild.Table{len(features)}
+	for i, f := range features {
+		feature := otbuild.Table{0, len(featureLookups[i])}
+		for _, l := range featureLookups[i] {
+			feature = append(feature, l)
+		}
+		featureList = append(featureList, uint32(MakeTag(f)), feature)
+	}
+
+	lookupList := otbuild.Table{len(lookups)}
+	for _, l := range lookups {
+		lookupList = append(lookupList, otbuild.Table{l.kind, l.flag, 1, l.subtable})
+	}
+
+	return otbuild.Table{1, 0,
+		otbuild.Table{1, uint32(MakeTag(script)), otbuild.Table{langSys, 0}},
+		featureList,
+		lookupList,
+	}.Bytes()
+}
+
+func shapeIDs(t *testing.T, gsub, gdef []byte, text string, glyphs []GlyphIndex, opts Options) ([]GlyphIndex, []int) {
+	t.Helper()
+
+	tables := map[string][]byte{"GSUB": gsub}
+	if gdef != nil {
+		tables["GDEF"] = gdef
+	}
+	s, err := New(tables)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []GlyphIndex
+	var clusters []int
+	for _, g := range s.Shape([]rune(text), glyphs, opts) {
+		ids = append(ids, g.ID)
+		clusters = append(clusters, g.Cluster)
+	}
+	return ids, clusters
+}
+
+// Glyph IDs for the tests
+const (
+	gF = 1 + iota
+	gI
+	gL
+	gA
+	gFI
+	gFFL
+	gMark
+	gX
+	gY
+	gZ
+)
+
+// ligatures is a ligature subtable with "fi" and "ffl", where "ffl" is listed first so that it takes precedence.
+var ligatures = otbuild.Table{1, coverageOf(gF), 1,
+	otbuild.Table{2,
+		otbuild.Table{gFFL, 3, gF, gL},
+		otbuild.Table{gFI, 2, gI},
+	},
+}
+
+func TestLigature(t *testing.T) {
+	gsub := buildLayoutTable("latn", []string{"liga"}, [][]int{{0}}, []testLookup{{kind: 4, subtable: ligatures}})
+
+	ids, clusters := shapeIDs(t, gsub, nil, "fiffla", []GlyphIndex{gF, gI, gF, gF, gL, gA}, Options{})
+	if want := []GlyphIndex{gFI, gFFL, gA}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got glyphs %v, want %v", ids, want)
+	}
+	if want := []int{0, 2, 5}; !reflect.DeepEqual(clusters, want) {
+		t.Errorf("got clusters %v, want %v", clusters, want)
+	}
+
+	// Turning the feature off leaves every glyph alone
+	features, err := ParseFeatures("-liga")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, _ = shapeIDs(t, gsub, nil, "fi", []GlyphIndex{gF, gI}, Options{Features: features})
+	if want := []GlyphIndex{gF, gI}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("with liga off, got glyphs %v, want %v", ids, want)
+	}
+}
+
+func TestLigatureSkipsMarks(t *testing.T) {
+	gsub := buildLayoutTable("latn", []string{"liga"}, [][]int{{0}}, []testLookup{{kind: 4, flag: flagIgnoreMarks, subtable: ligatures}})
+	// Glyph class 3 (mark) for gMark
+	gdef := otbuild.Table{1, 0, otbuild.Table{2, 1, gMark, gMark, classMark}, 0, 0, 0}.Bytes()
+
+	ids, _ := shapeIDs(t, gsub, gdef, "f́i", []GlyphIndex{gF, gMark, gI}, Options{})
+	if want := []GlyphIndex{gFI, gMark}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got glyphs %v, want %v", ids, want)
+	}
+}
+
+func TestSingleMultipleAlternate(t *testing.T) {
+	gsub := buildLayoutTable("latn", []string{"smcp", "ccmp", "salt"}, [][]int{{0, 1}, {2}, {3}}, []testLookup{
+		{kind: 1, subtable: otbuild.Table{1, coverageOf(gA), 1}},                               // a -> a+1 (gFI)
+		{kind: 1, subtable: otbuild.Table{2, coverageOf(gX, gY), 2, gY, gZ}},                   // x -> y, y -> z
+		{kind: 2, subtable: otbuild.Table{1, coverageOf(gL), 1, otbuild.Table{3, gX, gX, gX}}}, // l -> x x x
+		{kind: 3, subtable: otbuild.Table{1, coverageOf(gI), 1, otbuild.Table{2, gZ, gY}}},     // i -> z (first alternate)
+	})
+
+	features, _ := ParseFeatures("smcp,salt")
+	ids, clusters := shapeIDs(t, gsub, nil, "axli", []GlyphIndex{gA, gX, gL, gI}, Options{Features: features})
+	if want := []GlyphIndex{gFI, gY, gX, gX, gX, gZ}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got glyphs %v, want %v", ids, want)
+	}
+	if want := []int{0, 1, 2, 2, 2, 3}; !reflect.DeepEqual(clusters, want) {
+		t.Errorf("got clusters %v, want %v", clusters, want)
+	}
+
+	// smcp and salt aren't on by default; ccmp is
+	ids, _ = shapeIDs(t, gsub, nil, "axli", []GlyphIndex{gA, gX, gL, gI}, Options{})
+	if want := []GlyphIndex{gA, gX, gX, gX, gX, gI}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("with default features, got glyphs %v, want %v", ids, want)
+	}
+}
+
+func TestChainingContext(t *testing.T) {
+	single := testLookup{kind: 1, subtable: otbuild.Table{2, coverageOf(gA), 1, gZ}} // a -> z, only through the context
+	lookups := []testLookup{
+		// Format 3: a is replaced when preceded by x and followed by y
+		{kind: 6, subtable: otbuild.Table{3,
+			1, coverageOf(gX),
+			1, coverageOf(gA),
+			1, coverageOf(gY),
+			1, 0, 1,
+		}},
+		single,
+	}
+	gsub := buildLayoutTable("latn", []string{"calt"}, [][]int{{0}}, lookups)
+
+	ids, _ := shapeIDs(t, gsub, nil, "xayaxa", []GlyphIndex{gX, gA, gY, gA, gX, gA}, Options{})
+	if want := []GlyphIndex{gX, gZ, gY, gA, gX, gA}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("format 3: got glyphs %v, want %v", ids, want)
+	}
+
+	// Format 1: the same rule by glyph ID
+	lookups[0].subtable = otbuild.Table{1, coverageOf(gA), 1,
+		otbuild.Table{1, otbuild.Table{1, gX, 1, 1, gY, 1, 0, 1}},
+	}
+	gsub = buildLayoutTable("latn", []string{"calt"}, [][]int{{0}}, lookups)
+	ids, _ = shapeIDs(t, gsub, nil, "xayaxa", []GlyphIndex{gX, gA, gY, gA, gX, gA}, Options{})
+	if want := []GlyphIndex{gX, gZ, gY, gA, gX, gA}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("format 1: got glyphs %v, want %v", ids, want)
+	}
+
+	// Format 2: by class, with x and y in class 1 for backtrack and lookahead, and a in input class 1
+	classX := otbuild.Table{1, gX, 2, 1, 1} // x and y (gX+1) in class 1
+	lookups[0].subtable = otbuild.Table{2, coverageOf(gA),
+		classX, otbuild.Table{1, gA, 1, 1}, classX,
+		2, 0, otbuild.Table{1, otbuild.Table{1, 1, 1, 1, 1, 1, 0, 1}},
+	}
+	gsub = buildLayoutTable("latn", []string{"calt"}, [][]int{{0}}, lookups)
+	ids, _ = shapeIDs(t, gsub, nil, "yaxaxa", []GlyphIndex{gY, gA, gX, gA, gX, gA}, Options{})
+	if want := []GlyphIndex{gY, gZ, gX, gZ, gX, gA}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("format 2: got glyphs %v, want %v", ids, want)
+	}
+}
+
+func TestContextWithMultipleSubstitution(t *testing.T) {
+	// In the context "a x", x becomes "y y" and then a becomes z. The second record still finds a, and processing
+	// continues after the expanded glyphs.
+	gsub := buildLayoutTable("latn", []string{"calt"}, [][]int{{0}}, []testLookup{
+		{kind: 5, subtable: otbuild.Table{3, 2, 2, coverageOf(gA), coverageOf(gX), 1, 1, 0, 2}},
+		{kind: 2, subtable: otbuild.Table{1, coverageOf(gX), 1, otbuild.Table{2, gY, gY}}},
+		{kind: 1, subtable: otbuild.Table{2, coverageOf(gA), 1, gZ}},
+	})
+
+	ids, _ := shapeIDs(t, gsub, nil, "axax", []GlyphIndex{gA, gX, gA, gX}, Options{})
+	if want := []GlyphIndex{gZ, gY, gY, gZ, gY, gY}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got glyphs %v, want %v", ids, want)
+	}
+}
+
+func TestExtensionAndReverseChaining(t *testing.T) {
+	// A reverse chaining substitution, wrapped in an extension: a becomes z when followed by a or z. Working backwards,
+	// the substitution of the last a enables the one before it.
+	reverse := otbuild.Table{1, coverageOf(gA), 0, 1, coverageOf(gA, gZ), 1, gZ}
+	gsub := buildLayoutTable("latn", []string{"calt"}, [][]int{{0}}, []testLookup{
+		{kind: 7, subtable: otbuild.Table{1, 8, otbuild.Offset32(reverse)}},
+	})
+
+	ids, _ := shapeIDs(t, gsub, nil, "aaax", []GlyphIndex{gA, gA, gA, gX}, Options{})
+	if want := []GlyphIndex{gZ, gZ, gA, gX}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got glyphs %v, want %v", ids, want)
+	}
+}
+
+func TestArabicJoiningForms(t *testing.T) {
+	// Each form maps glyph 1 (standing in for every letter) to a different glyph
+	formLookup := func(to int) testLookup {
+		return testLookup{kind: 1, subtable: otbuild.Table{2, coverageOf(1), 1, to}}
+	}
+	gsub := buildLayoutTable("arab", []string{"isol", "fina", "medi", "init"}, [][]int{{0}, {1}, {2}, {3}}, []testLookup{
+		formLookup(10), formLookup(11), formLookup(12), formLookup(13),
+	})
+
+	// beh beh (fatha) alef, space, dal beh, space, beh
+	text := "ببَا دب ب"
+	glyphs := make([]GlyphIndex, len([]rune(text)))
+	for i, r := range []rune(text) {
+		if joiningType(r) == joinDual || joiningType(r) == joinRight {
+			glyphs[i] = 1
+		}
+	}
+
+	ids, _ := shapeIDs(t, gsub, nil, text, glyphs, Options{})
+	// Alef and dal only join to the right, so the beh after dal starts a new form
+	want := []GlyphIndex{13, 12, 0, 11, 0, 10, 10, 0, 10}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("got glyphs %v, want %v", ids, want)
+	}
+}
+
+func TestDetectScript(t *testing.T) {
+	for text, want := range map[string]string{
+		"123 Hello":   "latn",
+		"(مرحبا)":     "arab",
+		"Привет":      "cyrl",
+		"中文":          "hani",
+		"1 + 2 = 3 ?": "DFLT",
+	} {
+		if got := DetectScript([]rune(text)); got != MakeTag(want) {
+			t.Errorf("DetectScript(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
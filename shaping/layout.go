@@ -0,0 +1,410 @@
+package shaping
+
+import "sort"
+
+// Common OpenType layout table formats, shared by GSUB and GPOS.
+
+// coverage is the set of glyphs a subtable applies to, each with a coverage index used to find its data in the
+// subtable. Format 1 lists glyphs in order; format 2 lists ranges of consecutive glyphs.
+type coverage struct {
+	glyphs []GlyphIndex
+	ranges []coverageRange
+}
+
+type coverageRange struct {
+	start, end GlyphIndex
+	startIndex int
+}
+
+func parseCoverage(r *reader) coverage {
+	var c coverage
+	switch format := r.U16(); format {
+	case 1:
+		c.glyphs = glyphs(r, int(r.U16()))
+	case 2:
+		c.ranges = make([]coverageRange, r.U16())
+		for i := range c.ranges {
+			c.ranges[i] = coverageRange{GlyphIndex(r.U16()), GlyphIndex(r.U16()), int(r.U16())}
+		}
+	default:
+		r.Fail()
+	}
+	return c
+}
+
+// index returns the coverage index of g, and whether g is covered at all.
+func (c coverage) index(g GlyphIndex) (int, bool) {
+	if c.glyphs != nil {
+		i := sort.Search(len(c.glyphs), func(i int) bool { return c.glyphs[i] >= g })
+		return i, i < len(c.glyphs) && c.glyphs[i] == g
+	}
+
+	i := sort.Search(len(c.ranges), func(i int) bool { return c.ranges[i].end >= g })
+	if i < len(c.ranges) && c.ranges[i].start <= g {
+		return c.ranges[i].startIndex + int(g-c.ranges[i].start), true
+	}
+	return 0, false
+}
+
+// classDef assigns glyphs to classes. Glyphs that aren't listed are in class 0.
+type classDef struct {
+	start   GlyphIndex
+	classes []uint16
+	ranges  []classRange
+}
+
+type classRange struct {
+	start, end GlyphIndex
+	class      uint16
+}
+
+func parseClassDef(r *reader) classDef {
+	var c classDef
+	switch format := r.U16(); format {
+	case 1:
+		c.start = GlyphIndex(r.U16())
+		c.classes = r.U16s(int(r.U16()))
+	case 2:
+		c.ranges = make([]classRange, r.U16())
+		for i := range c.ranges {
+			c.ranges[i] = classRange{GlyphIndex(r.U16()), GlyphIndex(r.U16()), r.U16()}
+		}
+	default:
+		r.Fail()
+	}
+	return c
+}
+
+func (c classDef) class(g GlyphIndex) uint16 {
+	if c.classes != nil {
+		if g >= c.start && int(g-c.start) < len(c.classes) {
+			return c.classes[g-c.start]
+		}
+		return 0
+	}
+
+	i := sort.Search(len(c.ranges), func(i int) bool { return c.ranges[i].end >= g })
+	if i < len(c.ranges) && c.ranges[i].start <= g {
+		return c.ranges[i].class
+	}
+	return 0
+}
+
+// langSys is the set of features for one language system of a script, as indices into the feature list.
+// requiredFeature is -1 if there is none.
+type langSys struct {
+	requiredFeature int
+	features        []uint16
+}
+
+type script struct {
+	defaultLangSys *langSys
+	langSys        map[Tag]*langSys
+}
+
+type feature struct {
+	tag     Tag
+	lookups []uint16
+}
+
+// Lookup flags
+const (
+	flagRightToLeft         = 0x0001
+	flagIgnoreBaseGlyphs    = 0x0002
+	flagIgnoreLigatures     = 0x0004
+	flagIgnoreMarks         = 0x0008
+	flagUseMarkFilteringSet = 0x0010
+	flagMarkAttachmentType  = 0xFF00
+)
+
+type lookup[S any] struct {
+	kind             uint16
+	flag             uint16
+	markFilteringSet uint16
+	subtables        []S
+}
+
+// layoutTable is the script, feature and lookup lists common to GSUB and GPOS. S is the table's subtable type.
+type layoutTable[S any] struct {
+	scripts  map[Tag]*script
+	features []feature
+	lookups  []lookup[S]
+}
+
+// parseLayoutTable decodes the header and lists of a GSUB or GPOS table. parseSubtable decodes a single subtable of the
+// given lookup type. Extension subtables, of type extensionKind, are resolved here, so parseSubtable never sees them.
+func parseLayoutTable[S any](b []byte, extensionKind uint16, parseSubtable func(kind uint16, r *reader) (S, error)) (*layoutTable[S], error) {
+	r := newReader(b)
+	major, _ := r.U16(), r.U16()
+	scriptListOffset, featureListOffset, lookupListOffset := r.U16(), r.U16(), r.U16()
+	if r.Err() != nil {
+		return nil, r.Err()
+	}
+	if major != 1 {
+		return nil, ErrInvalidTable
+	}
+
+	t := &layoutTable[S]{}
+
+	var err error
+	if t.scripts, err = parseScriptList(r.At(int(scriptListOffset))); err != nil {
+		return nil, err
+	}
+	if t.features, err = parseFeatureList(r.At(int(featureListOffset))); err != nil {
+		return nil, err
+	}
+
+	lr := r.At(int(lookupListOffset))
+	lookupOffsets := lr.U16s(int(lr.U16()))
+	t.lookups = make([]lookup[S], len(lookupOffsets))
+	for i, offset := range lookupOffsets {
+		l := lr.At(int(offset))
+		t.lookups[i].kind, t.lookups[i].flag = l.U16(), l.U16()
+		subtableOffsets := l.U16s(int(l.U16()))
+		if t.lookups[i].flag&flagUseMarkFilteringSet != 0 {
+			t.lookups[i].markFilteringSet = l.U16()
+		}
+		if l.Err() != nil {
+			return nil, l.Err()
+		}
+
+		for _, so := range subtableOffsets {
+			kind, sr := t.lookups[i].kind, l.At(int(so))
+			if kind == extensionKind {
+				// An extension subtable only holds the real lookup type and a 32-bit offset to the subtable
+				format := sr.U16()
+				kind = sr.U16()
+				sr = sr.At(int(sr.U32()))
+				if format != 1 {
+					return nil, ErrInvalidTable
+				}
+				t.lookups[i].kind = kind
+			}
+
+			st, err := parseSubtable(kind, sr)
+			if err != nil {
+				return nil, err
+			}
+			t.lookups[i].subtables = append(t.lookups[i].subtables, st)
+		}
+	}
+
+	return t, nil
+}
+
+func parseScriptList(r *reader) (map[Tag]*script, error) {
+	n := int(r.U16())
+	scripts := make(map[Tag]*script, n)
+	for i := 0; i < n; i++ {
+		tag, offset := Tag(r.U32()), r.U16()
+		sr := r.At(int(offset))
+
+		s := &script{langSys: make(map[Tag]*langSys)}
+		if defaultOffset := sr.U16(); defaultOffset != 0 {
+			s.defaultLangSys = parseLangSys(sr.At(int(defaultOffset)))
+		}
+		count := int(sr.U16())
+		for j := 0; j < count; j++ {
+			langTag, langOffset := Tag(sr.U32()), sr.U16()
+			s.langSys[langTag] = parseLangSys(sr.At(int(langOffset)))
+		}
+		if sr.Err() != nil {
+			return nil, sr.Err()
+		}
+		scripts[tag] = s
+	}
+	return scripts, r.Err()
+}
+
+func parseLangSys(r *reader) *langSys {
+	r.U16() // lookupOrderOffset, reserved
+	ls := &langSys{requiredFeature: int(r.U16())}
+	if ls.requiredFeature == 0xFFFF {
+		ls.requiredFeature = -1
+	}
+	ls.features = r.U16s(int(r.U16()))
+	return ls
+}
+
+func parseFeatureList(r *reader) ([]feature, error) {
+	features := make([]feature, r.U16())
+	for i := range features {
+		features[i].tag = Tag(r.U32())
+		fr := r.At(int(r.U16()))
+		fr.U16() // featureParamsOffset
+		features[i].lookups = fr.U16s(int(fr.U16()))
+		if fr.Err() != nil {
+			return nil, fr.Err()
+		}
+	}
+	return features, r.Err()
+}
+
+// findLangSys returns the language system for script and language, falling back to the script's default language
+// system, and then to the DFLT and latn scripts. It returns nil if the table has none of them.
+func (t *layoutTable[S]) findLangSys(scriptTag, language Tag) *langSys {
+	for _, tag := range []Tag{scriptTag, MakeTag("DFLT"), MakeTag("latn")} {
+		s := t.scripts[tag]
+		if s == nil {
+			continue
+		}
+		if ls := s.langSys[language]; ls != nil {
+			return ls
+		}
+		if s.defaultLangSys != nil {
+			return s.defaultLangSys
+		}
+	}
+	return nil
+}
+
+// featureLookups returns the indices, in lookup list order, of every lookup of the features that are enabled, or
+// required, in the language system for script and language. It also returns the features each lookup belongs to.
+func (t *layoutTable[S]) featureLookups(scriptTag, language Tag, enabled map[Tag]bool) ([]int, map[int][]Tag) {
+	ls := t.findLangSys(scriptTag, language)
+	if ls == nil {
+		return nil, nil
+	}
+
+	lookupFeatures := make(map[int][]Tag)
+	addFeature := func(index int, required bool) {
+		if index >= len(t.features) {
+			return
+		}
+		f := t.features[index]
+		if !required && !enabled[f.tag] {
+			return
+		}
+		for _, l := range f.lookups {
+			if int(l) < len(t.lookups) {
+				lookupFeatures[int(l)] = append(lookupFeatures[int(l)], f.tag)
+			}
+		}
+	}
+	if ls.requiredFeature >= 0 {
+		addFeature(ls.requiredFeature, true)
+	}
+	for _, index := range ls.features {
+		addFeature(int(index), false)
+	}
+
+	lookups := make([]int, 0, len(lookupFeatures))
+	for l := range lookupFeatures {
+		lookups = append(lookups, l)
+	}
+	sort.Ints(lookups)
+
+	return lookups, lookupFeatures
+}
+
+// lookupRecord applies a lookup at one position of a matched context sequence.
+type lookupRecord struct {
+	sequenceIndex, lookupIndex uint16
+}
+
+func parseLookupRecords(r *reader, n int) []lookupRecord {
+	records := make([]lookupRecord, n)
+	for i := range records {
+		records[i] = lookupRecord{r.U16(), r.U16()}
+	}
+	return records
+}
+
+// contextRule is one rule of a (chained) sequence context subtable. Glyph values are glyph IDs for format 1 subtables,
+// and classes for format 2. The input excludes its first glyph, which was already matched by coverage or class.
+type contextRule struct {
+	backtrack, input, lookahead []uint16
+	records                     []lookupRecord
+}
+
+// contextSubtable is a sequence context (GSUB type 5, GPOS type 7) or chained sequence context (GSUB type 6, GPOS type 8)
+// subtable. A sequence context is a chained one without backtrack or lookahead.
+type contextSubtable struct {
+	format uint16
+	cov    coverage
+
+	// Formats 1 and 2: rules for each coverage index (format 1) or class of the first glyph (format 2)
+	ruleSets [][]contextRule
+
+	// Format 2
+	backtrackClasses, inputClasses, lookaheadClasses classDef
+
+	// Format 3: one coverage for every glyph in each sequence, including the first input glyph
+	backtrackCov, inputCov, lookaheadCov []coverage
+	records                              []lookupRecord
+}
+
+func parseContext(r *reader, chained bool) (*contextSubtable, error) {
+	st := &contextSubtable{format: r.U16()}
+
+	parseRuleSets := func(offsets []uint16) {
+		st.ruleSets = make([][]contextRule, len(offsets))
+		for i, offset := range offsets {
+			if offset == 0 {
+				continue
+			}
+			sr := r.At(int(offset))
+			ruleOffsets := sr.U16s(int(sr.U16()))
+			for _, ro := range ruleOffsets {
+				rr := sr.At(int(ro))
+				var rule contextRule
+				if chained {
+					rule.backtrack = rr.U16s(int(rr.U16()))
+					rule.input = rr.U16s(int(rr.U16()) - 1)
+					rule.lookahead = rr.U16s(int(rr.U16()))
+					rule.records = parseLookupRecords(rr, int(rr.U16()))
+				} else {
+					inputCount, recordCount := int(rr.U16()), int(rr.U16())
+					rule.input = rr.U16s(inputCount - 1)
+					rule.records = parseLookupRecords(rr, recordCount)
+				}
+				if rr.Err() != nil {
+					r.Fail()
+				}
+				st.ruleSets[i] = append(st.ruleSets[i], rule)
+			}
+		}
+	}
+
+	parseCoverages := func(n int) []coverage {
+		covs := make([]coverage, n)
+		for i, offset := range r.U16s(n) {
+			covs[i] = parseCoverage(r.At(int(offset)))
+		}
+		return covs
+	}
+
+	switch st.format {
+	case 1:
+		st.cov = parseCoverage(r.At(int(r.U16())))
+		parseRuleSets(r.U16s(int(r.U16())))
+	case 2:
+		st.cov = parseCoverage(r.At(int(r.U16())))
+		if chained {
+			st.backtrackClasses = parseClassDef(r.At(int(r.U16())))
+		}
+		st.inputClasses = parseClassDef(r.At(int(r.U16())))
+		if chained {
+			st.lookaheadClasses = parseClassDef(r.At(int(r.U16())))
+		}
+		parseRuleSets(r.U16s(int(r.U16())))
+	case 3:
+		if chained {
+			st.backtrackCov = parseCoverages(int(r.U16()))
+			st.inputCov = parseCoverages(int(r.U16()))
+			st.lookaheadCov = parseCoverages(int(r.U16()))
+			st.records = parseLookupRecords(r, int(r.U16()))
+		} else {
+			inputCount, recordCount := int(r.U16()), int(r.U16())
+			st.inputCov = parseCoverages(inputCount)
+			st.records = parseLookupRecords(r, recordCount)
+		}
+		if len(st.inputCov) == 0 {
+			return nil, ErrInvalidTable
+		}
+	default:
+		return nil, ErrInvalidTable
+	}
+
+	return st, r.Err()
+}
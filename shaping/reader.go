@@ -0,0 +1,31 @@
+package shaping
+
+import (
+	"errors"
+
+	"github.com/bbredesen/ttf-renderer/internal/otread"
+)
+
+var ErrInvalidTable = errors.New("shaping: invalid layout table")
+
+// reader is a bounds-checked cursor over big-endian table data, shared with packages ttf, colr and woff. Its first
+// out-of-range read sets its error to ErrInvalidTable. Offsets within a layout table are relative to the start of the
+// enclosing table or subtable, which is the start of its data.
+type reader = otread.Reader
+
+func newReader(b []byte) *reader {
+	return otread.New(b, ErrInvalidTable)
+}
+
+// glyphs reads an array of n glyph IDs.
+func glyphs(r *reader, n int) []GlyphIndex {
+	v := r.U16s(n)
+	if v == nil {
+		return nil
+	}
+	g := make([]GlyphIndex, n)
+	for i := range v {
+		g[i] = GlyphIndex(v[i])
+	}
+	return g
+}
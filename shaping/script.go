@@ -0,0 +1,135 @@
+package shaping
+
+import "unicode"
+
+// scriptTags maps Unicode scripts to their OpenType script tags. Scripts that OpenType shapes with a newer engine, such
+// as Devanagari (dev2), use the older tag here, since only GSUB lookups are applied and not script-specific reordering.
+var scriptTags = []struct {
+	table *unicode.RangeTable
+	tag   string
+}{
+	{unicode.Latin, "latn"},
+	{unicode.Greek, "grek"},
+	{unicode.Cyrillic, "cyrl"},
+	{unicode.Arabic, "arab"},
+	{unicode.Hebrew, "hebr"},
+	{unicode.Syriac, "syrc"},
+	{unicode.Nko, "nko "},
+	{unicode.Mongolian, "mong"},
+	{unicode.Armenian, "armn"},
+	{unicode.Georgian, "geor"},
+	{unicode.Thai, "thai"},
+	{unicode.Devanagari, "deva"},
+	{unicode.Bengali, "beng"},
+	{unicode.Tamil, "taml"},
+	{unicode.Han, "hani"},
+	{unicode.Hiragana, "kana"},
+	{unicode.Katakana, "kana"},
+	{unicode.Hangul, "hang"},
+}
+
+// DetectScript returns the OpenType script tag of the first rune in text that belongs to a specific script, skipping
+// digits, punctuation and other runes common to many scripts. It returns DFLT if there is no such rune, or its script
+// isn't known.
+func DetectScript(text []rune) Tag {
+	for _, r := range text {
+		if unicode.In(r, unicode.Common, unicode.Inherited) {
+			continue
+		}
+		for _, s := range scriptTags {
+			if unicode.Is(s.table, r) {
+				return MakeTag(s.tag)
+			}
+		}
+		break
+	}
+	return MakeTag("DFLT")
+}
+
+// isJoiningScript reports whether script uses the joining form features.
+func isJoiningScript(script Tag) bool {
+	switch script {
+	case MakeTag("arab"), MakeTag("syrc"), MakeTag("nko "), MakeTag("mong"):
+		return true
+	}
+	return false
+}
+
+// Joining types, from ArabicShaping.txt
+const (
+	joinNone        = iota // U: doesn't join
+	joinRight              // R: joins to the preceding character only
+	joinDual               // D: joins on both sides
+	joinCausing            // C: causes joining on both sides, without changing form itself
+	joinTransparent        // T: skipped when deciding whether neighbors join
+)
+
+// joiningType returns the joining type of r. Only the Arabic and Syriac blocks are covered in detail; every other
+// mark is transparent, and everything else doesn't join.
+func joiningType(r rune) int {
+	switch {
+	case r == 0x200D || r == 0x0640 || r == 0x07FA: // ZWJ, tatweel, N'Ko lajanyalan
+		return joinCausing
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) && r != 0x200C:
+		return joinTransparent
+
+	case r == 0x0622 || r == 0x0623 || r == 0x0624 || r == 0x0625 || r == 0x0627 || r == 0x0629,
+		r >= 0x062F && r <= 0x0632, r == 0x0648, r >= 0x0671 && r <= 0x0673, r >= 0x0675 && r <= 0x0677,
+		r >= 0x0688 && r <= 0x0699, r == 0x06C0, r >= 0x06C3 && r <= 0x06CB, r == 0x06CD, r == 0x06CF,
+		r == 0x06D2 || r == 0x06D3 || r == 0x06D5 || r == 0x06EE || r == 0x06EF,
+		r == 0x0710, r >= 0x0715 && r <= 0x0719, r == 0x071E, r == 0x0728, r == 0x072A,
+		r == 0x072C, r == 0x072F, r == 0x074D:
+		return joinRight
+
+	case r == 0x0620 || r == 0x0626 || r == 0x0628, r >= 0x062A && r <= 0x062E, r >= 0x0633 && r <= 0x063F,
+		r >= 0x0641 && r <= 0x0647, r == 0x0649 || r == 0x064A || r == 0x066E || r == 0x066F,
+		r >= 0x0678 && r <= 0x0687, r >= 0x069A && r <= 0x06BF, r == 0x06C1 || r == 0x06C2 || r == 0x06CC,
+		r == 0x06CE || r == 0x06D0 || r == 0x06D1, r >= 0x06FA && r <= 0x06FC, r == 0x06FF,
+		r >= 0x0750 && r <= 0x077F, r >= 0x0712 && r <= 0x0714, r >= 0x071A && r <= 0x071D,
+		r >= 0x071F && r <= 0x0727, r == 0x0729 || r == 0x072B || r == 0x072D || r == 0x072E,
+		r >= 0x074E && r <= 0x074F, r >= 0x07CA && r <= 0x07EA:
+		return joinDual
+	}
+	return joinNone
+}
+
+// joiningForms returns the joining form feature for each rune of text: init, medi, fina or isol for runes that can
+// join, and zero for the rest. Transparent runes, such as vowel marks, are skipped over when deciding whether their
+// neighbors join.
+func joiningForms(text []rune) []Tag {
+	forms := make([]Tag, len(text))
+
+	// prev is the index of the last non-transparent rune, and whether it joins to the following rune
+	prev, prevJoinsNext := -1, false
+
+	for i, r := range text {
+		t := joiningType(r)
+		if t == joinTransparent {
+			continue
+		}
+
+		joinsPrev := prevJoinsNext && (t == joinRight || t == joinDual || t == joinCausing)
+		if joinsPrev && prev >= 0 {
+			// The previous rune turns out to join forward
+			switch forms[prev] {
+			case tagIsol:
+				forms[prev] = tagInit
+			case tagFina:
+				forms[prev] = tagMedi
+			}
+		}
+
+		switch t {
+		case joinRight, joinDual:
+			if joinsPrev {
+				forms[i] = tagFina
+			} else {
+				forms[i] = tagIsol
+			}
+		}
+
+		prev, prevJoinsNext = i, t == joinDual || t == joinCausing
+	}
+
+	return forms
+}
@@ -0,0 +1,230 @@
+// Package shaping maps a run of text to the sequence of glyphs a font draws for it, by applying the lookups in the
+// font's OpenType GSUB table: ligatures such as "fi", contextual alternates, and the joining forms of scripts such as
+// Arabic. It then places those glyphs with the lookups in the GPOS table: kerning, mark attachment and cursive
+// connection. Like package ttf, it reads tables directly and has no dependency on the renderer.
+package shaping
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/image/font/sfnt"
+)
+
+// GlyphIndex is a glyph ID, the same as sfnt's.
+type GlyphIndex = sfnt.GlyphIndex
+
+// Glyph is a glyph in the output of Shape.
+type Glyph struct {
+	ID GlyphIndex
+
+	// Cluster is the index, in the shaped text, of the first rune this glyph was produced from. A ligature is in the
+	// cluster of its first component, and every glyph of a multiple substitution is in the cluster of the glyph it
+	// replaced, so clusters never decrease along the output.
+	Cluster int
+
+	// form is the joining form feature (init, medi, fina or isol) that applies to this glyph, if any
+	form Tag
+}
+
+// Options selects the script, language system and features to shape with.
+type Options struct {
+	// Script is an OpenType script tag, such as "latn" or "arab". If zero, it is detected from the text with
+	// DetectScript.
+	Script Tag
+
+	// Language is an OpenType language system tag, such as "TRK ". If zero, or not in the font, the script's default
+	// language system is used.
+	Language Tag
+
+	// Features turns features on or off, on top of the defaults. See ParseFeatures.
+	Features map[Tag]bool
+
+	// RightToLeft is set for runs that are drawn right to left. Glyphs are always shaped in logical order; Position
+	// then places them on the assumption that they are drawn in reverse.
+	RightToLeft bool
+}
+
+// defaultFeatures are applied to every script, unless turned off in Options.
+var defaultFeatures = []string{"ccmp", "locl", "rlig", "rclt", "calt", "liga", "clig"}
+
+// Joining form features, applied to the glyphs of joining scripts according to their position in a word
+var (
+	tagIsol = MakeTag("isol")
+	tagFina = MakeTag("fina")
+	tagMedi = MakeTag("medi")
+	tagInit = MakeTag("init")
+)
+
+// Shaper holds the layout tables of a font.
+type Shaper struct {
+	gsub *layoutTable[gsubSubtable]
+	gpos *layoutTable[gposSubtable]
+	gdef *gdef
+}
+
+// New parses the GSUB, GPOS and GDEF tables, any of which may be missing, from the raw tables of a font (see
+// ttf.ReadTables). A font without a GSUB table shapes every rune to its own glyph.
+func New(tables map[string][]byte) (*Shaper, error) {
+	s := &Shaper{}
+
+	if b := tables["GDEF"]; b != nil {
+		g, err := parseGDEF(b)
+		if err != nil {
+			return nil, fmt.Errorf("GDEF: %w", err)
+		}
+		s.gdef = g
+	}
+
+	if b := tables["GSUB"]; b != nil {
+		t, err := parseLayoutTable(b, gsubExtension, parseGSUBSubtable)
+		if err != nil {
+			return nil, fmt.Errorf("GSUB: %w", err)
+		}
+		s.gsub = t
+	}
+
+	if b := tables["GPOS"]; b != nil {
+		t, err := parseLayoutTable(b, gposExtension, parseGPOSSubtable)
+		if err != nil {
+			return nil, fmt.Errorf("GPOS: %w", err)
+		}
+		s.gpos = t
+	}
+
+	return s, nil
+}
+
+// HasPositioning reports whether the font has a GPOS table. Without one, Position only returns the advances it was
+// given, and callers may want to fall back to the legacy kern table.
+func (s *Shaper) HasPositioning() bool {
+	return s.gpos != nil
+}
+
+// Shape substitutes glyphs for text, where glyphs holds the glyph the font's cmap maps each rune of text to. The text
+// should be a single run of one script; line breaks end any context that lookups could match across.
+func (s *Shaper) Shape(text []rune, glyphs []GlyphIndex, opts Options) []Glyph {
+	buf := make([]Glyph, len(glyphs))
+	for i, id := range glyphs {
+		buf[i] = Glyph{ID: id, Cluster: i}
+	}
+
+	if s.gsub == nil {
+		return buf
+	}
+
+	scriptTag := opts.script(text)
+
+	enabled := make(map[Tag]bool)
+	for _, f := range defaultFeatures {
+		enabled[MakeTag(f)] = true
+	}
+	if isJoiningScript(scriptTag) {
+		for i, form := range joiningForms(text) {
+			buf[i].form = form
+		}
+		for _, t := range []Tag{tagIsol, tagFina, tagMedi, tagInit} {
+			enabled[t] = true
+		}
+	}
+	for t, on := range opts.Features {
+		enabled[t] = on
+	}
+
+	lookups, lookupFeatures := s.gsub.featureLookups(scriptTag, opts.Language, enabled)
+
+	sub := &substituter{
+		lookupContext: lookupContext{gdef: s.gdef, glyphs: buf},
+		lookups:       s.gsub.lookups,
+	}
+	for _, l := range lookups {
+		features := lookupFeatures[l]
+		sub.applyLookup(l, func(g *Glyph) bool {
+			for _, t := range features {
+				if !isFormFeature(t) || t == g.form {
+					return true
+				}
+			}
+			return false
+		})
+	}
+
+	return sub.glyphs
+}
+
+// Position places the glyphs returned by Shape, where advances holds the horizontal advance of each glyph from the
+// font's hmtx table, in font units. Text and opts should be the same as those passed to Shape.
+func (s *Shaper) Position(text []rune, glyphs []Glyph, advances []int32, opts Options) []Position {
+	positions := make([]Position, len(glyphs))
+	for i := range positions {
+		if i < len(advances) {
+			positions[i].XAdvance = advances[i]
+		}
+	}
+
+	if s.gpos == nil {
+		return positions
+	}
+
+	enabled := make(map[Tag]bool)
+	for _, f := range defaultPositionFeatures {
+		enabled[MakeTag(f)] = true
+	}
+	for t, on := range opts.Features {
+		enabled[t] = on
+	}
+
+	lookups, _ := s.gpos.featureLookups(opts.script(text), opts.Language, enabled)
+
+	pos := &positioner{
+		lookupContext: lookupContext{gdef: s.gdef, glyphs: glyphs},
+		lookups:       s.gpos.lookups,
+		rightToLeft:   opts.RightToLeft,
+		positions:     positions,
+		attachments:   make([]attachment, len(glyphs)),
+	}
+	for _, l := range lookups {
+		pos.applyLookup(l)
+	}
+	pos.resolve()
+
+	return pos.positions
+}
+
+// script returns the script to shape text with: the one in opts, or else the one detected from the text.
+func (opts Options) script(text []rune) Tag {
+	if opts.Script != 0 {
+		return opts.Script
+	}
+	return DetectScript(text)
+}
+
+func isFormFeature(t Tag) bool {
+	return t == tagIsol || t == tagFina || t == tagMedi || t == tagInit
+}
+
+// ParseFeatures parses a comma separated list of feature tags, such as "smcp,-liga". A tag on its own, or prefixed with
+// '+', turns the feature on; prefixed with '-', it turns the feature off.
+func ParseFeatures(s string) (map[Tag]bool, error) {
+	features := make(map[Tag]bool)
+	for _, f := range strings.Split(s, ",") {
+		f = strings.TrimSpace(f)
+		if f == "" {
+			continue
+		}
+
+		on := true
+		switch f[0] {
+		case '-':
+			on = false
+			f = f[1:]
+		case '+':
+			f = f[1:]
+		}
+		if len(f) == 0 || len(f) > 4 {
+			return nil, fmt.Errorf("shaping: invalid feature tag %q", f)
+		}
+		features[MakeTag(f)] = on
+	}
+	return features, nil
+}
@@ -0,0 +1,21 @@
+package shaping
+
+// Tag is an OpenType tag, such as a script, language system or feature tag, packed into 32 bits.
+type Tag uint32
+
+// MakeTag returns the tag for s, padded with spaces to four characters. Characters beyond the fourth are ignored.
+func MakeTag(s string) Tag {
+	var t Tag
+	for i := 0; i < 4; i++ {
+		c := byte(' ')
+		if i < len(s) {
+			c = s[i]
+		}
+		t = t<<8 | Tag(c)
+	}
+	return t
+}
+
+func (t Tag) String() string {
+	return string([]byte{byte(t >> 24), byte(t >> 16), byte(t >> 8), byte(t)})
+}
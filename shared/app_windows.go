@@ -1,8 +1,11 @@
+//go:build windows
+
 package shared
 
 import (
+	"fmt"
 	"runtime"
-	"time"
+	"unicode/utf16"
 	"unsafe"
 
 	"github.com/bbredesen/go-vk"
@@ -10,10 +13,14 @@ import (
 	"golang.org/x/sys/windows"
 )
 
+// NewWindow returns the Win32 implementation of Window.
+func NewWindow(c chan WindowMessage) Window {
+	return NewWin32App(c)
+}
+
 // To use this application template, get a new instance from this function, call
-// app.Initialize("My Window Title") to create a window and initialize Vulkan,
-// and then call app.MainLoop(). The app will gracefully shut down when the
-// window is closed.
+// app.Initialize("My Window Title", width, height) to create a window, and then
+// call DefaultMainLoop(app, ...). The loop will return when the window is closed.
 func NewWin32App(c chan WindowMessage) *Win32App {
 	globalChannel = c
 	return &Win32App{
@@ -31,8 +38,6 @@ type Win32App struct {
 	HInstance win32.HInstance
 	HWnd      win32.HWnd
 
-	lastFrameTime time.Time
-
 	ClassName     string
 	Width, Height uint32
 }
@@ -41,6 +46,24 @@ func (app *Win32App) GetRequiredInstanceExtensions() []string {
 	return []string{vk.KHR_SURFACE_EXTENSION_NAME, vk.KHR_WIN32_SURFACE_EXTENSION_NAME}
 }
 
+func (app *Win32App) CreateSurface(instance vk.Instance) vk.SurfaceKHR {
+	ci := vk.Win32SurfaceCreateInfoKHR{
+		Hinstance: windows.Handle(app.HInstance),
+		Hwnd:      windows.HWND(app.HWnd),
+	}
+
+	r, surface := vk.CreateWin32SurfaceKHR(instance, &ci, nil)
+	if r != vk.SUCCESS {
+		fmt.Printf("Could not create surface!\n")
+		panic(r)
+	}
+	return surface
+}
+
+func (app *Win32App) Messages() <-chan WindowMessage { return app.winMsgs }
+
+func (app *Win32App) Extent() vk.Extent2D { return GetWindowExtent(app.HWnd) }
+
 func (app *Win32App) createAndLoop(quitChan chan int) {
 	// The OS thread that creates the window also has to run the message loop. You
 	// can't createWindow and then go messageLoop, or the window simply freezes once the Go runtime attempts to run this
@@ -52,9 +75,10 @@ func (app *Win32App) createAndLoop(quitChan chan int) {
 	quitChan <- messageLoop(app.HWnd)
 }
 
-func (app *Win32App) Initialize(windowTitle string) {
+func (app *Win32App) Initialize(windowTitle string, width, height uint32) {
 	quitChan := make(chan int)
 	app.windowTitle = windowTitle
+	app.Width, app.Height = width, height
 
 	go app.createAndLoop(quitChan)
 
@@ -64,7 +88,7 @@ func (app *Win32App) Initialize(windowTitle string) {
 	if createMessage.Text != "CREATE" {
 		panic("Did not get CREATE as first message: " + createMessage.Text)
 	}
-	app.HWnd = createMessage.HWnd
+	app.HWnd = win32.HWnd(createMessage.Handle)
 
 	app.isInitialized = true
 
@@ -99,6 +123,9 @@ func (app *Win32App) createWindow() {
 		app.Height = 1024
 	}
 
+	if app.ClassName == "" {
+		app.ClassName = app.windowTitle
+	}
 	className := app.ClassName //"ttf-renderer"
 
 	var err win32.Win32Error
@@ -126,6 +153,12 @@ func (app *Win32App) createWindow() {
 		panic(err)
 	}
 
+	// Width and height are of the client area, but CreateWindowExW takes the size of the whole window, frame and all
+	frame := win32.Rect{Right: int32(app.Width), Bottom: int32(app.Height)}
+	if err := adjustWindowRect(&frame, win32.WS_OVERLAPPEDWINDOW, 0); err != nil {
+		panic(err)
+	}
+
 	app.HWnd, err = win32.CreateWindowExW(
 		0,
 		className,
@@ -133,8 +166,8 @@ func (app *Win32App) createWindow() {
 		(win32.WS_VISIBLE | win32.WS_OVERLAPPEDWINDOW),
 		win32.SW_USE_DEFAULT,
 		win32.SW_USE_DEFAULT,
-		app.Width,
-		app.Height,
+		uint32(frame.Right-frame.Left),
+		uint32(frame.Bottom-frame.Top),
 		0,
 		0,
 		app.HInstance,
@@ -147,6 +180,29 @@ func (app *Win32App) createWindow() {
 
 }
 
+// highSurrogate is the first half of a surrogate pair from WM_CHAR, held until the second half arrives
+var highSurrogate rune
+
+// combineSurrogates returns the character completed by the UTF-16 code unit u from WM_CHAR. Characters outside the
+// Basic Multilingual Plane, such as most emoji, arrive as a high surrogate followed by a low one. ok is false for a high
+// surrogate, whose character isn't complete yet, and for a surrogate that isn't part of a pair, which is dropped.
+func combineSurrogates(u rune) (c rune, ok bool) {
+	high := highSurrogate
+	highSurrogate = 0
+
+	switch {
+	case utf16.IsSurrogate(u) && u < 0xDC00:
+		highSurrogate = u
+		return 0, false
+	case utf16.IsSurrogate(u):
+		if high == 0 {
+			return 0, false
+		}
+		return utf16.DecodeRune(high, u), true
+	}
+	return u, true
+}
+
 func wndProc(hwnd win32.HWnd, msg win32.Msg, wParam, lParam uintptr) uintptr {
 	// switch msg {
 	// case win32.WM_CREATE:
@@ -179,57 +235,62 @@ func wndProc(hwnd win32.HWnd, msg win32.Msg, wParam, lParam uintptr) uintptr {
 	case win32.WM_CREATE:
 		// fmt.Printf("Message: CREATE\n")
 		globalChannel <- WindowMessage{
-			Text: "CREATE",
-			HWnd: hwnd,
+			Text:   "CREATE",
+			Handle: uintptr(hwnd),
 		}
 
 	case win32.WM_PAINT:
 		// fmt.Println("WM_PAINT")
 		globalChannel <- WindowMessage{
-			Text: "PAINT",
-			HWnd: hwnd,
+			Text:   "PAINT",
+			Handle: uintptr(hwnd),
 		}
 		win32.ValidateRect(hwnd, nil)
 
 	case win32.WM_CHAR:
-		globalChannel <- WindowMessage{
-			Text:      "CHAR",
-			HWnd:      hwnd,
-			Character: rune(wParam),
+		if c, ok := combineSurrogates(rune(wParam)); ok {
+			globalChannel <- WindowMessage{
+				Text:      "CHAR",
+				Handle:    uintptr(hwnd),
+				Character: c,
+			}
 		}
 	case win32.WM_KEYDOWN:
 		globalChannel <- WindowMessage{
 			Text:     "KEYDOWN",
-			HWnd:     hwnd,
+			Handle:   uintptr(hwnd),
 			KeyCode:  byte(wParam),
+			Key:      virtualKeys[byte(wParam)],
 			IsRepeat: lParam&(1<<30) != 0,
 		}
 	case win32.WM_KEYUP:
 		globalChannel <- WindowMessage{
 			Text:    "KEYUP",
-			HWnd:    hwnd,
+			Handle:  uintptr(hwnd),
 			KeyCode: byte(wParam),
 		}
 
 	case win32.WM_SIZE:
 		// fmt.Printf("WM_SIZE: %d x %d\n", lParam&0xFFFF, lParam>>16)
 		globalChannel <- WindowMessage{
-			Text: "SIZE",
-			HWnd: hwnd,
+			Text:   "SIZE",
+			Handle: uintptr(hwnd),
+			Width:  uint32(lParam & 0xFFFF),
+			Height: uint32((lParam >> 16) & 0xFFFF),
 		}
 		// win32.ValidateRect(hwnd, nil)
 	case win32.WM_ENTERSIZEMOVE:
 		// fmt.Printf("WM_ENTERSIZEMOVE\n")
 		globalChannel <- WindowMessage{
-			Text: "ENTERSIZEMOVE",
-			HWnd: hwnd,
+			Text:   "ENTERSIZEMOVE",
+			Handle: uintptr(hwnd),
 		}
 
 	case win32.WM_EXITSIZEMOVE:
 		// fmt.Printf("WM_EXITSIZEMOVE\n")
 		globalChannel <- WindowMessage{
-			Text: "EXITSIZEMOVE",
-			HWnd: hwnd,
+			Text:   "EXITSIZEMOVE",
+			Handle: uintptr(hwnd),
 		}
 
 	// case win32.WM_SIZING:
@@ -243,15 +304,15 @@ func wndProc(hwnd win32.HWnd, msg win32.Msg, wParam, lParam uintptr) uintptr {
 
 	case win32.WM_CLOSE:
 		globalChannel <- WindowMessage{
-			Text: "CLOSE",
-			HWnd: hwnd,
+			Text:   "CLOSE",
+			Handle: uintptr(hwnd),
 		}
 		win32.DestroyWindow(hwnd)
 
 	case win32.WM_DESTROY:
 		globalChannel <- WindowMessage{
-			Text: "DESTROY",
-			HWnd: hwnd,
+			Text:   "DESTROY",
+			Handle: uintptr(hwnd),
 		}
 		win32.PostQuitMessage(0)
 
@@ -262,69 +323,13 @@ func wndProc(hwnd win32.HWnd, msg win32.Msg, wParam, lParam uintptr) uintptr {
 	return 0
 }
 
-type ProcessInputFunc func(keys map[byte]bool, deltaT time.Duration)
-type TickFunc func(deltaT time.Duration)
-type DrawFunc func()
-
-func (app *Win32App) DefaultMainLoop(fnInput ProcessInputFunc, fnTick TickFunc, fnDraw DrawFunc) {
-
-	// Read any system messages...input, resize, window close, etc.
-	for {
-	innerLoop:
-		for {
-			select {
-			case msg := <-app.winMsgs:
-				// fmt.Println(msg.Text)
-				switch msg.Text {
-				case "KEYDOWN":
-					setAutoRepeat(msg.KeyCode)
-					// Some kind of non-repeat option for a keycode would be useful...handle a single keypress, possibly
-					// let the OS handle the input delay and watch msg.IsRepeat for certain keys
-				case "KEYUP":
-					clearAutoRepeat(msg.KeyCode)
-				case "DESTROY":
-					// Break out of the loop
-					return
-
-				}
-			default:
-				// Pull everything off the queue, then continue the outer loop
-				break innerLoop // "break" will break out of the select statement, not the loop, so we have to use a break label
-			}
-
-		}
-
-		deltaT := time.Since(app.lastFrameTime)
-		zeroTime := time.Time{}
-		if app.lastFrameTime == zeroTime {
-			deltaT = 0
-		}
-
-		app.lastFrameTime = time.Now()
-
-		fnInput(keyAutoRepeat(), deltaT)
-		fnTick(deltaT)
-		fnDraw()
-	}
-}
-
-func DefaultIgnoreInput(map[byte]bool, time.Duration) {}
-func DefaultIgnoreTick(time.Duration)                 {}
-func DefaultIgnoreDraw()                              {}
-
-var autoRepeater map[byte]bool
-
-func setAutoRepeat(keyCode byte) {
-	autoRepeater[keyCode] = true
-}
-func clearAutoRepeat(keyCode byte) {
-	delete(autoRepeater, keyCode)
-}
-
-func keyAutoRepeat() map[byte]bool {
-	if autoRepeater == nil {
-		autoRepeater = make(map[byte]bool)
-	}
-
-	return autoRepeater
+// virtualKeys maps the Win32 virtual-key codes of the named keys to their platform-neutral Key.
+var virtualKeys = map[byte]Key{
+	0x25: KeyLeft,   // VK_LEFT
+	0x27: KeyRight,  // VK_RIGHT
+	0x26: KeyUp,     // VK_UP
+	0x28: KeyDown,   // VK_DOWN
+	0x24: KeyHome,   // VK_HOME
+	0x23: KeyEnd,    // VK_END
+	0x2E: KeyDelete, // VK_DELETE
 }
@@ -0,0 +1,36 @@
+package shared
+
+// Key identifies a non-character key, independent of the platform's key codes. Keys that produce text are reported
+// through CHAR messages instead.
+type Key int
+
+const (
+	KeyNone Key = iota
+	KeyLeft
+	KeyRight
+	KeyUp
+	KeyDown
+	KeyHome
+	KeyEnd
+	KeyDelete
+)
+
+func (k Key) String() string {
+	switch k {
+	case KeyLeft:
+		return "Left"
+	case KeyRight:
+		return "Right"
+	case KeyUp:
+		return "Up"
+	case KeyDown:
+		return "Down"
+	case KeyHome:
+		return "Home"
+	case KeyEnd:
+		return "End"
+	case KeyDelete:
+		return "Delete"
+	}
+	return "None"
+}
@@ -0,0 +1,62 @@
+//go:build linux
+
+package shared
+
+import "unicode"
+
+// keysymKeys maps the keysyms of the named keys to their platform-neutral Key. Keypad variants are included for when
+// num lock is off.
+var keysymKeys = map[uint32]Key{
+	0xff51: KeyLeft,   // XK_Left
+	0xff53: KeyRight,  // XK_Right
+	0xff52: KeyUp,     // XK_Up
+	0xff54: KeyDown,   // XK_Down
+	0xff50: KeyHome,   // XK_Home
+	0xff57: KeyEnd,    // XK_End
+	0xffff: KeyDelete, // XK_Delete
+
+	0xff96: KeyLeft,   // XK_KP_Left
+	0xff98: KeyRight,  // XK_KP_Right
+	0xff97: KeyUp,     // XK_KP_Up
+	0xff99: KeyDown,   // XK_KP_Down
+	0xff95: KeyHome,   // XK_KP_Home
+	0xff9c: KeyEnd,    // XK_KP_End
+	0xff9f: KeyDelete, // XK_KP_Delete
+}
+
+// keysymRune returns the character typed by a key with keysym sym, matching what WM_CHAR would send on Windows.
+// Backspace, Tab and Enter produce control characters; other function keys produce nothing.
+func keysymRune(sym uint32) (rune, bool) {
+	switch {
+	case sym >= 0x20 && sym <= 0x7e, sym >= 0xa0 && sym <= 0xff:
+		// Latin-1 keysyms are the same as their code points
+		return rune(sym), true
+	case sym&0xff000000 == 0x01000000:
+		// Directly encoded Unicode keysyms
+		return rune(sym & 0x00ffffff), true
+	}
+
+	switch sym {
+	case 0xff08: // XK_BackSpace
+		return '\b', true
+	case 0xff09: // XK_Tab
+		return '\t', true
+	case 0xff0d, 0xff8d: // XK_Return, XK_KP_Enter
+		return '\r', true
+	}
+	return 0, false
+}
+
+// upperKeysym returns the upper case keysym for a lower case letter, and any other keysym unchanged.
+func upperKeysym(sym uint32) uint32 {
+	r, ok := keysymRune(sym)
+	if !ok || !unicode.IsLower(r) {
+		return sym
+	}
+
+	upper := unicode.ToUpper(r)
+	if upper <= 0xff {
+		return uint32(upper)
+	}
+	return 0x01000000 | uint32(upper)
+}
@@ -0,0 +1,110 @@
+package shared
+
+import (
+	"time"
+)
+
+type ProcessInputFunc func(keys map[byte]bool, deltaT time.Duration)
+type TickFunc func(deltaT time.Duration)
+
+// TextInputFunc is called for every typed character, with key set to KeyNone, and for every press (including
+// auto-repeats) of one of the named keys, with character set to zero. Calls are made in the order the input arrived,
+// before the frame's ProcessInputFunc.
+type TextInputFunc func(character rune, key Key)
+
+type DrawFunc func()
+
+// ResizeFunc is called with the new client area size after the window is resized. Either dimension may be zero if the
+// window has been minimized.
+type ResizeFunc func(width, height uint32)
+
+// DefaultMainLoop reads messages from the window and calls each of the provided functions once per frame, until the
+// window is destroyed. fnText is called as text input messages are read.
+func DefaultMainLoop(w Window, fnInput ProcessInputFunc, fnText TextInputFunc, fnTick TickFunc, fnDraw DrawFunc, fnResize ResizeFunc) {
+	// While the user is dragging the window border, Windows sends a stream of SIZE messages. Rebuilding the swapchain
+	// for each one is wasteful, so resizes are deferred until EXITSIZEMOVE. SIZE messages outside of a drag (maximize,
+	// restore, etc.) are handled immediately. X11 has no equivalent of ENTERSIZEMOVE, so every SIZE is handled.
+	inSizeMove, resizePending := false, false
+	var width, height uint32
+	var lastFrameTime time.Time
+
+	// Read any system messages...input, resize, window close, etc.
+	for {
+	innerLoop:
+		for {
+			select {
+			case msg := <-w.Messages():
+				// fmt.Println(msg.Text)
+				switch msg.Text {
+				case "KEYDOWN":
+					setAutoRepeat(msg.KeyCode)
+					if msg.Key != KeyNone {
+						fnText(0, msg.Key)
+					}
+					// Some kind of non-repeat option for a keycode would be useful...handle a single keypress, possibly
+					// let the OS handle the input delay and watch msg.IsRepeat for certain keys
+				case "CHAR":
+					fnText(msg.Character, KeyNone)
+				case "KEYUP":
+					clearAutoRepeat(msg.KeyCode)
+				case "SIZE":
+					width, height = msg.Width, msg.Height
+					resizePending = resizePending || !inSizeMove
+				case "ENTERSIZEMOVE":
+					inSizeMove = true
+				case "EXITSIZEMOVE":
+					inSizeMove = false
+					resizePending = true
+				case "DESTROY":
+					// Break out of the loop
+					return
+
+				}
+			default:
+				// Pull everything off the queue, then continue the outer loop
+				break innerLoop // "break" will break out of the select statement, not the loop, so we have to use a break label
+			}
+
+		}
+
+		deltaT := time.Since(lastFrameTime)
+		zeroTime := time.Time{}
+		if lastFrameTime == zeroTime {
+			deltaT = 0
+		}
+
+		lastFrameTime = time.Now()
+
+		if resizePending {
+			fnResize(width, height)
+			resizePending = false
+		}
+
+		fnInput(keyAutoRepeat(), deltaT)
+		fnTick(deltaT)
+		fnDraw()
+	}
+}
+
+func DefaultIgnoreInput(map[byte]bool, time.Duration) {}
+func DefaultIgnoreText(rune, Key)                     {}
+func DefaultIgnoreTick(time.Duration)                 {}
+func DefaultIgnoreDraw()                              {}
+func DefaultIgnoreResize(uint32, uint32)              {}
+
+var autoRepeater map[byte]bool
+
+func setAutoRepeat(keyCode byte) {
+	autoRepeater[keyCode] = true
+}
+func clearAutoRepeat(keyCode byte) {
+	delete(autoRepeater, keyCode)
+}
+
+func keyAutoRepeat() map[byte]bool {
+	if autoRepeater == nil {
+		autoRepeater = make(map[byte]bool)
+	}
+
+	return autoRepeater
+}
@@ -0,0 +1,54 @@
+//go:build windows
+
+package shared
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/bbredesen/go-vk"
+
+	"github.com/bbredesen/win32-toolkit"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	globalChannel chan<- WindowMessage
+	hInstance     win32.HInstance
+	hWnd          win32.HWnd
+)
+
+func GetWindowExtent(hWnd win32.HWnd) vk.Extent2D {
+	var rval vk.Extent2D
+	var rect win32.Rect
+
+	result := win32.GetClientRect(hWnd, &rect)
+
+	if result != 0 {
+		panic(result)
+	}
+	rval.Width = uint32(rect.Right - rect.Left)
+	rval.Height = uint32(rect.Bottom - rect.Top)
+	// fmt.Printf("Client size: %d t x %d w\n", rval.Height, rval.Width)
+	return rval
+
+}
+
+// win32-toolkit doesn't wrap AdjustWindowRectEx, so it is loaded here
+var procAdjustWindowRectEx = windows.NewLazySystemDLL("user32.dll").NewProc("AdjustWindowRectEx")
+
+// adjustWindowRect grows rect from a client area to the window that encloses it, with the given window styles and no
+// menu.
+func adjustWindowRect(rect *win32.Rect, style, exStyle uint32) error {
+	if r, _, err := procAdjustWindowRectEx.Call(uintptr(unsafe.Pointer(rect)), uintptr(style), 0, uintptr(exStyle)); r == 0 {
+		return err
+	}
+	return nil
+}
+
+func PrettyWin32Msg(msg win32.MSG) string {
+	return fmt.Sprintf("{ message: %s, wParam: %.8x, lParam: %.8x, pt: %v }",
+		win32.Msg(msg.Message).String(),
+		msg.WParam, msg.LParam, msg.Pt,
+	)
+}
@@ -1,50 +1,43 @@
 package shared
 
 import (
-	"fmt"
-
 	"github.com/bbredesen/go-vk"
-
-	"github.com/bbredesen/win32-toolkit"
 )
 
-var (
-	globalChannel chan<- WindowMessage
-	hInstance     win32.HInstance
-	hWnd          win32.HWnd
-)
+// Window is a native window that Vulkan can present to. Each platform provides one implementation, selected by build
+// tags: Win32App on Windows and X11Window on Linux. Use NewWindow to get the implementation for the current platform.
+type Window interface {
+	// Initialize creates and shows the window, with a client area of width x height pixels. It returns once the window
+	// exists, so that a surface can be created for it.
+	Initialize(title string, width, height uint32)
 
-type WindowMessage struct {
-	Text string
-	HWnd win32.HWnd
+	// GetRequiredInstanceExtensions returns the Vulkan instance extensions needed by CreateSurface.
+	GetRequiredInstanceExtensions() []string
 
-	Wparam, Lparam uint // Specifically defined as 64 bits by Win32 on 64-bit systems.
+	// CreateSurface creates a Vulkan surface for the window. The instance must have been created with the extensions
+	// from GetRequiredInstanceExtensions.
+	CreateSurface(instance vk.Instance) vk.SurfaceKHR
 
-	Character rune
-	KeyCode   byte
-	IsRepeat  bool
-	// todo
-}
+	// Messages returns the channel that window events are sent to. The same channel is passed to NewWindow.
+	Messages() <-chan WindowMessage
 
-func GetWindowExtent(hWnd win32.HWnd) vk.Extent2D {
-	var rval vk.Extent2D
-	var rect win32.Rect
+	// Extent returns the current size of the window's client area.
+	Extent() vk.Extent2D
+}
 
-	result := win32.GetClientRect(hWnd, &rect)
+// WindowMessage is a platform-neutral window event. Text identifies the kind of event, named after the equivalent Win32
+// message: CREATE, PAINT, CHAR, KEYDOWN, KEYUP, SIZE, ENTERSIZEMOVE, EXITSIZEMOVE, CLOSE and DESTROY.
+type WindowMessage struct {
+	Text   string
+	Handle uintptr // Native window handle; an HWND on Windows, or an xcb_window_t on X11
 
-	if result != 0 {
-		panic(result)
-	}
-	rval.Width = uint32(rect.Right - rect.Left)
-	rval.Height = uint32(rect.Bottom - rect.Top)
-	// fmt.Printf("Client size: %d t x %d w\n", rval.Height, rval.Width)
-	return rval
+	Wparam, Lparam uint // Specifically defined as 64 bits by Win32 on 64-bit systems.
 
-}
+	Character rune // Typed character, for CHAR messages. Backspace is '\b' and Enter is '\r', as with WM_CHAR.
+	KeyCode   byte // Platform key code; a virtual-key code on Windows, or an X keycode on X11
+	Key       Key  // Platform-neutral key, for KEYDOWN messages; KeyNone unless it is one of the named keys
+	IsRepeat  bool
 
-func PrettyWin32Msg(msg win32.MSG) string {
-	return fmt.Sprintf("{ message: %s, wParam: %.8x, lParam: %.8x, pt: %v }",
-		win32.Msg(msg.Message).String(),
-		msg.WParam, msg.LParam, msg.Pt,
-	)
+	Width, Height uint32 // New client area size, for SIZE messages
+	// todo
 }
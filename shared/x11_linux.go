@@ -0,0 +1,275 @@
+//go:build linux
+
+package shared
+
+/*
+#cgo LDFLAGS: -lxcb
+#include <stdint.h>
+#include <stdlib.h>
+#include <string.h>
+#include <xcb/xcb.h>
+
+// Mirrors VkXcbSurfaceCreateInfoKHR from vulkan_xcb.h. go-vk does not include the xcb platform types, and declaring the
+// struct here means the Vulkan headers aren't needed to build.
+typedef struct {
+	int32_t           sType;
+	const void       *pNext;
+	uint32_t          flags;
+	xcb_connection_t *connection;
+	xcb_window_t      window;
+} xcbSurfaceCreateInfo;
+
+typedef int32_t (*createXcbSurfaceFunc)(void *instance, const xcbSurfaceCreateInfo *createInfo, const void *allocator, uint64_t *surface);
+
+static int32_t createXcbSurface(void *fn, uintptr_t instance, xcb_connection_t *connection, xcb_window_t window, uint64_t *surface) {
+	xcbSurfaceCreateInfo ci;
+	memset(&ci, 0, sizeof(ci));
+	ci.sType = 1000005000; // VK_STRUCTURE_TYPE_XCB_SURFACE_CREATE_INFO_KHR
+	ci.connection = connection;
+	ci.window = window;
+
+	return ((createXcbSurfaceFunc)fn)((void *)instance, &ci, NULL, surface);
+}
+
+static xcb_screen_t *screenOfDisplay(xcb_connection_t *connection, int screen) {
+	xcb_screen_iterator_t it = xcb_setup_roots_iterator(xcb_get_setup(connection));
+	for (; it.rem; --screen, xcb_screen_next(&it)) {
+		if (screen == 0) {
+			return it.data;
+		}
+	}
+	return NULL;
+}
+
+static xcb_atom_t internAtom(xcb_connection_t *connection, const char *name) {
+	xcb_intern_atom_reply_t *reply = xcb_intern_atom_reply(connection, xcb_intern_atom(connection, 0, strlen(name), name), NULL);
+	if (reply == NULL) {
+		return XCB_ATOM_NONE;
+	}
+	xcb_atom_t atom = reply->atom;
+	free(reply);
+	return atom;
+}
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/bbredesen/go-vk"
+)
+
+// KHR_XCB_SURFACE_EXTENSION_NAME is not defined by go-vk
+const khrXcbSurfaceExtensionName = "VK_KHR_xcb_surface"
+
+// NewWindow returns the X11 implementation of Window.
+func NewWindow(c chan WindowMessage) Window {
+	return NewX11Window(c)
+}
+
+// X11Window is a Window on an X11 display, connected through xcb. Events are read from the connection on a separate
+// goroutine and translated to the same WindowMessages sent by Win32App.
+type X11Window struct {
+	msgs chan WindowMessage
+
+	conn   *C.xcb_connection_t
+	screen *C.xcb_screen_t
+	window C.xcb_window_t
+
+	wmProtocols, wmDeleteWindow C.xcb_atom_t
+
+	// keysyms is the server's keyboard mapping: keysymsPerKeycode keysyms for each keycode from minKeycode up
+	keysyms           []uint32
+	keysymsPerKeycode int
+	minKeycode        int
+
+	Width, Height uint32
+}
+
+func NewX11Window(c chan WindowMessage) *X11Window {
+	return &X11Window{
+		msgs: c,
+	}
+}
+
+func (w *X11Window) Initialize(windowTitle string, width, height uint32) {
+	w.Width, w.Height = width, height
+
+	var screenNum C.int
+	w.conn = C.xcb_connect(nil, &screenNum)
+	if C.xcb_connection_has_error(w.conn) != 0 {
+		panic("Could not connect to the X server; is DISPLAY set?")
+	}
+
+	if w.screen = C.screenOfDisplay(w.conn, screenNum); w.screen == nil {
+		panic("Could not find the default X screen")
+	}
+
+	w.window = C.xcb_generate_id(w.conn)
+
+	valueMask := C.uint32_t(C.XCB_CW_BACK_PIXEL | C.XCB_CW_EVENT_MASK)
+	values := []C.uint32_t{
+		w.screen.black_pixel,
+		C.XCB_EVENT_MASK_KEY_PRESS | C.XCB_EVENT_MASK_KEY_RELEASE | C.XCB_EVENT_MASK_EXPOSURE | C.XCB_EVENT_MASK_STRUCTURE_NOTIFY,
+	}
+
+	C.xcb_create_window(w.conn, C.XCB_COPY_FROM_PARENT, w.window, w.screen.root,
+		0, 0, C.uint16_t(width), C.uint16_t(height), 0,
+		C.XCB_WINDOW_CLASS_INPUT_OUTPUT, w.screen.root_visual, valueMask, unsafe.Pointer(&values[0]))
+
+	title := C.CString(windowTitle)
+	defer C.free(unsafe.Pointer(title))
+	C.xcb_change_property(w.conn, C.XCB_PROP_MODE_REPLACE, w.window, C.XCB_ATOM_WM_NAME, C.XCB_ATOM_STRING, 8,
+		C.uint32_t(len(windowTitle)), unsafe.Pointer(title))
+
+	// Ask the window manager to send a client message when the close button is clicked, instead of just disconnecting
+	protocols, deleteWindow := C.CString("WM_PROTOCOLS"), C.CString("WM_DELETE_WINDOW")
+	defer C.free(unsafe.Pointer(protocols))
+	defer C.free(unsafe.Pointer(deleteWindow))
+
+	w.wmProtocols = C.internAtom(w.conn, protocols)
+	w.wmDeleteWindow = C.internAtom(w.conn, deleteWindow)
+	C.xcb_change_property(w.conn, C.XCB_PROP_MODE_REPLACE, w.window, w.wmProtocols, C.XCB_ATOM_ATOM, 32, 1,
+		unsafe.Pointer(&w.wmDeleteWindow))
+
+	w.loadKeyboardMapping()
+
+	C.xcb_map_window(w.conn, w.window)
+	C.xcb_flush(w.conn)
+
+	// Unlike Win32, the window is complete as soon as it is mapped, so CREATE is only sent for consistency
+	go func() {
+		w.msgs <- WindowMessage{Text: "CREATE", Handle: uintptr(w.window)}
+		w.eventLoop()
+	}()
+	<-w.msgs
+}
+
+func (w *X11Window) GetRequiredInstanceExtensions() []string {
+	return []string{vk.KHR_SURFACE_EXTENSION_NAME, khrXcbSurfaceExtensionName}
+}
+
+func (w *X11Window) CreateSurface(instance vk.Instance) vk.SurfaceKHR {
+	fn := vk.GetInstanceProcAddr(instance, "vkCreateXcbSurfaceKHR")
+	if fn == nil {
+		panic("vkCreateXcbSurfaceKHR is not available; enable " + khrXcbSurfaceExtensionName + " on the instance")
+	}
+
+	var surface C.uint64_t
+	if r := vk.Result(C.createXcbSurface(unsafe.Pointer(fn), C.uintptr_t(instance), w.conn, w.window, &surface)); r != vk.SUCCESS {
+		panic("Could not create surface: " + r.String())
+	}
+	return vk.SurfaceKHR(surface)
+}
+
+func (w *X11Window) Messages() <-chan WindowMessage { return w.msgs }
+
+func (w *X11Window) Extent() vk.Extent2D {
+	reply := C.xcb_get_geometry_reply(w.conn, C.xcb_get_geometry(w.conn, C.xcb_drawable_t(w.window)), nil)
+	if reply == nil {
+		panic("Could not get window geometry")
+	}
+	defer C.free(unsafe.Pointer(reply))
+
+	return vk.Extent2D{Width: uint32(reply.width), Height: uint32(reply.height)}
+}
+
+// eventLoop blocks on the xcb connection and translates each event into a WindowMessage, until the window is closed.
+// xcb connections are thread safe, so this can run alongside Vulkan's use of the same connection for presentation.
+func (w *X11Window) eventLoop() {
+	handle := uintptr(w.window)
+
+	for {
+		ev := C.xcb_wait_for_event(w.conn)
+		if ev == nil {
+			// The connection to the X server has been lost
+			w.msgs <- WindowMessage{Text: "DESTROY", Handle: handle}
+			return
+		}
+
+		switch ev.response_type &^ 0x80 {
+		case C.XCB_EXPOSE:
+			w.msgs <- WindowMessage{Text: "PAINT", Handle: handle}
+
+		case C.XCB_KEY_PRESS:
+			// X11 reports an auto-repeat as a release followed by another press, so IsRepeat is never set
+			press := (*C.xcb_key_press_event_t)(unsafe.Pointer(ev))
+			key := byte(press.detail)
+			sym := w.keysym(key, uint16(press.state))
+			w.msgs <- WindowMessage{Text: "KEYDOWN", Handle: handle, KeyCode: key, Key: keysymKeys[sym]}
+
+			// X has no equivalent of WM_CHAR, so text is produced here, following the same key press
+			if r, ok := keysymRune(sym); ok && press.state&C.XCB_MOD_MASK_CONTROL == 0 {
+				w.msgs <- WindowMessage{Text: "CHAR", Handle: handle, Character: r}
+			}
+
+		case C.XCB_KEY_RELEASE:
+			key := byte((*C.xcb_key_release_event_t)(unsafe.Pointer(ev)).detail)
+			w.msgs <- WindowMessage{Text: "KEYUP", Handle: handle, KeyCode: key}
+
+		case C.XCB_CONFIGURE_NOTIFY:
+			// Also sent when the window is moved, so only report actual changes in size
+			cfg := (*C.xcb_configure_notify_event_t)(unsafe.Pointer(ev))
+			width, height := uint32(cfg.width), uint32(cfg.height)
+			if width != w.Width || height != w.Height {
+				w.Width, w.Height = width, height
+				w.msgs <- WindowMessage{Text: "SIZE", Handle: handle, Width: width, Height: height}
+			}
+
+		case C.XCB_CLIENT_MESSAGE:
+			cm := (*C.xcb_client_message_event_t)(unsafe.Pointer(ev))
+			if cm._type == w.wmProtocols && *(*C.xcb_atom_t)(unsafe.Pointer(&cm.data)) == w.wmDeleteWindow {
+				C.free(unsafe.Pointer(ev))
+
+				w.msgs <- WindowMessage{Text: "CLOSE", Handle: handle}
+				C.xcb_destroy_window(w.conn, w.window)
+				C.xcb_flush(w.conn)
+				w.msgs <- WindowMessage{Text: "DESTROY", Handle: handle}
+				return
+			}
+		}
+
+		C.free(unsafe.Pointer(ev))
+	}
+}
+
+// loadKeyboardMapping reads the keysyms for every keycode from the server, so that key presses can be translated
+// without a dependency on xkbcommon. Only the core protocol's shift and caps lock levels are supported.
+func (w *X11Window) loadKeyboardMapping() {
+	setup := C.xcb_get_setup(w.conn)
+	first, last := int(setup.min_keycode), int(setup.max_keycode)
+
+	reply := C.xcb_get_keyboard_mapping_reply(w.conn, C.xcb_get_keyboard_mapping(w.conn, setup.min_keycode, C.uint8_t(last-first+1)), nil)
+	if reply == nil {
+		panic("Could not get the keyboard mapping")
+	}
+	defer C.free(unsafe.Pointer(reply))
+
+	n := int(C.xcb_get_keyboard_mapping_keysyms_length(reply))
+	syms := unsafe.Slice((*C.xcb_keysym_t)(unsafe.Pointer(C.xcb_get_keyboard_mapping_keysyms(reply))), n)
+
+	w.keysyms = make([]uint32, n)
+	for i, sym := range syms {
+		w.keysyms[i] = uint32(sym)
+	}
+	w.keysymsPerKeycode = int(reply.keysyms_per_keycode)
+	w.minKeycode = first
+}
+
+// keysym returns the keysym for keycode, with the modifiers in state. Shift selects the second keysym of the keycode,
+// if there is one, and caps lock upper cases letters.
+func (w *X11Window) keysym(keycode byte, state uint16) uint32 {
+	i := (int(keycode) - w.minKeycode) * w.keysymsPerKeycode
+	if i < 0 || i+w.keysymsPerKeycode > len(w.keysyms) || w.keysymsPerKeycode == 0 {
+		return 0
+	}
+
+	sym := w.keysyms[i]
+	if state&C.XCB_MOD_MASK_SHIFT != 0 && w.keysymsPerKeycode > 1 && w.keysyms[i+1] != 0 {
+		sym = w.keysyms[i+1]
+	}
+	if state&C.XCB_MOD_MASK_LOCK != 0 {
+		sym = upperKeysym(sym)
+	}
+	return sym
+}
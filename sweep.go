@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bbredesen/ttf-renderer/ttf"
+)
+
+// axisSweep is the range one axis of a variable font is animated over.
+type axisSweep struct {
+	tag      string
+	from, to float32
+}
+
+// parseSweep parses the -sweep flag: a comma separated list of axis ranges, such as "wght=100:900,wdth=75:100".
+func parseSweep(s string) ([]axisSweep, error) {
+	var axes []axisSweep
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		tag, values, ok := strings.Cut(field, "=")
+		from, to, ok2 := strings.Cut(values, ":")
+		if !ok || !ok2 || len(tag) == 0 || len(tag) > 4 {
+			return nil, fmt.Errorf("invalid axis sweep %q; want tag=from:to", field)
+		}
+
+		lo, err := strconv.ParseFloat(from, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid axis sweep %q: %w", field, err)
+		}
+		hi, err := strconv.ParseFloat(to, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid axis sweep %q: %w", field, err)
+		}
+		// Tags shorter than four characters are padded with spaces, as by ttf.ParseVariation
+		axes = append(axes, axisSweep{tag: (tag + "   ")[:4], from: float32(lo), to: float32(hi)})
+	}
+	if len(axes) == 0 {
+		return nil, fmt.Errorf("no axes to sweep in %q", s)
+	}
+	return axes, nil
+}
+
+// variationSweep animates the instance of a variable font. Each swept axis moves from its first value to its second
+// and back once per period, easing in and out at either end; the other axes keep the values set by -axis.
+type variationSweep struct {
+	outlines *ttf.Font
+	fixed    map[string]float32
+	axes     []axisSweep
+	period   time.Duration
+	elapsed  time.Duration
+}
+
+// newVariationSweep starts a sweep of outlines over axes, from the first value of each. fixed holds the values of the
+// axes that aren't swept, and may be nil.
+func newVariationSweep(outlines *ttf.Font, fixed map[string]float32, axes []axisSweep, period time.Duration) (*variationSweep, error) {
+	if period <= 0 {
+		return nil, fmt.Errorf("sweep period %v is not positive", period)
+	}
+	s := &variationSweep{outlines: outlines, fixed: fixed, axes: axes, period: period}
+	if err := outlines.SetVariation(s.values()); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// values returns the axis values at the current point of the sweep.
+func (s *variationSweep) values() map[string]float32 {
+	// t goes from 0 to 1 and back to 0 over a period, following a cosine so that the sweep slows at either end
+	phase := float64(s.elapsed) / float64(s.period)
+	t := float32((1 - math.Cos(2*math.Pi*phase)) / 2)
+
+	values := make(map[string]float32, len(s.fixed)+len(s.axes))
+	for tag, v := range s.fixed {
+		values[tag] = v
+	}
+	for _, a := range s.axes {
+		values[a.tag] = a.from + (a.to-a.from)*t
+	}
+	return values
+}
+
+// tick advances the sweep by deltaT, and moves the font to the instance there.
+func (s *variationSweep) tick(deltaT time.Duration) error {
+	s.elapsed = (s.elapsed + deltaT) % s.period
+	return s.outlines.SetVariation(s.values())
+}
@@ -0,0 +1,80 @@
+package tess
+
+import (
+	"math"
+
+	"golang.org/x/image/math/fixed"
+)
+
+// maxCubicPieces caps how finely a single cubic is split, so a tiny tolerance can't blow up the vertex count.
+const maxCubicPieces = 32
+
+// cubicToQuads approximates the cubic Bézier (p0, p1, p2, p3) with a sequence of quadratic curves, so that CFF outlines
+// can be drawn through the same stencil pipelines as TrueType outlines. Each quadratic is returned as a pair of
+// {control point, end point}; the first curve starts at p0 and the last one ends exactly at p3.
+//
+// The cubic is split into n equal parameter ranges, each replaced by the quadratic whose control point is
+// (3(c1+c2) - (c0+c3)) / 4. The distance between a cubic and that quadratic is at most sqrt(3)/36 times the length of
+// the cubic's third difference, which shrinks by n^3 when the cubic is split n ways, so n is the smallest count that
+// brings the error within tolerance (in the same units as the points).
+func cubicToQuads(p0, p1, p2, p3 fixed.Point26_6, tolerance float64) (quads [][2]fixed.Point26_6) {
+	c := [4][2]float64{toFloat(p0), toFloat(p1), toFloat(p2), toFloat(p3)}
+
+	dx := c[3][0] - 3*c[2][0] + 3*c[1][0] - c[0][0]
+	dy := c[3][1] - 3*c[2][1] + 3*c[1][1] - c[0][1]
+	err := math.Sqrt(3) / 36 * math.Hypot(dx, dy)
+
+	n := 1
+	if tolerance > 0 {
+		n = int(math.Ceil(math.Cbrt(err / tolerance)))
+	}
+	if n < 1 {
+		n = 1
+	} else if n > maxCubicPieces {
+		n = maxCubicPieces
+	}
+
+	eval := func(t float64) (pt, deriv [2]float64) {
+		mt := 1 - t
+		for i := 0; i < 2; i++ {
+			pt[i] = mt*mt*mt*c[0][i] + 3*mt*mt*t*c[1][i] + 3*mt*t*t*c[2][i] + t*t*t*c[3][i]
+			deriv[i] = 3*mt*mt*(c[1][i]-c[0][i]) + 6*mt*t*(c[2][i]-c[1][i]) + 3*t*t*(c[3][i]-c[2][i])
+		}
+		return
+	}
+
+	h := 1 / float64(n)
+	start, startDeriv := eval(0)
+	for i := 1; i <= n; i++ {
+		end, endDeriv := eval(float64(i) * h)
+
+		var ctrl [2]float64
+		for j := 0; j < 2; j++ {
+			// Control points of the sub-cubic over [t-h, t]
+			c1 := start[j] + h/3*startDeriv[j]
+			c2 := end[j] - h/3*endDeriv[j]
+			ctrl[j] = (3*(c1+c2) - (start[j] + end[j])) / 4
+		}
+
+		endPt := p3
+		if i < n {
+			endPt = toFixed(end)
+		}
+		quads = append(quads, [2]fixed.Point26_6{toFixed(ctrl), endPt})
+
+		start, startDeriv = end, endDeriv
+	}
+
+	return quads
+}
+
+func toFloat(p fixed.Point26_6) [2]float64 {
+	return [2]float64{float64(p.X) / 64, float64(p.Y) / 64}
+}
+
+func toFixed(p [2]float64) fixed.Point26_6 {
+	return fixed.Point26_6{
+		X: fixed.Int26_6(math.Round(p[0] * 64)),
+		Y: fixed.Int26_6(math.Round(p[1] * 64)),
+	}
+}
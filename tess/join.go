@@ -0,0 +1,79 @@
+package tess
+
+import (
+	"math"
+
+	"github.com/bbredesen/vkm"
+)
+
+// Join combines meshes into a single mesh, translating each one by the matching offset. The fans of meshes[i] follow
+// those of meshes[i-1] in Verts and Inds, and likewise for the curve triangles, so a prefix of meshes always maps to a
+// prefix of each. The cover quads of the meshes are replaced by one covering all of them.
+//
+// Tessellating each distinct glyph once, at the origin, and joining the results is much cheaper than tessellating a
+// whole string again every time it changes.
+func Join(meshes []Mesh, offsets []vkm.Vec2) (m Mesh) {
+	minX, minY := float32(math.MaxFloat32), float32(math.MaxFloat32)
+	maxX, maxY := -minX, -minY
+	empty := true
+
+	for i, part := range meshes {
+		if len(part.Verts) == 0 {
+			// Nothing but a cover quad, e.g. a space
+			continue
+		}
+		empty = false
+		offset := offsets[i]
+
+		vertStart := uint32(len(m.Verts))
+		for _, v := range part.Verts {
+			v.Position = v.Position.Add(offset)
+			m.Verts = append(m.Verts, v)
+		}
+		for _, idx := range part.Inds {
+			if idx != RestartIndex {
+				idx += vertStart
+			}
+			m.Inds = append(m.Inds, idx)
+		}
+
+		n := len(part.QuadVerts) - 4
+		quadStart := uint32(len(m.QuadVerts))
+		for _, v := range part.QuadVerts[:n] {
+			v.Position = v.Position.Add(offset)
+			m.QuadVerts = append(m.QuadVerts, v)
+		}
+		for _, idx := range part.QuadInds[:len(part.QuadInds)-4] {
+			m.QuadInds = append(m.QuadInds, idx+quadStart)
+		}
+
+		pMin, pMax := part.Bounds()
+		if x := pMin[0] + offset[0]; x < minX {
+			minX = x
+		}
+		if y := pMin[1] + offset[1]; y < minY {
+			minY = y
+		}
+		if x := pMax[0] + offset[0]; x > maxX {
+			maxX = x
+		}
+		if y := pMax[1] + offset[1]; y > maxY {
+			maxY = y
+		}
+	}
+
+	if empty {
+		minX, minY, maxX, maxY = 0, 0, 0, 0
+	}
+
+	sidx := uint32(len(m.QuadVerts))
+	m.QuadVerts = append(m.QuadVerts,
+		Vertex{vkm.Pt2{minX, minY}, vkm.Origin3(), 0},
+		Vertex{vkm.Pt2{minX, maxY}, vkm.Origin3(), 0},
+		Vertex{vkm.Pt2{maxX, maxY}, vkm.Origin3(), 0},
+		Vertex{vkm.Pt2{maxX, minY}, vkm.Origin3(), 0},
+	)
+	m.QuadInds = append(m.QuadInds, sidx, sidx+1, sidx+2, sidx+3)
+
+	return m
+}
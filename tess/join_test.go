@@ -0,0 +1,162 @@
+package tess
+
+import (
+	"testing"
+
+	"github.com/bbredesen/vkm"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// square returns the outline of a square with a side of size font units, from (x, y), with one curved side when
+// curved is set.
+func square(x, y, size int, curved bool) sfnt.Segments {
+	p := func(x, y int) fixed.Point26_6 { return fixed.P(x, y) }
+	s := sfnt.Segments{
+		{Op: sfnt.SegmentOpMoveTo, Args: [3]fixed.Point26_6{p(x, y)}},
+		{Op: sfnt.SegmentOpLineTo, Args: [3]fixed.Point26_6{p(x+size, y)}},
+		{Op: sfnt.SegmentOpLineTo, Args: [3]fixed.Point26_6{p(x+size, y+size)}},
+		{Op: sfnt.SegmentOpLineTo, Args: [3]fixed.Point26_6{p(x, y+size)}},
+		{Op: sfnt.SegmentOpLineTo, Args: [3]fixed.Point26_6{p(x, y)}},
+	}
+	if curved {
+		s[2] = sfnt.Segment{Op: sfnt.SegmentOpQuadTo, Args: [3]fixed.Point26_6{p(x+size+size/2, y+size/2), p(x+size, y+size)}}
+	}
+	return s
+}
+
+func TestJoin(t *testing.T) {
+	// Two contours, a space with nothing but a cover quad, and a glyph with a curve
+	meshes := []Mesh{
+		Tessellate(append(square(0, 0, 10, false), square(2, 2, 4, false)...), 1, 0.25),
+		Tessellate(nil, 1, 0.25),
+		Tessellate(square(0, 0, 10, true), 1, 0.25),
+	}
+	offsets := []vkm.Vec2{{100, 50}, {200, 50}, {300, 60}}
+
+	m := Join(meshes, offsets)
+
+	// Every vertex is translated by the offset of its mesh, and every index is moved by the vertices before it
+	var vertStart, indStart, quadVertStart, quadIndStart int
+	for i, part := range meshes {
+		for k, v := range part.Verts {
+			if got, want := m.Verts[vertStart+k].Position, part.Verts[k].Position.Add(offsets[i]); got != want {
+				t.Errorf("mesh %d vertex %d is at %v, want %v", i, k, got, want)
+			}
+			if m.Verts[vertStart+k].BaryCoords != v.BaryCoords {
+				t.Errorf("mesh %d vertex %d lost its barycentric coordinates", i, k)
+			}
+		}
+		for k, idx := range part.Inds {
+			got := m.Inds[indStart+k]
+			switch {
+			case idx == RestartIndex && got != RestartIndex:
+				t.Errorf("mesh %d index %d is %d, want a restart", i, k, got)
+			case idx != RestartIndex && got != idx+uint32(vertStart):
+				t.Errorf("mesh %d index %d is %d, want %d", i, k, got, idx+uint32(vertStart))
+			}
+		}
+
+		curveVerts, curveInds := part.QuadVerts[:len(part.QuadVerts)-4], part.QuadInds[:len(part.QuadInds)-4]
+		for k := range curveVerts {
+			if got, want := m.QuadVerts[quadVertStart+k].Position, curveVerts[k].Position.Add(offsets[i]); got != want {
+				t.Errorf("mesh %d curve vertex %d is at %v, want %v", i, k, got, want)
+			}
+		}
+		for k, idx := range curveInds {
+			if got := m.QuadInds[quadIndStart+k]; got != idx+uint32(quadVertStart) {
+				t.Errorf("mesh %d curve index %d is %d, want %d", i, k, got, idx+uint32(quadVertStart))
+			}
+		}
+
+		vertStart += len(part.Verts)
+		indStart += len(part.Inds)
+		quadVertStart += len(curveVerts)
+		quadIndStart += len(curveInds)
+	}
+
+	if len(m.Verts) != vertStart || len(m.Inds) != indStart {
+		t.Errorf("got %d fan vertices and %d indices, want %d and %d", len(m.Verts), len(m.Inds), vertStart, indStart)
+	}
+	if len(m.QuadVerts) != quadVertStart+4 || len(m.QuadInds) != quadIndStart+4 {
+		t.Errorf("got %d curve vertices and %d indices, want %d and %d with a single cover quad", len(m.QuadVerts), len(m.QuadInds), quadVertStart+4, quadIndStart+4)
+	}
+
+	restarts := 0
+	for _, idx := range m.Inds {
+		if idx == RestartIndex {
+			restarts++
+		}
+	}
+	if restarts != 3 {
+		t.Errorf("got %d restarts, want one for each of the 3 contours", restarts)
+	}
+
+	// The cover quad spans every mesh but the space, whose quad is empty at its offset
+	min, max := m.Bounds()
+	if want := (vkm.Pt2{100, 50}); min != want {
+		t.Errorf("cover quad starts at %v, want %v", min, want)
+	}
+	if want := (vkm.Pt2{315, 70}); max != want {
+		t.Errorf("cover quad ends at %v, want %v", max, want)
+	}
+	for k, idx := range m.QuadInds[quadIndStart:] {
+		if idx != uint32(quadVertStart+k) {
+			t.Errorf("cover quad index %d is %d, want %d", k, idx, quadVertStart+k)
+		}
+	}
+}
+
+func TestJoinEmpty(t *testing.T) {
+	m := Join([]Mesh{Tessellate(nil, 1, 0.25)}, []vkm.Vec2{{10, 10}})
+	if len(m.Verts) != 0 || len(m.Inds) != 0 {
+		t.Errorf("got %d fan vertices and %d indices from a space", len(m.Verts), len(m.Inds))
+	}
+	if min, max := m.Bounds(); min != (vkm.Pt2{}) || max != (vkm.Pt2{}) {
+		t.Errorf("got cover quad %v to %v, want an empty quad at the origin", min, max)
+	}
+}
+
+// TestJoinCaretPrefix checks what the editor relies on to hide its caret: with the caret's mesh joined last, leaving
+// its indices off the end of Inds leaves every glyph fan whole, and nothing of the caret.
+func TestJoinCaretPrefix(t *testing.T) {
+	caret := Tessellate(square(0, -8, 1, false), 1, 0.25)
+	meshes := []Mesh{
+		Tessellate(square(0, 0, 10, true), 1, 0.25),
+		Tessellate(append(square(0, 0, 10, false), square(2, 2, 4, false)...), 1, 0.25),
+		caret,
+	}
+	m := Join(meshes, []vkm.Vec2{{0, 0}, {12, 0}, {22, 0}})
+
+	prefix := len(m.Inds) - len(caret.Inds)
+	caretStart := uint32(len(m.Verts) - len(caret.Verts))
+
+	if m.Inds[prefix] != RestartIndex {
+		t.Fatalf("the caret's indices start with %d, want the restart of its fan", m.Inds[prefix])
+	}
+	for k, idx := range m.Inds[prefix:] {
+		want := caret.Inds[k]
+		if want != RestartIndex {
+			want += caretStart
+		}
+		if idx != want {
+			t.Errorf("caret index %d is %d, want %d", k, idx, want)
+		}
+	}
+	for k, idx := range m.Inds[:prefix] {
+		if idx != RestartIndex && idx >= caretStart {
+			t.Errorf("glyph index %d is %d, a vertex of the caret", k, idx)
+		}
+	}
+
+	// The glyphs' fans are the same with or without the caret
+	glyphs := Join(meshes[:2], []vkm.Vec2{{0, 0}, {12, 0}})
+	if len(glyphs.Inds) != prefix {
+		t.Fatalf("got %d indices before the caret, want the %d of the glyphs", prefix, len(glyphs.Inds))
+	}
+	for k := range glyphs.Inds {
+		if m.Inds[k] != glyphs.Inds[k] {
+			t.Errorf("index %d is %d with the caret, %d without", k, m.Inds[k], glyphs.Inds[k])
+		}
+	}
+}
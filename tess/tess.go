@@ -0,0 +1,272 @@
+// Package tess converts glyph outlines into the triangle geometry drawn by the stencil pipelines. It has no dependency on
+// Vulkan, so the same geometry can be rasterized on the CPU (see package raster) and tested without a GPU.
+package tess
+
+import (
+	"math"
+
+	"github.com/bbredesen/vkm"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// RestartIndex separates the triangle fans of each contour in Mesh.Inds, as a primitive restart. Vulkan restarts on
+// the largest value of the bound index type, so this becomes 0xFFFF when the indices are narrowed with Narrow.
+const RestartIndex = 0xFFFFFFFF
+
+// RestartIndex16 is the primitive restart value for 16-bit indices.
+const RestartIndex16 = 0xFFFF
+
+// Vertex is the vertex layout shared by every stencil pipeline. The fan pipeline only reads Position.
+type Vertex struct {
+	Position   vkm.Pt2
+	BaryCoords vkm.Pt3
+
+	// Orientation is +1 for a curve triangle whose control point lies outside of its contour (the curve bulges outward,
+	// adding area to the fan polygon), and -1 when the control point lies inside (the curve bulges inward). Zero for
+	// fan and color vertices.
+	Orientation float32
+}
+
+// Mesh is the geometry for a set of outlines, drawn in three passes:
+//
+//   - Verts and Inds are triangle fans, one per contour, each starting with RestartIndex. They are drawn into the
+//     stencil buffer with the fan pipeline.
+//   - QuadVerts and QuadInds, apart from the last four of each, are a triangle list with one triangle per quadratic
+//     curve, drawn into the stencil buffer with the curve pipeline.
+//   - The last four QuadVerts and QuadInds are a fan covering every point drawn into the stencil, drawn with the color
+//     pipeline wherever the stencil is non-zero.
+//
+// QuadInds are relative to the start of QuadVerts.
+type Mesh struct {
+	Verts []Vertex
+	Inds  []uint32
+
+	QuadVerts []Vertex
+	QuadInds  []uint32
+}
+
+// Fits16 reports whether every index in the mesh can be narrowed to 16 bits, without colliding with RestartIndex16.
+// Curve indices are relative to the start of QuadVerts, so each half of the mesh is limited separately.
+func (m *Mesh) Fits16() bool {
+	return len(m.Verts) <= RestartIndex16 && len(m.QuadVerts) <= RestartIndex16
+}
+
+// Narrow converts indices to 16 bits, mapping RestartIndex to RestartIndex16. The mesh the indices came from must
+// satisfy Fits16.
+func Narrow(inds []uint32) []uint16 {
+	narrow := make([]uint16, len(inds))
+	for i, idx := range inds {
+		if idx == RestartIndex {
+			narrow[i] = RestartIndex16
+		} else {
+			narrow[i] = uint16(idx)
+		}
+	}
+	return narrow
+}
+
+// Bounds returns the corners of the quad covering the mesh, as (minX, minY) and (maxX, maxY).
+func (m *Mesh) Bounds() (min, max vkm.Pt2) {
+	n := len(m.QuadVerts)
+	return m.QuadVerts[n-4].Position, m.QuadVerts[n-2].Position
+}
+
+// Tessellate builds the triangle fan and quadratic curve geometry for segments, which are expected in font units.
+// Every position is multiplied by scale (i.e. ppem / units per em). Cubic curves are approximated by quadratics to
+// within cubicTolerance pixels.
+//
+// The final four quadVerts are a quad covering the bounding box of every emitted on-curve and control point. A curve
+// never leaves the hull of its control points, so this quad always covers everything drawn into the stencil.
+//
+// Every curve triangle is emitted with the same winding as its contour, so that it always adds to the stencil value
+// in the same direction as that contour's fan. For a convex curve (bulging outward) the fan runs along the chord and the
+// curve triangle fills the region between the chord and the curve. For a concave curve (bulging inward) the fan runs
+// through the control point instead, leaving out the whole triangle, and the curve triangle fills back in the region
+// between the curve and the control point. quad_shader.frag uses the orientation to keep the correct side of the curve.
+func Tessellate(segments sfnt.Segments, scale float32, cubicTolerance float64) (m Mesh) {
+	contourSigns := contourWindings(segments)
+	contour := -1
+
+	barySign := 0
+
+	getBaryCoord := func() vkm.Pt3 {
+		switch barySign {
+		case -1:
+			barySign *= -1
+			return vkm.Pt3{0, 0, 1}
+		case 0:
+			barySign = 1
+			return vkm.Pt3{0, 1, 0}
+		case 1:
+			barySign *= -1
+			return vkm.Pt3{1, 0, 0}
+		}
+		panic("unexpected barySign ")
+	}
+
+	minX, minY := float32(math.MaxFloat32), float32(math.MaxFloat32)
+	maxX, maxY := -minX, -minY
+
+	pt2FromFixed := func(fp fixed.Point26_6) vkm.Pt2 {
+		pt := vkm.Pt2{int26_6_to_float32(fp.X) * scale, int26_6_to_float32(fp.Y) * scale}
+
+		// Every emitted position passes through here, so track the bounds as we go
+		if pt[0] < minX {
+			minX = pt[0]
+		}
+		if pt[0] > maxX {
+			maxX = pt[0]
+		}
+		if pt[1] < minY {
+			minY = pt[1]
+		}
+		if pt[1] > maxY {
+			maxY = pt[1]
+		}
+		return pt
+	}
+	pushRestart := func() {
+		m.Inds = append(m.Inds, RestartIndex)
+		barySign = 0
+	}
+
+	pushVertex := func(fp fixed.Point26_6) {
+		nextIdx := uint32(len(m.Verts))
+
+		pt := pt2FromFixed(fp)
+		m.Verts = append(m.Verts, Vertex{pt, getBaryCoord(), 0})
+		m.Inds = append(m.Inds, nextIdx)
+	}
+
+	// current is the end point of the most recent segment, which is the start point of the next curve
+	var current fixed.Point26_6
+
+	pushQuad := func(ctrl, end fixed.Point26_6) {
+		p0, p1, p2 := pt2FromFixed(current), pt2FromFixed(ctrl), pt2FromFixed(end)
+		current = end
+
+		// The sign of the cross product gives the winding of the triangle (p0, p1, p2). Matching the contour's winding
+		// means the control point is outside of the contour.
+		cross := (p1[0]-p0[0])*(p2[1]-p0[1]) - (p1[1]-p0[1])*(p2[0]-p0[0])
+		if cross == 0 {
+			// Control point is on the chord, so the curve is a straight line
+			pushVertex(end)
+			return
+		}
+
+		var orientation float32 = 1
+		if (cross > 0) != contourSigns[contour] {
+			// Concave: the fan goes through the control point, and the curve triangle is reversed to match the
+			// contour's winding
+			orientation = -1
+			pushVertex(ctrl)
+			p0, p2 = p2, p0
+		}
+
+		pushVertex(end) // push for rough rendering triangle fans
+
+		qvIdxStart := uint32(len(m.QuadVerts))
+
+		// for each quad, need to push last point, control point, this point, with bary coords
+		m.QuadVerts = append(m.QuadVerts,
+			Vertex{p0, vkm.Pt3{1, 0, 0}, orientation},
+			Vertex{p1, vkm.Pt3{0, 1, 0}, orientation},
+			Vertex{p2, vkm.Pt3{0, 0, 1}, orientation},
+		)
+		m.QuadInds = append(m.QuadInds, qvIdxStart, qvIdxStart+1, qvIdxStart+2)
+	}
+
+	// Segments is a list of movement instructions
+	// OpCode MoveTo - Restart primitive and use arg[0] as the first point
+	// OpCode QuadTO - Quadratic curve to arg[1], arg[0] is the control point
+	// OpCode CubeTo - Cubic curve to arg[2], with control points arg[0] and arg[1]. Approximated with quadratic curves,
+	// within cubicTolerance pixels.
+
+	for _, segment := range segments {
+		switch segment.Op {
+		case sfnt.SegmentOpMoveTo:
+			pushRestart()
+			pushVertex(segment.Args[0])
+			current = segment.Args[0]
+			contour++
+
+		case sfnt.SegmentOpLineTo:
+			pushVertex(segment.Args[0])
+			current = segment.Args[0]
+
+		case sfnt.SegmentOpQuadTo:
+			pushQuad(segment.Args[0], segment.Args[1])
+
+		case sfnt.SegmentOpCubeTo:
+			// Segments are in font units, so convert the pixel tolerance before subdividing
+			for _, q := range cubicToQuads(current, segment.Args[0], segment.Args[1], segment.Args[2], cubicTolerance/float64(scale)) {
+				pushQuad(q[0], q[1])
+			}
+		}
+	}
+
+	sidx := uint32(len(m.QuadVerts))
+
+	if len(m.Verts) == 0 {
+		// Nothing to draw, e.g. a string of spaces
+		minX, minY, maxX, maxY = 0, 0, 0, 0
+	}
+
+	m.QuadVerts = append(m.QuadVerts,
+		Vertex{vkm.Pt2{minX, minY}, vkm.Origin3(), 0},
+		Vertex{vkm.Pt2{minX, maxY}, vkm.Origin3(), 0},
+		Vertex{vkm.Pt2{maxX, maxY}, vkm.Origin3(), 0},
+		Vertex{vkm.Pt2{maxX, minY}, vkm.Origin3(), 0},
+	)
+	m.QuadInds = append(m.QuadInds, sidx, sidx+1, sidx+2, sidx+3)
+
+	return m
+}
+
+func int26_6_to_float32(x fixed.Int26_6) float32 {
+	return float32(x) / 64
+}
+
+// contourWindings returns, for each contour in segments, whether its signed area is positive. The area is taken over
+// the polygon through every on-curve and control point, which is plenty to determine the winding direction of a whole
+// contour. Scaling by a positive factor doesn't change the sign, so this can work directly in font units.
+func contourWindings(segments sfnt.Segments) (signs []bool) {
+	var area float64
+	var start, prev fixed.Point26_6
+
+	addEdge := func(to fixed.Point26_6) {
+		area += float64(prev.X)*float64(to.Y) - float64(to.X)*float64(prev.Y)
+		prev = to
+	}
+	closeContour := func() {
+		addEdge(start)
+		signs = append(signs, area > 0)
+		area = 0
+	}
+
+	for i, segment := range segments {
+		n := 1
+		switch segment.Op {
+		case sfnt.SegmentOpMoveTo:
+			if i > 0 {
+				closeContour()
+			}
+			start, prev = segment.Args[0], segment.Args[0]
+			continue
+		case sfnt.SegmentOpQuadTo:
+			n = 2
+		case sfnt.SegmentOpCubeTo:
+			n = 3
+		}
+
+		for _, pt := range segment.Args[:n] {
+			addEdge(pt)
+		}
+	}
+	if len(segments) > 0 {
+		closeContour()
+	}
+
+	return signs
+}
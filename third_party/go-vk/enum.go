@@ -0,0 +1,3339 @@
+// Code generated by go-vk from vk.xml at 2023-02-03 15:01:43.8486832 -0600 CST m=+1.858523301. DO NOT EDIT.
+package vk
+
+//go:generate stringer -output=enum_string_0.go -type=AccelerationStructureBuildTypeKHR,AccelerationStructureCompatibilityKHR,AccelerationStructureCreateFlagBitsKHR,AccelerationStructureMemoryRequirementsTypeNV,AccelerationStructureMotionInstanceTypeNV,AccelerationStructureTypeKHR,AccessFlagBits,AccessFlagBits2KHR,AttachmentDescriptionFlagBits,AttachmentLoadOp,AttachmentStoreOp,BlendFactor,BlendOp,BlendOverlapEXT,BorderColor,BufferCreateFlagBits,BufferUsageFlagBits,BuildAccelerationStructureFlagBitsKHR,BuildAccelerationStructureModeKHR,ChromaLocation,CoarseSampleOrderTypeNV,ColorComponentFlagBits,ColorSpaceKHR,CommandBufferLevel,CommandBufferResetFlagBits,CommandBufferUsageFlagBits,CommandPoolCreateFlagBits,CommandPoolResetFlagBits,CompareOp,ComponentSwizzle,ComponentTypeNV
+//go:generate stringer -output=enum_string_1.go -type=CompositeAlphaFlagBitsKHR,ConditionalRenderingFlagBitsEXT,ConservativeRasterizationModeEXT,CopyAccelerationStructureModeKHR,CoverageModulationModeNV,CoverageReductionModeNV,CullModeFlagBits,DebugReportFlagBitsEXT,DebugReportObjectTypeEXT,DebugUtilsMessageSeverityFlagBitsEXT,DebugUtilsMessageTypeFlagBitsEXT,DependencyFlagBits,DescriptorBindingFlagBits,DescriptorPoolCreateFlagBits,DescriptorSetLayoutCreateFlagBits,DescriptorType,DescriptorUpdateTemplateType,DeviceDiagnosticsConfigFlagBitsNV,DeviceEventTypeEXT,DeviceGroupPresentModeFlagBitsKHR,DeviceMemoryReportEventTypeEXT,DeviceQueueCreateFlagBits,DiscardRectangleModeEXT,DisplayEventTypeEXT,DisplayPlaneAlphaFlagBitsKHR,DisplayPowerStateEXT,DriverId,DynamicState,EventCreateFlagBits,ExternalFenceFeatureFlagBits,ExternalFenceHandleTypeFlagBits
+//go:generate stringer -output=enum_string_2.go -type=ExternalMemoryFeatureFlagBits,ExternalMemoryFeatureFlagBitsNV,ExternalMemoryHandleTypeFlagBits,ExternalMemoryHandleTypeFlagBitsNV,ExternalSemaphoreFeatureFlagBits,ExternalSemaphoreHandleTypeFlagBits,FenceCreateFlagBits,FenceImportFlagBits,Filter,Format,FormatFeatureFlagBits,FragmentShadingRateCombinerOpKHR,FragmentShadingRateNV,FragmentShadingRateTypeNV,FramebufferCreateFlagBits,FrontFace,GeometryFlagBitsKHR,GeometryInstanceFlagBitsKHR,GeometryTypeKHR,ImageAspectFlagBits,ImageCreateFlagBits,ImageLayout,ImageTiling,ImageType,ImageUsageFlagBits,ImageViewCreateFlagBits,ImageViewType,IndexType,IndirectCommandsLayoutUsageFlagBitsNV,IndirectCommandsTokenTypeNV,IndirectStateFlagBitsNV
+//go:generate stringer -output=enum_string_3.go -type=InternalAllocationType,LineRasterizationModeEXT,LogicOp,MemoryAllocateFlagBits,MemoryHeapFlagBits,MemoryOverallocationBehaviorAMD,MemoryPropertyFlagBits,ObjectType,PeerMemoryFeatureFlagBits,PerformanceConfigurationTypeINTEL,PerformanceCounterDescriptionFlagBitsKHR,PerformanceCounterScopeKHR,PerformanceCounterStorageKHR,PerformanceCounterUnitKHR,PerformanceOverrideTypeINTEL,PerformanceParameterTypeINTEL,PerformanceValueTypeINTEL,PhysicalDeviceType,PipelineBindPoint,PipelineCacheCreateFlagBits,PipelineCacheHeaderVersion,PipelineCreateFlagBits,PipelineCreationFeedbackFlagBitsEXT,PipelineExecutableStatisticFormatKHR,PipelineShaderStageCreateFlagBits,PipelineStageFlagBits,PipelineStageFlagBits2KHR,PointClippingBehavior,PolygonMode,PresentModeKHR,PrimitiveTopology
+//go:generate stringer -output=enum_string_4.go -type=ProvokingVertexModeEXT,QueryControlFlagBits,QueryPipelineStatisticFlagBits,QueryPoolSamplingModeINTEL,QueryResultFlagBits,QueryType,QueueFlagBits,QueueGlobalPriorityEXT,RasterizationOrderAMD,RayTracingShaderGroupTypeKHR,RenderPassCreateFlagBits,ResolveModeFlagBits,Result,SampleCountFlagBits,SamplerAddressMode,SamplerCreateFlagBits,SamplerMipmapMode,SamplerReductionMode,SamplerYcbcrModelConversion,SamplerYcbcrRange,ScopeNV,SemaphoreImportFlagBits,SemaphoreType,SemaphoreWaitFlagBits,ShaderFloatControlsIndependence,ShaderGroupShaderKHR,ShaderInfoTypeAMD,ShaderStageFlagBits,ShadingRatePaletteEntryNV,SharingMode,SparseImageFormatFlagBits
+//go:generate stringer -output=enum_string_5.go -type=SparseMemoryBindFlagBits,StencilFaceFlagBits,StencilOp,StructureType,SubgroupFeatureFlagBits,SubmitFlagBitsKHR,SubpassContents,SubpassDescriptionFlagBits,SurfaceCounterFlagBitsEXT,SurfaceTransformFlagBitsKHR,SwapchainCreateFlagBitsKHR,SystemAllocationScope,TessellationDomainOrigin,TimeDomainEXT,ToolPurposeFlagBitsEXT,ValidationCacheHeaderVersionEXT,ValidationCheckEXT,ValidationFeatureDisableEXT,ValidationFeatureEnableEXT,VendorId,VertexInputRate,ViewportCoordinateSwizzleNV
+// AccelerationStructureBuildTypeKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkAccelerationStructureBuildTypeKHR.html
+type AccelerationStructureBuildTypeKHR int32
+
+const (
+	ACCELERATION_STRUCTURE_BUILD_TYPE_HOST_KHR           AccelerationStructureBuildTypeKHR = 0
+	ACCELERATION_STRUCTURE_BUILD_TYPE_DEVICE_KHR         AccelerationStructureBuildTypeKHR = 1
+	ACCELERATION_STRUCTURE_BUILD_TYPE_HOST_OR_DEVICE_KHR AccelerationStructureBuildTypeKHR = 2
+)
+
+// AccelerationStructureCompatibilityKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkAccelerationStructureCompatibilityKHR.html
+type AccelerationStructureCompatibilityKHR int32
+
+const (
+	ACCELERATION_STRUCTURE_COMPATIBILITY_COMPATIBLE_KHR   AccelerationStructureCompatibilityKHR = 0
+	ACCELERATION_STRUCTURE_COMPATIBILITY_INCOMPATIBLE_KHR AccelerationStructureCompatibilityKHR = 1
+)
+
+type AccelerationStructureCreateFlagBitsKHR = AccelerationStructureCreateFlagsKHR
+
+const (
+	ACCELERATION_STRUCTURE_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT_KHR AccelerationStructureCreateFlagBitsKHR = 1 << 0
+	ACCELERATION_STRUCTURE_CREATE_MOTION_BIT_NV                         AccelerationStructureCreateFlagBitsKHR = 1000327000
+)
+
+// AccelerationStructureMemoryRequirementsTypeNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkAccelerationStructureMemoryRequirementsTypeNV.html
+type AccelerationStructureMemoryRequirementsTypeNV int32
+
+const (
+	ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_TYPE_OBJECT_NV         AccelerationStructureMemoryRequirementsTypeNV = 0
+	ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_TYPE_BUILD_SCRATCH_NV  AccelerationStructureMemoryRequirementsTypeNV = 1
+	ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_TYPE_UPDATE_SCRATCH_NV AccelerationStructureMemoryRequirementsTypeNV = 2
+)
+
+// AccelerationStructureMotionInstanceTypeNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkAccelerationStructureMotionInstanceTypeNV.html
+type AccelerationStructureMotionInstanceTypeNV int32
+
+const (
+	ACCELERATION_STRUCTURE_MOTION_INSTANCE_TYPE_STATIC_NV        AccelerationStructureMotionInstanceTypeNV = 0
+	ACCELERATION_STRUCTURE_MOTION_INSTANCE_TYPE_MATRIX_MOTION_NV AccelerationStructureMotionInstanceTypeNV = 1
+	ACCELERATION_STRUCTURE_MOTION_INSTANCE_TYPE_SRT_MOTION_NV    AccelerationStructureMotionInstanceTypeNV = 2
+)
+
+// AccelerationStructureTypeKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkAccelerationStructureTypeKHR.html
+type AccelerationStructureTypeKHR int32
+
+const (
+	ACCELERATION_STRUCTURE_TYPE_TOP_LEVEL_KHR    AccelerationStructureTypeKHR = 0
+	ACCELERATION_STRUCTURE_TYPE_BOTTOM_LEVEL_KHR AccelerationStructureTypeKHR = 1
+	ACCELERATION_STRUCTURE_TYPE_GENERIC_KHR      AccelerationStructureTypeKHR = 2
+	ACCELERATION_STRUCTURE_TYPE_BOTTOM_LEVEL_NV  AccelerationStructureTypeKHR = ACCELERATION_STRUCTURE_TYPE_BOTTOM_LEVEL_KHR
+	ACCELERATION_STRUCTURE_TYPE_TOP_LEVEL_NV     AccelerationStructureTypeKHR = ACCELERATION_STRUCTURE_TYPE_TOP_LEVEL_KHR
+)
+
+// AccelerationStructureTypeNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkAccelerationStructureTypeNV.html
+type AccelerationStructureTypeNV = AccelerationStructureTypeKHR
+type AccessFlagBits = AccessFlags
+
+const (
+	ACCESS_INDIRECT_COMMAND_READ_BIT                AccessFlagBits = 1 << 0
+	ACCESS_INDEX_READ_BIT                           AccessFlagBits = 1 << 1
+	ACCESS_DEPTH_STENCIL_ATTACHMENT_WRITE_BIT       AccessFlagBits = 1 << 10
+	ACCESS_TRANSFER_READ_BIT                        AccessFlagBits = 1 << 11
+	ACCESS_TRANSFER_WRITE_BIT                       AccessFlagBits = 1 << 12
+	ACCESS_HOST_READ_BIT                            AccessFlagBits = 1 << 13
+	ACCESS_HOST_WRITE_BIT                           AccessFlagBits = 1 << 14
+	ACCESS_MEMORY_READ_BIT                          AccessFlagBits = 1 << 15
+	ACCESS_MEMORY_WRITE_BIT                         AccessFlagBits = 1 << 16
+	ACCESS_VERTEX_ATTRIBUTE_READ_BIT                AccessFlagBits = 1 << 2
+	ACCESS_UNIFORM_READ_BIT                         AccessFlagBits = 1 << 3
+	ACCESS_INPUT_ATTACHMENT_READ_BIT                AccessFlagBits = 1 << 4
+	ACCESS_SHADER_READ_BIT                          AccessFlagBits = 1 << 5
+	ACCESS_SHADER_WRITE_BIT                         AccessFlagBits = 1 << 6
+	ACCESS_COLOR_ATTACHMENT_READ_BIT                AccessFlagBits = 1 << 7
+	ACCESS_COLOR_ATTACHMENT_WRITE_BIT               AccessFlagBits = 1 << 8
+	ACCESS_DEPTH_STENCIL_ATTACHMENT_READ_BIT        AccessFlagBits = 1 << 9
+	ACCESS_TRANSFORM_FEEDBACK_COUNTER_READ_BIT_EXT  AccessFlagBits = 1000028000
+	ACCESS_TRANSFORM_FEEDBACK_COUNTER_WRITE_BIT_EXT AccessFlagBits = 1000028000
+	ACCESS_TRANSFORM_FEEDBACK_WRITE_BIT_EXT         AccessFlagBits = 1000028000
+	// read access flag for reading conditional rendering predicate
+	ACCESS_CONDITIONAL_RENDERING_READ_BIT_EXT            AccessFlagBits = 1000081000
+	ACCESS_COLOR_ATTACHMENT_READ_NONCOHERENT_BIT_EXT     AccessFlagBits = 1000148000
+	ACCESS_ACCELERATION_STRUCTURE_WRITE_BIT_KHR          AccessFlagBits = 1000150000
+	ACCESS_ACCELERATION_STRUCTURE_READ_BIT_KHR           AccessFlagBits = 1000150000
+	ACCESS_FRAGMENT_DENSITY_MAP_READ_BIT_EXT             AccessFlagBits = 1000218000
+	ACCESS_FRAGMENT_SHADING_RATE_ATTACHMENT_READ_BIT_KHR AccessFlagBits = 1000226000
+	ACCESS_COMMAND_PREPROCESS_READ_BIT_NV                AccessFlagBits = 1000277000
+	ACCESS_COMMAND_PREPROCESS_WRITE_BIT_NV               AccessFlagBits = 1000277000
+	ACCESS_NONE_KHR                                      AccessFlagBits = 1000314000
+	ACCESS_ACCELERATION_STRUCTURE_READ_BIT_NV            AccessFlagBits = ACCESS_ACCELERATION_STRUCTURE_READ_BIT_KHR
+	ACCESS_ACCELERATION_STRUCTURE_WRITE_BIT_NV           AccessFlagBits = ACCESS_ACCELERATION_STRUCTURE_WRITE_BIT_KHR
+	ACCESS_SHADING_RATE_IMAGE_READ_BIT_NV                AccessFlagBits = ACCESS_FRAGMENT_SHADING_RATE_ATTACHMENT_READ_BIT_KHR
+)
+
+type AccessFlagBits2KHR = AccessFlags2KHR
+
+const (
+	ACCESS_2_NONE_KHR                                  AccessFlagBits2KHR = 0
+	ACCESS_2_INDIRECT_COMMAND_READ_BIT_KHR             AccessFlagBits2KHR = 1 << 0
+	ACCESS_2_INDEX_READ_BIT_KHR                        AccessFlagBits2KHR = 1 << 1
+	ACCESS_2_DEPTH_STENCIL_ATTACHMENT_WRITE_BIT_KHR    AccessFlagBits2KHR = 1 << 10
+	ACCESS_2_TRANSFER_READ_BIT_KHR                     AccessFlagBits2KHR = 1 << 11
+	ACCESS_2_TRANSFER_WRITE_BIT_KHR                    AccessFlagBits2KHR = 1 << 12
+	ACCESS_2_HOST_READ_BIT_KHR                         AccessFlagBits2KHR = 1 << 13
+	ACCESS_2_HOST_WRITE_BIT_KHR                        AccessFlagBits2KHR = 1 << 14
+	ACCESS_2_MEMORY_READ_BIT_KHR                       AccessFlagBits2KHR = 1 << 15
+	ACCESS_2_MEMORY_WRITE_BIT_KHR                      AccessFlagBits2KHR = 1 << 16
+	ACCESS_2_VERTEX_ATTRIBUTE_READ_BIT_KHR             AccessFlagBits2KHR = 1 << 2
+	ACCESS_2_UNIFORM_READ_BIT_KHR                      AccessFlagBits2KHR = 1 << 3
+	ACCESS_2_SHADER_SAMPLED_READ_BIT_KHR               AccessFlagBits2KHR = 1 << 32
+	ACCESS_2_SHADER_STORAGE_READ_BIT_KHR               AccessFlagBits2KHR = 1 << 33
+	ACCESS_2_SHADER_STORAGE_WRITE_BIT_KHR              AccessFlagBits2KHR = 1 << 34
+	ACCESS_2_INPUT_ATTACHMENT_READ_BIT_KHR             AccessFlagBits2KHR = 1 << 4
+	ACCESS_2_SHADER_READ_BIT_KHR                       AccessFlagBits2KHR = 1 << 5
+	ACCESS_2_SHADER_WRITE_BIT_KHR                      AccessFlagBits2KHR = 1 << 6
+	ACCESS_2_COLOR_ATTACHMENT_READ_BIT_KHR             AccessFlagBits2KHR = 1 << 7
+	ACCESS_2_COLOR_ATTACHMENT_WRITE_BIT_KHR            AccessFlagBits2KHR = 1 << 8
+	ACCESS_2_DEPTH_STENCIL_ATTACHMENT_READ_BIT_KHR     AccessFlagBits2KHR = 1 << 9
+	ACCESS_2_COLOR_ATTACHMENT_READ_NONCOHERENT_BIT_EXT AccessFlagBits2KHR = 1000314000
+	ACCESS_2_TRANSFORM_FEEDBACK_COUNTER_WRITE_BIT_EXT  AccessFlagBits2KHR = 1000314000
+	ACCESS_2_COMMAND_PREPROCESS_READ_BIT_NV            AccessFlagBits2KHR = 1000314000
+	ACCESS_2_COMMAND_PREPROCESS_WRITE_BIT_NV           AccessFlagBits2KHR = 1000314000
+	ACCESS_2_FRAGMENT_DENSITY_MAP_READ_BIT_EXT         AccessFlagBits2KHR = 1000314000
+	ACCESS_2_ACCELERATION_STRUCTURE_READ_BIT_KHR       AccessFlagBits2KHR = 1000314000
+	// read access flag for reading conditional rendering predicate
+	ACCESS_2_CONDITIONAL_RENDERING_READ_BIT_EXT            AccessFlagBits2KHR = 1000314000
+	ACCESS_2_ACCELERATION_STRUCTURE_WRITE_BIT_KHR          AccessFlagBits2KHR = 1000314000
+	ACCESS_2_TRANSFORM_FEEDBACK_WRITE_BIT_EXT              AccessFlagBits2KHR = 1000314000
+	ACCESS_2_FRAGMENT_SHADING_RATE_ATTACHMENT_READ_BIT_KHR AccessFlagBits2KHR = 1000314000
+	ACCESS_2_TRANSFORM_FEEDBACK_COUNTER_READ_BIT_EXT       AccessFlagBits2KHR = 1000314000
+	ACCESS_2_INVOCATION_MASK_READ_BIT_HUAWEI               AccessFlagBits2KHR = 1000370000
+	ACCESS_2_ACCELERATION_STRUCTURE_READ_BIT_NV            AccessFlagBits2KHR = ACCESS_2_ACCELERATION_STRUCTURE_READ_BIT_KHR
+	ACCESS_2_ACCELERATION_STRUCTURE_WRITE_BIT_NV           AccessFlagBits2KHR = ACCESS_2_ACCELERATION_STRUCTURE_WRITE_BIT_KHR
+	ACCESS_2_SHADING_RATE_IMAGE_READ_BIT_NV                AccessFlagBits2KHR = ACCESS_2_FRAGMENT_SHADING_RATE_ATTACHMENT_READ_BIT_KHR
+)
+
+type AcquireProfilingLockFlagBitsKHR = AcquireProfilingLockFlagsKHR
+type AttachmentDescriptionFlagBits = AttachmentDescriptionFlags
+
+const (
+	ATTACHMENT_DESCRIPTION_MAY_ALIAS_BIT AttachmentDescriptionFlagBits = 1 << 0
+)
+
+// AttachmentLoadOp: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkAttachmentLoadOp.html
+type AttachmentLoadOp int32
+
+const (
+	ATTACHMENT_LOAD_OP_LOAD      AttachmentLoadOp = 0
+	ATTACHMENT_LOAD_OP_CLEAR     AttachmentLoadOp = 1
+	ATTACHMENT_LOAD_OP_DONT_CARE AttachmentLoadOp = 2
+	ATTACHMENT_LOAD_OP_NONE_EXT  AttachmentLoadOp = 1000400000
+)
+
+// AttachmentStoreOp: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkAttachmentStoreOp.html
+type AttachmentStoreOp int32
+
+const (
+	ATTACHMENT_STORE_OP_STORE     AttachmentStoreOp = 0
+	ATTACHMENT_STORE_OP_DONT_CARE AttachmentStoreOp = 1
+	ATTACHMENT_STORE_OP_NONE_EXT  AttachmentStoreOp = 1000400000
+	ATTACHMENT_STORE_OP_NONE_QCOM AttachmentStoreOp = ATTACHMENT_STORE_OP_NONE_EXT
+)
+
+// BlendFactor: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkBlendFactor.html
+type BlendFactor int32
+
+const (
+	BLEND_FACTOR_ZERO                     BlendFactor = 0
+	BLEND_FACTOR_ONE                      BlendFactor = 1
+	BLEND_FACTOR_SRC_COLOR                BlendFactor = 2
+	BLEND_FACTOR_ONE_MINUS_SRC_COLOR      BlendFactor = 3
+	BLEND_FACTOR_DST_COLOR                BlendFactor = 4
+	BLEND_FACTOR_ONE_MINUS_DST_COLOR      BlendFactor = 5
+	BLEND_FACTOR_SRC_ALPHA                BlendFactor = 6
+	BLEND_FACTOR_ONE_MINUS_SRC_ALPHA      BlendFactor = 7
+	BLEND_FACTOR_DST_ALPHA                BlendFactor = 8
+	BLEND_FACTOR_ONE_MINUS_DST_ALPHA      BlendFactor = 9
+	BLEND_FACTOR_CONSTANT_COLOR           BlendFactor = 10
+	BLEND_FACTOR_ONE_MINUS_CONSTANT_COLOR BlendFactor = 11
+	BLEND_FACTOR_CONSTANT_ALPHA           BlendFactor = 12
+	BLEND_FACTOR_ONE_MINUS_CONSTANT_ALPHA BlendFactor = 13
+	BLEND_FACTOR_SRC_ALPHA_SATURATE       BlendFactor = 14
+	BLEND_FACTOR_SRC1_COLOR               BlendFactor = 15
+	BLEND_FACTOR_ONE_MINUS_SRC1_COLOR     BlendFactor = 16
+	BLEND_FACTOR_SRC1_ALPHA               BlendFactor = 17
+	BLEND_FACTOR_ONE_MINUS_SRC1_ALPHA     BlendFactor = 18
+)
+
+// BlendOp: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkBlendOp.html
+type BlendOp int32
+
+const (
+	BLEND_OP_ADD                    BlendOp = 0
+	BLEND_OP_SUBTRACT               BlendOp = 1
+	BLEND_OP_REVERSE_SUBTRACT       BlendOp = 2
+	BLEND_OP_MIN                    BlendOp = 3
+	BLEND_OP_MAX                    BlendOp = 4
+	BLEND_OP_ZERO_EXT               BlendOp = 1000148000
+	BLEND_OP_SRC_EXT                BlendOp = 1000148001
+	BLEND_OP_DST_EXT                BlendOp = 1000148002
+	BLEND_OP_SRC_OVER_EXT           BlendOp = 1000148003
+	BLEND_OP_DST_OVER_EXT           BlendOp = 1000148004
+	BLEND_OP_SRC_IN_EXT             BlendOp = 1000148005
+	BLEND_OP_DST_IN_EXT             BlendOp = 1000148006
+	BLEND_OP_SRC_OUT_EXT            BlendOp = 1000148007
+	BLEND_OP_DST_OUT_EXT            BlendOp = 1000148008
+	BLEND_OP_SRC_ATOP_EXT           BlendOp = 1000148009
+	BLEND_OP_DST_ATOP_EXT           BlendOp = 1000148010
+	BLEND_OP_XOR_EXT                BlendOp = 1000148011
+	BLEND_OP_MULTIPLY_EXT           BlendOp = 1000148012
+	BLEND_OP_SCREEN_EXT             BlendOp = 1000148013
+	BLEND_OP_OVERLAY_EXT            BlendOp = 1000148014
+	BLEND_OP_DARKEN_EXT             BlendOp = 1000148015
+	BLEND_OP_LIGHTEN_EXT            BlendOp = 1000148016
+	BLEND_OP_COLORDODGE_EXT         BlendOp = 1000148017
+	BLEND_OP_COLORBURN_EXT          BlendOp = 1000148018
+	BLEND_OP_HARDLIGHT_EXT          BlendOp = 1000148019
+	BLEND_OP_SOFTLIGHT_EXT          BlendOp = 1000148020
+	BLEND_OP_DIFFERENCE_EXT         BlendOp = 1000148021
+	BLEND_OP_EXCLUSION_EXT          BlendOp = 1000148022
+	BLEND_OP_INVERT_EXT             BlendOp = 1000148023
+	BLEND_OP_INVERT_RGB_EXT         BlendOp = 1000148024
+	BLEND_OP_LINEARDODGE_EXT        BlendOp = 1000148025
+	BLEND_OP_LINEARBURN_EXT         BlendOp = 1000148026
+	BLEND_OP_VIVIDLIGHT_EXT         BlendOp = 1000148027
+	BLEND_OP_LINEARLIGHT_EXT        BlendOp = 1000148028
+	BLEND_OP_PINLIGHT_EXT           BlendOp = 1000148029
+	BLEND_OP_HARDMIX_EXT            BlendOp = 1000148030
+	BLEND_OP_HSL_HUE_EXT            BlendOp = 1000148031
+	BLEND_OP_HSL_SATURATION_EXT     BlendOp = 1000148032
+	BLEND_OP_HSL_COLOR_EXT          BlendOp = 1000148033
+	BLEND_OP_HSL_LUMINOSITY_EXT     BlendOp = 1000148034
+	BLEND_OP_PLUS_EXT               BlendOp = 1000148035
+	BLEND_OP_PLUS_CLAMPED_EXT       BlendOp = 1000148036
+	BLEND_OP_PLUS_CLAMPED_ALPHA_EXT BlendOp = 1000148037
+	BLEND_OP_PLUS_DARKER_EXT        BlendOp = 1000148038
+	BLEND_OP_MINUS_EXT              BlendOp = 1000148039
+	BLEND_OP_MINUS_CLAMPED_EXT      BlendOp = 1000148040
+	BLEND_OP_CONTRAST_EXT           BlendOp = 1000148041
+	BLEND_OP_INVERT_OVG_EXT         BlendOp = 1000148042
+	BLEND_OP_RED_EXT                BlendOp = 1000148043
+	BLEND_OP_GREEN_EXT              BlendOp = 1000148044
+	BLEND_OP_BLUE_EXT               BlendOp = 1000148045
+)
+
+// BlendOverlapEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkBlendOverlapEXT.html
+type BlendOverlapEXT int32
+
+const (
+	BLEND_OVERLAP_UNCORRELATED_EXT BlendOverlapEXT = 0
+	BLEND_OVERLAP_DISJOINT_EXT     BlendOverlapEXT = 1
+	BLEND_OVERLAP_CONJOINT_EXT     BlendOverlapEXT = 2
+)
+
+// BorderColor: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkBorderColor.html
+type BorderColor int32
+
+const (
+	BORDER_COLOR_FLOAT_TRANSPARENT_BLACK BorderColor = 0
+	BORDER_COLOR_INT_TRANSPARENT_BLACK   BorderColor = 1
+	BORDER_COLOR_FLOAT_OPAQUE_BLACK      BorderColor = 2
+	BORDER_COLOR_INT_OPAQUE_BLACK        BorderColor = 3
+	BORDER_COLOR_FLOAT_OPAQUE_WHITE      BorderColor = 4
+	BORDER_COLOR_INT_OPAQUE_WHITE        BorderColor = 5
+	BORDER_COLOR_FLOAT_CUSTOM_EXT        BorderColor = 1000287003
+	BORDER_COLOR_INT_CUSTOM_EXT          BorderColor = 1000287004
+)
+
+type BufferCreateFlagBits = BufferCreateFlags
+
+const (
+	BUFFER_CREATE_SPARSE_BINDING_BIT                    BufferCreateFlagBits = 1 << 0
+	BUFFER_CREATE_SPARSE_RESIDENCY_BIT                  BufferCreateFlagBits = 1 << 1
+	BUFFER_CREATE_SPARSE_ALIASED_BIT                    BufferCreateFlagBits = 1 << 2
+	BUFFER_CREATE_PROTECTED_BIT                         BufferCreateFlagBits = 1 << 3
+	BUFFER_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT     BufferCreateFlagBits = 1 << 4
+	BUFFER_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT_EXT BufferCreateFlagBits = BUFFER_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT
+	BUFFER_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT_KHR BufferCreateFlagBits = BUFFER_CREATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT
+)
+
+type BufferUsageFlagBits = BufferUsageFlags
+
+const (
+	BUFFER_USAGE_TRANSFER_SRC_BIT                          BufferUsageFlagBits = 1 << 0
+	BUFFER_USAGE_TRANSFER_DST_BIT                          BufferUsageFlagBits = 1 << 1
+	BUFFER_USAGE_SHADER_DEVICE_ADDRESS_BIT                 BufferUsageFlagBits = 1 << 17
+	BUFFER_USAGE_UNIFORM_TEXEL_BUFFER_BIT                  BufferUsageFlagBits = 1 << 2
+	BUFFER_USAGE_STORAGE_TEXEL_BUFFER_BIT                  BufferUsageFlagBits = 1 << 3
+	BUFFER_USAGE_UNIFORM_BUFFER_BIT                        BufferUsageFlagBits = 1 << 4
+	BUFFER_USAGE_STORAGE_BUFFER_BIT                        BufferUsageFlagBits = 1 << 5
+	BUFFER_USAGE_INDEX_BUFFER_BIT                          BufferUsageFlagBits = 1 << 6
+	BUFFER_USAGE_VERTEX_BUFFER_BIT                         BufferUsageFlagBits = 1 << 7
+	BUFFER_USAGE_INDIRECT_BUFFER_BIT                       BufferUsageFlagBits = 1 << 8
+	BUFFER_USAGE_TRANSFORM_FEEDBACK_COUNTER_BUFFER_BIT_EXT BufferUsageFlagBits = 1000028000
+	BUFFER_USAGE_TRANSFORM_FEEDBACK_BUFFER_BIT_EXT         BufferUsageFlagBits = 1000028000
+	// Specifies the buffer can be used as predicate in conditional rendering
+	BUFFER_USAGE_CONDITIONAL_RENDERING_BIT_EXT                        BufferUsageFlagBits = 1000081000
+	BUFFER_USAGE_ACCELERATION_STRUCTURE_BUILD_INPUT_READ_ONLY_BIT_KHR BufferUsageFlagBits = 1000150000
+	BUFFER_USAGE_ACCELERATION_STRUCTURE_STORAGE_BIT_KHR               BufferUsageFlagBits = 1000150000
+	BUFFER_USAGE_SHADER_BINDING_TABLE_BIT_KHR                         BufferUsageFlagBits = 1000347000
+	BUFFER_USAGE_RAY_TRACING_BIT_NV                                   BufferUsageFlagBits = BUFFER_USAGE_SHADER_BINDING_TABLE_BIT_KHR
+	BUFFER_USAGE_SHADER_DEVICE_ADDRESS_BIT_KHR                        BufferUsageFlagBits = BUFFER_USAGE_SHADER_DEVICE_ADDRESS_BIT
+	BUFFER_USAGE_SHADER_DEVICE_ADDRESS_BIT_EXT                        BufferUsageFlagBits = BUFFER_USAGE_SHADER_DEVICE_ADDRESS_BIT
+)
+
+type BuildAccelerationStructureFlagBitsKHR = BuildAccelerationStructureFlagsKHR
+
+const (
+	BUILD_ACCELERATION_STRUCTURE_ALLOW_UPDATE_BIT_KHR      BuildAccelerationStructureFlagBitsKHR = 1 << 0
+	BUILD_ACCELERATION_STRUCTURE_ALLOW_COMPACTION_BIT_KHR  BuildAccelerationStructureFlagBitsKHR = 1 << 1
+	BUILD_ACCELERATION_STRUCTURE_PREFER_FAST_TRACE_BIT_KHR BuildAccelerationStructureFlagBitsKHR = 1 << 2
+	BUILD_ACCELERATION_STRUCTURE_PREFER_FAST_BUILD_BIT_KHR BuildAccelerationStructureFlagBitsKHR = 1 << 3
+	BUILD_ACCELERATION_STRUCTURE_LOW_MEMORY_BIT_KHR        BuildAccelerationStructureFlagBitsKHR = 1 << 4
+	BUILD_ACCELERATION_STRUCTURE_MOTION_BIT_NV             BuildAccelerationStructureFlagBitsKHR = 1000327000
+	BUILD_ACCELERATION_STRUCTURE_ALLOW_COMPACTION_BIT_NV   BuildAccelerationStructureFlagBitsKHR = BUILD_ACCELERATION_STRUCTURE_ALLOW_COMPACTION_BIT_KHR
+	BUILD_ACCELERATION_STRUCTURE_ALLOW_UPDATE_BIT_NV       BuildAccelerationStructureFlagBitsKHR = BUILD_ACCELERATION_STRUCTURE_ALLOW_UPDATE_BIT_KHR
+	BUILD_ACCELERATION_STRUCTURE_LOW_MEMORY_BIT_NV         BuildAccelerationStructureFlagBitsKHR = BUILD_ACCELERATION_STRUCTURE_LOW_MEMORY_BIT_KHR
+	BUILD_ACCELERATION_STRUCTURE_PREFER_FAST_BUILD_BIT_NV  BuildAccelerationStructureFlagBitsKHR = BUILD_ACCELERATION_STRUCTURE_PREFER_FAST_BUILD_BIT_KHR
+	BUILD_ACCELERATION_STRUCTURE_PREFER_FAST_TRACE_BIT_NV  BuildAccelerationStructureFlagBitsKHR = BUILD_ACCELERATION_STRUCTURE_PREFER_FAST_TRACE_BIT_KHR
+)
+
+// BuildAccelerationStructureFlagBitsNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkBuildAccelerationStructureFlagBitsNV.html
+type BuildAccelerationStructureFlagBitsNV = BuildAccelerationStructureFlagBitsKHR
+
+// BuildAccelerationStructureModeKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkBuildAccelerationStructureModeKHR.html
+type BuildAccelerationStructureModeKHR int32
+
+const (
+	BUILD_ACCELERATION_STRUCTURE_MODE_BUILD_KHR  BuildAccelerationStructureModeKHR = 0
+	BUILD_ACCELERATION_STRUCTURE_MODE_UPDATE_KHR BuildAccelerationStructureModeKHR = 1
+)
+
+// ChromaLocation: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkChromaLocation.html
+type ChromaLocation int32
+
+const (
+	CHROMA_LOCATION_COSITED_EVEN     ChromaLocation = 0
+	CHROMA_LOCATION_MIDPOINT         ChromaLocation = 1
+	CHROMA_LOCATION_COSITED_EVEN_KHR ChromaLocation = CHROMA_LOCATION_COSITED_EVEN
+	CHROMA_LOCATION_MIDPOINT_KHR     ChromaLocation = CHROMA_LOCATION_MIDPOINT
+)
+
+// ChromaLocationKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkChromaLocationKHR.html
+type ChromaLocationKHR = ChromaLocation
+
+// CoarseSampleOrderTypeNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkCoarseSampleOrderTypeNV.html
+type CoarseSampleOrderTypeNV int32
+
+const (
+	COARSE_SAMPLE_ORDER_TYPE_DEFAULT_NV      CoarseSampleOrderTypeNV = 0
+	COARSE_SAMPLE_ORDER_TYPE_CUSTOM_NV       CoarseSampleOrderTypeNV = 1
+	COARSE_SAMPLE_ORDER_TYPE_PIXEL_MAJOR_NV  CoarseSampleOrderTypeNV = 2
+	COARSE_SAMPLE_ORDER_TYPE_SAMPLE_MAJOR_NV CoarseSampleOrderTypeNV = 3
+)
+
+type ColorComponentFlagBits = ColorComponentFlags
+
+const (
+	COLOR_COMPONENT_R_BIT ColorComponentFlagBits = 1 << 0
+	COLOR_COMPONENT_G_BIT ColorComponentFlagBits = 1 << 1
+	COLOR_COMPONENT_B_BIT ColorComponentFlagBits = 1 << 2
+	COLOR_COMPONENT_A_BIT ColorComponentFlagBits = 1 << 3
+)
+
+// ColorSpaceKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkColorSpaceKHR.html
+type ColorSpaceKHR int32
+
+const (
+	COLOR_SPACE_SRGB_NONLINEAR_KHR          ColorSpaceKHR = 0
+	COLOR_SPACE_DISPLAY_P3_NONLINEAR_EXT    ColorSpaceKHR = 1000104001
+	COLOR_SPACE_EXTENDED_SRGB_LINEAR_EXT    ColorSpaceKHR = 1000104002
+	COLOR_SPACE_DISPLAY_P3_LINEAR_EXT       ColorSpaceKHR = 1000104003
+	COLOR_SPACE_DCI_P3_NONLINEAR_EXT        ColorSpaceKHR = 1000104004
+	COLOR_SPACE_BT709_LINEAR_EXT            ColorSpaceKHR = 1000104005
+	COLOR_SPACE_BT709_NONLINEAR_EXT         ColorSpaceKHR = 1000104006
+	COLOR_SPACE_BT2020_LINEAR_EXT           ColorSpaceKHR = 1000104007
+	COLOR_SPACE_HDR10_ST2084_EXT            ColorSpaceKHR = 1000104008
+	COLOR_SPACE_DOLBYVISION_EXT             ColorSpaceKHR = 1000104009
+	COLOR_SPACE_HDR10_HLG_EXT               ColorSpaceKHR = 1000104010
+	COLOR_SPACE_ADOBERGB_LINEAR_EXT         ColorSpaceKHR = 1000104011
+	COLOR_SPACE_ADOBERGB_NONLINEAR_EXT      ColorSpaceKHR = 1000104012
+	COLOR_SPACE_PASS_THROUGH_EXT            ColorSpaceKHR = 1000104013
+	COLOR_SPACE_EXTENDED_SRGB_NONLINEAR_EXT ColorSpaceKHR = 1000104014
+	COLOR_SPACE_DISPLAY_NATIVE_AMD          ColorSpaceKHR = 1000213000
+	// Deprecated name for backwards compatibility
+	COLOR_SPACE_DCI_P3_LINEAR_EXT ColorSpaceKHR = COLOR_SPACE_DISPLAY_P3_LINEAR_EXT
+	// Backwards-compatible alias containing a typo
+	COLORSPACE_SRGB_NONLINEAR_KHR ColorSpaceKHR = COLOR_SPACE_SRGB_NONLINEAR_KHR
+)
+
+// CommandBufferLevel: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkCommandBufferLevel.html
+type CommandBufferLevel int32
+
+const (
+	COMMAND_BUFFER_LEVEL_PRIMARY   CommandBufferLevel = 0
+	COMMAND_BUFFER_LEVEL_SECONDARY CommandBufferLevel = 1
+)
+
+type CommandBufferResetFlagBits = CommandBufferResetFlags
+
+const (
+	COMMAND_BUFFER_RESET_RELEASE_RESOURCES_BIT CommandBufferResetFlagBits = 1 << 0
+)
+
+type CommandBufferUsageFlagBits = CommandBufferUsageFlags
+
+const (
+	COMMAND_BUFFER_USAGE_ONE_TIME_SUBMIT_BIT      CommandBufferUsageFlagBits = 1 << 0
+	COMMAND_BUFFER_USAGE_RENDER_PASS_CONTINUE_BIT CommandBufferUsageFlagBits = 1 << 1
+	COMMAND_BUFFER_USAGE_SIMULTANEOUS_USE_BIT     CommandBufferUsageFlagBits = 1 << 2
+)
+
+type CommandPoolCreateFlagBits = CommandPoolCreateFlags
+
+const (
+	COMMAND_POOL_CREATE_TRANSIENT_BIT            CommandPoolCreateFlagBits = 1 << 0
+	COMMAND_POOL_CREATE_RESET_COMMAND_BUFFER_BIT CommandPoolCreateFlagBits = 1 << 1
+	COMMAND_POOL_CREATE_PROTECTED_BIT            CommandPoolCreateFlagBits = 1 << 2
+)
+
+type CommandPoolResetFlagBits = CommandPoolResetFlags
+
+const (
+	COMMAND_POOL_RESET_RELEASE_RESOURCES_BIT CommandPoolResetFlagBits = 1 << 0
+)
+
+// CompareOp: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkCompareOp.html
+type CompareOp int32
+
+const (
+	COMPARE_OP_NEVER            CompareOp = 0
+	COMPARE_OP_LESS             CompareOp = 1
+	COMPARE_OP_EQUAL            CompareOp = 2
+	COMPARE_OP_LESS_OR_EQUAL    CompareOp = 3
+	COMPARE_OP_GREATER          CompareOp = 4
+	COMPARE_OP_NOT_EQUAL        CompareOp = 5
+	COMPARE_OP_GREATER_OR_EQUAL CompareOp = 6
+	COMPARE_OP_ALWAYS           CompareOp = 7
+)
+
+// ComponentSwizzle: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkComponentSwizzle.html
+type ComponentSwizzle int32
+
+const (
+	COMPONENT_SWIZZLE_IDENTITY ComponentSwizzle = 0
+	COMPONENT_SWIZZLE_ZERO     ComponentSwizzle = 1
+	COMPONENT_SWIZZLE_ONE      ComponentSwizzle = 2
+	COMPONENT_SWIZZLE_R        ComponentSwizzle = 3
+	COMPONENT_SWIZZLE_G        ComponentSwizzle = 4
+	COMPONENT_SWIZZLE_B        ComponentSwizzle = 5
+	COMPONENT_SWIZZLE_A        ComponentSwizzle = 6
+)
+
+// ComponentTypeNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkComponentTypeNV.html
+type ComponentTypeNV int32
+
+const (
+	COMPONENT_TYPE_FLOAT16_NV ComponentTypeNV = 0
+	COMPONENT_TYPE_FLOAT32_NV ComponentTypeNV = 1
+	COMPONENT_TYPE_FLOAT64_NV ComponentTypeNV = 2
+	COMPONENT_TYPE_SINT8_NV   ComponentTypeNV = 3
+	COMPONENT_TYPE_SINT16_NV  ComponentTypeNV = 4
+	COMPONENT_TYPE_SINT32_NV  ComponentTypeNV = 5
+	COMPONENT_TYPE_SINT64_NV  ComponentTypeNV = 6
+	COMPONENT_TYPE_UINT8_NV   ComponentTypeNV = 7
+	COMPONENT_TYPE_UINT16_NV  ComponentTypeNV = 8
+	COMPONENT_TYPE_UINT32_NV  ComponentTypeNV = 9
+	COMPONENT_TYPE_UINT64_NV  ComponentTypeNV = 10
+)
+
+type CompositeAlphaFlagBitsKHR = CompositeAlphaFlagsKHR
+
+const (
+	COMPOSITE_ALPHA_OPAQUE_BIT_KHR          CompositeAlphaFlagBitsKHR = 1 << 0
+	COMPOSITE_ALPHA_PRE_MULTIPLIED_BIT_KHR  CompositeAlphaFlagBitsKHR = 1 << 1
+	COMPOSITE_ALPHA_POST_MULTIPLIED_BIT_KHR CompositeAlphaFlagBitsKHR = 1 << 2
+	COMPOSITE_ALPHA_INHERIT_BIT_KHR         CompositeAlphaFlagBitsKHR = 1 << 3
+)
+
+type ConditionalRenderingFlagBitsEXT = ConditionalRenderingFlagsEXT
+
+const (
+	CONDITIONAL_RENDERING_INVERTED_BIT_EXT ConditionalRenderingFlagBitsEXT = 1 << 0
+)
+
+// ConservativeRasterizationModeEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkConservativeRasterizationModeEXT.html
+type ConservativeRasterizationModeEXT int32
+
+const (
+	CONSERVATIVE_RASTERIZATION_MODE_DISABLED_EXT      ConservativeRasterizationModeEXT = 0
+	CONSERVATIVE_RASTERIZATION_MODE_OVERESTIMATE_EXT  ConservativeRasterizationModeEXT = 1
+	CONSERVATIVE_RASTERIZATION_MODE_UNDERESTIMATE_EXT ConservativeRasterizationModeEXT = 2
+)
+
+// CopyAccelerationStructureModeKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkCopyAccelerationStructureModeKHR.html
+type CopyAccelerationStructureModeKHR int32
+
+const (
+	COPY_ACCELERATION_STRUCTURE_MODE_CLONE_KHR       CopyAccelerationStructureModeKHR = 0
+	COPY_ACCELERATION_STRUCTURE_MODE_COMPACT_KHR     CopyAccelerationStructureModeKHR = 1
+	COPY_ACCELERATION_STRUCTURE_MODE_SERIALIZE_KHR   CopyAccelerationStructureModeKHR = 2
+	COPY_ACCELERATION_STRUCTURE_MODE_DESERIALIZE_KHR CopyAccelerationStructureModeKHR = 3
+	COPY_ACCELERATION_STRUCTURE_MODE_CLONE_NV        CopyAccelerationStructureModeKHR = COPY_ACCELERATION_STRUCTURE_MODE_CLONE_KHR
+	COPY_ACCELERATION_STRUCTURE_MODE_COMPACT_NV      CopyAccelerationStructureModeKHR = COPY_ACCELERATION_STRUCTURE_MODE_COMPACT_KHR
+)
+
+// CopyAccelerationStructureModeNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkCopyAccelerationStructureModeNV.html
+type CopyAccelerationStructureModeNV = CopyAccelerationStructureModeKHR
+
+// CoverageModulationModeNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkCoverageModulationModeNV.html
+type CoverageModulationModeNV int32
+
+const (
+	COVERAGE_MODULATION_MODE_NONE_NV  CoverageModulationModeNV = 0
+	COVERAGE_MODULATION_MODE_RGB_NV   CoverageModulationModeNV = 1
+	COVERAGE_MODULATION_MODE_ALPHA_NV CoverageModulationModeNV = 2
+	COVERAGE_MODULATION_MODE_RGBA_NV  CoverageModulationModeNV = 3
+)
+
+// CoverageReductionModeNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkCoverageReductionModeNV.html
+type CoverageReductionModeNV int32
+
+const (
+	COVERAGE_REDUCTION_MODE_MERGE_NV    CoverageReductionModeNV = 0
+	COVERAGE_REDUCTION_MODE_TRUNCATE_NV CoverageReductionModeNV = 1
+)
+
+type CullModeFlagBits = CullModeFlags
+
+const (
+	CULL_MODE_NONE           CullModeFlagBits = 0
+	CULL_MODE_FRONT_AND_BACK CullModeFlagBits = 0x00000003
+	CULL_MODE_FRONT_BIT      CullModeFlagBits = 1 << 0
+	CULL_MODE_BACK_BIT       CullModeFlagBits = 1 << 1
+)
+
+type DebugReportFlagBitsEXT = DebugReportFlagsEXT
+
+const (
+	DEBUG_REPORT_INFORMATION_BIT_EXT         DebugReportFlagBitsEXT = 1 << 0
+	DEBUG_REPORT_WARNING_BIT_EXT             DebugReportFlagBitsEXT = 1 << 1
+	DEBUG_REPORT_PERFORMANCE_WARNING_BIT_EXT DebugReportFlagBitsEXT = 1 << 2
+	DEBUG_REPORT_ERROR_BIT_EXT               DebugReportFlagBitsEXT = 1 << 3
+	DEBUG_REPORT_DEBUG_BIT_EXT               DebugReportFlagBitsEXT = 1 << 4
+)
+
+// DebugReportObjectTypeEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkDebugReportObjectTypeEXT.html
+type DebugReportObjectTypeEXT int32
+
+const (
+	DEBUG_REPORT_OBJECT_TYPE_UNKNOWN_EXT                    DebugReportObjectTypeEXT = 0
+	DEBUG_REPORT_OBJECT_TYPE_INSTANCE_EXT                   DebugReportObjectTypeEXT = 1
+	DEBUG_REPORT_OBJECT_TYPE_PHYSICAL_DEVICE_EXT            DebugReportObjectTypeEXT = 2
+	DEBUG_REPORT_OBJECT_TYPE_DEVICE_EXT                     DebugReportObjectTypeEXT = 3
+	DEBUG_REPORT_OBJECT_TYPE_QUEUE_EXT                      DebugReportObjectTypeEXT = 4
+	DEBUG_REPORT_OBJECT_TYPE_SEMAPHORE_EXT                  DebugReportObjectTypeEXT = 5
+	DEBUG_REPORT_OBJECT_TYPE_COMMAND_BUFFER_EXT             DebugReportObjectTypeEXT = 6
+	DEBUG_REPORT_OBJECT_TYPE_FENCE_EXT                      DebugReportObjectTypeEXT = 7
+	DEBUG_REPORT_OBJECT_TYPE_DEVICE_MEMORY_EXT              DebugReportObjectTypeEXT = 8
+	DEBUG_REPORT_OBJECT_TYPE_BUFFER_EXT                     DebugReportObjectTypeEXT = 9
+	DEBUG_REPORT_OBJECT_TYPE_IMAGE_EXT                      DebugReportObjectTypeEXT = 10
+	DEBUG_REPORT_OBJECT_TYPE_EVENT_EXT                      DebugReportObjectTypeEXT = 11
+	DEBUG_REPORT_OBJECT_TYPE_QUERY_POOL_EXT                 DebugReportObjectTypeEXT = 12
+	DEBUG_REPORT_OBJECT_TYPE_BUFFER_VIEW_EXT                DebugReportObjectTypeEXT = 13
+	DEBUG_REPORT_OBJECT_TYPE_IMAGE_VIEW_EXT                 DebugReportObjectTypeEXT = 14
+	DEBUG_REPORT_OBJECT_TYPE_SHADER_MODULE_EXT              DebugReportObjectTypeEXT = 15
+	DEBUG_REPORT_OBJECT_TYPE_PIPELINE_CACHE_EXT             DebugReportObjectTypeEXT = 16
+	DEBUG_REPORT_OBJECT_TYPE_PIPELINE_LAYOUT_EXT            DebugReportObjectTypeEXT = 17
+	DEBUG_REPORT_OBJECT_TYPE_RENDER_PASS_EXT                DebugReportObjectTypeEXT = 18
+	DEBUG_REPORT_OBJECT_TYPE_PIPELINE_EXT                   DebugReportObjectTypeEXT = 19
+	DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_SET_LAYOUT_EXT      DebugReportObjectTypeEXT = 20
+	DEBUG_REPORT_OBJECT_TYPE_SAMPLER_EXT                    DebugReportObjectTypeEXT = 21
+	DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_POOL_EXT            DebugReportObjectTypeEXT = 22
+	DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_SET_EXT             DebugReportObjectTypeEXT = 23
+	DEBUG_REPORT_OBJECT_TYPE_FRAMEBUFFER_EXT                DebugReportObjectTypeEXT = 24
+	DEBUG_REPORT_OBJECT_TYPE_COMMAND_POOL_EXT               DebugReportObjectTypeEXT = 25
+	DEBUG_REPORT_OBJECT_TYPE_SURFACE_KHR_EXT                DebugReportObjectTypeEXT = 26
+	DEBUG_REPORT_OBJECT_TYPE_SWAPCHAIN_KHR_EXT              DebugReportObjectTypeEXT = 27
+	DEBUG_REPORT_OBJECT_TYPE_DEBUG_REPORT_CALLBACK_EXT_EXT  DebugReportObjectTypeEXT = 28
+	DEBUG_REPORT_OBJECT_TYPE_DISPLAY_KHR_EXT                DebugReportObjectTypeEXT = 29
+	DEBUG_REPORT_OBJECT_TYPE_DISPLAY_MODE_KHR_EXT           DebugReportObjectTypeEXT = 30
+	DEBUG_REPORT_OBJECT_TYPE_VALIDATION_CACHE_EXT_EXT       DebugReportObjectTypeEXT = 33
+	DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_UPDATE_TEMPLATE_EXT DebugReportObjectTypeEXT = 1000011000
+	DEBUG_REPORT_OBJECT_TYPE_CU_MODULE_NVX_EXT              DebugReportObjectTypeEXT = 1000029000
+	DEBUG_REPORT_OBJECT_TYPE_CU_FUNCTION_NVX_EXT            DebugReportObjectTypeEXT = 1000029001
+	DEBUG_REPORT_OBJECT_TYPE_ACCELERATION_STRUCTURE_KHR_EXT DebugReportObjectTypeEXT = 1000150000
+	DEBUG_REPORT_OBJECT_TYPE_SAMPLER_YCBCR_CONVERSION_EXT   DebugReportObjectTypeEXT = 1000156000
+	DEBUG_REPORT_OBJECT_TYPE_ACCELERATION_STRUCTURE_NV_EXT  DebugReportObjectTypeEXT = 1000165000
+	// Backwards-compatible alias containing a typo
+	DEBUG_REPORT_OBJECT_TYPE_DEBUG_REPORT_EXT                   DebugReportObjectTypeEXT = DEBUG_REPORT_OBJECT_TYPE_DEBUG_REPORT_CALLBACK_EXT_EXT
+	DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_UPDATE_TEMPLATE_KHR_EXT DebugReportObjectTypeEXT = DEBUG_REPORT_OBJECT_TYPE_DESCRIPTOR_UPDATE_TEMPLATE_EXT
+	DEBUG_REPORT_OBJECT_TYPE_SAMPLER_YCBCR_CONVERSION_KHR_EXT   DebugReportObjectTypeEXT = DEBUG_REPORT_OBJECT_TYPE_SAMPLER_YCBCR_CONVERSION_EXT
+	// Backwards-compatible alias containing a typo
+	DEBUG_REPORT_OBJECT_TYPE_VALIDATION_CACHE_EXT DebugReportObjectTypeEXT = DEBUG_REPORT_OBJECT_TYPE_VALIDATION_CACHE_EXT_EXT
+)
+
+type DebugUtilsMessageSeverityFlagBitsEXT = DebugUtilsMessageSeverityFlagsEXT
+
+const (
+	DEBUG_UTILS_MESSAGE_SEVERITY_VERBOSE_BIT_EXT DebugUtilsMessageSeverityFlagBitsEXT = 1 << 0
+	DEBUG_UTILS_MESSAGE_SEVERITY_ERROR_BIT_EXT   DebugUtilsMessageSeverityFlagBitsEXT = 1 << 12
+	DEBUG_UTILS_MESSAGE_SEVERITY_INFO_BIT_EXT    DebugUtilsMessageSeverityFlagBitsEXT = 1 << 4
+	DEBUG_UTILS_MESSAGE_SEVERITY_WARNING_BIT_EXT DebugUtilsMessageSeverityFlagBitsEXT = 1 << 8
+)
+
+type DebugUtilsMessageTypeFlagBitsEXT = DebugUtilsMessageTypeFlagsEXT
+
+const (
+	DEBUG_UTILS_MESSAGE_TYPE_GENERAL_BIT_EXT     DebugUtilsMessageTypeFlagBitsEXT = 1 << 0
+	DEBUG_UTILS_MESSAGE_TYPE_VALIDATION_BIT_EXT  DebugUtilsMessageTypeFlagBitsEXT = 1 << 1
+	DEBUG_UTILS_MESSAGE_TYPE_PERFORMANCE_BIT_EXT DebugUtilsMessageTypeFlagBitsEXT = 1 << 2
+)
+
+type DependencyFlagBits = DependencyFlags
+
+const (
+	DEPENDENCY_BY_REGION_BIT        DependencyFlagBits = 1 << 0
+	DEPENDENCY_VIEW_LOCAL_BIT       DependencyFlagBits = 1 << 1
+	DEPENDENCY_DEVICE_GROUP_BIT     DependencyFlagBits = 1 << 2
+	DEPENDENCY_DEVICE_GROUP_BIT_KHR DependencyFlagBits = DEPENDENCY_DEVICE_GROUP_BIT
+	DEPENDENCY_VIEW_LOCAL_BIT_KHR   DependencyFlagBits = DEPENDENCY_VIEW_LOCAL_BIT
+)
+
+type DescriptorBindingFlagBits = DescriptorBindingFlags
+
+const (
+	DESCRIPTOR_BINDING_UPDATE_AFTER_BIND_BIT               DescriptorBindingFlagBits = 1 << 0
+	DESCRIPTOR_BINDING_UPDATE_UNUSED_WHILE_PENDING_BIT     DescriptorBindingFlagBits = 1 << 1
+	DESCRIPTOR_BINDING_PARTIALLY_BOUND_BIT                 DescriptorBindingFlagBits = 1 << 2
+	DESCRIPTOR_BINDING_VARIABLE_DESCRIPTOR_COUNT_BIT       DescriptorBindingFlagBits = 1 << 3
+	DESCRIPTOR_BINDING_PARTIALLY_BOUND_BIT_EXT             DescriptorBindingFlagBits = DESCRIPTOR_BINDING_PARTIALLY_BOUND_BIT
+	DESCRIPTOR_BINDING_UPDATE_AFTER_BIND_BIT_EXT           DescriptorBindingFlagBits = DESCRIPTOR_BINDING_UPDATE_AFTER_BIND_BIT
+	DESCRIPTOR_BINDING_UPDATE_UNUSED_WHILE_PENDING_BIT_EXT DescriptorBindingFlagBits = DESCRIPTOR_BINDING_UPDATE_UNUSED_WHILE_PENDING_BIT
+	DESCRIPTOR_BINDING_VARIABLE_DESCRIPTOR_COUNT_BIT_EXT   DescriptorBindingFlagBits = DESCRIPTOR_BINDING_VARIABLE_DESCRIPTOR_COUNT_BIT
+)
+
+// DescriptorBindingFlagBitsEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkDescriptorBindingFlagBitsEXT.html
+type DescriptorBindingFlagBitsEXT = DescriptorBindingFlagBits
+type DescriptorPoolCreateFlagBits = DescriptorPoolCreateFlags
+
+const (
+	DESCRIPTOR_POOL_CREATE_FREE_DESCRIPTOR_SET_BIT   DescriptorPoolCreateFlagBits = 1 << 0
+	DESCRIPTOR_POOL_CREATE_UPDATE_AFTER_BIND_BIT     DescriptorPoolCreateFlagBits = 1 << 1
+	DESCRIPTOR_POOL_CREATE_HOST_ONLY_BIT_VALVE       DescriptorPoolCreateFlagBits = 1000351000
+	DESCRIPTOR_POOL_CREATE_UPDATE_AFTER_BIND_BIT_EXT DescriptorPoolCreateFlagBits = DESCRIPTOR_POOL_CREATE_UPDATE_AFTER_BIND_BIT
+)
+
+type DescriptorSetLayoutCreateFlagBits = DescriptorSetLayoutCreateFlags
+
+const (
+	DESCRIPTOR_SET_LAYOUT_CREATE_UPDATE_AFTER_BIND_POOL_BIT DescriptorSetLayoutCreateFlagBits = 1 << 1
+	// Descriptors are pushed via flink:vkCmdPushDescriptorSetKHR
+	DESCRIPTOR_SET_LAYOUT_CREATE_PUSH_DESCRIPTOR_BIT_KHR        DescriptorSetLayoutCreateFlagBits = 1000080000
+	DESCRIPTOR_SET_LAYOUT_CREATE_HOST_ONLY_POOL_BIT_VALVE       DescriptorSetLayoutCreateFlagBits = 1000351000
+	DESCRIPTOR_SET_LAYOUT_CREATE_UPDATE_AFTER_BIND_POOL_BIT_EXT DescriptorSetLayoutCreateFlagBits = DESCRIPTOR_SET_LAYOUT_CREATE_UPDATE_AFTER_BIND_POOL_BIT
+)
+
+// DescriptorType: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkDescriptorType.html
+type DescriptorType int32
+
+const (
+	DESCRIPTOR_TYPE_SAMPLER                    DescriptorType = 0
+	DESCRIPTOR_TYPE_COMBINED_IMAGE_SAMPLER     DescriptorType = 1
+	DESCRIPTOR_TYPE_SAMPLED_IMAGE              DescriptorType = 2
+	DESCRIPTOR_TYPE_STORAGE_IMAGE              DescriptorType = 3
+	DESCRIPTOR_TYPE_UNIFORM_TEXEL_BUFFER       DescriptorType = 4
+	DESCRIPTOR_TYPE_STORAGE_TEXEL_BUFFER       DescriptorType = 5
+	DESCRIPTOR_TYPE_UNIFORM_BUFFER             DescriptorType = 6
+	DESCRIPTOR_TYPE_STORAGE_BUFFER             DescriptorType = 7
+	DESCRIPTOR_TYPE_UNIFORM_BUFFER_DYNAMIC     DescriptorType = 8
+	DESCRIPTOR_TYPE_STORAGE_BUFFER_DYNAMIC     DescriptorType = 9
+	DESCRIPTOR_TYPE_INPUT_ATTACHMENT           DescriptorType = 10
+	DESCRIPTOR_TYPE_INLINE_UNIFORM_BLOCK_EXT   DescriptorType = 1000138000
+	DESCRIPTOR_TYPE_ACCELERATION_STRUCTURE_KHR DescriptorType = 1000150000
+	DESCRIPTOR_TYPE_ACCELERATION_STRUCTURE_NV  DescriptorType = 1000165000
+	DESCRIPTOR_TYPE_MUTABLE_VALVE              DescriptorType = 1000351000
+)
+
+// DescriptorUpdateTemplateType: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkDescriptorUpdateTemplateType.html
+type DescriptorUpdateTemplateType int32
+
+const (
+	// Create descriptor update template for descriptor set updates
+	DESCRIPTOR_UPDATE_TEMPLATE_TYPE_DESCRIPTOR_SET DescriptorUpdateTemplateType = 0
+	// Create descriptor update template for pushed descriptor updates
+	DESCRIPTOR_UPDATE_TEMPLATE_TYPE_PUSH_DESCRIPTORS_KHR DescriptorUpdateTemplateType = 1000085000
+	DESCRIPTOR_UPDATE_TEMPLATE_TYPE_DESCRIPTOR_SET_KHR   DescriptorUpdateTemplateType = DESCRIPTOR_UPDATE_TEMPLATE_TYPE_DESCRIPTOR_SET
+)
+
+// DescriptorUpdateTemplateTypeKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkDescriptorUpdateTemplateTypeKHR.html
+type DescriptorUpdateTemplateTypeKHR = DescriptorUpdateTemplateType
+type DeviceDiagnosticsConfigFlagBitsNV = DeviceDiagnosticsConfigFlagsNV
+
+const (
+	DEVICE_DIAGNOSTICS_CONFIG_ENABLE_SHADER_DEBUG_INFO_BIT_NV     DeviceDiagnosticsConfigFlagBitsNV = 1 << 0
+	DEVICE_DIAGNOSTICS_CONFIG_ENABLE_RESOURCE_TRACKING_BIT_NV     DeviceDiagnosticsConfigFlagBitsNV = 1 << 1
+	DEVICE_DIAGNOSTICS_CONFIG_ENABLE_AUTOMATIC_CHECKPOINTS_BIT_NV DeviceDiagnosticsConfigFlagBitsNV = 1 << 2
+)
+
+// DeviceEventTypeEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkDeviceEventTypeEXT.html
+type DeviceEventTypeEXT int32
+
+const (
+	DEVICE_EVENT_TYPE_DISPLAY_HOTPLUG_EXT DeviceEventTypeEXT = 0
+)
+
+type DeviceGroupPresentModeFlagBitsKHR = DeviceGroupPresentModeFlagsKHR
+
+const (
+	DEVICE_GROUP_PRESENT_MODE_LOCAL_BIT_KHR              DeviceGroupPresentModeFlagBitsKHR = 1 << 0
+	DEVICE_GROUP_PRESENT_MODE_REMOTE_BIT_KHR             DeviceGroupPresentModeFlagBitsKHR = 1 << 1
+	DEVICE_GROUP_PRESENT_MODE_SUM_BIT_KHR                DeviceGroupPresentModeFlagBitsKHR = 1 << 2
+	DEVICE_GROUP_PRESENT_MODE_LOCAL_MULTI_DEVICE_BIT_KHR DeviceGroupPresentModeFlagBitsKHR = 1 << 3
+)
+
+// DeviceMemoryReportEventTypeEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkDeviceMemoryReportEventTypeEXT.html
+type DeviceMemoryReportEventTypeEXT int32
+
+const (
+	DEVICE_MEMORY_REPORT_EVENT_TYPE_ALLOCATE_EXT          DeviceMemoryReportEventTypeEXT = 0
+	DEVICE_MEMORY_REPORT_EVENT_TYPE_FREE_EXT              DeviceMemoryReportEventTypeEXT = 1
+	DEVICE_MEMORY_REPORT_EVENT_TYPE_IMPORT_EXT            DeviceMemoryReportEventTypeEXT = 2
+	DEVICE_MEMORY_REPORT_EVENT_TYPE_UNIMPORT_EXT          DeviceMemoryReportEventTypeEXT = 3
+	DEVICE_MEMORY_REPORT_EVENT_TYPE_ALLOCATION_FAILED_EXT DeviceMemoryReportEventTypeEXT = 4
+)
+
+type DeviceQueueCreateFlagBits = DeviceQueueCreateFlags
+
+const (
+	DEVICE_QUEUE_CREATE_PROTECTED_BIT DeviceQueueCreateFlagBits = 1 << 0
+)
+
+// DiscardRectangleModeEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkDiscardRectangleModeEXT.html
+type DiscardRectangleModeEXT int32
+
+const (
+	DISCARD_RECTANGLE_MODE_INCLUSIVE_EXT DiscardRectangleModeEXT = 0
+	DISCARD_RECTANGLE_MODE_EXCLUSIVE_EXT DiscardRectangleModeEXT = 1
+)
+
+// DisplayEventTypeEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkDisplayEventTypeEXT.html
+type DisplayEventTypeEXT int32
+
+const (
+	DISPLAY_EVENT_TYPE_FIRST_PIXEL_OUT_EXT DisplayEventTypeEXT = 0
+)
+
+type DisplayPlaneAlphaFlagBitsKHR = DisplayPlaneAlphaFlagsKHR
+
+const (
+	DISPLAY_PLANE_ALPHA_OPAQUE_BIT_KHR                  DisplayPlaneAlphaFlagBitsKHR = 1 << 0
+	DISPLAY_PLANE_ALPHA_GLOBAL_BIT_KHR                  DisplayPlaneAlphaFlagBitsKHR = 1 << 1
+	DISPLAY_PLANE_ALPHA_PER_PIXEL_BIT_KHR               DisplayPlaneAlphaFlagBitsKHR = 1 << 2
+	DISPLAY_PLANE_ALPHA_PER_PIXEL_PREMULTIPLIED_BIT_KHR DisplayPlaneAlphaFlagBitsKHR = 1 << 3
+)
+
+// DisplayPowerStateEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkDisplayPowerStateEXT.html
+type DisplayPowerStateEXT int32
+
+const (
+	DISPLAY_POWER_STATE_OFF_EXT     DisplayPowerStateEXT = 0
+	DISPLAY_POWER_STATE_SUSPEND_EXT DisplayPowerStateEXT = 1
+	DISPLAY_POWER_STATE_ON_EXT      DisplayPowerStateEXT = 2
+)
+
+// DriverId: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkDriverId.html
+type DriverId int32
+
+const (
+	// Advanced Micro Devices, Inc.
+	DRIVER_ID_AMD_PROPRIETARY DriverId = 1
+	// Advanced Micro Devices, Inc.
+	DRIVER_ID_AMD_OPEN_SOURCE DriverId = 2
+	// Mesa open source project
+	DRIVER_ID_MESA_RADV DriverId = 3
+	// NVIDIA Corporation
+	DRIVER_ID_NVIDIA_PROPRIETARY DriverId = 4
+	// Intel Corporation
+	DRIVER_ID_INTEL_PROPRIETARY_WINDOWS DriverId = 5
+	// Intel Corporation
+	DRIVER_ID_INTEL_OPEN_SOURCE_MESA DriverId = 6
+	// Imagination Technologies
+	DRIVER_ID_IMAGINATION_PROPRIETARY DriverId = 7
+	// Qualcomm Technologies, Inc.
+	DRIVER_ID_QUALCOMM_PROPRIETARY DriverId = 8
+	// Arm Limited
+	DRIVER_ID_ARM_PROPRIETARY DriverId = 9
+	// Google LLC
+	DRIVER_ID_GOOGLE_SWIFTSHADER DriverId = 10
+	// Google LLC
+	DRIVER_ID_GGP_PROPRIETARY DriverId = 11
+	// Broadcom Inc.
+	DRIVER_ID_BROADCOM_PROPRIETARY DriverId = 12
+	// Mesa
+	DRIVER_ID_MESA_LLVMPIPE DriverId = 13
+	// MoltenVK
+	DRIVER_ID_MOLTENVK DriverId = 14
+	// Core Avionics & Industrial Inc.
+	DRIVER_ID_COREAVI_PROPRIETARY DriverId = 15
+	// Juice Technologies, Inc.
+	DRIVER_ID_JUICE_PROPRIETARY DriverId = 16
+	// Verisilicon, Inc.
+	DRIVER_ID_VERISILICON_PROPRIETARY       DriverId = 17
+	DRIVER_ID_AMD_OPEN_SOURCE_KHR           DriverId = DRIVER_ID_AMD_OPEN_SOURCE
+	DRIVER_ID_AMD_PROPRIETARY_KHR           DriverId = DRIVER_ID_AMD_PROPRIETARY
+	DRIVER_ID_ARM_PROPRIETARY_KHR           DriverId = DRIVER_ID_ARM_PROPRIETARY
+	DRIVER_ID_BROADCOM_PROPRIETARY_KHR      DriverId = DRIVER_ID_BROADCOM_PROPRIETARY
+	DRIVER_ID_GGP_PROPRIETARY_KHR           DriverId = DRIVER_ID_GGP_PROPRIETARY
+	DRIVER_ID_GOOGLE_SWIFTSHADER_KHR        DriverId = DRIVER_ID_GOOGLE_SWIFTSHADER
+	DRIVER_ID_IMAGINATION_PROPRIETARY_KHR   DriverId = DRIVER_ID_IMAGINATION_PROPRIETARY
+	DRIVER_ID_INTEL_OPEN_SOURCE_MESA_KHR    DriverId = DRIVER_ID_INTEL_OPEN_SOURCE_MESA
+	DRIVER_ID_INTEL_PROPRIETARY_WINDOWS_KHR DriverId = DRIVER_ID_INTEL_PROPRIETARY_WINDOWS
+	DRIVER_ID_MESA_RADV_KHR                 DriverId = DRIVER_ID_MESA_RADV
+	DRIVER_ID_NVIDIA_PROPRIETARY_KHR        DriverId = DRIVER_ID_NVIDIA_PROPRIETARY
+	DRIVER_ID_QUALCOMM_PROPRIETARY_KHR      DriverId = DRIVER_ID_QUALCOMM_PROPRIETARY
+)
+
+// DriverIdKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkDriverIdKHR.html
+type DriverIdKHR = DriverId
+
+// DynamicState: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkDynamicState.html
+type DynamicState int32
+
+const (
+	DYNAMIC_STATE_VIEWPORT                            DynamicState = 0
+	DYNAMIC_STATE_SCISSOR                             DynamicState = 1
+	DYNAMIC_STATE_LINE_WIDTH                          DynamicState = 2
+	DYNAMIC_STATE_DEPTH_BIAS                          DynamicState = 3
+	DYNAMIC_STATE_BLEND_CONSTANTS                     DynamicState = 4
+	DYNAMIC_STATE_DEPTH_BOUNDS                        DynamicState = 5
+	DYNAMIC_STATE_STENCIL_COMPARE_MASK                DynamicState = 6
+	DYNAMIC_STATE_STENCIL_WRITE_MASK                  DynamicState = 7
+	DYNAMIC_STATE_STENCIL_REFERENCE                   DynamicState = 8
+	DYNAMIC_STATE_VIEWPORT_W_SCALING_NV               DynamicState = 1000087000
+	DYNAMIC_STATE_DISCARD_RECTANGLE_EXT               DynamicState = 1000099000
+	DYNAMIC_STATE_SAMPLE_LOCATIONS_EXT                DynamicState = 1000143000
+	DYNAMIC_STATE_VIEWPORT_SHADING_RATE_PALETTE_NV    DynamicState = 1000164004
+	DYNAMIC_STATE_VIEWPORT_COARSE_SAMPLE_ORDER_NV     DynamicState = 1000164006
+	DYNAMIC_STATE_EXCLUSIVE_SCISSOR_NV                DynamicState = 1000205001
+	DYNAMIC_STATE_FRAGMENT_SHADING_RATE_KHR           DynamicState = 1000226000
+	DYNAMIC_STATE_LINE_STIPPLE_EXT                    DynamicState = 1000259000
+	DYNAMIC_STATE_CULL_MODE_EXT                       DynamicState = 1000267000
+	DYNAMIC_STATE_FRONT_FACE_EXT                      DynamicState = 1000267001
+	DYNAMIC_STATE_PRIMITIVE_TOPOLOGY_EXT              DynamicState = 1000267002
+	DYNAMIC_STATE_VIEWPORT_WITH_COUNT_EXT             DynamicState = 1000267003
+	DYNAMIC_STATE_SCISSOR_WITH_COUNT_EXT              DynamicState = 1000267004
+	DYNAMIC_STATE_VERTEX_INPUT_BINDING_STRIDE_EXT     DynamicState = 1000267005
+	DYNAMIC_STATE_DEPTH_TEST_ENABLE_EXT               DynamicState = 1000267006
+	DYNAMIC_STATE_DEPTH_WRITE_ENABLE_EXT              DynamicState = 1000267007
+	DYNAMIC_STATE_DEPTH_COMPARE_OP_EXT                DynamicState = 1000267008
+	DYNAMIC_STATE_DEPTH_BOUNDS_TEST_ENABLE_EXT        DynamicState = 1000267009
+	DYNAMIC_STATE_STENCIL_TEST_ENABLE_EXT             DynamicState = 1000267010
+	DYNAMIC_STATE_STENCIL_OP_EXT                      DynamicState = 1000267011
+	DYNAMIC_STATE_RAY_TRACING_PIPELINE_STACK_SIZE_KHR DynamicState = 1000347000
+	DYNAMIC_STATE_VERTEX_INPUT_EXT                    DynamicState = 1000352000
+	DYNAMIC_STATE_PATCH_CONTROL_POINTS_EXT            DynamicState = 1000377000
+	DYNAMIC_STATE_RASTERIZER_DISCARD_ENABLE_EXT       DynamicState = 1000377001
+	DYNAMIC_STATE_DEPTH_BIAS_ENABLE_EXT               DynamicState = 1000377002
+	DYNAMIC_STATE_LOGIC_OP_EXT                        DynamicState = 1000377003
+	DYNAMIC_STATE_PRIMITIVE_RESTART_ENABLE_EXT        DynamicState = 1000377004
+	DYNAMIC_STATE_COLOR_WRITE_ENABLE_EXT              DynamicState = 1000381000
+)
+
+type EventCreateFlagBits = EventCreateFlags
+
+const (
+	EVENT_CREATE_DEVICE_ONLY_BIT_KHR EventCreateFlagBits = 1000314000
+)
+
+type ExternalFenceFeatureFlagBits = ExternalFenceFeatureFlags
+
+const (
+	EXTERNAL_FENCE_FEATURE_EXPORTABLE_BIT     ExternalFenceFeatureFlagBits = 1 << 0
+	EXTERNAL_FENCE_FEATURE_IMPORTABLE_BIT     ExternalFenceFeatureFlagBits = 1 << 1
+	EXTERNAL_FENCE_FEATURE_EXPORTABLE_BIT_KHR ExternalFenceFeatureFlagBits = EXTERNAL_FENCE_FEATURE_EXPORTABLE_BIT
+	EXTERNAL_FENCE_FEATURE_IMPORTABLE_BIT_KHR ExternalFenceFeatureFlagBits = EXTERNAL_FENCE_FEATURE_IMPORTABLE_BIT
+)
+
+// ExternalFenceFeatureFlagBitsKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkExternalFenceFeatureFlagBitsKHR.html
+type ExternalFenceFeatureFlagBitsKHR = ExternalFenceFeatureFlagBits
+type ExternalFenceHandleTypeFlagBits = ExternalFenceHandleTypeFlags
+
+const (
+	EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_FD_BIT            ExternalFenceHandleTypeFlagBits = 1 << 0
+	EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_WIN32_BIT         ExternalFenceHandleTypeFlagBits = 1 << 1
+	EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT     ExternalFenceHandleTypeFlagBits = 1 << 2
+	EXTERNAL_FENCE_HANDLE_TYPE_SYNC_FD_BIT              ExternalFenceHandleTypeFlagBits = 1 << 3
+	EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_FD_BIT_KHR        ExternalFenceHandleTypeFlagBits = EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_FD_BIT
+	EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_WIN32_BIT_KHR     ExternalFenceHandleTypeFlagBits = EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_WIN32_BIT
+	EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT_KHR ExternalFenceHandleTypeFlagBits = EXTERNAL_FENCE_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT
+	EXTERNAL_FENCE_HANDLE_TYPE_SYNC_FD_BIT_KHR          ExternalFenceHandleTypeFlagBits = EXTERNAL_FENCE_HANDLE_TYPE_SYNC_FD_BIT
+)
+
+// ExternalFenceHandleTypeFlagBitsKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkExternalFenceHandleTypeFlagBitsKHR.html
+type ExternalFenceHandleTypeFlagBitsKHR = ExternalFenceHandleTypeFlagBits
+type ExternalMemoryFeatureFlagBits = ExternalMemoryFeatureFlags
+
+const (
+	EXTERNAL_MEMORY_FEATURE_DEDICATED_ONLY_BIT     ExternalMemoryFeatureFlagBits = 1 << 0
+	EXTERNAL_MEMORY_FEATURE_EXPORTABLE_BIT         ExternalMemoryFeatureFlagBits = 1 << 1
+	EXTERNAL_MEMORY_FEATURE_IMPORTABLE_BIT         ExternalMemoryFeatureFlagBits = 1 << 2
+	EXTERNAL_MEMORY_FEATURE_DEDICATED_ONLY_BIT_KHR ExternalMemoryFeatureFlagBits = EXTERNAL_MEMORY_FEATURE_DEDICATED_ONLY_BIT
+	EXTERNAL_MEMORY_FEATURE_EXPORTABLE_BIT_KHR     ExternalMemoryFeatureFlagBits = EXTERNAL_MEMORY_FEATURE_EXPORTABLE_BIT
+	EXTERNAL_MEMORY_FEATURE_IMPORTABLE_BIT_KHR     ExternalMemoryFeatureFlagBits = EXTERNAL_MEMORY_FEATURE_IMPORTABLE_BIT
+)
+
+// ExternalMemoryFeatureFlagBitsKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkExternalMemoryFeatureFlagBitsKHR.html
+type ExternalMemoryFeatureFlagBitsKHR = ExternalMemoryFeatureFlagBits
+type ExternalMemoryFeatureFlagBitsNV = ExternalMemoryFeatureFlagsNV
+
+const (
+	EXTERNAL_MEMORY_FEATURE_DEDICATED_ONLY_BIT_NV ExternalMemoryFeatureFlagBitsNV = 1 << 0
+	EXTERNAL_MEMORY_FEATURE_EXPORTABLE_BIT_NV     ExternalMemoryFeatureFlagBitsNV = 1 << 1
+	EXTERNAL_MEMORY_FEATURE_IMPORTABLE_BIT_NV     ExternalMemoryFeatureFlagBitsNV = 1 << 2
+)
+
+type ExternalMemoryHandleTypeFlagBits = ExternalMemoryHandleTypeFlags
+
+const (
+	EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_FD_BIT                      ExternalMemoryHandleTypeFlagBits = 1 << 0
+	EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_BIT                   ExternalMemoryHandleTypeFlagBits = 1 << 1
+	EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT               ExternalMemoryHandleTypeFlagBits = 1 << 2
+	EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_TEXTURE_BIT                  ExternalMemoryHandleTypeFlagBits = 1 << 3
+	EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_TEXTURE_KMT_BIT              ExternalMemoryHandleTypeFlagBits = 1 << 4
+	EXTERNAL_MEMORY_HANDLE_TYPE_D3D12_HEAP_BIT                     ExternalMemoryHandleTypeFlagBits = 1 << 5
+	EXTERNAL_MEMORY_HANDLE_TYPE_D3D12_RESOURCE_BIT                 ExternalMemoryHandleTypeFlagBits = 1 << 6
+	EXTERNAL_MEMORY_HANDLE_TYPE_DMA_BUF_BIT_EXT                    ExternalMemoryHandleTypeFlagBits = 1000125000
+	EXTERNAL_MEMORY_HANDLE_TYPE_HOST_ALLOCATION_BIT_EXT            ExternalMemoryHandleTypeFlagBits = 1000178000
+	EXTERNAL_MEMORY_HANDLE_TYPE_HOST_MAPPED_FOREIGN_MEMORY_BIT_EXT ExternalMemoryHandleTypeFlagBits = 1000178000
+	EXTERNAL_MEMORY_HANDLE_TYPE_RDMA_ADDRESS_BIT_NV                ExternalMemoryHandleTypeFlagBits = 1000371000
+	EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_TEXTURE_BIT_KHR              ExternalMemoryHandleTypeFlagBits = EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_TEXTURE_BIT
+	EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_TEXTURE_KMT_BIT_KHR          ExternalMemoryHandleTypeFlagBits = EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_TEXTURE_KMT_BIT
+	EXTERNAL_MEMORY_HANDLE_TYPE_D3D12_HEAP_BIT_KHR                 ExternalMemoryHandleTypeFlagBits = EXTERNAL_MEMORY_HANDLE_TYPE_D3D12_HEAP_BIT
+	EXTERNAL_MEMORY_HANDLE_TYPE_D3D12_RESOURCE_BIT_KHR             ExternalMemoryHandleTypeFlagBits = EXTERNAL_MEMORY_HANDLE_TYPE_D3D12_RESOURCE_BIT
+	EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_FD_BIT_KHR                  ExternalMemoryHandleTypeFlagBits = EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_FD_BIT
+	EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_BIT_KHR               ExternalMemoryHandleTypeFlagBits = EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_BIT
+	EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT_KHR           ExternalMemoryHandleTypeFlagBits = EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT
+)
+
+// ExternalMemoryHandleTypeFlagBitsKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkExternalMemoryHandleTypeFlagBitsKHR.html
+type ExternalMemoryHandleTypeFlagBitsKHR = ExternalMemoryHandleTypeFlagBits
+type ExternalMemoryHandleTypeFlagBitsNV = ExternalMemoryHandleTypeFlagsNV
+
+const (
+	EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_BIT_NV     ExternalMemoryHandleTypeFlagBitsNV = 1 << 0
+	EXTERNAL_MEMORY_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT_NV ExternalMemoryHandleTypeFlagBitsNV = 1 << 1
+	EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_IMAGE_BIT_NV      ExternalMemoryHandleTypeFlagBitsNV = 1 << 2
+	EXTERNAL_MEMORY_HANDLE_TYPE_D3D11_IMAGE_KMT_BIT_NV  ExternalMemoryHandleTypeFlagBitsNV = 1 << 3
+)
+
+type ExternalSemaphoreFeatureFlagBits = ExternalSemaphoreFeatureFlags
+
+const (
+	EXTERNAL_SEMAPHORE_FEATURE_EXPORTABLE_BIT     ExternalSemaphoreFeatureFlagBits = 1 << 0
+	EXTERNAL_SEMAPHORE_FEATURE_IMPORTABLE_BIT     ExternalSemaphoreFeatureFlagBits = 1 << 1
+	EXTERNAL_SEMAPHORE_FEATURE_EXPORTABLE_BIT_KHR ExternalSemaphoreFeatureFlagBits = EXTERNAL_SEMAPHORE_FEATURE_EXPORTABLE_BIT
+	EXTERNAL_SEMAPHORE_FEATURE_IMPORTABLE_BIT_KHR ExternalSemaphoreFeatureFlagBits = EXTERNAL_SEMAPHORE_FEATURE_IMPORTABLE_BIT
+)
+
+// ExternalSemaphoreFeatureFlagBitsKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkExternalSemaphoreFeatureFlagBitsKHR.html
+type ExternalSemaphoreFeatureFlagBitsKHR = ExternalSemaphoreFeatureFlagBits
+type ExternalSemaphoreHandleTypeFlagBits = ExternalSemaphoreHandleTypeFlags
+
+const (
+	EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_FD_BIT            ExternalSemaphoreHandleTypeFlagBits = 1 << 0
+	EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_WIN32_BIT         ExternalSemaphoreHandleTypeFlagBits = 1 << 1
+	EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT     ExternalSemaphoreHandleTypeFlagBits = 1 << 2
+	EXTERNAL_SEMAPHORE_HANDLE_TYPE_D3D12_FENCE_BIT          ExternalSemaphoreHandleTypeFlagBits = 1 << 3
+	EXTERNAL_SEMAPHORE_HANDLE_TYPE_SYNC_FD_BIT              ExternalSemaphoreHandleTypeFlagBits = 1 << 4
+	EXTERNAL_SEMAPHORE_HANDLE_TYPE_D3D11_FENCE_BIT          ExternalSemaphoreHandleTypeFlagBits = EXTERNAL_SEMAPHORE_HANDLE_TYPE_D3D12_FENCE_BIT
+	EXTERNAL_SEMAPHORE_HANDLE_TYPE_D3D12_FENCE_BIT_KHR      ExternalSemaphoreHandleTypeFlagBits = EXTERNAL_SEMAPHORE_HANDLE_TYPE_D3D12_FENCE_BIT
+	EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_FD_BIT_KHR        ExternalSemaphoreHandleTypeFlagBits = EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_FD_BIT
+	EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_WIN32_BIT_KHR     ExternalSemaphoreHandleTypeFlagBits = EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_WIN32_BIT
+	EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT_KHR ExternalSemaphoreHandleTypeFlagBits = EXTERNAL_SEMAPHORE_HANDLE_TYPE_OPAQUE_WIN32_KMT_BIT
+	EXTERNAL_SEMAPHORE_HANDLE_TYPE_SYNC_FD_BIT_KHR          ExternalSemaphoreHandleTypeFlagBits = EXTERNAL_SEMAPHORE_HANDLE_TYPE_SYNC_FD_BIT
+)
+
+// ExternalSemaphoreHandleTypeFlagBitsKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkExternalSemaphoreHandleTypeFlagBitsKHR.html
+type ExternalSemaphoreHandleTypeFlagBitsKHR = ExternalSemaphoreHandleTypeFlagBits
+type FenceCreateFlagBits = FenceCreateFlags
+
+const (
+	FENCE_CREATE_SIGNALED_BIT FenceCreateFlagBits = 1 << 0
+)
+
+type FenceImportFlagBits = FenceImportFlags
+
+const (
+	FENCE_IMPORT_TEMPORARY_BIT     FenceImportFlagBits = 1 << 0
+	FENCE_IMPORT_TEMPORARY_BIT_KHR FenceImportFlagBits = FENCE_IMPORT_TEMPORARY_BIT
+)
+
+// FenceImportFlagBitsKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkFenceImportFlagBitsKHR.html
+type FenceImportFlagBitsKHR = FenceImportFlagBits
+
+// Filter: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkFilter.html
+type Filter int32
+
+const (
+	FILTER_NEAREST   Filter = 0
+	FILTER_LINEAR    Filter = 1
+	FILTER_CUBIC_IMG Filter = 1000015000
+	FILTER_CUBIC_EXT Filter = FILTER_CUBIC_IMG
+)
+
+// Format: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkFormat.html
+type Format int32
+
+const (
+	FORMAT_UNDEFINED                                      Format = 0
+	FORMAT_R4G4_UNORM_PACK8                               Format = 1
+	FORMAT_R4G4B4A4_UNORM_PACK16                          Format = 2
+	FORMAT_B4G4R4A4_UNORM_PACK16                          Format = 3
+	FORMAT_R5G6B5_UNORM_PACK16                            Format = 4
+	FORMAT_B5G6R5_UNORM_PACK16                            Format = 5
+	FORMAT_R5G5B5A1_UNORM_PACK16                          Format = 6
+	FORMAT_B5G5R5A1_UNORM_PACK16                          Format = 7
+	FORMAT_A1R5G5B5_UNORM_PACK16                          Format = 8
+	FORMAT_R8_UNORM                                       Format = 9
+	FORMAT_R8_SNORM                                       Format = 10
+	FORMAT_R8_USCALED                                     Format = 11
+	FORMAT_R8_SSCALED                                     Format = 12
+	FORMAT_R8_UINT                                        Format = 13
+	FORMAT_R8_SINT                                        Format = 14
+	FORMAT_R8_SRGB                                        Format = 15
+	FORMAT_R8G8_UNORM                                     Format = 16
+	FORMAT_R8G8_SNORM                                     Format = 17
+	FORMAT_R8G8_USCALED                                   Format = 18
+	FORMAT_R8G8_SSCALED                                   Format = 19
+	FORMAT_R8G8_UINT                                      Format = 20
+	FORMAT_R8G8_SINT                                      Format = 21
+	FORMAT_R8G8_SRGB                                      Format = 22
+	FORMAT_R8G8B8_UNORM                                   Format = 23
+	FORMAT_R8G8B8_SNORM                                   Format = 24
+	FORMAT_R8G8B8_USCALED                                 Format = 25
+	FORMAT_R8G8B8_SSCALED                                 Format = 26
+	FORMAT_R8G8B8_UINT                                    Format = 27
+	FORMAT_R8G8B8_SINT                                    Format = 28
+	FORMAT_R8G8B8_SRGB                                    Format = 29
+	FORMAT_B8G8R8_UNORM                                   Format = 30
+	FORMAT_B8G8R8_SNORM                                   Format = 31
+	FORMAT_B8G8R8_USCALED                                 Format = 32
+	FORMAT_B8G8R8_SSCALED                                 Format = 33
+	FORMAT_B8G8R8_UINT                                    Format = 34
+	FORMAT_B8G8R8_SINT                                    Format = 35
+	FORMAT_B8G8R8_SRGB                                    Format = 36
+	FORMAT_R8G8B8A8_UNORM                                 Format = 37
+	FORMAT_R8G8B8A8_SNORM                                 Format = 38
+	FORMAT_R8G8B8A8_USCALED                               Format = 39
+	FORMAT_R8G8B8A8_SSCALED                               Format = 40
+	FORMAT_R8G8B8A8_UINT                                  Format = 41
+	FORMAT_R8G8B8A8_SINT                                  Format = 42
+	FORMAT_R8G8B8A8_SRGB                                  Format = 43
+	FORMAT_B8G8R8A8_UNORM                                 Format = 44
+	FORMAT_B8G8R8A8_SNORM                                 Format = 45
+	FORMAT_B8G8R8A8_USCALED                               Format = 46
+	FORMAT_B8G8R8A8_SSCALED                               Format = 47
+	FORMAT_B8G8R8A8_UINT                                  Format = 48
+	FORMAT_B8G8R8A8_SINT                                  Format = 49
+	FORMAT_B8G8R8A8_SRGB                                  Format = 50
+	FORMAT_A8B8G8R8_UNORM_PACK32                          Format = 51
+	FORMAT_A8B8G8R8_SNORM_PACK32                          Format = 52
+	FORMAT_A8B8G8R8_USCALED_PACK32                        Format = 53
+	FORMAT_A8B8G8R8_SSCALED_PACK32                        Format = 54
+	FORMAT_A8B8G8R8_UINT_PACK32                           Format = 55
+	FORMAT_A8B8G8R8_SINT_PACK32                           Format = 56
+	FORMAT_A8B8G8R8_SRGB_PACK32                           Format = 57
+	FORMAT_A2R10G10B10_UNORM_PACK32                       Format = 58
+	FORMAT_A2R10G10B10_SNORM_PACK32                       Format = 59
+	FORMAT_A2R10G10B10_USCALED_PACK32                     Format = 60
+	FORMAT_A2R10G10B10_SSCALED_PACK32                     Format = 61
+	FORMAT_A2R10G10B10_UINT_PACK32                        Format = 62
+	FORMAT_A2R10G10B10_SINT_PACK32                        Format = 63
+	FORMAT_A2B10G10R10_UNORM_PACK32                       Format = 64
+	FORMAT_A2B10G10R10_SNORM_PACK32                       Format = 65
+	FORMAT_A2B10G10R10_USCALED_PACK32                     Format = 66
+	FORMAT_A2B10G10R10_SSCALED_PACK32                     Format = 67
+	FORMAT_A2B10G10R10_UINT_PACK32                        Format = 68
+	FORMAT_A2B10G10R10_SINT_PACK32                        Format = 69
+	FORMAT_R16_UNORM                                      Format = 70
+	FORMAT_R16_SNORM                                      Format = 71
+	FORMAT_R16_USCALED                                    Format = 72
+	FORMAT_R16_SSCALED                                    Format = 73
+	FORMAT_R16_UINT                                       Format = 74
+	FORMAT_R16_SINT                                       Format = 75
+	FORMAT_R16_SFLOAT                                     Format = 76
+	FORMAT_R16G16_UNORM                                   Format = 77
+	FORMAT_R16G16_SNORM                                   Format = 78
+	FORMAT_R16G16_USCALED                                 Format = 79
+	FORMAT_R16G16_SSCALED                                 Format = 80
+	FORMAT_R16G16_UINT                                    Format = 81
+	FORMAT_R16G16_SINT                                    Format = 82
+	FORMAT_R16G16_SFLOAT                                  Format = 83
+	FORMAT_R16G16B16_UNORM                                Format = 84
+	FORMAT_R16G16B16_SNORM                                Format = 85
+	FORMAT_R16G16B16_USCALED                              Format = 86
+	FORMAT_R16G16B16_SSCALED                              Format = 87
+	FORMAT_R16G16B16_UINT                                 Format = 88
+	FORMAT_R16G16B16_SINT                                 Format = 89
+	FORMAT_R16G16B16_SFLOAT                               Format = 90
+	FORMAT_R16G16B16A16_UNORM                             Format = 91
+	FORMAT_R16G16B16A16_SNORM                             Format = 92
+	FORMAT_R16G16B16A16_USCALED                           Format = 93
+	FORMAT_R16G16B16A16_SSCALED                           Format = 94
+	FORMAT_R16G16B16A16_UINT                              Format = 95
+	FORMAT_R16G16B16A16_SINT                              Format = 96
+	FORMAT_R16G16B16A16_SFLOAT                            Format = 97
+	FORMAT_R32_UINT                                       Format = 98
+	FORMAT_R32_SINT                                       Format = 99
+	FORMAT_R32_SFLOAT                                     Format = 100
+	FORMAT_R32G32_UINT                                    Format = 101
+	FORMAT_R32G32_SINT                                    Format = 102
+	FORMAT_R32G32_SFLOAT                                  Format = 103
+	FORMAT_R32G32B32_UINT                                 Format = 104
+	FORMAT_R32G32B32_SINT                                 Format = 105
+	FORMAT_R32G32B32_SFLOAT                               Format = 106
+	FORMAT_R32G32B32A32_UINT                              Format = 107
+	FORMAT_R32G32B32A32_SINT                              Format = 108
+	FORMAT_R32G32B32A32_SFLOAT                            Format = 109
+	FORMAT_R64_UINT                                       Format = 110
+	FORMAT_R64_SINT                                       Format = 111
+	FORMAT_R64_SFLOAT                                     Format = 112
+	FORMAT_R64G64_UINT                                    Format = 113
+	FORMAT_R64G64_SINT                                    Format = 114
+	FORMAT_R64G64_SFLOAT                                  Format = 115
+	FORMAT_R64G64B64_UINT                                 Format = 116
+	FORMAT_R64G64B64_SINT                                 Format = 117
+	FORMAT_R64G64B64_SFLOAT                               Format = 118
+	FORMAT_R64G64B64A64_UINT                              Format = 119
+	FORMAT_R64G64B64A64_SINT                              Format = 120
+	FORMAT_R64G64B64A64_SFLOAT                            Format = 121
+	FORMAT_B10G11R11_UFLOAT_PACK32                        Format = 122
+	FORMAT_E5B9G9R9_UFLOAT_PACK32                         Format = 123
+	FORMAT_D16_UNORM                                      Format = 124
+	FORMAT_X8_D24_UNORM_PACK32                            Format = 125
+	FORMAT_D32_SFLOAT                                     Format = 126
+	FORMAT_S8_UINT                                        Format = 127
+	FORMAT_D16_UNORM_S8_UINT                              Format = 128
+	FORMAT_D24_UNORM_S8_UINT                              Format = 129
+	FORMAT_D32_SFLOAT_S8_UINT                             Format = 130
+	FORMAT_BC1_RGB_UNORM_BLOCK                            Format = 131
+	FORMAT_BC1_RGB_SRGB_BLOCK                             Format = 132
+	FORMAT_BC1_RGBA_UNORM_BLOCK                           Format = 133
+	FORMAT_BC1_RGBA_SRGB_BLOCK                            Format = 134
+	FORMAT_BC2_UNORM_BLOCK                                Format = 135
+	FORMAT_BC2_SRGB_BLOCK                                 Format = 136
+	FORMAT_BC3_UNORM_BLOCK                                Format = 137
+	FORMAT_BC3_SRGB_BLOCK                                 Format = 138
+	FORMAT_BC4_UNORM_BLOCK                                Format = 139
+	FORMAT_BC4_SNORM_BLOCK                                Format = 140
+	FORMAT_BC5_UNORM_BLOCK                                Format = 141
+	FORMAT_BC5_SNORM_BLOCK                                Format = 142
+	FORMAT_BC6H_UFLOAT_BLOCK                              Format = 143
+	FORMAT_BC6H_SFLOAT_BLOCK                              Format = 144
+	FORMAT_BC7_UNORM_BLOCK                                Format = 145
+	FORMAT_BC7_SRGB_BLOCK                                 Format = 146
+	FORMAT_ETC2_R8G8B8_UNORM_BLOCK                        Format = 147
+	FORMAT_ETC2_R8G8B8_SRGB_BLOCK                         Format = 148
+	FORMAT_ETC2_R8G8B8A1_UNORM_BLOCK                      Format = 149
+	FORMAT_ETC2_R8G8B8A1_SRGB_BLOCK                       Format = 150
+	FORMAT_ETC2_R8G8B8A8_UNORM_BLOCK                      Format = 151
+	FORMAT_ETC2_R8G8B8A8_SRGB_BLOCK                       Format = 152
+	FORMAT_EAC_R11_UNORM_BLOCK                            Format = 153
+	FORMAT_EAC_R11_SNORM_BLOCK                            Format = 154
+	FORMAT_EAC_R11G11_UNORM_BLOCK                         Format = 155
+	FORMAT_EAC_R11G11_SNORM_BLOCK                         Format = 156
+	FORMAT_ASTC_4x4_UNORM_BLOCK                           Format = 157
+	FORMAT_ASTC_4x4_SRGB_BLOCK                            Format = 158
+	FORMAT_ASTC_5x4_UNORM_BLOCK                           Format = 159
+	FORMAT_ASTC_5x4_SRGB_BLOCK                            Format = 160
+	FORMAT_ASTC_5x5_UNORM_BLOCK                           Format = 161
+	FORMAT_ASTC_5x5_SRGB_BLOCK                            Format = 162
+	FORMAT_ASTC_6x5_UNORM_BLOCK                           Format = 163
+	FORMAT_ASTC_6x5_SRGB_BLOCK                            Format = 164
+	FORMAT_ASTC_6x6_UNORM_BLOCK                           Format = 165
+	FORMAT_ASTC_6x6_SRGB_BLOCK                            Format = 166
+	FORMAT_ASTC_8x5_UNORM_BLOCK                           Format = 167
+	FORMAT_ASTC_8x5_SRGB_BLOCK                            Format = 168
+	FORMAT_ASTC_8x6_UNORM_BLOCK                           Format = 169
+	FORMAT_ASTC_8x6_SRGB_BLOCK                            Format = 170
+	FORMAT_ASTC_8x8_UNORM_BLOCK                           Format = 171
+	FORMAT_ASTC_8x8_SRGB_BLOCK                            Format = 172
+	FORMAT_ASTC_10x5_UNORM_BLOCK                          Format = 173
+	FORMAT_ASTC_10x5_SRGB_BLOCK                           Format = 174
+	FORMAT_ASTC_10x6_UNORM_BLOCK                          Format = 175
+	FORMAT_ASTC_10x6_SRGB_BLOCK                           Format = 176
+	FORMAT_ASTC_10x8_UNORM_BLOCK                          Format = 177
+	FORMAT_ASTC_10x8_SRGB_BLOCK                           Format = 178
+	FORMAT_ASTC_10x10_UNORM_BLOCK                         Format = 179
+	FORMAT_ASTC_10x10_SRGB_BLOCK                          Format = 180
+	FORMAT_ASTC_12x10_UNORM_BLOCK                         Format = 181
+	FORMAT_ASTC_12x10_SRGB_BLOCK                          Format = 182
+	FORMAT_ASTC_12x12_UNORM_BLOCK                         Format = 183
+	FORMAT_ASTC_12x12_SRGB_BLOCK                          Format = 184
+	FORMAT_PVRTC1_2BPP_UNORM_BLOCK_IMG                    Format = 1000054000
+	FORMAT_PVRTC1_4BPP_UNORM_BLOCK_IMG                    Format = 1000054001
+	FORMAT_PVRTC2_2BPP_UNORM_BLOCK_IMG                    Format = 1000054002
+	FORMAT_PVRTC2_4BPP_UNORM_BLOCK_IMG                    Format = 1000054003
+	FORMAT_PVRTC1_2BPP_SRGB_BLOCK_IMG                     Format = 1000054004
+	FORMAT_PVRTC1_4BPP_SRGB_BLOCK_IMG                     Format = 1000054005
+	FORMAT_PVRTC2_2BPP_SRGB_BLOCK_IMG                     Format = 1000054006
+	FORMAT_PVRTC2_4BPP_SRGB_BLOCK_IMG                     Format = 1000054007
+	FORMAT_ASTC_4x4_SFLOAT_BLOCK_EXT                      Format = 1000066000
+	FORMAT_ASTC_5x4_SFLOAT_BLOCK_EXT                      Format = 1000066001
+	FORMAT_ASTC_5x5_SFLOAT_BLOCK_EXT                      Format = 1000066002
+	FORMAT_ASTC_6x5_SFLOAT_BLOCK_EXT                      Format = 1000066003
+	FORMAT_ASTC_6x6_SFLOAT_BLOCK_EXT                      Format = 1000066004
+	FORMAT_ASTC_8x5_SFLOAT_BLOCK_EXT                      Format = 1000066005
+	FORMAT_ASTC_8x6_SFLOAT_BLOCK_EXT                      Format = 1000066006
+	FORMAT_ASTC_8x8_SFLOAT_BLOCK_EXT                      Format = 1000066007
+	FORMAT_ASTC_10x5_SFLOAT_BLOCK_EXT                     Format = 1000066008
+	FORMAT_ASTC_10x6_SFLOAT_BLOCK_EXT                     Format = 1000066009
+	FORMAT_ASTC_10x8_SFLOAT_BLOCK_EXT                     Format = 1000066010
+	FORMAT_ASTC_10x10_SFLOAT_BLOCK_EXT                    Format = 1000066011
+	FORMAT_ASTC_12x10_SFLOAT_BLOCK_EXT                    Format = 1000066012
+	FORMAT_ASTC_12x12_SFLOAT_BLOCK_EXT                    Format = 1000066013
+	FORMAT_G8B8G8R8_422_UNORM                             Format = 1000156000
+	FORMAT_B8G8R8G8_422_UNORM                             Format = 1000156001
+	FORMAT_G8_B8_R8_3PLANE_420_UNORM                      Format = 1000156002
+	FORMAT_G8_B8R8_2PLANE_420_UNORM                       Format = 1000156003
+	FORMAT_G8_B8_R8_3PLANE_422_UNORM                      Format = 1000156004
+	FORMAT_G8_B8R8_2PLANE_422_UNORM                       Format = 1000156005
+	FORMAT_G8_B8_R8_3PLANE_444_UNORM                      Format = 1000156006
+	FORMAT_R10X6_UNORM_PACK16                             Format = 1000156007
+	FORMAT_R10X6G10X6_UNORM_2PACK16                       Format = 1000156008
+	FORMAT_R10X6G10X6B10X6A10X6_UNORM_4PACK16             Format = 1000156009
+	FORMAT_G10X6B10X6G10X6R10X6_422_UNORM_4PACK16         Format = 1000156010
+	FORMAT_B10X6G10X6R10X6G10X6_422_UNORM_4PACK16         Format = 1000156011
+	FORMAT_G10X6_B10X6_R10X6_3PLANE_420_UNORM_3PACK16     Format = 1000156012
+	FORMAT_G10X6_B10X6R10X6_2PLANE_420_UNORM_3PACK16      Format = 1000156013
+	FORMAT_G10X6_B10X6_R10X6_3PLANE_422_UNORM_3PACK16     Format = 1000156014
+	FORMAT_G10X6_B10X6R10X6_2PLANE_422_UNORM_3PACK16      Format = 1000156015
+	FORMAT_G10X6_B10X6_R10X6_3PLANE_444_UNORM_3PACK16     Format = 1000156016
+	FORMAT_R12X4_UNORM_PACK16                             Format = 1000156017
+	FORMAT_R12X4G12X4_UNORM_2PACK16                       Format = 1000156018
+	FORMAT_R12X4G12X4B12X4A12X4_UNORM_4PACK16             Format = 1000156019
+	FORMAT_G12X4B12X4G12X4R12X4_422_UNORM_4PACK16         Format = 1000156020
+	FORMAT_B12X4G12X4R12X4G12X4_422_UNORM_4PACK16         Format = 1000156021
+	FORMAT_G12X4_B12X4_R12X4_3PLANE_420_UNORM_3PACK16     Format = 1000156022
+	FORMAT_G12X4_B12X4R12X4_2PLANE_420_UNORM_3PACK16      Format = 1000156023
+	FORMAT_G12X4_B12X4_R12X4_3PLANE_422_UNORM_3PACK16     Format = 1000156024
+	FORMAT_G12X4_B12X4R12X4_2PLANE_422_UNORM_3PACK16      Format = 1000156025
+	FORMAT_G12X4_B12X4_R12X4_3PLANE_444_UNORM_3PACK16     Format = 1000156026
+	FORMAT_G16B16G16R16_422_UNORM                         Format = 1000156027
+	FORMAT_B16G16R16G16_422_UNORM                         Format = 1000156028
+	FORMAT_G16_B16_R16_3PLANE_420_UNORM                   Format = 1000156029
+	FORMAT_G16_B16R16_2PLANE_420_UNORM                    Format = 1000156030
+	FORMAT_G16_B16_R16_3PLANE_422_UNORM                   Format = 1000156031
+	FORMAT_G16_B16R16_2PLANE_422_UNORM                    Format = 1000156032
+	FORMAT_G16_B16_R16_3PLANE_444_UNORM                   Format = 1000156033
+	FORMAT_G8_B8R8_2PLANE_444_UNORM_EXT                   Format = 1000330000
+	FORMAT_G10X6_B10X6R10X6_2PLANE_444_UNORM_3PACK16_EXT  Format = 1000330001
+	FORMAT_G12X4_B12X4R12X4_2PLANE_444_UNORM_3PACK16_EXT  Format = 1000330002
+	FORMAT_G16_B16R16_2PLANE_444_UNORM_EXT                Format = 1000330003
+	FORMAT_A4R4G4B4_UNORM_PACK16_EXT                      Format = 1000340000
+	FORMAT_A4B4G4R4_UNORM_PACK16_EXT                      Format = 1000340001
+	FORMAT_B10X6G10X6R10X6G10X6_422_UNORM_4PACK16_KHR     Format = FORMAT_B10X6G10X6R10X6G10X6_422_UNORM_4PACK16
+	FORMAT_B12X4G12X4R12X4G12X4_422_UNORM_4PACK16_KHR     Format = FORMAT_B12X4G12X4R12X4G12X4_422_UNORM_4PACK16
+	FORMAT_B16G16R16G16_422_UNORM_KHR                     Format = FORMAT_B16G16R16G16_422_UNORM
+	FORMAT_B8G8R8G8_422_UNORM_KHR                         Format = FORMAT_B8G8R8G8_422_UNORM
+	FORMAT_G10X6B10X6G10X6R10X6_422_UNORM_4PACK16_KHR     Format = FORMAT_G10X6B10X6G10X6R10X6_422_UNORM_4PACK16
+	FORMAT_G10X6_B10X6R10X6_2PLANE_420_UNORM_3PACK16_KHR  Format = FORMAT_G10X6_B10X6R10X6_2PLANE_420_UNORM_3PACK16
+	FORMAT_G10X6_B10X6R10X6_2PLANE_422_UNORM_3PACK16_KHR  Format = FORMAT_G10X6_B10X6R10X6_2PLANE_422_UNORM_3PACK16
+	FORMAT_G10X6_B10X6_R10X6_3PLANE_420_UNORM_3PACK16_KHR Format = FORMAT_G10X6_B10X6_R10X6_3PLANE_420_UNORM_3PACK16
+	FORMAT_G10X6_B10X6_R10X6_3PLANE_422_UNORM_3PACK16_KHR Format = FORMAT_G10X6_B10X6_R10X6_3PLANE_422_UNORM_3PACK16
+	FORMAT_G10X6_B10X6_R10X6_3PLANE_444_UNORM_3PACK16_KHR Format = FORMAT_G10X6_B10X6_R10X6_3PLANE_444_UNORM_3PACK16
+	FORMAT_G12X4B12X4G12X4R12X4_422_UNORM_4PACK16_KHR     Format = FORMAT_G12X4B12X4G12X4R12X4_422_UNORM_4PACK16
+	FORMAT_G12X4_B12X4R12X4_2PLANE_420_UNORM_3PACK16_KHR  Format = FORMAT_G12X4_B12X4R12X4_2PLANE_420_UNORM_3PACK16
+	FORMAT_G12X4_B12X4R12X4_2PLANE_422_UNORM_3PACK16_KHR  Format = FORMAT_G12X4_B12X4R12X4_2PLANE_422_UNORM_3PACK16
+	FORMAT_G12X4_B12X4_R12X4_3PLANE_420_UNORM_3PACK16_KHR Format = FORMAT_G12X4_B12X4_R12X4_3PLANE_420_UNORM_3PACK16
+	FORMAT_G12X4_B12X4_R12X4_3PLANE_422_UNORM_3PACK16_KHR Format = FORMAT_G12X4_B12X4_R12X4_3PLANE_422_UNORM_3PACK16
+	FORMAT_G12X4_B12X4_R12X4_3PLANE_444_UNORM_3PACK16_KHR Format = FORMAT_G12X4_B12X4_R12X4_3PLANE_444_UNORM_3PACK16
+	FORMAT_G16B16G16R16_422_UNORM_KHR                     Format = FORMAT_G16B16G16R16_422_UNORM
+	FORMAT_G16_B16R16_2PLANE_420_UNORM_KHR                Format = FORMAT_G16_B16R16_2PLANE_420_UNORM
+	FORMAT_G16_B16R16_2PLANE_422_UNORM_KHR                Format = FORMAT_G16_B16R16_2PLANE_422_UNORM
+	FORMAT_G16_B16_R16_3PLANE_420_UNORM_KHR               Format = FORMAT_G16_B16_R16_3PLANE_420_UNORM
+	FORMAT_G16_B16_R16_3PLANE_422_UNORM_KHR               Format = FORMAT_G16_B16_R16_3PLANE_422_UNORM
+	FORMAT_G16_B16_R16_3PLANE_444_UNORM_KHR               Format = FORMAT_G16_B16_R16_3PLANE_444_UNORM
+	FORMAT_G8B8G8R8_422_UNORM_KHR                         Format = FORMAT_G8B8G8R8_422_UNORM
+	FORMAT_G8_B8R8_2PLANE_420_UNORM_KHR                   Format = FORMAT_G8_B8R8_2PLANE_420_UNORM
+	FORMAT_G8_B8R8_2PLANE_422_UNORM_KHR                   Format = FORMAT_G8_B8R8_2PLANE_422_UNORM
+	FORMAT_G8_B8_R8_3PLANE_420_UNORM_KHR                  Format = FORMAT_G8_B8_R8_3PLANE_420_UNORM
+	FORMAT_G8_B8_R8_3PLANE_422_UNORM_KHR                  Format = FORMAT_G8_B8_R8_3PLANE_422_UNORM
+	FORMAT_G8_B8_R8_3PLANE_444_UNORM_KHR                  Format = FORMAT_G8_B8_R8_3PLANE_444_UNORM
+	FORMAT_R10X6G10X6B10X6A10X6_UNORM_4PACK16_KHR         Format = FORMAT_R10X6G10X6B10X6A10X6_UNORM_4PACK16
+	FORMAT_R10X6G10X6_UNORM_2PACK16_KHR                   Format = FORMAT_R10X6G10X6_UNORM_2PACK16
+	FORMAT_R10X6_UNORM_PACK16_KHR                         Format = FORMAT_R10X6_UNORM_PACK16
+	FORMAT_R12X4G12X4B12X4A12X4_UNORM_4PACK16_KHR         Format = FORMAT_R12X4G12X4B12X4A12X4_UNORM_4PACK16
+	FORMAT_R12X4G12X4_UNORM_2PACK16_KHR                   Format = FORMAT_R12X4G12X4_UNORM_2PACK16
+	FORMAT_R12X4_UNORM_PACK16_KHR                         Format = FORMAT_R12X4_UNORM_PACK16
+)
+
+type FormatFeatureFlagBits = FormatFeatureFlags
+
+const (
+	FORMAT_FEATURE_SAMPLED_IMAGE_BIT                                                           FormatFeatureFlagBits = 1 << 0
+	FORMAT_FEATURE_STORAGE_IMAGE_BIT                                                           FormatFeatureFlagBits = 1 << 1
+	FORMAT_FEATURE_BLIT_SRC_BIT                                                                FormatFeatureFlagBits = 1 << 10
+	FORMAT_FEATURE_BLIT_DST_BIT                                                                FormatFeatureFlagBits = 1 << 11
+	FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_LINEAR_BIT                                             FormatFeatureFlagBits = 1 << 12
+	FORMAT_FEATURE_TRANSFER_SRC_BIT                                                            FormatFeatureFlagBits = 1 << 14
+	FORMAT_FEATURE_TRANSFER_DST_BIT                                                            FormatFeatureFlagBits = 1 << 15
+	FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_MINMAX_BIT                                             FormatFeatureFlagBits = 1 << 16
+	FORMAT_FEATURE_MIDPOINT_CHROMA_SAMPLES_BIT                                                 FormatFeatureFlagBits = 1 << 17
+	FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_LINEAR_FILTER_BIT                            FormatFeatureFlagBits = 1 << 18
+	FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_SEPARATE_RECONSTRUCTION_FILTER_BIT           FormatFeatureFlagBits = 1 << 19
+	FORMAT_FEATURE_STORAGE_IMAGE_ATOMIC_BIT                                                    FormatFeatureFlagBits = 1 << 2
+	FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_BIT           FormatFeatureFlagBits = 1 << 20
+	FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_FORCEABLE_BIT FormatFeatureFlagBits = 1 << 21
+	FORMAT_FEATURE_DISJOINT_BIT                                                                FormatFeatureFlagBits = 1 << 22
+	FORMAT_FEATURE_COSITED_CHROMA_SAMPLES_BIT                                                  FormatFeatureFlagBits = 1 << 23
+	FORMAT_FEATURE_UNIFORM_TEXEL_BUFFER_BIT                                                    FormatFeatureFlagBits = 1 << 3
+	FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_BIT                                                    FormatFeatureFlagBits = 1 << 4
+	FORMAT_FEATURE_STORAGE_TEXEL_BUFFER_ATOMIC_BIT                                             FormatFeatureFlagBits = 1 << 5
+	FORMAT_FEATURE_VERTEX_BUFFER_BIT                                                           FormatFeatureFlagBits = 1 << 6
+	FORMAT_FEATURE_COLOR_ATTACHMENT_BIT                                                        FormatFeatureFlagBits = 1 << 7
+	FORMAT_FEATURE_COLOR_ATTACHMENT_BLEND_BIT                                                  FormatFeatureFlagBits = 1 << 8
+	FORMAT_FEATURE_DEPTH_STENCIL_ATTACHMENT_BIT                                                FormatFeatureFlagBits = 1 << 9
+	// Format can be filtered with VK_FILTER_CUBIC_IMG when being sampled
+	FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_CUBIC_BIT_IMG                                              FormatFeatureFlagBits = 1000015000
+	FORMAT_FEATURE_ACCELERATION_STRUCTURE_VERTEX_BUFFER_BIT_KHR                                    FormatFeatureFlagBits = 1000150000
+	FORMAT_FEATURE_FRAGMENT_DENSITY_MAP_BIT_EXT                                                    FormatFeatureFlagBits = 1000218000
+	FORMAT_FEATURE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR                                        FormatFeatureFlagBits = 1000226000
+	FORMAT_FEATURE_COSITED_CHROMA_SAMPLES_BIT_KHR                                                  FormatFeatureFlagBits = FORMAT_FEATURE_COSITED_CHROMA_SAMPLES_BIT
+	FORMAT_FEATURE_DISJOINT_BIT_KHR                                                                FormatFeatureFlagBits = FORMAT_FEATURE_DISJOINT_BIT
+	FORMAT_FEATURE_MIDPOINT_CHROMA_SAMPLES_BIT_KHR                                                 FormatFeatureFlagBits = FORMAT_FEATURE_MIDPOINT_CHROMA_SAMPLES_BIT
+	FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_CUBIC_BIT_EXT                                              FormatFeatureFlagBits = FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_CUBIC_BIT_IMG
+	FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_MINMAX_BIT_EXT                                             FormatFeatureFlagBits = FORMAT_FEATURE_SAMPLED_IMAGE_FILTER_MINMAX_BIT
+	FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_BIT_KHR           FormatFeatureFlagBits = FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_BIT
+	FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_FORCEABLE_BIT_KHR FormatFeatureFlagBits = FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_CHROMA_RECONSTRUCTION_EXPLICIT_FORCEABLE_BIT
+	FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_LINEAR_FILTER_BIT_KHR                            FormatFeatureFlagBits = FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_LINEAR_FILTER_BIT
+	FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_SEPARATE_RECONSTRUCTION_FILTER_BIT_KHR           FormatFeatureFlagBits = FORMAT_FEATURE_SAMPLED_IMAGE_YCBCR_CONVERSION_SEPARATE_RECONSTRUCTION_FILTER_BIT
+	FORMAT_FEATURE_TRANSFER_DST_BIT_KHR                                                            FormatFeatureFlagBits = FORMAT_FEATURE_TRANSFER_DST_BIT
+	FORMAT_FEATURE_TRANSFER_SRC_BIT_KHR                                                            FormatFeatureFlagBits = FORMAT_FEATURE_TRANSFER_SRC_BIT
+)
+
+// FragmentShadingRateCombinerOpKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkFragmentShadingRateCombinerOpKHR.html
+type FragmentShadingRateCombinerOpKHR int32
+
+const (
+	FRAGMENT_SHADING_RATE_COMBINER_OP_KEEP_KHR    FragmentShadingRateCombinerOpKHR = 0
+	FRAGMENT_SHADING_RATE_COMBINER_OP_REPLACE_KHR FragmentShadingRateCombinerOpKHR = 1
+	FRAGMENT_SHADING_RATE_COMBINER_OP_MIN_KHR     FragmentShadingRateCombinerOpKHR = 2
+	FRAGMENT_SHADING_RATE_COMBINER_OP_MAX_KHR     FragmentShadingRateCombinerOpKHR = 3
+	FRAGMENT_SHADING_RATE_COMBINER_OP_MUL_KHR     FragmentShadingRateCombinerOpKHR = 4
+)
+
+// FragmentShadingRateNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkFragmentShadingRateNV.html
+type FragmentShadingRateNV int32
+
+const (
+	FRAGMENT_SHADING_RATE_1_INVOCATION_PER_PIXEL_NV      FragmentShadingRateNV = 0
+	FRAGMENT_SHADING_RATE_1_INVOCATION_PER_1X2_PIXELS_NV FragmentShadingRateNV = 1
+	FRAGMENT_SHADING_RATE_1_INVOCATION_PER_2X1_PIXELS_NV FragmentShadingRateNV = 4
+	FRAGMENT_SHADING_RATE_1_INVOCATION_PER_2X2_PIXELS_NV FragmentShadingRateNV = 5
+	FRAGMENT_SHADING_RATE_1_INVOCATION_PER_2X4_PIXELS_NV FragmentShadingRateNV = 6
+	FRAGMENT_SHADING_RATE_1_INVOCATION_PER_4X2_PIXELS_NV FragmentShadingRateNV = 9
+	FRAGMENT_SHADING_RATE_1_INVOCATION_PER_4X4_PIXELS_NV FragmentShadingRateNV = 10
+	FRAGMENT_SHADING_RATE_2_INVOCATIONS_PER_PIXEL_NV     FragmentShadingRateNV = 11
+	FRAGMENT_SHADING_RATE_4_INVOCATIONS_PER_PIXEL_NV     FragmentShadingRateNV = 12
+	FRAGMENT_SHADING_RATE_8_INVOCATIONS_PER_PIXEL_NV     FragmentShadingRateNV = 13
+	FRAGMENT_SHADING_RATE_16_INVOCATIONS_PER_PIXEL_NV    FragmentShadingRateNV = 14
+	FRAGMENT_SHADING_RATE_NO_INVOCATIONS_NV              FragmentShadingRateNV = 15
+)
+
+// FragmentShadingRateTypeNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkFragmentShadingRateTypeNV.html
+type FragmentShadingRateTypeNV int32
+
+const (
+	FRAGMENT_SHADING_RATE_TYPE_FRAGMENT_SIZE_NV FragmentShadingRateTypeNV = 0
+	FRAGMENT_SHADING_RATE_TYPE_ENUMS_NV         FragmentShadingRateTypeNV = 1
+)
+
+type FramebufferCreateFlagBits = FramebufferCreateFlags
+
+const (
+	FRAMEBUFFER_CREATE_IMAGELESS_BIT     FramebufferCreateFlagBits = 1 << 0
+	FRAMEBUFFER_CREATE_IMAGELESS_BIT_KHR FramebufferCreateFlagBits = FRAMEBUFFER_CREATE_IMAGELESS_BIT
+)
+
+// FrontFace: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkFrontFace.html
+type FrontFace int32
+
+const (
+	FRONT_FACE_COUNTER_CLOCKWISE FrontFace = 0
+	FRONT_FACE_CLOCKWISE         FrontFace = 1
+)
+
+type GeometryFlagBitsKHR = GeometryFlagsKHR
+
+const (
+	GEOMETRY_OPAQUE_BIT_KHR                          GeometryFlagBitsKHR = 1 << 0
+	GEOMETRY_NO_DUPLICATE_ANY_HIT_INVOCATION_BIT_KHR GeometryFlagBitsKHR = 1 << 1
+	GEOMETRY_NO_DUPLICATE_ANY_HIT_INVOCATION_BIT_NV  GeometryFlagBitsKHR = GEOMETRY_NO_DUPLICATE_ANY_HIT_INVOCATION_BIT_KHR
+	GEOMETRY_OPAQUE_BIT_NV                           GeometryFlagBitsKHR = GEOMETRY_OPAQUE_BIT_KHR
+)
+
+// GeometryFlagBitsNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkGeometryFlagBitsNV.html
+type GeometryFlagBitsNV = GeometryFlagBitsKHR
+type GeometryInstanceFlagBitsKHR = GeometryInstanceFlagsKHR
+
+const (
+	GEOMETRY_INSTANCE_TRIANGLE_FACING_CULL_DISABLE_BIT_KHR    GeometryInstanceFlagBitsKHR = 1 << 0
+	GEOMETRY_INSTANCE_TRIANGLE_FLIP_FACING_BIT_KHR            GeometryInstanceFlagBitsKHR = 1 << 1
+	GEOMETRY_INSTANCE_FORCE_OPAQUE_BIT_KHR                    GeometryInstanceFlagBitsKHR = 1 << 2
+	GEOMETRY_INSTANCE_FORCE_NO_OPAQUE_BIT_KHR                 GeometryInstanceFlagBitsKHR = 1 << 3
+	GEOMETRY_INSTANCE_FORCE_NO_OPAQUE_BIT_NV                  GeometryInstanceFlagBitsKHR = GEOMETRY_INSTANCE_FORCE_NO_OPAQUE_BIT_KHR
+	GEOMETRY_INSTANCE_FORCE_OPAQUE_BIT_NV                     GeometryInstanceFlagBitsKHR = GEOMETRY_INSTANCE_FORCE_OPAQUE_BIT_KHR
+	GEOMETRY_INSTANCE_TRIANGLE_CULL_DISABLE_BIT_NV            GeometryInstanceFlagBitsKHR = GEOMETRY_INSTANCE_TRIANGLE_FACING_CULL_DISABLE_BIT_KHR
+	GEOMETRY_INSTANCE_TRIANGLE_FRONT_COUNTERCLOCKWISE_BIT_KHR GeometryInstanceFlagBitsKHR = GEOMETRY_INSTANCE_TRIANGLE_FLIP_FACING_BIT_KHR
+	GEOMETRY_INSTANCE_TRIANGLE_FRONT_COUNTERCLOCKWISE_BIT_NV  GeometryInstanceFlagBitsKHR = GEOMETRY_INSTANCE_TRIANGLE_FRONT_COUNTERCLOCKWISE_BIT_KHR
+)
+
+// GeometryInstanceFlagBitsNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkGeometryInstanceFlagBitsNV.html
+type GeometryInstanceFlagBitsNV = GeometryInstanceFlagBitsKHR
+
+// GeometryTypeKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkGeometryTypeKHR.html
+type GeometryTypeKHR int32
+
+const (
+	GEOMETRY_TYPE_TRIANGLES_KHR GeometryTypeKHR = 0
+	GEOMETRY_TYPE_AABBS_KHR     GeometryTypeKHR = 1
+	GEOMETRY_TYPE_INSTANCES_KHR GeometryTypeKHR = 2
+	GEOMETRY_TYPE_AABBS_NV      GeometryTypeKHR = GEOMETRY_TYPE_AABBS_KHR
+	GEOMETRY_TYPE_TRIANGLES_NV  GeometryTypeKHR = GEOMETRY_TYPE_TRIANGLES_KHR
+)
+
+// GeometryTypeNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkGeometryTypeNV.html
+type GeometryTypeNV = GeometryTypeKHR
+type ImageAspectFlagBits = ImageAspectFlags
+
+const (
+	IMAGE_ASPECT_COLOR_BIT              ImageAspectFlagBits = 1 << 0
+	IMAGE_ASPECT_DEPTH_BIT              ImageAspectFlagBits = 1 << 1
+	IMAGE_ASPECT_STENCIL_BIT            ImageAspectFlagBits = 1 << 2
+	IMAGE_ASPECT_METADATA_BIT           ImageAspectFlagBits = 1 << 3
+	IMAGE_ASPECT_PLANE_0_BIT            ImageAspectFlagBits = 1 << 4
+	IMAGE_ASPECT_PLANE_1_BIT            ImageAspectFlagBits = 1 << 5
+	IMAGE_ASPECT_PLANE_2_BIT            ImageAspectFlagBits = 1 << 6
+	IMAGE_ASPECT_MEMORY_PLANE_3_BIT_EXT ImageAspectFlagBits = 1000158000
+	IMAGE_ASPECT_MEMORY_PLANE_2_BIT_EXT ImageAspectFlagBits = 1000158000
+	IMAGE_ASPECT_MEMORY_PLANE_1_BIT_EXT ImageAspectFlagBits = 1000158000
+	IMAGE_ASPECT_MEMORY_PLANE_0_BIT_EXT ImageAspectFlagBits = 1000158000
+	IMAGE_ASPECT_PLANE_0_BIT_KHR        ImageAspectFlagBits = IMAGE_ASPECT_PLANE_0_BIT
+	IMAGE_ASPECT_PLANE_1_BIT_KHR        ImageAspectFlagBits = IMAGE_ASPECT_PLANE_1_BIT
+	IMAGE_ASPECT_PLANE_2_BIT_KHR        ImageAspectFlagBits = IMAGE_ASPECT_PLANE_2_BIT
+)
+
+type ImageCreateFlagBits = ImageCreateFlags
+
+const (
+	IMAGE_CREATE_SPARSE_BINDING_BIT                        ImageCreateFlagBits = 1 << 0
+	IMAGE_CREATE_SPARSE_RESIDENCY_BIT                      ImageCreateFlagBits = 1 << 1
+	IMAGE_CREATE_ALIAS_BIT                                 ImageCreateFlagBits = 1 << 10
+	IMAGE_CREATE_PROTECTED_BIT                             ImageCreateFlagBits = 1 << 11
+	IMAGE_CREATE_SPARSE_ALIASED_BIT                        ImageCreateFlagBits = 1 << 2
+	IMAGE_CREATE_MUTABLE_FORMAT_BIT                        ImageCreateFlagBits = 1 << 3
+	IMAGE_CREATE_CUBE_COMPATIBLE_BIT                       ImageCreateFlagBits = 1 << 4
+	IMAGE_CREATE_2D_ARRAY_COMPATIBLE_BIT                   ImageCreateFlagBits = 1 << 5
+	IMAGE_CREATE_SPLIT_INSTANCE_BIND_REGIONS_BIT           ImageCreateFlagBits = 1 << 6
+	IMAGE_CREATE_BLOCK_TEXEL_VIEW_COMPATIBLE_BIT           ImageCreateFlagBits = 1 << 7
+	IMAGE_CREATE_EXTENDED_USAGE_BIT                        ImageCreateFlagBits = 1 << 8
+	IMAGE_CREATE_DISJOINT_BIT                              ImageCreateFlagBits = 1 << 9
+	IMAGE_CREATE_CORNER_SAMPLED_BIT_NV                     ImageCreateFlagBits = 1000050000
+	IMAGE_CREATE_SAMPLE_LOCATIONS_COMPATIBLE_DEPTH_BIT_EXT ImageCreateFlagBits = 1000143000
+	IMAGE_CREATE_SUBSAMPLED_BIT_EXT                        ImageCreateFlagBits = 1000218000
+	IMAGE_CREATE_2D_ARRAY_COMPATIBLE_BIT_KHR               ImageCreateFlagBits = IMAGE_CREATE_2D_ARRAY_COMPATIBLE_BIT
+	IMAGE_CREATE_ALIAS_BIT_KHR                             ImageCreateFlagBits = IMAGE_CREATE_ALIAS_BIT
+	IMAGE_CREATE_BLOCK_TEXEL_VIEW_COMPATIBLE_BIT_KHR       ImageCreateFlagBits = IMAGE_CREATE_BLOCK_TEXEL_VIEW_COMPATIBLE_BIT
+	IMAGE_CREATE_DISJOINT_BIT_KHR                          ImageCreateFlagBits = IMAGE_CREATE_DISJOINT_BIT
+	IMAGE_CREATE_EXTENDED_USAGE_BIT_KHR                    ImageCreateFlagBits = IMAGE_CREATE_EXTENDED_USAGE_BIT
+	IMAGE_CREATE_SPLIT_INSTANCE_BIND_REGIONS_BIT_KHR       ImageCreateFlagBits = IMAGE_CREATE_SPLIT_INSTANCE_BIND_REGIONS_BIT
+)
+
+// ImageLayout: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkImageLayout.html
+type ImageLayout int32
+
+const (
+	// Implicit layout an image is when its contents are undefined due to various reasons (e.g. right after creation)
+	IMAGE_LAYOUT_UNDEFINED ImageLayout = 0
+	// General layout when image can be used for any kind of access
+	IMAGE_LAYOUT_GENERAL ImageLayout = 1
+	// Optimal layout when image is only used for color attachment read/write
+	IMAGE_LAYOUT_COLOR_ATTACHMENT_OPTIMAL ImageLayout = 2
+	// Optimal layout when image is only used for depth/stencil attachment read/write
+	IMAGE_LAYOUT_DEPTH_STENCIL_ATTACHMENT_OPTIMAL ImageLayout = 3
+	// Optimal layout when image is used for read only depth/stencil attachment and shader access
+	IMAGE_LAYOUT_DEPTH_STENCIL_READ_ONLY_OPTIMAL ImageLayout = 4
+	// Optimal layout when image is used for read only shader access
+	IMAGE_LAYOUT_SHADER_READ_ONLY_OPTIMAL ImageLayout = 5
+	// Optimal layout when image is used only as source of transfer operations
+	IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL ImageLayout = 6
+	// Optimal layout when image is used only as destination of transfer operations
+	IMAGE_LAYOUT_TRANSFER_DST_OPTIMAL ImageLayout = 7
+	// Initial layout used when the data is populated by the CPU
+	IMAGE_LAYOUT_PREINITIALIZED                                 ImageLayout = 8
+	IMAGE_LAYOUT_PRESENT_SRC_KHR                                ImageLayout = 1000001002
+	IMAGE_LAYOUT_SHARED_PRESENT_KHR                             ImageLayout = 1000111000
+	IMAGE_LAYOUT_DEPTH_READ_ONLY_STENCIL_ATTACHMENT_OPTIMAL     ImageLayout = 1000117000
+	IMAGE_LAYOUT_DEPTH_ATTACHMENT_STENCIL_READ_ONLY_OPTIMAL     ImageLayout = 1000117001
+	IMAGE_LAYOUT_FRAGMENT_DENSITY_MAP_OPTIMAL_EXT               ImageLayout = 1000218000
+	IMAGE_LAYOUT_FRAGMENT_SHADING_RATE_ATTACHMENT_OPTIMAL_KHR   ImageLayout = 1000226003
+	IMAGE_LAYOUT_DEPTH_ATTACHMENT_OPTIMAL                       ImageLayout = 1000241000
+	IMAGE_LAYOUT_DEPTH_READ_ONLY_OPTIMAL                        ImageLayout = 1000241001
+	IMAGE_LAYOUT_STENCIL_ATTACHMENT_OPTIMAL                     ImageLayout = 1000241002
+	IMAGE_LAYOUT_STENCIL_READ_ONLY_OPTIMAL                      ImageLayout = 1000241003
+	IMAGE_LAYOUT_READ_ONLY_OPTIMAL_KHR                          ImageLayout = 1000314000
+	IMAGE_LAYOUT_ATTACHMENT_OPTIMAL_KHR                         ImageLayout = 1000314001
+	IMAGE_LAYOUT_DEPTH_ATTACHMENT_OPTIMAL_KHR                   ImageLayout = IMAGE_LAYOUT_DEPTH_ATTACHMENT_OPTIMAL
+	IMAGE_LAYOUT_DEPTH_ATTACHMENT_STENCIL_READ_ONLY_OPTIMAL_KHR ImageLayout = IMAGE_LAYOUT_DEPTH_ATTACHMENT_STENCIL_READ_ONLY_OPTIMAL
+	IMAGE_LAYOUT_DEPTH_READ_ONLY_OPTIMAL_KHR                    ImageLayout = IMAGE_LAYOUT_DEPTH_READ_ONLY_OPTIMAL
+	IMAGE_LAYOUT_DEPTH_READ_ONLY_STENCIL_ATTACHMENT_OPTIMAL_KHR ImageLayout = IMAGE_LAYOUT_DEPTH_READ_ONLY_STENCIL_ATTACHMENT_OPTIMAL
+	IMAGE_LAYOUT_SHADING_RATE_OPTIMAL_NV                        ImageLayout = IMAGE_LAYOUT_FRAGMENT_SHADING_RATE_ATTACHMENT_OPTIMAL_KHR
+	IMAGE_LAYOUT_STENCIL_ATTACHMENT_OPTIMAL_KHR                 ImageLayout = IMAGE_LAYOUT_STENCIL_ATTACHMENT_OPTIMAL
+	IMAGE_LAYOUT_STENCIL_READ_ONLY_OPTIMAL_KHR                  ImageLayout = IMAGE_LAYOUT_STENCIL_READ_ONLY_OPTIMAL
+)
+
+// ImageTiling: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkImageTiling.html
+type ImageTiling int32
+
+const (
+	IMAGE_TILING_OPTIMAL                 ImageTiling = 0
+	IMAGE_TILING_LINEAR                  ImageTiling = 1
+	IMAGE_TILING_DRM_FORMAT_MODIFIER_EXT ImageTiling = 1000158000
+)
+
+// ImageType: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkImageType.html
+type ImageType int32
+
+const (
+	IMAGE_TYPE_1D ImageType = 0
+	IMAGE_TYPE_2D ImageType = 1
+	IMAGE_TYPE_3D ImageType = 2
+)
+
+type ImageUsageFlagBits = ImageUsageFlags
+
+const (
+	IMAGE_USAGE_TRANSFER_SRC_BIT                         ImageUsageFlagBits = 1 << 0
+	IMAGE_USAGE_TRANSFER_DST_BIT                         ImageUsageFlagBits = 1 << 1
+	IMAGE_USAGE_SAMPLED_BIT                              ImageUsageFlagBits = 1 << 2
+	IMAGE_USAGE_STORAGE_BIT                              ImageUsageFlagBits = 1 << 3
+	IMAGE_USAGE_COLOR_ATTACHMENT_BIT                     ImageUsageFlagBits = 1 << 4
+	IMAGE_USAGE_DEPTH_STENCIL_ATTACHMENT_BIT             ImageUsageFlagBits = 1 << 5
+	IMAGE_USAGE_TRANSIENT_ATTACHMENT_BIT                 ImageUsageFlagBits = 1 << 6
+	IMAGE_USAGE_INPUT_ATTACHMENT_BIT                     ImageUsageFlagBits = 1 << 7
+	IMAGE_USAGE_FRAGMENT_DENSITY_MAP_BIT_EXT             ImageUsageFlagBits = 1000218000
+	IMAGE_USAGE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR ImageUsageFlagBits = 1000226000
+	IMAGE_USAGE_INVOCATION_MASK_BIT_HUAWEI               ImageUsageFlagBits = 1000370000
+	IMAGE_USAGE_SHADING_RATE_IMAGE_BIT_NV                ImageUsageFlagBits = IMAGE_USAGE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR
+)
+
+type ImageViewCreateFlagBits = ImageViewCreateFlags
+
+const (
+	IMAGE_VIEW_CREATE_FRAGMENT_DENSITY_MAP_DYNAMIC_BIT_EXT  ImageViewCreateFlagBits = 1000218000
+	IMAGE_VIEW_CREATE_FRAGMENT_DENSITY_MAP_DEFERRED_BIT_EXT ImageViewCreateFlagBits = 1000332000
+)
+
+// ImageViewType: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkImageViewType.html
+type ImageViewType int32
+
+const (
+	IMAGE_VIEW_TYPE_1D         ImageViewType = 0
+	IMAGE_VIEW_TYPE_2D         ImageViewType = 1
+	IMAGE_VIEW_TYPE_3D         ImageViewType = 2
+	IMAGE_VIEW_TYPE_CUBE       ImageViewType = 3
+	IMAGE_VIEW_TYPE_1D_ARRAY   ImageViewType = 4
+	IMAGE_VIEW_TYPE_2D_ARRAY   ImageViewType = 5
+	IMAGE_VIEW_TYPE_CUBE_ARRAY ImageViewType = 6
+)
+
+// IndexType: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkIndexType.html
+type IndexType int32
+
+const (
+	INDEX_TYPE_UINT16    IndexType = 0
+	INDEX_TYPE_UINT32    IndexType = 1
+	INDEX_TYPE_NONE_KHR  IndexType = 1000150000
+	INDEX_TYPE_UINT8_EXT IndexType = 1000265000
+	INDEX_TYPE_NONE_NV   IndexType = INDEX_TYPE_NONE_KHR
+)
+
+type IndirectCommandsLayoutUsageFlagBitsNV = IndirectCommandsLayoutUsageFlagsNV
+
+const (
+	INDIRECT_COMMANDS_LAYOUT_USAGE_EXPLICIT_PREPROCESS_BIT_NV IndirectCommandsLayoutUsageFlagBitsNV = 1 << 0
+	INDIRECT_COMMANDS_LAYOUT_USAGE_INDEXED_SEQUENCES_BIT_NV   IndirectCommandsLayoutUsageFlagBitsNV = 1 << 1
+	INDIRECT_COMMANDS_LAYOUT_USAGE_UNORDERED_SEQUENCES_BIT_NV IndirectCommandsLayoutUsageFlagBitsNV = 1 << 2
+)
+
+// IndirectCommandsTokenTypeNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkIndirectCommandsTokenTypeNV.html
+type IndirectCommandsTokenTypeNV int32
+
+const (
+	INDIRECT_COMMANDS_TOKEN_TYPE_SHADER_GROUP_NV  IndirectCommandsTokenTypeNV = 0
+	INDIRECT_COMMANDS_TOKEN_TYPE_STATE_FLAGS_NV   IndirectCommandsTokenTypeNV = 1
+	INDIRECT_COMMANDS_TOKEN_TYPE_INDEX_BUFFER_NV  IndirectCommandsTokenTypeNV = 2
+	INDIRECT_COMMANDS_TOKEN_TYPE_VERTEX_BUFFER_NV IndirectCommandsTokenTypeNV = 3
+	INDIRECT_COMMANDS_TOKEN_TYPE_PUSH_CONSTANT_NV IndirectCommandsTokenTypeNV = 4
+	INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_INDEXED_NV  IndirectCommandsTokenTypeNV = 5
+	INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_NV          IndirectCommandsTokenTypeNV = 6
+	INDIRECT_COMMANDS_TOKEN_TYPE_DRAW_TASKS_NV    IndirectCommandsTokenTypeNV = 7
+)
+
+type IndirectStateFlagBitsNV = IndirectStateFlagsNV
+
+const (
+	INDIRECT_STATE_FLAG_FRONTFACE_BIT_NV IndirectStateFlagBitsNV = 1 << 0
+)
+
+// InternalAllocationType: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkInternalAllocationType.html
+type InternalAllocationType int32
+
+const (
+	INTERNAL_ALLOCATION_TYPE_EXECUTABLE InternalAllocationType = 0
+)
+
+// LineRasterizationModeEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkLineRasterizationModeEXT.html
+type LineRasterizationModeEXT int32
+
+const (
+	LINE_RASTERIZATION_MODE_DEFAULT_EXT            LineRasterizationModeEXT = 0
+	LINE_RASTERIZATION_MODE_RECTANGULAR_EXT        LineRasterizationModeEXT = 1
+	LINE_RASTERIZATION_MODE_BRESENHAM_EXT          LineRasterizationModeEXT = 2
+	LINE_RASTERIZATION_MODE_RECTANGULAR_SMOOTH_EXT LineRasterizationModeEXT = 3
+)
+
+// LogicOp: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkLogicOp.html
+type LogicOp int32
+
+const (
+	LOGIC_OP_CLEAR         LogicOp = 0
+	LOGIC_OP_AND           LogicOp = 1
+	LOGIC_OP_AND_REVERSE   LogicOp = 2
+	LOGIC_OP_COPY          LogicOp = 3
+	LOGIC_OP_AND_INVERTED  LogicOp = 4
+	LOGIC_OP_NO_OP         LogicOp = 5
+	LOGIC_OP_XOR           LogicOp = 6
+	LOGIC_OP_OR            LogicOp = 7
+	LOGIC_OP_NOR           LogicOp = 8
+	LOGIC_OP_EQUIVALENT    LogicOp = 9
+	LOGIC_OP_INVERT        LogicOp = 10
+	LOGIC_OP_OR_REVERSE    LogicOp = 11
+	LOGIC_OP_COPY_INVERTED LogicOp = 12
+	LOGIC_OP_OR_INVERTED   LogicOp = 13
+	LOGIC_OP_NAND          LogicOp = 14
+	LOGIC_OP_SET           LogicOp = 15
+)
+
+type MemoryAllocateFlagBits = MemoryAllocateFlags
+
+const (
+	MEMORY_ALLOCATE_DEVICE_MASK_BIT                       MemoryAllocateFlagBits = 1 << 0
+	MEMORY_ALLOCATE_DEVICE_ADDRESS_BIT                    MemoryAllocateFlagBits = 1 << 1
+	MEMORY_ALLOCATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT     MemoryAllocateFlagBits = 1 << 2
+	MEMORY_ALLOCATE_DEVICE_ADDRESS_BIT_KHR                MemoryAllocateFlagBits = MEMORY_ALLOCATE_DEVICE_ADDRESS_BIT
+	MEMORY_ALLOCATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT_KHR MemoryAllocateFlagBits = MEMORY_ALLOCATE_DEVICE_ADDRESS_CAPTURE_REPLAY_BIT
+	MEMORY_ALLOCATE_DEVICE_MASK_BIT_KHR                   MemoryAllocateFlagBits = MEMORY_ALLOCATE_DEVICE_MASK_BIT
+)
+
+// MemoryAllocateFlagBitsKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkMemoryAllocateFlagBitsKHR.html
+type MemoryAllocateFlagBitsKHR = MemoryAllocateFlagBits
+type MemoryHeapFlagBits = MemoryHeapFlags
+
+const (
+	MEMORY_HEAP_DEVICE_LOCAL_BIT       MemoryHeapFlagBits = 1 << 0
+	MEMORY_HEAP_MULTI_INSTANCE_BIT     MemoryHeapFlagBits = 1 << 1
+	MEMORY_HEAP_MULTI_INSTANCE_BIT_KHR MemoryHeapFlagBits = MEMORY_HEAP_MULTI_INSTANCE_BIT
+)
+
+// MemoryOverallocationBehaviorAMD: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkMemoryOverallocationBehaviorAMD.html
+type MemoryOverallocationBehaviorAMD int32
+
+const (
+	MEMORY_OVERALLOCATION_BEHAVIOR_DEFAULT_AMD    MemoryOverallocationBehaviorAMD = 0
+	MEMORY_OVERALLOCATION_BEHAVIOR_ALLOWED_AMD    MemoryOverallocationBehaviorAMD = 1
+	MEMORY_OVERALLOCATION_BEHAVIOR_DISALLOWED_AMD MemoryOverallocationBehaviorAMD = 2
+)
+
+type MemoryPropertyFlagBits = MemoryPropertyFlags
+
+const (
+	MEMORY_PROPERTY_DEVICE_LOCAL_BIT        MemoryPropertyFlagBits = 1 << 0
+	MEMORY_PROPERTY_HOST_VISIBLE_BIT        MemoryPropertyFlagBits = 1 << 1
+	MEMORY_PROPERTY_HOST_COHERENT_BIT       MemoryPropertyFlagBits = 1 << 2
+	MEMORY_PROPERTY_HOST_CACHED_BIT         MemoryPropertyFlagBits = 1 << 3
+	MEMORY_PROPERTY_LAZILY_ALLOCATED_BIT    MemoryPropertyFlagBits = 1 << 4
+	MEMORY_PROPERTY_PROTECTED_BIT           MemoryPropertyFlagBits = 1 << 5
+	MEMORY_PROPERTY_DEVICE_UNCACHED_BIT_AMD MemoryPropertyFlagBits = 1000229000
+	MEMORY_PROPERTY_DEVICE_COHERENT_BIT_AMD MemoryPropertyFlagBits = 1000229000
+	MEMORY_PROPERTY_RDMA_CAPABLE_BIT_NV     MemoryPropertyFlagBits = 1000371000
+)
+
+// ObjectType: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkObjectType.html
+type ObjectType int32
+
+const (
+	OBJECT_TYPE_UNKNOWN                         ObjectType = 0
+	OBJECT_TYPE_INSTANCE                        ObjectType = 1
+	OBJECT_TYPE_PHYSICAL_DEVICE                 ObjectType = 2
+	OBJECT_TYPE_DEVICE                          ObjectType = 3
+	OBJECT_TYPE_QUEUE                           ObjectType = 4
+	OBJECT_TYPE_SEMAPHORE                       ObjectType = 5
+	OBJECT_TYPE_COMMAND_BUFFER                  ObjectType = 6
+	OBJECT_TYPE_FENCE                           ObjectType = 7
+	OBJECT_TYPE_DEVICE_MEMORY                   ObjectType = 8
+	OBJECT_TYPE_BUFFER                          ObjectType = 9
+	OBJECT_TYPE_IMAGE                           ObjectType = 10
+	OBJECT_TYPE_EVENT                           ObjectType = 11
+	OBJECT_TYPE_QUERY_POOL                      ObjectType = 12
+	OBJECT_TYPE_BUFFER_VIEW                     ObjectType = 13
+	OBJECT_TYPE_IMAGE_VIEW                      ObjectType = 14
+	OBJECT_TYPE_SHADER_MODULE                   ObjectType = 15
+	OBJECT_TYPE_PIPELINE_CACHE                  ObjectType = 16
+	OBJECT_TYPE_PIPELINE_LAYOUT                 ObjectType = 17
+	OBJECT_TYPE_RENDER_PASS                     ObjectType = 18
+	OBJECT_TYPE_PIPELINE                        ObjectType = 19
+	OBJECT_TYPE_DESCRIPTOR_SET_LAYOUT           ObjectType = 20
+	OBJECT_TYPE_SAMPLER                         ObjectType = 21
+	OBJECT_TYPE_DESCRIPTOR_POOL                 ObjectType = 22
+	OBJECT_TYPE_DESCRIPTOR_SET                  ObjectType = 23
+	OBJECT_TYPE_FRAMEBUFFER                     ObjectType = 24
+	OBJECT_TYPE_COMMAND_POOL                    ObjectType = 25
+	OBJECT_TYPE_SURFACE_KHR                     ObjectType = 1000000000
+	OBJECT_TYPE_SWAPCHAIN_KHR                   ObjectType = 1000001000
+	OBJECT_TYPE_DISPLAY_KHR                     ObjectType = 1000002000
+	OBJECT_TYPE_DISPLAY_MODE_KHR                ObjectType = 1000002001
+	OBJECT_TYPE_DEBUG_REPORT_CALLBACK_EXT       ObjectType = 1000011000
+	OBJECT_TYPE_CU_MODULE_NVX                   ObjectType = 1000029000
+	OBJECT_TYPE_CU_FUNCTION_NVX                 ObjectType = 1000029001
+	OBJECT_TYPE_DESCRIPTOR_UPDATE_TEMPLATE      ObjectType = 1000085000
+	OBJECT_TYPE_DEBUG_UTILS_MESSENGER_EXT       ObjectType = 1000128000
+	OBJECT_TYPE_ACCELERATION_STRUCTURE_KHR      ObjectType = 1000150000
+	OBJECT_TYPE_SAMPLER_YCBCR_CONVERSION        ObjectType = 1000156000
+	OBJECT_TYPE_VALIDATION_CACHE_EXT            ObjectType = 1000160000
+	OBJECT_TYPE_ACCELERATION_STRUCTURE_NV       ObjectType = 1000165000
+	OBJECT_TYPE_PERFORMANCE_CONFIGURATION_INTEL ObjectType = 1000210000
+	OBJECT_TYPE_DEFERRED_OPERATION_KHR          ObjectType = 1000268000
+	OBJECT_TYPE_INDIRECT_COMMANDS_LAYOUT_NV     ObjectType = 1000277000
+	OBJECT_TYPE_PRIVATE_DATA_SLOT_EXT           ObjectType = 1000295000
+	OBJECT_TYPE_DESCRIPTOR_UPDATE_TEMPLATE_KHR  ObjectType = OBJECT_TYPE_DESCRIPTOR_UPDATE_TEMPLATE
+	OBJECT_TYPE_SAMPLER_YCBCR_CONVERSION_KHR    ObjectType = OBJECT_TYPE_SAMPLER_YCBCR_CONVERSION
+)
+
+type PeerMemoryFeatureFlagBits = PeerMemoryFeatureFlags
+
+const (
+	PEER_MEMORY_FEATURE_COPY_SRC_BIT        PeerMemoryFeatureFlagBits = 1 << 0
+	PEER_MEMORY_FEATURE_COPY_DST_BIT        PeerMemoryFeatureFlagBits = 1 << 1
+	PEER_MEMORY_FEATURE_GENERIC_SRC_BIT     PeerMemoryFeatureFlagBits = 1 << 2
+	PEER_MEMORY_FEATURE_GENERIC_DST_BIT     PeerMemoryFeatureFlagBits = 1 << 3
+	PEER_MEMORY_FEATURE_COPY_DST_BIT_KHR    PeerMemoryFeatureFlagBits = PEER_MEMORY_FEATURE_COPY_DST_BIT
+	PEER_MEMORY_FEATURE_COPY_SRC_BIT_KHR    PeerMemoryFeatureFlagBits = PEER_MEMORY_FEATURE_COPY_SRC_BIT
+	PEER_MEMORY_FEATURE_GENERIC_DST_BIT_KHR PeerMemoryFeatureFlagBits = PEER_MEMORY_FEATURE_GENERIC_DST_BIT
+	PEER_MEMORY_FEATURE_GENERIC_SRC_BIT_KHR PeerMemoryFeatureFlagBits = PEER_MEMORY_FEATURE_GENERIC_SRC_BIT
+)
+
+// PeerMemoryFeatureFlagBitsKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPeerMemoryFeatureFlagBitsKHR.html
+type PeerMemoryFeatureFlagBitsKHR = PeerMemoryFeatureFlagBits
+
+// PerformanceConfigurationTypeINTEL: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPerformanceConfigurationTypeINTEL.html
+type PerformanceConfigurationTypeINTEL int32
+
+const (
+	PERFORMANCE_CONFIGURATION_TYPE_COMMAND_QUEUE_METRICS_DISCOVERY_ACTIVATED_INTEL PerformanceConfigurationTypeINTEL = 0
+)
+
+type PerformanceCounterDescriptionFlagBitsKHR = PerformanceCounterDescriptionFlagsKHR
+
+const (
+	PERFORMANCE_COUNTER_DESCRIPTION_PERFORMANCE_IMPACTING_BIT_KHR PerformanceCounterDescriptionFlagBitsKHR = 1 << 0
+	PERFORMANCE_COUNTER_DESCRIPTION_CONCURRENTLY_IMPACTED_BIT_KHR PerformanceCounterDescriptionFlagBitsKHR = 1 << 1
+	PERFORMANCE_COUNTER_DESCRIPTION_CONCURRENTLY_IMPACTED_KHR     PerformanceCounterDescriptionFlagBitsKHR = PERFORMANCE_COUNTER_DESCRIPTION_CONCURRENTLY_IMPACTED_BIT_KHR
+	PERFORMANCE_COUNTER_DESCRIPTION_PERFORMANCE_IMPACTING_KHR     PerformanceCounterDescriptionFlagBitsKHR = PERFORMANCE_COUNTER_DESCRIPTION_PERFORMANCE_IMPACTING_BIT_KHR
+)
+
+// PerformanceCounterScopeKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPerformanceCounterScopeKHR.html
+type PerformanceCounterScopeKHR int32
+
+const (
+	PERFORMANCE_COUNTER_SCOPE_COMMAND_BUFFER_KHR PerformanceCounterScopeKHR = 0
+	PERFORMANCE_COUNTER_SCOPE_RENDER_PASS_KHR    PerformanceCounterScopeKHR = 1
+	PERFORMANCE_COUNTER_SCOPE_COMMAND_KHR        PerformanceCounterScopeKHR = 2
+	QUERY_SCOPE_COMMAND_BUFFER_KHR               PerformanceCounterScopeKHR = PERFORMANCE_COUNTER_SCOPE_COMMAND_BUFFER_KHR
+	QUERY_SCOPE_COMMAND_KHR                      PerformanceCounterScopeKHR = PERFORMANCE_COUNTER_SCOPE_COMMAND_KHR
+	QUERY_SCOPE_RENDER_PASS_KHR                  PerformanceCounterScopeKHR = PERFORMANCE_COUNTER_SCOPE_RENDER_PASS_KHR
+)
+
+// PerformanceCounterStorageKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPerformanceCounterStorageKHR.html
+type PerformanceCounterStorageKHR int32
+
+const (
+	PERFORMANCE_COUNTER_STORAGE_INT32_KHR   PerformanceCounterStorageKHR = 0
+	PERFORMANCE_COUNTER_STORAGE_INT64_KHR   PerformanceCounterStorageKHR = 1
+	PERFORMANCE_COUNTER_STORAGE_UINT32_KHR  PerformanceCounterStorageKHR = 2
+	PERFORMANCE_COUNTER_STORAGE_UINT64_KHR  PerformanceCounterStorageKHR = 3
+	PERFORMANCE_COUNTER_STORAGE_FLOAT32_KHR PerformanceCounterStorageKHR = 4
+	PERFORMANCE_COUNTER_STORAGE_FLOAT64_KHR PerformanceCounterStorageKHR = 5
+)
+
+// PerformanceCounterUnitKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPerformanceCounterUnitKHR.html
+type PerformanceCounterUnitKHR int32
+
+const (
+	PERFORMANCE_COUNTER_UNIT_GENERIC_KHR          PerformanceCounterUnitKHR = 0
+	PERFORMANCE_COUNTER_UNIT_PERCENTAGE_KHR       PerformanceCounterUnitKHR = 1
+	PERFORMANCE_COUNTER_UNIT_NANOSECONDS_KHR      PerformanceCounterUnitKHR = 2
+	PERFORMANCE_COUNTER_UNIT_BYTES_KHR            PerformanceCounterUnitKHR = 3
+	PERFORMANCE_COUNTER_UNIT_BYTES_PER_SECOND_KHR PerformanceCounterUnitKHR = 4
+	PERFORMANCE_COUNTER_UNIT_KELVIN_KHR           PerformanceCounterUnitKHR = 5
+	PERFORMANCE_COUNTER_UNIT_WATTS_KHR            PerformanceCounterUnitKHR = 6
+	PERFORMANCE_COUNTER_UNIT_VOLTS_KHR            PerformanceCounterUnitKHR = 7
+	PERFORMANCE_COUNTER_UNIT_AMPS_KHR             PerformanceCounterUnitKHR = 8
+	PERFORMANCE_COUNTER_UNIT_HERTZ_KHR            PerformanceCounterUnitKHR = 9
+	PERFORMANCE_COUNTER_UNIT_CYCLES_KHR           PerformanceCounterUnitKHR = 10
+)
+
+// PerformanceOverrideTypeINTEL: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPerformanceOverrideTypeINTEL.html
+type PerformanceOverrideTypeINTEL int32
+
+const (
+	PERFORMANCE_OVERRIDE_TYPE_NULL_HARDWARE_INTEL    PerformanceOverrideTypeINTEL = 0
+	PERFORMANCE_OVERRIDE_TYPE_FLUSH_GPU_CACHES_INTEL PerformanceOverrideTypeINTEL = 1
+)
+
+// PerformanceParameterTypeINTEL: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPerformanceParameterTypeINTEL.html
+type PerformanceParameterTypeINTEL int32
+
+const (
+	PERFORMANCE_PARAMETER_TYPE_HW_COUNTERS_SUPPORTED_INTEL    PerformanceParameterTypeINTEL = 0
+	PERFORMANCE_PARAMETER_TYPE_STREAM_MARKER_VALID_BITS_INTEL PerformanceParameterTypeINTEL = 1
+)
+
+// PerformanceValueTypeINTEL: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPerformanceValueTypeINTEL.html
+type PerformanceValueTypeINTEL int32
+
+const (
+	PERFORMANCE_VALUE_TYPE_UINT32_INTEL PerformanceValueTypeINTEL = 0
+	PERFORMANCE_VALUE_TYPE_UINT64_INTEL PerformanceValueTypeINTEL = 1
+	PERFORMANCE_VALUE_TYPE_FLOAT_INTEL  PerformanceValueTypeINTEL = 2
+	PERFORMANCE_VALUE_TYPE_BOOL_INTEL   PerformanceValueTypeINTEL = 3
+	PERFORMANCE_VALUE_TYPE_STRING_INTEL PerformanceValueTypeINTEL = 4
+)
+
+// PhysicalDeviceType: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPhysicalDeviceType.html
+type PhysicalDeviceType int32
+
+const (
+	PHYSICAL_DEVICE_TYPE_OTHER          PhysicalDeviceType = 0
+	PHYSICAL_DEVICE_TYPE_INTEGRATED_GPU PhysicalDeviceType = 1
+	PHYSICAL_DEVICE_TYPE_DISCRETE_GPU   PhysicalDeviceType = 2
+	PHYSICAL_DEVICE_TYPE_VIRTUAL_GPU    PhysicalDeviceType = 3
+	PHYSICAL_DEVICE_TYPE_CPU            PhysicalDeviceType = 4
+)
+
+// PipelineBindPoint: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPipelineBindPoint.html
+type PipelineBindPoint int32
+
+const (
+	PIPELINE_BIND_POINT_GRAPHICS               PipelineBindPoint = 0
+	PIPELINE_BIND_POINT_COMPUTE                PipelineBindPoint = 1
+	PIPELINE_BIND_POINT_RAY_TRACING_KHR        PipelineBindPoint = 1000347000
+	PIPELINE_BIND_POINT_SUBPASS_SHADING_HUAWEI PipelineBindPoint = 1000369003
+	PIPELINE_BIND_POINT_RAY_TRACING_NV         PipelineBindPoint = PIPELINE_BIND_POINT_RAY_TRACING_KHR
+)
+
+type PipelineCacheCreateFlagBits = PipelineCacheCreateFlags
+
+const (
+	PIPELINE_CACHE_CREATE_EXTERNALLY_SYNCHRONIZED_BIT_EXT PipelineCacheCreateFlagBits = 1000297000
+)
+
+// PipelineCacheHeaderVersion: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPipelineCacheHeaderVersion.html
+type PipelineCacheHeaderVersion int32
+
+const (
+	PIPELINE_CACHE_HEADER_VERSION_ONE PipelineCacheHeaderVersion = 1
+)
+
+type PipelineCompilerControlFlagBitsAMD = PipelineCompilerControlFlagsAMD
+type PipelineCreateFlagBits = PipelineCreateFlags
+
+const (
+	PIPELINE_CREATE_DISABLE_OPTIMIZATION_BIT                               PipelineCreateFlagBits = 1 << 0
+	PIPELINE_CREATE_ALLOW_DERIVATIVES_BIT                                  PipelineCreateFlagBits = 1 << 1
+	PIPELINE_CREATE_DERIVATIVE_BIT                                         PipelineCreateFlagBits = 1 << 2
+	PIPELINE_CREATE_VIEW_INDEX_FROM_DEVICE_INDEX_BIT                       PipelineCreateFlagBits = 1 << 3
+	PIPELINE_CREATE_DISPATCH_BASE_BIT                                      PipelineCreateFlagBits = 1 << 4
+	PIPELINE_CREATE_DEFER_COMPILE_BIT_NV                                   PipelineCreateFlagBits = 1000165000
+	PIPELINE_CREATE_CAPTURE_INTERNAL_REPRESENTATIONS_BIT_KHR               PipelineCreateFlagBits = 1000269000
+	PIPELINE_CREATE_CAPTURE_STATISTICS_BIT_KHR                             PipelineCreateFlagBits = 1000269000
+	PIPELINE_CREATE_INDIRECT_BINDABLE_BIT_NV                               PipelineCreateFlagBits = 1000277000
+	PIPELINE_CREATE_LIBRARY_BIT_KHR                                        PipelineCreateFlagBits = 1000290000
+	PIPELINE_CREATE_FAIL_ON_PIPELINE_COMPILE_REQUIRED_BIT_EXT              PipelineCreateFlagBits = 1000297000
+	PIPELINE_CREATE_EARLY_RETURN_ON_FAILURE_BIT_EXT                        PipelineCreateFlagBits = 1000297000
+	PIPELINE_CREATE_RAY_TRACING_ALLOW_MOTION_BIT_NV                        PipelineCreateFlagBits = 1000327000
+	PIPELINE_CREATE_RAY_TRACING_NO_NULL_ANY_HIT_SHADERS_BIT_KHR            PipelineCreateFlagBits = 1000347000
+	PIPELINE_CREATE_RAY_TRACING_SKIP_TRIANGLES_BIT_KHR                     PipelineCreateFlagBits = 1000347000
+	PIPELINE_CREATE_RAY_TRACING_NO_NULL_MISS_SHADERS_BIT_KHR               PipelineCreateFlagBits = 1000347000
+	PIPELINE_CREATE_RAY_TRACING_NO_NULL_CLOSEST_HIT_SHADERS_BIT_KHR        PipelineCreateFlagBits = 1000347000
+	PIPELINE_CREATE_RAY_TRACING_SHADER_GROUP_HANDLE_CAPTURE_REPLAY_BIT_KHR PipelineCreateFlagBits = 1000347000
+	PIPELINE_CREATE_RAY_TRACING_SKIP_AABBS_BIT_KHR                         PipelineCreateFlagBits = 1000347000
+	PIPELINE_CREATE_RAY_TRACING_NO_NULL_INTERSECTION_SHADERS_BIT_KHR       PipelineCreateFlagBits = 1000347000
+	PIPELINE_CREATE_DISPATCH_BASE_KHR                                      PipelineCreateFlagBits = PIPELINE_CREATE_DISPATCH_BASE
+	PIPELINE_CREATE_DISPATCH_BASE                                          PipelineCreateFlagBits = PIPELINE_CREATE_DISPATCH_BASE_BIT
+	PIPELINE_CREATE_VIEW_INDEX_FROM_DEVICE_INDEX_BIT_KHR                   PipelineCreateFlagBits = PIPELINE_CREATE_VIEW_INDEX_FROM_DEVICE_INDEX_BIT
+)
+
+type PipelineCreationFeedbackFlagBitsEXT = PipelineCreationFeedbackFlagsEXT
+
+const (
+	PIPELINE_CREATION_FEEDBACK_VALID_BIT_EXT                          PipelineCreationFeedbackFlagBitsEXT = 1 << 0
+	PIPELINE_CREATION_FEEDBACK_APPLICATION_PIPELINE_CACHE_HIT_BIT_EXT PipelineCreationFeedbackFlagBitsEXT = 1 << 1
+	PIPELINE_CREATION_FEEDBACK_BASE_PIPELINE_ACCELERATION_BIT_EXT     PipelineCreationFeedbackFlagBitsEXT = 1 << 2
+)
+
+// PipelineExecutableStatisticFormatKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPipelineExecutableStatisticFormatKHR.html
+type PipelineExecutableStatisticFormatKHR int32
+
+const (
+	PIPELINE_EXECUTABLE_STATISTIC_FORMAT_BOOL32_KHR  PipelineExecutableStatisticFormatKHR = 0
+	PIPELINE_EXECUTABLE_STATISTIC_FORMAT_INT64_KHR   PipelineExecutableStatisticFormatKHR = 1
+	PIPELINE_EXECUTABLE_STATISTIC_FORMAT_UINT64_KHR  PipelineExecutableStatisticFormatKHR = 2
+	PIPELINE_EXECUTABLE_STATISTIC_FORMAT_FLOAT64_KHR PipelineExecutableStatisticFormatKHR = 3
+)
+
+type PipelineShaderStageCreateFlagBits = PipelineShaderStageCreateFlags
+
+const (
+	PIPELINE_SHADER_STAGE_CREATE_REQUIRE_FULL_SUBGROUPS_BIT_EXT      PipelineShaderStageCreateFlagBits = 1000225000
+	PIPELINE_SHADER_STAGE_CREATE_ALLOW_VARYING_SUBGROUP_SIZE_BIT_EXT PipelineShaderStageCreateFlagBits = 1000225000
+)
+
+type PipelineStageFlagBits = PipelineStageFlags
+
+const (
+	PIPELINE_STAGE_TOP_OF_PIPE_BIT                    PipelineStageFlagBits = 1 << 0
+	PIPELINE_STAGE_DRAW_INDIRECT_BIT                  PipelineStageFlagBits = 1 << 1
+	PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT        PipelineStageFlagBits = 1 << 10
+	PIPELINE_STAGE_COMPUTE_SHADER_BIT                 PipelineStageFlagBits = 1 << 11
+	PIPELINE_STAGE_TRANSFER_BIT                       PipelineStageFlagBits = 1 << 12
+	PIPELINE_STAGE_BOTTOM_OF_PIPE_BIT                 PipelineStageFlagBits = 1 << 13
+	PIPELINE_STAGE_HOST_BIT                           PipelineStageFlagBits = 1 << 14
+	PIPELINE_STAGE_ALL_GRAPHICS_BIT                   PipelineStageFlagBits = 1 << 15
+	PIPELINE_STAGE_ALL_COMMANDS_BIT                   PipelineStageFlagBits = 1 << 16
+	PIPELINE_STAGE_VERTEX_INPUT_BIT                   PipelineStageFlagBits = 1 << 2
+	PIPELINE_STAGE_VERTEX_SHADER_BIT                  PipelineStageFlagBits = 1 << 3
+	PIPELINE_STAGE_TESSELLATION_CONTROL_SHADER_BIT    PipelineStageFlagBits = 1 << 4
+	PIPELINE_STAGE_TESSELLATION_EVALUATION_SHADER_BIT PipelineStageFlagBits = 1 << 5
+	PIPELINE_STAGE_GEOMETRY_SHADER_BIT                PipelineStageFlagBits = 1 << 6
+	PIPELINE_STAGE_FRAGMENT_SHADER_BIT                PipelineStageFlagBits = 1 << 7
+	PIPELINE_STAGE_EARLY_FRAGMENT_TESTS_BIT           PipelineStageFlagBits = 1 << 8
+	PIPELINE_STAGE_LATE_FRAGMENT_TESTS_BIT            PipelineStageFlagBits = 1 << 9
+	PIPELINE_STAGE_TRANSFORM_FEEDBACK_BIT_EXT         PipelineStageFlagBits = 1000028000
+	// A pipeline stage for conditional rendering predicate fetch
+	PIPELINE_STAGE_CONDITIONAL_RENDERING_BIT_EXT            PipelineStageFlagBits = 1000081000
+	PIPELINE_STAGE_ACCELERATION_STRUCTURE_BUILD_BIT_KHR     PipelineStageFlagBits = 1000150000
+	PIPELINE_STAGE_TASK_SHADER_BIT_NV                       PipelineStageFlagBits = 1000202000
+	PIPELINE_STAGE_MESH_SHADER_BIT_NV                       PipelineStageFlagBits = 1000202000
+	PIPELINE_STAGE_FRAGMENT_DENSITY_PROCESS_BIT_EXT         PipelineStageFlagBits = 1000218000
+	PIPELINE_STAGE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR PipelineStageFlagBits = 1000226000
+	PIPELINE_STAGE_COMMAND_PREPROCESS_BIT_NV                PipelineStageFlagBits = 1000277000
+	PIPELINE_STAGE_NONE_KHR                                 PipelineStageFlagBits = 1000314000
+	PIPELINE_STAGE_RAY_TRACING_SHADER_BIT_KHR               PipelineStageFlagBits = 1000347000
+	PIPELINE_STAGE_ACCELERATION_STRUCTURE_BUILD_BIT_NV      PipelineStageFlagBits = PIPELINE_STAGE_ACCELERATION_STRUCTURE_BUILD_BIT_KHR
+	PIPELINE_STAGE_SHADING_RATE_IMAGE_BIT_NV                PipelineStageFlagBits = PIPELINE_STAGE_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR
+	PIPELINE_STAGE_RAY_TRACING_SHADER_BIT_NV                PipelineStageFlagBits = PIPELINE_STAGE_RAY_TRACING_SHADER_BIT_KHR
+)
+
+type PipelineStageFlagBits2KHR = PipelineStageFlags2KHR
+
+const (
+	PIPELINE_STAGE_2_NONE_KHR                                 PipelineStageFlagBits2KHR = 0
+	PIPELINE_STAGE_2_TOP_OF_PIPE_BIT_KHR                      PipelineStageFlagBits2KHR = 1 << 0
+	PIPELINE_STAGE_2_DRAW_INDIRECT_BIT_KHR                    PipelineStageFlagBits2KHR = 1 << 1
+	PIPELINE_STAGE_2_COLOR_ATTACHMENT_OUTPUT_BIT_KHR          PipelineStageFlagBits2KHR = 1 << 10
+	PIPELINE_STAGE_2_COMPUTE_SHADER_BIT_KHR                   PipelineStageFlagBits2KHR = 1 << 11
+	PIPELINE_STAGE_2_ALL_TRANSFER_BIT_KHR                     PipelineStageFlagBits2KHR = 1 << 12
+	PIPELINE_STAGE_2_BOTTOM_OF_PIPE_BIT_KHR                   PipelineStageFlagBits2KHR = 1 << 13
+	PIPELINE_STAGE_2_HOST_BIT_KHR                             PipelineStageFlagBits2KHR = 1 << 14
+	PIPELINE_STAGE_2_ALL_GRAPHICS_BIT_KHR                     PipelineStageFlagBits2KHR = 1 << 15
+	PIPELINE_STAGE_2_ALL_COMMANDS_BIT_KHR                     PipelineStageFlagBits2KHR = 1 << 16
+	PIPELINE_STAGE_2_VERTEX_INPUT_BIT_KHR                     PipelineStageFlagBits2KHR = 1 << 2
+	PIPELINE_STAGE_2_VERTEX_SHADER_BIT_KHR                    PipelineStageFlagBits2KHR = 1 << 3
+	PIPELINE_STAGE_2_COPY_BIT_KHR                             PipelineStageFlagBits2KHR = 1 << 32
+	PIPELINE_STAGE_2_RESOLVE_BIT_KHR                          PipelineStageFlagBits2KHR = 1 << 33
+	PIPELINE_STAGE_2_BLIT_BIT_KHR                             PipelineStageFlagBits2KHR = 1 << 34
+	PIPELINE_STAGE_2_CLEAR_BIT_KHR                            PipelineStageFlagBits2KHR = 1 << 35
+	PIPELINE_STAGE_2_INDEX_INPUT_BIT_KHR                      PipelineStageFlagBits2KHR = 1 << 36
+	PIPELINE_STAGE_2_VERTEX_ATTRIBUTE_INPUT_BIT_KHR           PipelineStageFlagBits2KHR = 1 << 37
+	PIPELINE_STAGE_2_PRE_RASTERIZATION_SHADERS_BIT_KHR        PipelineStageFlagBits2KHR = 1 << 38
+	PIPELINE_STAGE_2_TESSELLATION_CONTROL_SHADER_BIT_KHR      PipelineStageFlagBits2KHR = 1 << 4
+	PIPELINE_STAGE_2_TESSELLATION_EVALUATION_SHADER_BIT_KHR   PipelineStageFlagBits2KHR = 1 << 5
+	PIPELINE_STAGE_2_GEOMETRY_SHADER_BIT_KHR                  PipelineStageFlagBits2KHR = 1 << 6
+	PIPELINE_STAGE_2_FRAGMENT_SHADER_BIT_KHR                  PipelineStageFlagBits2KHR = 1 << 7
+	PIPELINE_STAGE_2_EARLY_FRAGMENT_TESTS_BIT_KHR             PipelineStageFlagBits2KHR = 1 << 8
+	PIPELINE_STAGE_2_LATE_FRAGMENT_TESTS_BIT_KHR              PipelineStageFlagBits2KHR = 1 << 9
+	PIPELINE_STAGE_2_COMMAND_PREPROCESS_BIT_NV                PipelineStageFlagBits2KHR = 1000314000
+	PIPELINE_STAGE_2_RAY_TRACING_SHADER_BIT_KHR               PipelineStageFlagBits2KHR = 1000314000
+	PIPELINE_STAGE_2_FRAGMENT_DENSITY_PROCESS_BIT_EXT         PipelineStageFlagBits2KHR = 1000314000
+	PIPELINE_STAGE_2_MESH_SHADER_BIT_NV                       PipelineStageFlagBits2KHR = 1000314000
+	PIPELINE_STAGE_2_TASK_SHADER_BIT_NV                       PipelineStageFlagBits2KHR = 1000314000
+	PIPELINE_STAGE_2_ACCELERATION_STRUCTURE_BUILD_BIT_KHR     PipelineStageFlagBits2KHR = 1000314000
+	PIPELINE_STAGE_2_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR PipelineStageFlagBits2KHR = 1000314000
+	// A pipeline stage for conditional rendering predicate fetch
+	PIPELINE_STAGE_2_CONDITIONAL_RENDERING_BIT_EXT       PipelineStageFlagBits2KHR = 1000314000
+	PIPELINE_STAGE_2_TRANSFORM_FEEDBACK_BIT_EXT          PipelineStageFlagBits2KHR = 1000314000
+	PIPELINE_STAGE_2_SUBPASS_SHADING_BIT_HUAWEI          PipelineStageFlagBits2KHR = 1000369000
+	PIPELINE_STAGE_2_INVOCATION_MASK_BIT_HUAWEI          PipelineStageFlagBits2KHR = 1000370000
+	PIPELINE_STAGE_2_ACCELERATION_STRUCTURE_BUILD_BIT_NV PipelineStageFlagBits2KHR = PIPELINE_STAGE_2_ACCELERATION_STRUCTURE_BUILD_BIT_KHR
+	PIPELINE_STAGE_2_TRANSFER_BIT_KHR                    PipelineStageFlagBits2KHR = PIPELINE_STAGE_2_ALL_TRANSFER_BIT_KHR
+	PIPELINE_STAGE_2_SHADING_RATE_IMAGE_BIT_NV           PipelineStageFlagBits2KHR = PIPELINE_STAGE_2_FRAGMENT_SHADING_RATE_ATTACHMENT_BIT_KHR
+	PIPELINE_STAGE_2_RAY_TRACING_SHADER_BIT_NV           PipelineStageFlagBits2KHR = PIPELINE_STAGE_2_RAY_TRACING_SHADER_BIT_KHR
+)
+
+// PointClippingBehavior: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPointClippingBehavior.html
+type PointClippingBehavior int32
+
+const (
+	POINT_CLIPPING_BEHAVIOR_ALL_CLIP_PLANES           PointClippingBehavior = 0
+	POINT_CLIPPING_BEHAVIOR_USER_CLIP_PLANES_ONLY     PointClippingBehavior = 1
+	POINT_CLIPPING_BEHAVIOR_ALL_CLIP_PLANES_KHR       PointClippingBehavior = POINT_CLIPPING_BEHAVIOR_ALL_CLIP_PLANES
+	POINT_CLIPPING_BEHAVIOR_USER_CLIP_PLANES_ONLY_KHR PointClippingBehavior = POINT_CLIPPING_BEHAVIOR_USER_CLIP_PLANES_ONLY
+)
+
+// PointClippingBehaviorKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPointClippingBehaviorKHR.html
+type PointClippingBehaviorKHR = PointClippingBehavior
+
+// PolygonMode: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPolygonMode.html
+type PolygonMode int32
+
+const (
+	POLYGON_MODE_FILL              PolygonMode = 0
+	POLYGON_MODE_LINE              PolygonMode = 1
+	POLYGON_MODE_POINT             PolygonMode = 2
+	POLYGON_MODE_FILL_RECTANGLE_NV PolygonMode = 1000153000
+)
+
+// PresentModeKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPresentModeKHR.html
+type PresentModeKHR int32
+
+const (
+	PRESENT_MODE_IMMEDIATE_KHR                 PresentModeKHR = 0
+	PRESENT_MODE_MAILBOX_KHR                   PresentModeKHR = 1
+	PRESENT_MODE_FIFO_KHR                      PresentModeKHR = 2
+	PRESENT_MODE_FIFO_RELAXED_KHR              PresentModeKHR = 3
+	PRESENT_MODE_SHARED_DEMAND_REFRESH_KHR     PresentModeKHR = 1000111000
+	PRESENT_MODE_SHARED_CONTINUOUS_REFRESH_KHR PresentModeKHR = 1000111001
+)
+
+// PrimitiveTopology: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkPrimitiveTopology.html
+type PrimitiveTopology int32
+
+const (
+	PRIMITIVE_TOPOLOGY_POINT_LIST                    PrimitiveTopology = 0
+	PRIMITIVE_TOPOLOGY_LINE_LIST                     PrimitiveTopology = 1
+	PRIMITIVE_TOPOLOGY_LINE_STRIP                    PrimitiveTopology = 2
+	PRIMITIVE_TOPOLOGY_TRIANGLE_LIST                 PrimitiveTopology = 3
+	PRIMITIVE_TOPOLOGY_TRIANGLE_STRIP                PrimitiveTopology = 4
+	PRIMITIVE_TOPOLOGY_TRIANGLE_FAN                  PrimitiveTopology = 5
+	PRIMITIVE_TOPOLOGY_LINE_LIST_WITH_ADJACENCY      PrimitiveTopology = 6
+	PRIMITIVE_TOPOLOGY_LINE_STRIP_WITH_ADJACENCY     PrimitiveTopology = 7
+	PRIMITIVE_TOPOLOGY_TRIANGLE_LIST_WITH_ADJACENCY  PrimitiveTopology = 8
+	PRIMITIVE_TOPOLOGY_TRIANGLE_STRIP_WITH_ADJACENCY PrimitiveTopology = 9
+	PRIMITIVE_TOPOLOGY_PATCH_LIST                    PrimitiveTopology = 10
+)
+
+type PrivateDataSlotCreateFlagBitsEXT = PrivateDataSlotCreateFlagsEXT
+
+// ProvokingVertexModeEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkProvokingVertexModeEXT.html
+type ProvokingVertexModeEXT int32
+
+const (
+	PROVOKING_VERTEX_MODE_FIRST_VERTEX_EXT ProvokingVertexModeEXT = 0
+	PROVOKING_VERTEX_MODE_LAST_VERTEX_EXT  ProvokingVertexModeEXT = 1
+)
+
+type QueryControlFlagBits = QueryControlFlags
+
+const (
+	QUERY_CONTROL_PRECISE_BIT QueryControlFlagBits = 1 << 0
+)
+
+type QueryPipelineStatisticFlagBits = QueryPipelineStatisticFlags
+
+const (
+	QUERY_PIPELINE_STATISTIC_INPUT_ASSEMBLY_VERTICES_BIT                    QueryPipelineStatisticFlagBits = 1 << 0
+	QUERY_PIPELINE_STATISTIC_INPUT_ASSEMBLY_PRIMITIVES_BIT                  QueryPipelineStatisticFlagBits = 1 << 1
+	QUERY_PIPELINE_STATISTIC_COMPUTE_SHADER_INVOCATIONS_BIT                 QueryPipelineStatisticFlagBits = 1 << 10
+	QUERY_PIPELINE_STATISTIC_VERTEX_SHADER_INVOCATIONS_BIT                  QueryPipelineStatisticFlagBits = 1 << 2
+	QUERY_PIPELINE_STATISTIC_GEOMETRY_SHADER_INVOCATIONS_BIT                QueryPipelineStatisticFlagBits = 1 << 3
+	QUERY_PIPELINE_STATISTIC_GEOMETRY_SHADER_PRIMITIVES_BIT                 QueryPipelineStatisticFlagBits = 1 << 4
+	QUERY_PIPELINE_STATISTIC_CLIPPING_INVOCATIONS_BIT                       QueryPipelineStatisticFlagBits = 1 << 5
+	QUERY_PIPELINE_STATISTIC_CLIPPING_PRIMITIVES_BIT                        QueryPipelineStatisticFlagBits = 1 << 6
+	QUERY_PIPELINE_STATISTIC_FRAGMENT_SHADER_INVOCATIONS_BIT                QueryPipelineStatisticFlagBits = 1 << 7
+	QUERY_PIPELINE_STATISTIC_TESSELLATION_CONTROL_SHADER_PATCHES_BIT        QueryPipelineStatisticFlagBits = 1 << 8
+	QUERY_PIPELINE_STATISTIC_TESSELLATION_EVALUATION_SHADER_INVOCATIONS_BIT QueryPipelineStatisticFlagBits = 1 << 9
+)
+
+// QueryPoolSamplingModeINTEL: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkQueryPoolSamplingModeINTEL.html
+type QueryPoolSamplingModeINTEL int32
+
+const (
+	QUERY_POOL_SAMPLING_MODE_MANUAL_INTEL QueryPoolSamplingModeINTEL = 0
+)
+
+type QueryResultFlagBits = QueryResultFlags
+
+const (
+	QUERY_RESULT_64_BIT                QueryResultFlagBits = 1 << 0
+	QUERY_RESULT_WAIT_BIT              QueryResultFlagBits = 1 << 1
+	QUERY_RESULT_WITH_AVAILABILITY_BIT QueryResultFlagBits = 1 << 2
+	QUERY_RESULT_PARTIAL_BIT           QueryResultFlagBits = 1 << 3
+)
+
+// QueryType: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkQueryType.html
+type QueryType int32
+
+const (
+	QUERY_TYPE_OCCLUSION QueryType = 0
+	// Optional
+	QUERY_TYPE_PIPELINE_STATISTICS                           QueryType = 1
+	QUERY_TYPE_TIMESTAMP                                     QueryType = 2
+	QUERY_TYPE_TRANSFORM_FEEDBACK_STREAM_EXT                 QueryType = 1000028004
+	QUERY_TYPE_PERFORMANCE_QUERY_KHR                         QueryType = 1000116000
+	QUERY_TYPE_ACCELERATION_STRUCTURE_COMPACTED_SIZE_KHR     QueryType = 1000150000
+	QUERY_TYPE_ACCELERATION_STRUCTURE_SERIALIZATION_SIZE_KHR QueryType = 1000150001
+	QUERY_TYPE_ACCELERATION_STRUCTURE_COMPACTED_SIZE_NV      QueryType = 1000165000
+	QUERY_TYPE_PERFORMANCE_QUERY_INTEL                       QueryType = 1000210000
+)
+
+type QueueFlagBits = QueueFlags
+
+const (
+	QUEUE_GRAPHICS_BIT       QueueFlagBits = 1 << 0
+	QUEUE_COMPUTE_BIT        QueueFlagBits = 1 << 1
+	QUEUE_TRANSFER_BIT       QueueFlagBits = 1 << 2
+	QUEUE_SPARSE_BINDING_BIT QueueFlagBits = 1 << 3
+	QUEUE_PROTECTED_BIT      QueueFlagBits = 1 << 4
+)
+
+// QueueGlobalPriorityEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkQueueGlobalPriorityEXT.html
+type QueueGlobalPriorityEXT int32
+
+const (
+	QUEUE_GLOBAL_PRIORITY_LOW_EXT      QueueGlobalPriorityEXT = 128
+	QUEUE_GLOBAL_PRIORITY_MEDIUM_EXT   QueueGlobalPriorityEXT = 256
+	QUEUE_GLOBAL_PRIORITY_HIGH_EXT     QueueGlobalPriorityEXT = 512
+	QUEUE_GLOBAL_PRIORITY_REALTIME_EXT QueueGlobalPriorityEXT = 1024
+)
+
+// RasterizationOrderAMD: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkRasterizationOrderAMD.html
+type RasterizationOrderAMD int32
+
+const (
+	RASTERIZATION_ORDER_STRICT_AMD  RasterizationOrderAMD = 0
+	RASTERIZATION_ORDER_RELAXED_AMD RasterizationOrderAMD = 1
+)
+
+// RayTracingShaderGroupTypeKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkRayTracingShaderGroupTypeKHR.html
+type RayTracingShaderGroupTypeKHR int32
+
+const (
+	RAY_TRACING_SHADER_GROUP_TYPE_GENERAL_KHR              RayTracingShaderGroupTypeKHR = 0
+	RAY_TRACING_SHADER_GROUP_TYPE_TRIANGLES_HIT_GROUP_KHR  RayTracingShaderGroupTypeKHR = 1
+	RAY_TRACING_SHADER_GROUP_TYPE_PROCEDURAL_HIT_GROUP_KHR RayTracingShaderGroupTypeKHR = 2
+	RAY_TRACING_SHADER_GROUP_TYPE_GENERAL_NV               RayTracingShaderGroupTypeKHR = RAY_TRACING_SHADER_GROUP_TYPE_GENERAL_KHR
+	RAY_TRACING_SHADER_GROUP_TYPE_PROCEDURAL_HIT_GROUP_NV  RayTracingShaderGroupTypeKHR = RAY_TRACING_SHADER_GROUP_TYPE_PROCEDURAL_HIT_GROUP_KHR
+	RAY_TRACING_SHADER_GROUP_TYPE_TRIANGLES_HIT_GROUP_NV   RayTracingShaderGroupTypeKHR = RAY_TRACING_SHADER_GROUP_TYPE_TRIANGLES_HIT_GROUP_KHR
+)
+
+// RayTracingShaderGroupTypeNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkRayTracingShaderGroupTypeNV.html
+type RayTracingShaderGroupTypeNV = RayTracingShaderGroupTypeKHR
+type RenderPassCreateFlagBits = RenderPassCreateFlags
+
+const (
+	RENDER_PASS_CREATE_TRANSFORM_BIT_QCOM RenderPassCreateFlagBits = 1000282000
+)
+
+type ResolveModeFlagBits = ResolveModeFlags
+
+const (
+	RESOLVE_MODE_NONE                ResolveModeFlagBits = 0
+	RESOLVE_MODE_SAMPLE_ZERO_BIT     ResolveModeFlagBits = 1 << 0
+	RESOLVE_MODE_AVERAGE_BIT         ResolveModeFlagBits = 1 << 1
+	RESOLVE_MODE_MIN_BIT             ResolveModeFlagBits = 1 << 2
+	RESOLVE_MODE_MAX_BIT             ResolveModeFlagBits = 1 << 3
+	RESOLVE_MODE_AVERAGE_BIT_KHR     ResolveModeFlagBits = RESOLVE_MODE_AVERAGE_BIT
+	RESOLVE_MODE_MAX_BIT_KHR         ResolveModeFlagBits = RESOLVE_MODE_MAX_BIT
+	RESOLVE_MODE_MIN_BIT_KHR         ResolveModeFlagBits = RESOLVE_MODE_MIN_BIT
+	RESOLVE_MODE_NONE_KHR            ResolveModeFlagBits = RESOLVE_MODE_NONE
+	RESOLVE_MODE_SAMPLE_ZERO_BIT_KHR ResolveModeFlagBits = RESOLVE_MODE_SAMPLE_ZERO_BIT
+)
+
+// ResolveModeFlagBitsKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkResolveModeFlagBitsKHR.html
+type ResolveModeFlagBitsKHR = ResolveModeFlagBits
+
+// Result: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkResult.html
+type Result int32
+
+const (
+	ERROR_INVALID_OPAQUE_CAPTURE_ADDRESS               Result = -1000257000
+	ERROR_NOT_PERMITTED_EXT                            Result = -1000174001
+	ERROR_FRAGMENTATION                                Result = -1000161000
+	ERROR_INVALID_DRM_FORMAT_MODIFIER_PLANE_LAYOUT_EXT Result = -1000158000
+	ERROR_INVALID_EXTERNAL_HANDLE                      Result = -1000072003
+	ERROR_OUT_OF_POOL_MEMORY                           Result = -1000069000
+	ERROR_INVALID_SHADER_NV                            Result = -1000012000
+	ERROR_VALIDATION_FAILED_EXT                        Result = -1000011001
+	ERROR_INCOMPATIBLE_DISPLAY_KHR                     Result = -1000003001
+	ERROR_OUT_OF_DATE_KHR                              Result = -1000001004
+	ERROR_NATIVE_WINDOW_IN_USE_KHR                     Result = -1000000001
+	ERROR_SURFACE_LOST_KHR                             Result = -1000000000
+	// An unknown error has occurred, due to an implementation or application bug
+	ERROR_UNKNOWN Result = -13
+	// A requested pool allocation has failed due to fragmentation of the pool's memory
+	ERROR_FRAGMENTED_POOL Result = -12
+	// Requested format is not supported on this device
+	ERROR_FORMAT_NOT_SUPPORTED Result = -11
+	// Too many objects of the type have already been created
+	ERROR_TOO_MANY_OBJECTS Result = -10
+	// Unable to find a Vulkan driver
+	ERROR_INCOMPATIBLE_DRIVER Result = -9
+	// Requested feature is not available on this device
+	ERROR_FEATURE_NOT_PRESENT Result = -8
+	// Extension specified does not exist
+	ERROR_EXTENSION_NOT_PRESENT Result = -7
+	// Layer specified does not exist
+	ERROR_LAYER_NOT_PRESENT Result = -6
+	// Mapping of a memory object has failed
+	ERROR_MEMORY_MAP_FAILED Result = -5
+	// The logical device has been lost. See <<devsandqueues-lost-device>>
+	ERROR_DEVICE_LOST Result = -4
+	// Initialization of a object has failed
+	ERROR_INITIALIZATION_FAILED Result = -3
+	// A device memory allocation has failed
+	ERROR_OUT_OF_DEVICE_MEMORY Result = -2
+	// A host memory allocation has failed
+	ERROR_OUT_OF_HOST_MEMORY Result = -1
+	// Command completed successfully
+	SUCCESS Result = 0
+	// A fence or query has not yet completed
+	NOT_READY Result = 1
+	// A wait operation has not completed in the specified time
+	TIMEOUT Result = 2
+	// An event is signaled
+	EVENT_SET Result = 3
+	// An event is unsignaled
+	EVENT_RESET Result = 4
+	// A return array was too small for the result
+	INCOMPLETE                               Result = 5
+	SUBOPTIMAL_KHR                           Result = 1000001003
+	THREAD_IDLE_KHR                          Result = 1000268000
+	THREAD_DONE_KHR                          Result = 1000268001
+	OPERATION_DEFERRED_KHR                   Result = 1000268002
+	OPERATION_NOT_DEFERRED_KHR               Result = 1000268003
+	PIPELINE_COMPILE_REQUIRED_EXT            Result = 1000297000
+	ERROR_FRAGMENTATION_EXT                  Result = ERROR_FRAGMENTATION
+	ERROR_INVALID_EXTERNAL_HANDLE_KHR        Result = ERROR_INVALID_EXTERNAL_HANDLE
+	ERROR_INVALID_DEVICE_ADDRESS_EXT         Result = ERROR_INVALID_OPAQUE_CAPTURE_ADDRESS
+	ERROR_INVALID_OPAQUE_CAPTURE_ADDRESS_KHR Result = ERROR_INVALID_OPAQUE_CAPTURE_ADDRESS
+	ERROR_OUT_OF_POOL_MEMORY_KHR             Result = ERROR_OUT_OF_POOL_MEMORY
+	ERROR_PIPELINE_COMPILE_REQUIRED_EXT      Result = PIPELINE_COMPILE_REQUIRED_EXT
+)
+
+type SampleCountFlagBits = SampleCountFlags
+
+const (
+	SAMPLE_COUNT_1_BIT  SampleCountFlagBits = 1 << 0
+	SAMPLE_COUNT_2_BIT  SampleCountFlagBits = 1 << 1
+	SAMPLE_COUNT_4_BIT  SampleCountFlagBits = 1 << 2
+	SAMPLE_COUNT_8_BIT  SampleCountFlagBits = 1 << 3
+	SAMPLE_COUNT_16_BIT SampleCountFlagBits = 1 << 4
+	SAMPLE_COUNT_32_BIT SampleCountFlagBits = 1 << 5
+	SAMPLE_COUNT_64_BIT SampleCountFlagBits = 1 << 6
+)
+
+// SamplerAddressMode: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkSamplerAddressMode.html
+type SamplerAddressMode int32
+
+const (
+	SAMPLER_ADDRESS_MODE_REPEAT          SamplerAddressMode = 0
+	SAMPLER_ADDRESS_MODE_MIRRORED_REPEAT SamplerAddressMode = 1
+	SAMPLER_ADDRESS_MODE_CLAMP_TO_EDGE   SamplerAddressMode = 2
+	SAMPLER_ADDRESS_MODE_CLAMP_TO_BORDER SamplerAddressMode = 3
+	// Note that this defines what was previously a core enum, and so uses the 'value' attribute rather than 'offset', and does not have a suffix. This is a special case, and should not be repeated
+	SAMPLER_ADDRESS_MODE_MIRROR_CLAMP_TO_EDGE SamplerAddressMode = 1000014000
+	// Alias introduced for consistency with extension suffixing rules
+	SAMPLER_ADDRESS_MODE_MIRROR_CLAMP_TO_EDGE_KHR SamplerAddressMode = SAMPLER_ADDRESS_MODE_MIRROR_CLAMP_TO_EDGE
+)
+
+type SamplerCreateFlagBits = SamplerCreateFlags
+
+const (
+	SAMPLER_CREATE_SUBSAMPLED_COARSE_RECONSTRUCTION_BIT_EXT SamplerCreateFlagBits = 1000218000
+	SAMPLER_CREATE_SUBSAMPLED_BIT_EXT                       SamplerCreateFlagBits = 1000218000
+)
+
+// SamplerMipmapMode: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkSamplerMipmapMode.html
+type SamplerMipmapMode int32
+
+const (
+	// Choose nearest mip level
+	SAMPLER_MIPMAP_MODE_NEAREST SamplerMipmapMode = 0
+	// Linear filter between mip levels
+	SAMPLER_MIPMAP_MODE_LINEAR SamplerMipmapMode = 1
+)
+
+// SamplerReductionMode: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkSamplerReductionMode.html
+type SamplerReductionMode int32
+
+const (
+	SAMPLER_REDUCTION_MODE_WEIGHTED_AVERAGE     SamplerReductionMode = 0
+	SAMPLER_REDUCTION_MODE_MIN                  SamplerReductionMode = 1
+	SAMPLER_REDUCTION_MODE_MAX                  SamplerReductionMode = 2
+	SAMPLER_REDUCTION_MODE_MAX_EXT              SamplerReductionMode = SAMPLER_REDUCTION_MODE_MAX
+	SAMPLER_REDUCTION_MODE_MIN_EXT              SamplerReductionMode = SAMPLER_REDUCTION_MODE_MIN
+	SAMPLER_REDUCTION_MODE_WEIGHTED_AVERAGE_EXT SamplerReductionMode = SAMPLER_REDUCTION_MODE_WEIGHTED_AVERAGE
+)
+
+// SamplerReductionModeEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkSamplerReductionModeEXT.html
+type SamplerReductionModeEXT = SamplerReductionMode
+
+// SamplerYcbcrModelConversion: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkSamplerYcbcrModelConversion.html
+type SamplerYcbcrModelConversion int32
+
+const (
+	SAMPLER_YCBCR_MODEL_CONVERSION_RGB_IDENTITY SamplerYcbcrModelConversion = 0
+	// just range expansion
+	SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_IDENTITY SamplerYcbcrModelConversion = 1
+	// aka HD YUV
+	SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_709 SamplerYcbcrModelConversion = 2
+	// aka SD YUV
+	SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_601 SamplerYcbcrModelConversion = 3
+	// aka UHD YUV
+	SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_2020         SamplerYcbcrModelConversion = 4
+	SAMPLER_YCBCR_MODEL_CONVERSION_RGB_IDENTITY_KHR   SamplerYcbcrModelConversion = SAMPLER_YCBCR_MODEL_CONVERSION_RGB_IDENTITY
+	SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_2020_KHR     SamplerYcbcrModelConversion = SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_2020
+	SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_601_KHR      SamplerYcbcrModelConversion = SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_601
+	SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_709_KHR      SamplerYcbcrModelConversion = SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_709
+	SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_IDENTITY_KHR SamplerYcbcrModelConversion = SAMPLER_YCBCR_MODEL_CONVERSION_YCBCR_IDENTITY
+)
+
+// SamplerYcbcrModelConversionKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkSamplerYcbcrModelConversionKHR.html
+type SamplerYcbcrModelConversionKHR = SamplerYcbcrModelConversion
+
+// SamplerYcbcrRange: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkSamplerYcbcrRange.html
+type SamplerYcbcrRange int32
+
+const (
+	// Luma 0..1 maps to 0..255, chroma -0.5..0.5 to 1..255 (clamped)
+	SAMPLER_YCBCR_RANGE_ITU_FULL SamplerYcbcrRange = 0
+	// Luma 0..1 maps to 16..235, chroma -0.5..0.5 to 16..240
+	SAMPLER_YCBCR_RANGE_ITU_NARROW     SamplerYcbcrRange = 1
+	SAMPLER_YCBCR_RANGE_ITU_FULL_KHR   SamplerYcbcrRange = SAMPLER_YCBCR_RANGE_ITU_FULL
+	SAMPLER_YCBCR_RANGE_ITU_NARROW_KHR SamplerYcbcrRange = SAMPLER_YCBCR_RANGE_ITU_NARROW
+)
+
+// SamplerYcbcrRangeKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkSamplerYcbcrRangeKHR.html
+type SamplerYcbcrRangeKHR = SamplerYcbcrRange
+
+// ScopeNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkScopeNV.html
+type ScopeNV int32
+
+const (
+	SCOPE_DEVICE_NV       ScopeNV = 1
+	SCOPE_WORKGROUP_NV    ScopeNV = 2
+	SCOPE_SUBGROUP_NV     ScopeNV = 3
+	SCOPE_QUEUE_FAMILY_NV ScopeNV = 5
+)
+
+type SemaphoreImportFlagBits = SemaphoreImportFlags
+
+const (
+	SEMAPHORE_IMPORT_TEMPORARY_BIT     SemaphoreImportFlagBits = 1 << 0
+	SEMAPHORE_IMPORT_TEMPORARY_BIT_KHR SemaphoreImportFlagBits = SEMAPHORE_IMPORT_TEMPORARY_BIT
+)
+
+// SemaphoreImportFlagBitsKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkSemaphoreImportFlagBitsKHR.html
+type SemaphoreImportFlagBitsKHR = SemaphoreImportFlagBits
+
+// SemaphoreType: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkSemaphoreType.html
+type SemaphoreType int32
+
+const (
+	SEMAPHORE_TYPE_BINARY       SemaphoreType = 0
+	SEMAPHORE_TYPE_TIMELINE     SemaphoreType = 1
+	SEMAPHORE_TYPE_BINARY_KHR   SemaphoreType = SEMAPHORE_TYPE_BINARY
+	SEMAPHORE_TYPE_TIMELINE_KHR SemaphoreType = SEMAPHORE_TYPE_TIMELINE
+)
+
+// SemaphoreTypeKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkSemaphoreTypeKHR.html
+type SemaphoreTypeKHR = SemaphoreType
+type SemaphoreWaitFlagBits = SemaphoreWaitFlags
+
+const (
+	SEMAPHORE_WAIT_ANY_BIT     SemaphoreWaitFlagBits = 1 << 0
+	SEMAPHORE_WAIT_ANY_BIT_KHR SemaphoreWaitFlagBits = SEMAPHORE_WAIT_ANY_BIT
+)
+
+// SemaphoreWaitFlagBitsKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkSemaphoreWaitFlagBitsKHR.html
+type SemaphoreWaitFlagBitsKHR = SemaphoreWaitFlagBits
+type ShaderCorePropertiesFlagBitsAMD = ShaderCorePropertiesFlagsAMD
+
+// ShaderFloatControlsIndependence: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkShaderFloatControlsIndependence.html
+type ShaderFloatControlsIndependence int32
+
+const (
+	SHADER_FLOAT_CONTROLS_INDEPENDENCE_32_BIT_ONLY     ShaderFloatControlsIndependence = 0
+	SHADER_FLOAT_CONTROLS_INDEPENDENCE_ALL             ShaderFloatControlsIndependence = 1
+	SHADER_FLOAT_CONTROLS_INDEPENDENCE_NONE            ShaderFloatControlsIndependence = 2
+	SHADER_FLOAT_CONTROLS_INDEPENDENCE_32_BIT_ONLY_KHR ShaderFloatControlsIndependence = SHADER_FLOAT_CONTROLS_INDEPENDENCE_32_BIT_ONLY
+	SHADER_FLOAT_CONTROLS_INDEPENDENCE_ALL_KHR         ShaderFloatControlsIndependence = SHADER_FLOAT_CONTROLS_INDEPENDENCE_ALL
+	SHADER_FLOAT_CONTROLS_INDEPENDENCE_NONE_KHR        ShaderFloatControlsIndependence = SHADER_FLOAT_CONTROLS_INDEPENDENCE_NONE
+)
+
+// ShaderFloatControlsIndependenceKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkShaderFloatControlsIndependenceKHR.html
+type ShaderFloatControlsIndependenceKHR = ShaderFloatControlsIndependence
+
+// ShaderGroupShaderKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkShaderGroupShaderKHR.html
+type ShaderGroupShaderKHR int32
+
+const (
+	SHADER_GROUP_SHADER_GENERAL_KHR      ShaderGroupShaderKHR = 0
+	SHADER_GROUP_SHADER_CLOSEST_HIT_KHR  ShaderGroupShaderKHR = 1
+	SHADER_GROUP_SHADER_ANY_HIT_KHR      ShaderGroupShaderKHR = 2
+	SHADER_GROUP_SHADER_INTERSECTION_KHR ShaderGroupShaderKHR = 3
+)
+
+// ShaderInfoTypeAMD: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkShaderInfoTypeAMD.html
+type ShaderInfoTypeAMD int32
+
+const (
+	SHADER_INFO_TYPE_STATISTICS_AMD  ShaderInfoTypeAMD = 0
+	SHADER_INFO_TYPE_BINARY_AMD      ShaderInfoTypeAMD = 1
+	SHADER_INFO_TYPE_DISASSEMBLY_AMD ShaderInfoTypeAMD = 2
+)
+
+type ShaderStageFlagBits = ShaderStageFlags
+
+const (
+	SHADER_STAGE_ALL_GRAPHICS                ShaderStageFlagBits = 0x0000001F
+	SHADER_STAGE_ALL                         ShaderStageFlagBits = 0x7FFFFFFF
+	SHADER_STAGE_VERTEX_BIT                  ShaderStageFlagBits = 1 << 0
+	SHADER_STAGE_TESSELLATION_CONTROL_BIT    ShaderStageFlagBits = 1 << 1
+	SHADER_STAGE_TESSELLATION_EVALUATION_BIT ShaderStageFlagBits = 1 << 2
+	SHADER_STAGE_GEOMETRY_BIT                ShaderStageFlagBits = 1 << 3
+	SHADER_STAGE_FRAGMENT_BIT                ShaderStageFlagBits = 1 << 4
+	SHADER_STAGE_COMPUTE_BIT                 ShaderStageFlagBits = 1 << 5
+	SHADER_STAGE_TASK_BIT_NV                 ShaderStageFlagBits = 1000202000
+	SHADER_STAGE_MESH_BIT_NV                 ShaderStageFlagBits = 1000202000
+	SHADER_STAGE_RAYGEN_BIT_KHR              ShaderStageFlagBits = 1000347000
+	SHADER_STAGE_MISS_BIT_KHR                ShaderStageFlagBits = 1000347000
+	SHADER_STAGE_CALLABLE_BIT_KHR            ShaderStageFlagBits = 1000347000
+	SHADER_STAGE_ANY_HIT_BIT_KHR             ShaderStageFlagBits = 1000347000
+	SHADER_STAGE_CLOSEST_HIT_BIT_KHR         ShaderStageFlagBits = 1000347000
+	SHADER_STAGE_INTERSECTION_BIT_KHR        ShaderStageFlagBits = 1000347000
+	SHADER_STAGE_SUBPASS_SHADING_BIT_HUAWEI  ShaderStageFlagBits = 1000369000
+	SHADER_STAGE_ANY_HIT_BIT_NV              ShaderStageFlagBits = SHADER_STAGE_ANY_HIT_BIT_KHR
+	SHADER_STAGE_CALLABLE_BIT_NV             ShaderStageFlagBits = SHADER_STAGE_CALLABLE_BIT_KHR
+	SHADER_STAGE_CLOSEST_HIT_BIT_NV          ShaderStageFlagBits = SHADER_STAGE_CLOSEST_HIT_BIT_KHR
+	SHADER_STAGE_INTERSECTION_BIT_NV         ShaderStageFlagBits = SHADER_STAGE_INTERSECTION_BIT_KHR
+	SHADER_STAGE_MISS_BIT_NV                 ShaderStageFlagBits = SHADER_STAGE_MISS_BIT_KHR
+	SHADER_STAGE_RAYGEN_BIT_NV               ShaderStageFlagBits = SHADER_STAGE_RAYGEN_BIT_KHR
+)
+
+// ShadingRatePaletteEntryNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkShadingRatePaletteEntryNV.html
+type ShadingRatePaletteEntryNV int32
+
+const (
+	SHADING_RATE_PALETTE_ENTRY_NO_INVOCATIONS_NV              ShadingRatePaletteEntryNV = 0
+	SHADING_RATE_PALETTE_ENTRY_16_INVOCATIONS_PER_PIXEL_NV    ShadingRatePaletteEntryNV = 1
+	SHADING_RATE_PALETTE_ENTRY_8_INVOCATIONS_PER_PIXEL_NV     ShadingRatePaletteEntryNV = 2
+	SHADING_RATE_PALETTE_ENTRY_4_INVOCATIONS_PER_PIXEL_NV     ShadingRatePaletteEntryNV = 3
+	SHADING_RATE_PALETTE_ENTRY_2_INVOCATIONS_PER_PIXEL_NV     ShadingRatePaletteEntryNV = 4
+	SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_PIXEL_NV      ShadingRatePaletteEntryNV = 5
+	SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_2X1_PIXELS_NV ShadingRatePaletteEntryNV = 6
+	SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_1X2_PIXELS_NV ShadingRatePaletteEntryNV = 7
+	SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_2X2_PIXELS_NV ShadingRatePaletteEntryNV = 8
+	SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_4X2_PIXELS_NV ShadingRatePaletteEntryNV = 9
+	SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_2X4_PIXELS_NV ShadingRatePaletteEntryNV = 10
+	SHADING_RATE_PALETTE_ENTRY_1_INVOCATION_PER_4X4_PIXELS_NV ShadingRatePaletteEntryNV = 11
+)
+
+// SharingMode: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkSharingMode.html
+type SharingMode int32
+
+const (
+	SHARING_MODE_EXCLUSIVE  SharingMode = 0
+	SHARING_MODE_CONCURRENT SharingMode = 1
+)
+
+type SparseImageFormatFlagBits = SparseImageFormatFlags
+
+const (
+	SPARSE_IMAGE_FORMAT_SINGLE_MIPTAIL_BIT         SparseImageFormatFlagBits = 1 << 0
+	SPARSE_IMAGE_FORMAT_ALIGNED_MIP_SIZE_BIT       SparseImageFormatFlagBits = 1 << 1
+	SPARSE_IMAGE_FORMAT_NONSTANDARD_BLOCK_SIZE_BIT SparseImageFormatFlagBits = 1 << 2
+)
+
+type SparseMemoryBindFlagBits = SparseMemoryBindFlags
+
+const (
+	SPARSE_MEMORY_BIND_METADATA_BIT SparseMemoryBindFlagBits = 1 << 0
+)
+
+type StencilFaceFlagBits = StencilFaceFlags
+
+const (
+	STENCIL_FACE_FRONT_AND_BACK StencilFaceFlagBits = 0x00000003
+	STENCIL_FACE_FRONT_BIT      StencilFaceFlagBits = 1 << 0
+	STENCIL_FACE_BACK_BIT       StencilFaceFlagBits = 1 << 1
+	STENCIL_FRONT_AND_BACK      StencilFaceFlagBits = STENCIL_FACE_FRONT_AND_BACK
+)
+
+// StencilOp: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkStencilOp.html
+type StencilOp int32
+
+const (
+	STENCIL_OP_KEEP                StencilOp = 0
+	STENCIL_OP_ZERO                StencilOp = 1
+	STENCIL_OP_REPLACE             StencilOp = 2
+	STENCIL_OP_INCREMENT_AND_CLAMP StencilOp = 3
+	STENCIL_OP_DECREMENT_AND_CLAMP StencilOp = 4
+	STENCIL_OP_INVERT              StencilOp = 5
+	STENCIL_OP_INCREMENT_AND_WRAP  StencilOp = 6
+	STENCIL_OP_DECREMENT_AND_WRAP  StencilOp = 7
+)
+
+// StructureType: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkStructureType.html
+type StructureType int32
+
+const (
+	STRUCTURE_TYPE_APPLICATION_INFO                          StructureType = 0
+	STRUCTURE_TYPE_INSTANCE_CREATE_INFO                      StructureType = 1
+	STRUCTURE_TYPE_DEVICE_QUEUE_CREATE_INFO                  StructureType = 2
+	STRUCTURE_TYPE_DEVICE_CREATE_INFO                        StructureType = 3
+	STRUCTURE_TYPE_SUBMIT_INFO                               StructureType = 4
+	STRUCTURE_TYPE_MEMORY_ALLOCATE_INFO                      StructureType = 5
+	STRUCTURE_TYPE_MAPPED_MEMORY_RANGE                       StructureType = 6
+	STRUCTURE_TYPE_BIND_SPARSE_INFO                          StructureType = 7
+	STRUCTURE_TYPE_FENCE_CREATE_INFO                         StructureType = 8
+	STRUCTURE_TYPE_SEMAPHORE_CREATE_INFO                     StructureType = 9
+	STRUCTURE_TYPE_EVENT_CREATE_INFO                         StructureType = 10
+	STRUCTURE_TYPE_QUERY_POOL_CREATE_INFO                    StructureType = 11
+	STRUCTURE_TYPE_BUFFER_CREATE_INFO                        StructureType = 12
+	STRUCTURE_TYPE_BUFFER_VIEW_CREATE_INFO                   StructureType = 13
+	STRUCTURE_TYPE_IMAGE_CREATE_INFO                         StructureType = 14
+	STRUCTURE_TYPE_IMAGE_VIEW_CREATE_INFO                    StructureType = 15
+	STRUCTURE_TYPE_SHADER_MODULE_CREATE_INFO                 StructureType = 16
+	STRUCTURE_TYPE_PIPELINE_CACHE_CREATE_INFO                StructureType = 17
+	STRUCTURE_TYPE_PIPELINE_SHADER_STAGE_CREATE_INFO         StructureType = 18
+	STRUCTURE_TYPE_PIPELINE_VERTEX_INPUT_STATE_CREATE_INFO   StructureType = 19
+	STRUCTURE_TYPE_PIPELINE_INPUT_ASSEMBLY_STATE_CREATE_INFO StructureType = 20
+	STRUCTURE_TYPE_PIPELINE_TESSELLATION_STATE_CREATE_INFO   StructureType = 21
+	STRUCTURE_TYPE_PIPELINE_VIEWPORT_STATE_CREATE_INFO       StructureType = 22
+	STRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_CREATE_INFO  StructureType = 23
+	STRUCTURE_TYPE_PIPELINE_MULTISAMPLE_STATE_CREATE_INFO    StructureType = 24
+	STRUCTURE_TYPE_PIPELINE_DEPTH_STENCIL_STATE_CREATE_INFO  StructureType = 25
+	STRUCTURE_TYPE_PIPELINE_COLOR_BLEND_STATE_CREATE_INFO    StructureType = 26
+	STRUCTURE_TYPE_PIPELINE_DYNAMIC_STATE_CREATE_INFO        StructureType = 27
+	STRUCTURE_TYPE_GRAPHICS_PIPELINE_CREATE_INFO             StructureType = 28
+	STRUCTURE_TYPE_COMPUTE_PIPELINE_CREATE_INFO              StructureType = 29
+	STRUCTURE_TYPE_PIPELINE_LAYOUT_CREATE_INFO               StructureType = 30
+	STRUCTURE_TYPE_SAMPLER_CREATE_INFO                       StructureType = 31
+	STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_CREATE_INFO         StructureType = 32
+	STRUCTURE_TYPE_DESCRIPTOR_POOL_CREATE_INFO               StructureType = 33
+	STRUCTURE_TYPE_DESCRIPTOR_SET_ALLOCATE_INFO              StructureType = 34
+	STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET                      StructureType = 35
+	STRUCTURE_TYPE_COPY_DESCRIPTOR_SET                       StructureType = 36
+	STRUCTURE_TYPE_FRAMEBUFFER_CREATE_INFO                   StructureType = 37
+	STRUCTURE_TYPE_RENDER_PASS_CREATE_INFO                   StructureType = 38
+	STRUCTURE_TYPE_COMMAND_POOL_CREATE_INFO                  StructureType = 39
+	STRUCTURE_TYPE_COMMAND_BUFFER_ALLOCATE_INFO              StructureType = 40
+	STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_INFO           StructureType = 41
+	STRUCTURE_TYPE_COMMAND_BUFFER_BEGIN_INFO                 StructureType = 42
+	STRUCTURE_TYPE_RENDER_PASS_BEGIN_INFO                    StructureType = 43
+	STRUCTURE_TYPE_BUFFER_MEMORY_BARRIER                     StructureType = 44
+	STRUCTURE_TYPE_IMAGE_MEMORY_BARRIER                      StructureType = 45
+	STRUCTURE_TYPE_MEMORY_BARRIER                            StructureType = 46
+	// Reserved for internal use by the loader, layers, and ICDs
+	STRUCTURE_TYPE_LOADER_INSTANCE_CREATE_INFO StructureType = 47
+	// Reserved for internal use by the loader, layers, and ICDs
+	STRUCTURE_TYPE_LOADER_DEVICE_CREATE_INFO                                         StructureType = 48
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_1_FEATURES                               StructureType = 49
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_1_PROPERTIES                             StructureType = 50
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_2_FEATURES                               StructureType = 51
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_2_PROPERTIES                             StructureType = 52
+	STRUCTURE_TYPE_SWAPCHAIN_CREATE_INFO_KHR                                         StructureType = 1000001000
+	STRUCTURE_TYPE_PRESENT_INFO_KHR                                                  StructureType = 1000001001
+	STRUCTURE_TYPE_DISPLAY_MODE_CREATE_INFO_KHR                                      StructureType = 1000002000
+	STRUCTURE_TYPE_DISPLAY_SURFACE_CREATE_INFO_KHR                                   StructureType = 1000002001
+	STRUCTURE_TYPE_DISPLAY_PRESENT_INFO_KHR                                          StructureType = 1000003000
+	STRUCTURE_TYPE_DEBUG_REPORT_CALLBACK_CREATE_INFO_EXT                             StructureType = 1000011000
+	STRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_RASTERIZATION_ORDER_AMD              StructureType = 1000018000
+	STRUCTURE_TYPE_DEBUG_MARKER_OBJECT_NAME_INFO_EXT                                 StructureType = 1000022000
+	STRUCTURE_TYPE_DEBUG_MARKER_OBJECT_TAG_INFO_EXT                                  StructureType = 1000022001
+	STRUCTURE_TYPE_DEBUG_MARKER_MARKER_INFO_EXT                                      StructureType = 1000022002
+	STRUCTURE_TYPE_DEDICATED_ALLOCATION_IMAGE_CREATE_INFO_NV                         StructureType = 1000026000
+	STRUCTURE_TYPE_DEDICATED_ALLOCATION_BUFFER_CREATE_INFO_NV                        StructureType = 1000026001
+	STRUCTURE_TYPE_DEDICATED_ALLOCATION_MEMORY_ALLOCATE_INFO_NV                      StructureType = 1000026002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_FEATURES_EXT                   StructureType = 1000028000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_PROPERTIES_EXT                 StructureType = 1000028001
+	STRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_STREAM_CREATE_INFO_EXT               StructureType = 1000028002
+	STRUCTURE_TYPE_CU_MODULE_CREATE_INFO_NVX                                         StructureType = 1000029000
+	STRUCTURE_TYPE_CU_FUNCTION_CREATE_INFO_NVX                                       StructureType = 1000029001
+	STRUCTURE_TYPE_CU_LAUNCH_INFO_NVX                                                StructureType = 1000029002
+	STRUCTURE_TYPE_IMAGE_VIEW_HANDLE_INFO_NVX                                        StructureType = 1000030000
+	STRUCTURE_TYPE_IMAGE_VIEW_ADDRESS_PROPERTIES_NVX                                 StructureType = 1000030001
+	STRUCTURE_TYPE_TEXTURE_LOD_GATHER_FORMAT_PROPERTIES_AMD                          StructureType = 1000041000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_CORNER_SAMPLED_IMAGE_FEATURES_NV                  StructureType = 1000050000
+	STRUCTURE_TYPE_RENDER_PASS_MULTIVIEW_CREATE_INFO                                 StructureType = 1000053000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_FEATURES                                StructureType = 1000053001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_PROPERTIES                              StructureType = 1000053002
+	STRUCTURE_TYPE_EXTERNAL_MEMORY_IMAGE_CREATE_INFO_NV                              StructureType = 1000056000
+	STRUCTURE_TYPE_EXPORT_MEMORY_ALLOCATE_INFO_NV                                    StructureType = 1000056001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_FEATURES_2                                        StructureType = 1000059000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_PROPERTIES_2                                      StructureType = 1000059001
+	STRUCTURE_TYPE_FORMAT_PROPERTIES_2                                               StructureType = 1000059002
+	STRUCTURE_TYPE_IMAGE_FORMAT_PROPERTIES_2                                         StructureType = 1000059003
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_FORMAT_INFO_2                               StructureType = 1000059004
+	STRUCTURE_TYPE_QUEUE_FAMILY_PROPERTIES_2                                         StructureType = 1000059005
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_PROPERTIES_2                               StructureType = 1000059006
+	STRUCTURE_TYPE_SPARSE_IMAGE_FORMAT_PROPERTIES_2                                  StructureType = 1000059007
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SPARSE_IMAGE_FORMAT_INFO_2                        StructureType = 1000059008
+	STRUCTURE_TYPE_MEMORY_ALLOCATE_FLAGS_INFO                                        StructureType = 1000060000
+	STRUCTURE_TYPE_DEVICE_GROUP_RENDER_PASS_BEGIN_INFO                               StructureType = 1000060003
+	STRUCTURE_TYPE_DEVICE_GROUP_COMMAND_BUFFER_BEGIN_INFO                            StructureType = 1000060004
+	STRUCTURE_TYPE_DEVICE_GROUP_SUBMIT_INFO                                          StructureType = 1000060005
+	STRUCTURE_TYPE_DEVICE_GROUP_BIND_SPARSE_INFO                                     StructureType = 1000060006
+	STRUCTURE_TYPE_DEVICE_GROUP_PRESENT_CAPABILITIES_KHR                             StructureType = 1000060007
+	STRUCTURE_TYPE_IMAGE_SWAPCHAIN_CREATE_INFO_KHR                                   StructureType = 1000060008
+	STRUCTURE_TYPE_BIND_IMAGE_MEMORY_SWAPCHAIN_INFO_KHR                              StructureType = 1000060009
+	STRUCTURE_TYPE_ACQUIRE_NEXT_IMAGE_INFO_KHR                                       StructureType = 1000060010
+	STRUCTURE_TYPE_DEVICE_GROUP_PRESENT_INFO_KHR                                     StructureType = 1000060011
+	STRUCTURE_TYPE_DEVICE_GROUP_SWAPCHAIN_CREATE_INFO_KHR                            StructureType = 1000060012
+	STRUCTURE_TYPE_BIND_BUFFER_MEMORY_DEVICE_GROUP_INFO                              StructureType = 1000060013
+	STRUCTURE_TYPE_BIND_IMAGE_MEMORY_DEVICE_GROUP_INFO                               StructureType = 1000060014
+	STRUCTURE_TYPE_VALIDATION_FLAGS_EXT                                              StructureType = 1000061000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_DRAW_PARAMETERS_FEATURES                   StructureType = 1000063000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_TEXTURE_COMPRESSION_ASTC_HDR_FEATURES_EXT         StructureType = 1000066000
+	STRUCTURE_TYPE_IMAGE_VIEW_ASTC_DECODE_MODE_EXT                                   StructureType = 1000067000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_ASTC_DECODE_FEATURES_EXT                          StructureType = 1000067001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_GROUP_PROPERTIES                                  StructureType = 1000070000
+	STRUCTURE_TYPE_DEVICE_GROUP_DEVICE_CREATE_INFO                                   StructureType = 1000070001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_IMAGE_FORMAT_INFO                        StructureType = 1000071000
+	STRUCTURE_TYPE_EXTERNAL_IMAGE_FORMAT_PROPERTIES                                  StructureType = 1000071001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_BUFFER_INFO                              StructureType = 1000071002
+	STRUCTURE_TYPE_EXTERNAL_BUFFER_PROPERTIES                                        StructureType = 1000071003
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_ID_PROPERTIES                                     StructureType = 1000071004
+	STRUCTURE_TYPE_EXTERNAL_MEMORY_BUFFER_CREATE_INFO                                StructureType = 1000072000
+	STRUCTURE_TYPE_EXTERNAL_MEMORY_IMAGE_CREATE_INFO                                 StructureType = 1000072001
+	STRUCTURE_TYPE_EXPORT_MEMORY_ALLOCATE_INFO                                       StructureType = 1000072002
+	STRUCTURE_TYPE_IMPORT_MEMORY_FD_INFO_KHR                                         StructureType = 1000074000
+	STRUCTURE_TYPE_MEMORY_FD_PROPERTIES_KHR                                          StructureType = 1000074001
+	STRUCTURE_TYPE_MEMORY_GET_FD_INFO_KHR                                            StructureType = 1000074002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_SEMAPHORE_INFO                           StructureType = 1000076000
+	STRUCTURE_TYPE_EXTERNAL_SEMAPHORE_PROPERTIES                                     StructureType = 1000076001
+	STRUCTURE_TYPE_EXPORT_SEMAPHORE_CREATE_INFO                                      StructureType = 1000077000
+	STRUCTURE_TYPE_IMPORT_SEMAPHORE_FD_INFO_KHR                                      StructureType = 1000079000
+	STRUCTURE_TYPE_SEMAPHORE_GET_FD_INFO_KHR                                         StructureType = 1000079001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_PUSH_DESCRIPTOR_PROPERTIES_KHR                    StructureType = 1000080000
+	STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_CONDITIONAL_RENDERING_INFO_EXT         StructureType = 1000081000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_CONDITIONAL_RENDERING_FEATURES_EXT                StructureType = 1000081001
+	STRUCTURE_TYPE_CONDITIONAL_RENDERING_BEGIN_INFO_EXT                              StructureType = 1000081002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_FLOAT16_INT8_FEATURES                      StructureType = 1000082000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_16BIT_STORAGE_FEATURES                            StructureType = 1000083000
+	STRUCTURE_TYPE_PRESENT_REGIONS_KHR                                               StructureType = 1000084000
+	STRUCTURE_TYPE_DESCRIPTOR_UPDATE_TEMPLATE_CREATE_INFO                            StructureType = 1000085000
+	STRUCTURE_TYPE_PIPELINE_VIEWPORT_W_SCALING_STATE_CREATE_INFO_NV                  StructureType = 1000087000
+	STRUCTURE_TYPE_SURFACE_CAPABILITIES_2_EXT                                        StructureType = 1000090000
+	STRUCTURE_TYPE_DISPLAY_POWER_INFO_EXT                                            StructureType = 1000091000
+	STRUCTURE_TYPE_DEVICE_EVENT_INFO_EXT                                             StructureType = 1000091001
+	STRUCTURE_TYPE_DISPLAY_EVENT_INFO_EXT                                            StructureType = 1000091002
+	STRUCTURE_TYPE_SWAPCHAIN_COUNTER_CREATE_INFO_EXT                                 StructureType = 1000091003
+	STRUCTURE_TYPE_PRESENT_TIMES_INFO_GOOGLE                                         StructureType = 1000092000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBGROUP_PROPERTIES                               StructureType = 1000094000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_PER_VIEW_ATTRIBUTES_PROPERTIES_NVX      StructureType = 1000097000
+	STRUCTURE_TYPE_PIPELINE_VIEWPORT_SWIZZLE_STATE_CREATE_INFO_NV                    StructureType = 1000098000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_DISCARD_RECTANGLE_PROPERTIES_EXT                  StructureType = 1000099000
+	STRUCTURE_TYPE_PIPELINE_DISCARD_RECTANGLE_STATE_CREATE_INFO_EXT                  StructureType = 1000099001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_CONSERVATIVE_RASTERIZATION_PROPERTIES_EXT         StructureType = 1000101000
+	STRUCTURE_TYPE_PIPELINE_RASTERIZATION_CONSERVATIVE_STATE_CREATE_INFO_EXT         StructureType = 1000101001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_CLIP_ENABLE_FEATURES_EXT                    StructureType = 1000102000
+	STRUCTURE_TYPE_PIPELINE_RASTERIZATION_DEPTH_CLIP_STATE_CREATE_INFO_EXT           StructureType = 1000102001
+	STRUCTURE_TYPE_HDR_METADATA_EXT                                                  StructureType = 1000105000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGELESS_FRAMEBUFFER_FEATURES                    StructureType = 1000108000
+	STRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENTS_CREATE_INFO                               StructureType = 1000108001
+	STRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENT_IMAGE_INFO                                 StructureType = 1000108002
+	STRUCTURE_TYPE_RENDER_PASS_ATTACHMENT_BEGIN_INFO                                 StructureType = 1000108003
+	STRUCTURE_TYPE_ATTACHMENT_DESCRIPTION_2                                          StructureType = 1000109000
+	STRUCTURE_TYPE_ATTACHMENT_REFERENCE_2                                            StructureType = 1000109001
+	STRUCTURE_TYPE_SUBPASS_DESCRIPTION_2                                             StructureType = 1000109002
+	STRUCTURE_TYPE_SUBPASS_DEPENDENCY_2                                              StructureType = 1000109003
+	STRUCTURE_TYPE_RENDER_PASS_CREATE_INFO_2                                         StructureType = 1000109004
+	STRUCTURE_TYPE_SUBPASS_BEGIN_INFO                                                StructureType = 1000109005
+	STRUCTURE_TYPE_SUBPASS_END_INFO                                                  StructureType = 1000109006
+	STRUCTURE_TYPE_SHARED_PRESENT_SURFACE_CAPABILITIES_KHR                           StructureType = 1000111000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_FENCE_INFO                               StructureType = 1000112000
+	STRUCTURE_TYPE_EXTERNAL_FENCE_PROPERTIES                                         StructureType = 1000112001
+	STRUCTURE_TYPE_EXPORT_FENCE_CREATE_INFO                                          StructureType = 1000113000
+	STRUCTURE_TYPE_IMPORT_FENCE_FD_INFO_KHR                                          StructureType = 1000115000
+	STRUCTURE_TYPE_FENCE_GET_FD_INFO_KHR                                             StructureType = 1000115001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_PERFORMANCE_QUERY_FEATURES_KHR                    StructureType = 1000116000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_PERFORMANCE_QUERY_PROPERTIES_KHR                  StructureType = 1000116001
+	STRUCTURE_TYPE_QUERY_POOL_PERFORMANCE_CREATE_INFO_KHR                            StructureType = 1000116002
+	STRUCTURE_TYPE_PERFORMANCE_QUERY_SUBMIT_INFO_KHR                                 StructureType = 1000116003
+	STRUCTURE_TYPE_ACQUIRE_PROFILING_LOCK_INFO_KHR                                   StructureType = 1000116004
+	STRUCTURE_TYPE_PERFORMANCE_COUNTER_KHR                                           StructureType = 1000116005
+	STRUCTURE_TYPE_PERFORMANCE_COUNTER_DESCRIPTION_KHR                               StructureType = 1000116006
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_POINT_CLIPPING_PROPERTIES                         StructureType = 1000117000
+	STRUCTURE_TYPE_RENDER_PASS_INPUT_ATTACHMENT_ASPECT_CREATE_INFO                   StructureType = 1000117001
+	STRUCTURE_TYPE_IMAGE_VIEW_USAGE_CREATE_INFO                                      StructureType = 1000117002
+	STRUCTURE_TYPE_PIPELINE_TESSELLATION_DOMAIN_ORIGIN_STATE_CREATE_INFO             StructureType = 1000117003
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SURFACE_INFO_2_KHR                                StructureType = 1000119000
+	STRUCTURE_TYPE_SURFACE_CAPABILITIES_2_KHR                                        StructureType = 1000119001
+	STRUCTURE_TYPE_SURFACE_FORMAT_2_KHR                                              StructureType = 1000119002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_VARIABLE_POINTERS_FEATURES                        StructureType = 1000120000
+	STRUCTURE_TYPE_DISPLAY_PROPERTIES_2_KHR                                          StructureType = 1000121000
+	STRUCTURE_TYPE_DISPLAY_PLANE_PROPERTIES_2_KHR                                    StructureType = 1000121001
+	STRUCTURE_TYPE_DISPLAY_MODE_PROPERTIES_2_KHR                                     StructureType = 1000121002
+	STRUCTURE_TYPE_DISPLAY_PLANE_INFO_2_KHR                                          StructureType = 1000121003
+	STRUCTURE_TYPE_DISPLAY_PLANE_CAPABILITIES_2_KHR                                  StructureType = 1000121004
+	STRUCTURE_TYPE_MEMORY_DEDICATED_REQUIREMENTS                                     StructureType = 1000127000
+	STRUCTURE_TYPE_MEMORY_DEDICATED_ALLOCATE_INFO                                    StructureType = 1000127001
+	STRUCTURE_TYPE_DEBUG_UTILS_OBJECT_NAME_INFO_EXT                                  StructureType = 1000128000
+	STRUCTURE_TYPE_DEBUG_UTILS_OBJECT_TAG_INFO_EXT                                   StructureType = 1000128001
+	STRUCTURE_TYPE_DEBUG_UTILS_LABEL_EXT                                             StructureType = 1000128002
+	STRUCTURE_TYPE_DEBUG_UTILS_MESSENGER_CALLBACK_DATA_EXT                           StructureType = 1000128003
+	STRUCTURE_TYPE_DEBUG_UTILS_MESSENGER_CREATE_INFO_EXT                             StructureType = 1000128004
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLER_FILTER_MINMAX_PROPERTIES                  StructureType = 1000130000
+	STRUCTURE_TYPE_SAMPLER_REDUCTION_MODE_CREATE_INFO                                StructureType = 1000130001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_FEATURES_EXT                 StructureType = 1000138000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_PROPERTIES_EXT               StructureType = 1000138001
+	STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_INLINE_UNIFORM_BLOCK_EXT                     StructureType = 1000138002
+	STRUCTURE_TYPE_DESCRIPTOR_POOL_INLINE_UNIFORM_BLOCK_CREATE_INFO_EXT              StructureType = 1000138003
+	STRUCTURE_TYPE_SAMPLE_LOCATIONS_INFO_EXT                                         StructureType = 1000143000
+	STRUCTURE_TYPE_RENDER_PASS_SAMPLE_LOCATIONS_BEGIN_INFO_EXT                       StructureType = 1000143001
+	STRUCTURE_TYPE_PIPELINE_SAMPLE_LOCATIONS_STATE_CREATE_INFO_EXT                   StructureType = 1000143002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLE_LOCATIONS_PROPERTIES_EXT                   StructureType = 1000143003
+	STRUCTURE_TYPE_MULTISAMPLE_PROPERTIES_EXT                                        StructureType = 1000143004
+	STRUCTURE_TYPE_PROTECTED_SUBMIT_INFO                                             StructureType = 1000145000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_PROTECTED_MEMORY_FEATURES                         StructureType = 1000145001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_PROTECTED_MEMORY_PROPERTIES                       StructureType = 1000145002
+	STRUCTURE_TYPE_DEVICE_QUEUE_INFO_2                                               StructureType = 1000145003
+	STRUCTURE_TYPE_BUFFER_MEMORY_REQUIREMENTS_INFO_2                                 StructureType = 1000146000
+	STRUCTURE_TYPE_IMAGE_MEMORY_REQUIREMENTS_INFO_2                                  StructureType = 1000146001
+	STRUCTURE_TYPE_IMAGE_SPARSE_MEMORY_REQUIREMENTS_INFO_2                           StructureType = 1000146002
+	STRUCTURE_TYPE_MEMORY_REQUIREMENTS_2                                             StructureType = 1000146003
+	STRUCTURE_TYPE_SPARSE_IMAGE_MEMORY_REQUIREMENTS_2                                StructureType = 1000146004
+	STRUCTURE_TYPE_IMAGE_FORMAT_LIST_CREATE_INFO                                     StructureType = 1000147000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_BLEND_OPERATION_ADVANCED_FEATURES_EXT             StructureType = 1000148000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_BLEND_OPERATION_ADVANCED_PROPERTIES_EXT           StructureType = 1000148001
+	STRUCTURE_TYPE_PIPELINE_COLOR_BLEND_ADVANCED_STATE_CREATE_INFO_EXT               StructureType = 1000148002
+	STRUCTURE_TYPE_PIPELINE_COVERAGE_TO_COLOR_STATE_CREATE_INFO_NV                   StructureType = 1000149000
+	STRUCTURE_TYPE_ACCELERATION_STRUCTURE_BUILD_GEOMETRY_INFO_KHR                    StructureType = 1000150000
+	STRUCTURE_TYPE_ACCELERATION_STRUCTURE_DEVICE_ADDRESS_INFO_KHR                    StructureType = 1000150002
+	STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_AABBS_DATA_KHR                    StructureType = 1000150003
+	STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_INSTANCES_DATA_KHR                StructureType = 1000150004
+	STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_TRIANGLES_DATA_KHR                StructureType = 1000150005
+	STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_KHR                               StructureType = 1000150006
+	STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_ACCELERATION_STRUCTURE_KHR                   StructureType = 1000150007
+	STRUCTURE_TYPE_ACCELERATION_STRUCTURE_VERSION_INFO_KHR                           StructureType = 1000150009
+	STRUCTURE_TYPE_COPY_ACCELERATION_STRUCTURE_INFO_KHR                              StructureType = 1000150010
+	STRUCTURE_TYPE_COPY_ACCELERATION_STRUCTURE_TO_MEMORY_INFO_KHR                    StructureType = 1000150011
+	STRUCTURE_TYPE_COPY_MEMORY_TO_ACCELERATION_STRUCTURE_INFO_KHR                    StructureType = 1000150012
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_ACCELERATION_STRUCTURE_FEATURES_KHR               StructureType = 1000150013
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_ACCELERATION_STRUCTURE_PROPERTIES_KHR             StructureType = 1000150014
+	STRUCTURE_TYPE_ACCELERATION_STRUCTURE_CREATE_INFO_KHR                            StructureType = 1000150017
+	STRUCTURE_TYPE_ACCELERATION_STRUCTURE_BUILD_SIZES_INFO_KHR                       StructureType = 1000150020
+	STRUCTURE_TYPE_PIPELINE_COVERAGE_MODULATION_STATE_CREATE_INFO_NV                 StructureType = 1000152000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SM_BUILTINS_FEATURES_NV                    StructureType = 1000154000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SM_BUILTINS_PROPERTIES_NV                  StructureType = 1000154001
+	STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_CREATE_INFO                              StructureType = 1000156000
+	STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_INFO                                     StructureType = 1000156001
+	STRUCTURE_TYPE_BIND_IMAGE_PLANE_MEMORY_INFO                                      StructureType = 1000156002
+	STRUCTURE_TYPE_IMAGE_PLANE_MEMORY_REQUIREMENTS_INFO                              StructureType = 1000156003
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLER_YCBCR_CONVERSION_FEATURES                 StructureType = 1000156004
+	STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_IMAGE_FORMAT_PROPERTIES                  StructureType = 1000156005
+	STRUCTURE_TYPE_BIND_BUFFER_MEMORY_INFO                                           StructureType = 1000157000
+	STRUCTURE_TYPE_BIND_IMAGE_MEMORY_INFO                                            StructureType = 1000157001
+	STRUCTURE_TYPE_DRM_FORMAT_MODIFIER_PROPERTIES_LIST_EXT                           StructureType = 1000158000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_DRM_FORMAT_MODIFIER_INFO_EXT                StructureType = 1000158002
+	STRUCTURE_TYPE_IMAGE_DRM_FORMAT_MODIFIER_LIST_CREATE_INFO_EXT                    StructureType = 1000158003
+	STRUCTURE_TYPE_IMAGE_DRM_FORMAT_MODIFIER_EXPLICIT_CREATE_INFO_EXT                StructureType = 1000158004
+	STRUCTURE_TYPE_IMAGE_DRM_FORMAT_MODIFIER_PROPERTIES_EXT                          StructureType = 1000158005
+	STRUCTURE_TYPE_VALIDATION_CACHE_CREATE_INFO_EXT                                  StructureType = 1000160000
+	STRUCTURE_TYPE_SHADER_MODULE_VALIDATION_CACHE_CREATE_INFO_EXT                    StructureType = 1000160001
+	STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_BINDING_FLAGS_CREATE_INFO                   StructureType = 1000161000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_FEATURES                      StructureType = 1000161001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_PROPERTIES                    StructureType = 1000161002
+	STRUCTURE_TYPE_DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_ALLOCATE_INFO            StructureType = 1000161003
+	STRUCTURE_TYPE_DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_LAYOUT_SUPPORT           StructureType = 1000161004
+	STRUCTURE_TYPE_PIPELINE_VIEWPORT_SHADING_RATE_IMAGE_STATE_CREATE_INFO_NV         StructureType = 1000164000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADING_RATE_IMAGE_FEATURES_NV                    StructureType = 1000164001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADING_RATE_IMAGE_PROPERTIES_NV                  StructureType = 1000164002
+	STRUCTURE_TYPE_PIPELINE_VIEWPORT_COARSE_SAMPLE_ORDER_STATE_CREATE_INFO_NV        StructureType = 1000164005
+	STRUCTURE_TYPE_RAY_TRACING_PIPELINE_CREATE_INFO_NV                               StructureType = 1000165000
+	STRUCTURE_TYPE_ACCELERATION_STRUCTURE_CREATE_INFO_NV                             StructureType = 1000165001
+	STRUCTURE_TYPE_GEOMETRY_NV                                                       StructureType = 1000165003
+	STRUCTURE_TYPE_GEOMETRY_TRIANGLES_NV                                             StructureType = 1000165004
+	STRUCTURE_TYPE_GEOMETRY_AABB_NV                                                  StructureType = 1000165005
+	STRUCTURE_TYPE_BIND_ACCELERATION_STRUCTURE_MEMORY_INFO_NV                        StructureType = 1000165006
+	STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_ACCELERATION_STRUCTURE_NV                    StructureType = 1000165007
+	STRUCTURE_TYPE_ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_INFO_NV                StructureType = 1000165008
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_PROPERTIES_NV                         StructureType = 1000165009
+	STRUCTURE_TYPE_RAY_TRACING_SHADER_GROUP_CREATE_INFO_NV                           StructureType = 1000165011
+	STRUCTURE_TYPE_ACCELERATION_STRUCTURE_INFO_NV                                    StructureType = 1000165012
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_REPRESENTATIVE_FRAGMENT_TEST_FEATURES_NV          StructureType = 1000166000
+	STRUCTURE_TYPE_PIPELINE_REPRESENTATIVE_FRAGMENT_TEST_STATE_CREATE_INFO_NV        StructureType = 1000166001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_3_PROPERTIES                          StructureType = 1000168000
+	STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_SUPPORT                                     StructureType = 1000168001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_VIEW_IMAGE_FORMAT_INFO_EXT                  StructureType = 1000170000
+	STRUCTURE_TYPE_FILTER_CUBIC_IMAGE_VIEW_IMAGE_FORMAT_PROPERTIES_EXT               StructureType = 1000170001
+	STRUCTURE_TYPE_DEVICE_QUEUE_GLOBAL_PRIORITY_CREATE_INFO_EXT                      StructureType = 1000174000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SUBGROUP_EXTENDED_TYPES_FEATURES           StructureType = 1000175000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_8BIT_STORAGE_FEATURES                             StructureType = 1000177000
+	STRUCTURE_TYPE_IMPORT_MEMORY_HOST_POINTER_INFO_EXT                               StructureType = 1000178000
+	STRUCTURE_TYPE_MEMORY_HOST_POINTER_PROPERTIES_EXT                                StructureType = 1000178001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_MEMORY_HOST_PROPERTIES_EXT               StructureType = 1000178002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_INT64_FEATURES                      StructureType = 1000180000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CLOCK_FEATURES_KHR                         StructureType = 1000181000
+	STRUCTURE_TYPE_PIPELINE_COMPILER_CONTROL_CREATE_INFO_AMD                         StructureType = 1000183000
+	STRUCTURE_TYPE_CALIBRATED_TIMESTAMP_INFO_EXT                                     StructureType = 1000184000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_AMD                        StructureType = 1000185000
+	STRUCTURE_TYPE_DEVICE_MEMORY_OVERALLOCATION_CREATE_INFO_AMD                      StructureType = 1000189000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_PROPERTIES_EXT           StructureType = 1000190000
+	STRUCTURE_TYPE_PIPELINE_VERTEX_INPUT_DIVISOR_STATE_CREATE_INFO_EXT               StructureType = 1000190001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_FEATURES_EXT             StructureType = 1000190002
+	STRUCTURE_TYPE_PIPELINE_CREATION_FEEDBACK_CREATE_INFO_EXT                        StructureType = 1000192000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_DRIVER_PROPERTIES                                 StructureType = 1000196000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_FLOAT_CONTROLS_PROPERTIES                         StructureType = 1000197000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_STENCIL_RESOLVE_PROPERTIES                  StructureType = 1000199000
+	STRUCTURE_TYPE_SUBPASS_DESCRIPTION_DEPTH_STENCIL_RESOLVE                         StructureType = 1000199001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_COMPUTE_SHADER_DERIVATIVES_FEATURES_NV            StructureType = 1000201000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_MESH_SHADER_FEATURES_NV                           StructureType = 1000202000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_MESH_SHADER_PROPERTIES_NV                         StructureType = 1000202001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADER_BARYCENTRIC_FEATURES_NV           StructureType = 1000203000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_IMAGE_FOOTPRINT_FEATURES_NV                StructureType = 1000204000
+	STRUCTURE_TYPE_PIPELINE_VIEWPORT_EXCLUSIVE_SCISSOR_STATE_CREATE_INFO_NV          StructureType = 1000205000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_EXCLUSIVE_SCISSOR_FEATURES_NV                     StructureType = 1000205002
+	STRUCTURE_TYPE_CHECKPOINT_DATA_NV                                                StructureType = 1000206000
+	STRUCTURE_TYPE_QUEUE_FAMILY_CHECKPOINT_PROPERTIES_NV                             StructureType = 1000206001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_FEATURES                       StructureType = 1000207000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_PROPERTIES                     StructureType = 1000207001
+	STRUCTURE_TYPE_SEMAPHORE_TYPE_CREATE_INFO                                        StructureType = 1000207002
+	STRUCTURE_TYPE_TIMELINE_SEMAPHORE_SUBMIT_INFO                                    StructureType = 1000207003
+	STRUCTURE_TYPE_SEMAPHORE_WAIT_INFO                                               StructureType = 1000207004
+	STRUCTURE_TYPE_SEMAPHORE_SIGNAL_INFO                                             StructureType = 1000207005
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_INTEGER_FUNCTIONS_2_FEATURES_INTEL         StructureType = 1000209000
+	STRUCTURE_TYPE_QUERY_POOL_PERFORMANCE_QUERY_CREATE_INFO_INTEL                    StructureType = 1000210000
+	STRUCTURE_TYPE_INITIALIZE_PERFORMANCE_API_INFO_INTEL                             StructureType = 1000210001
+	STRUCTURE_TYPE_PERFORMANCE_MARKER_INFO_INTEL                                     StructureType = 1000210002
+	STRUCTURE_TYPE_PERFORMANCE_STREAM_MARKER_INFO_INTEL                              StructureType = 1000210003
+	STRUCTURE_TYPE_PERFORMANCE_OVERRIDE_INFO_INTEL                                   StructureType = 1000210004
+	STRUCTURE_TYPE_PERFORMANCE_CONFIGURATION_ACQUIRE_INFO_INTEL                      StructureType = 1000210005
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_MEMORY_MODEL_FEATURES                      StructureType = 1000211000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_PCI_BUS_INFO_PROPERTIES_EXT                       StructureType = 1000212000
+	STRUCTURE_TYPE_DISPLAY_NATIVE_HDR_SURFACE_CAPABILITIES_AMD                       StructureType = 1000213000
+	STRUCTURE_TYPE_SWAPCHAIN_DISPLAY_NATIVE_HDR_CREATE_INFO_AMD                      StructureType = 1000213001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_TERMINATE_INVOCATION_FEATURES_KHR          StructureType = 1000215000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_FEATURES_EXT                 StructureType = 1000218000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_PROPERTIES_EXT               StructureType = 1000218001
+	STRUCTURE_TYPE_RENDER_PASS_FRAGMENT_DENSITY_MAP_CREATE_INFO_EXT                  StructureType = 1000218002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SCALAR_BLOCK_LAYOUT_FEATURES                      StructureType = 1000221000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBGROUP_SIZE_CONTROL_PROPERTIES_EXT              StructureType = 1000225000
+	STRUCTURE_TYPE_PIPELINE_SHADER_STAGE_REQUIRED_SUBGROUP_SIZE_CREATE_INFO_EXT      StructureType = 1000225001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBGROUP_SIZE_CONTROL_FEATURES_EXT                StructureType = 1000225002
+	STRUCTURE_TYPE_FRAGMENT_SHADING_RATE_ATTACHMENT_INFO_KHR                         StructureType = 1000226000
+	STRUCTURE_TYPE_PIPELINE_FRAGMENT_SHADING_RATE_STATE_CREATE_INFO_KHR              StructureType = 1000226001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_PROPERTIES_KHR              StructureType = 1000226002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_FEATURES_KHR                StructureType = 1000226003
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_KHR                         StructureType = 1000226004
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_2_AMD                      StructureType = 1000227000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_COHERENT_MEMORY_FEATURES_AMD                      StructureType = 1000229000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_IMAGE_ATOMIC_INT64_FEATURES_EXT            StructureType = 1000234000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_BUDGET_PROPERTIES_EXT                      StructureType = 1000237000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_PRIORITY_FEATURES_EXT                      StructureType = 1000238000
+	STRUCTURE_TYPE_MEMORY_PRIORITY_ALLOCATE_INFO_EXT                                 StructureType = 1000238001
+	STRUCTURE_TYPE_SURFACE_PROTECTED_CAPABILITIES_KHR                                StructureType = 1000239000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_DEDICATED_ALLOCATION_IMAGE_ALIASING_FEATURES_NV   StructureType = 1000240000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SEPARATE_DEPTH_STENCIL_LAYOUTS_FEATURES           StructureType = 1000241000
+	STRUCTURE_TYPE_ATTACHMENT_REFERENCE_STENCIL_LAYOUT                               StructureType = 1000241001
+	STRUCTURE_TYPE_ATTACHMENT_DESCRIPTION_STENCIL_LAYOUT                             StructureType = 1000241002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES_EXT                StructureType = 1000244000
+	STRUCTURE_TYPE_BUFFER_DEVICE_ADDRESS_INFO                                        StructureType = 1000244001
+	STRUCTURE_TYPE_BUFFER_DEVICE_ADDRESS_CREATE_INFO_EXT                             StructureType = 1000244002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_TOOL_PROPERTIES_EXT                               StructureType = 1000245000
+	STRUCTURE_TYPE_IMAGE_STENCIL_USAGE_CREATE_INFO                                   StructureType = 1000246000
+	STRUCTURE_TYPE_VALIDATION_FEATURES_EXT                                           StructureType = 1000247000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_PRESENT_WAIT_FEATURES_KHR                         StructureType = 1000248000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_COOPERATIVE_MATRIX_FEATURES_NV                    StructureType = 1000249000
+	STRUCTURE_TYPE_COOPERATIVE_MATRIX_PROPERTIES_NV                                  StructureType = 1000249001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_COOPERATIVE_MATRIX_PROPERTIES_NV                  StructureType = 1000249002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_COVERAGE_REDUCTION_MODE_FEATURES_NV               StructureType = 1000250000
+	STRUCTURE_TYPE_PIPELINE_COVERAGE_REDUCTION_STATE_CREATE_INFO_NV                  StructureType = 1000250001
+	STRUCTURE_TYPE_FRAMEBUFFER_MIXED_SAMPLES_COMBINATION_NV                          StructureType = 1000250002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADER_INTERLOCK_FEATURES_EXT            StructureType = 1000251000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_YCBCR_IMAGE_ARRAYS_FEATURES_EXT                   StructureType = 1000252000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_UNIFORM_BUFFER_STANDARD_LAYOUT_FEATURES           StructureType = 1000253000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_PROVOKING_VERTEX_FEATURES_EXT                     StructureType = 1000254000
+	STRUCTURE_TYPE_PIPELINE_RASTERIZATION_PROVOKING_VERTEX_STATE_CREATE_INFO_EXT     StructureType = 1000254001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_PROVOKING_VERTEX_PROPERTIES_EXT                   StructureType = 1000254002
+	STRUCTURE_TYPE_HEADLESS_SURFACE_CREATE_INFO_EXT                                  StructureType = 1000256000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES                    StructureType = 1000257000
+	STRUCTURE_TYPE_BUFFER_OPAQUE_CAPTURE_ADDRESS_CREATE_INFO                         StructureType = 1000257002
+	STRUCTURE_TYPE_MEMORY_OPAQUE_CAPTURE_ADDRESS_ALLOCATE_INFO                       StructureType = 1000257003
+	STRUCTURE_TYPE_DEVICE_MEMORY_OPAQUE_CAPTURE_ADDRESS_INFO                         StructureType = 1000257004
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_LINE_RASTERIZATION_FEATURES_EXT                   StructureType = 1000259000
+	STRUCTURE_TYPE_PIPELINE_RASTERIZATION_LINE_STATE_CREATE_INFO_EXT                 StructureType = 1000259001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_LINE_RASTERIZATION_PROPERTIES_EXT                 StructureType = 1000259002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT_FEATURES_EXT                  StructureType = 1000260000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_HOST_QUERY_RESET_FEATURES                         StructureType = 1000261000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_INDEX_TYPE_UINT8_FEATURES_EXT                     StructureType = 1000265000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_FEATURES_EXT               StructureType = 1000267000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_EXECUTABLE_PROPERTIES_FEATURES_KHR       StructureType = 1000269000
+	STRUCTURE_TYPE_PIPELINE_INFO_KHR                                                 StructureType = 1000269001
+	STRUCTURE_TYPE_PIPELINE_EXECUTABLE_PROPERTIES_KHR                                StructureType = 1000269002
+	STRUCTURE_TYPE_PIPELINE_EXECUTABLE_INFO_KHR                                      StructureType = 1000269003
+	STRUCTURE_TYPE_PIPELINE_EXECUTABLE_STATISTIC_KHR                                 StructureType = 1000269004
+	STRUCTURE_TYPE_PIPELINE_EXECUTABLE_INTERNAL_REPRESENTATION_KHR                   StructureType = 1000269005
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT_2_FEATURES_EXT                StructureType = 1000273000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_DEMOTE_TO_HELPER_INVOCATION_FEATURES_EXT   StructureType = 1000276000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_PROPERTIES_NV           StructureType = 1000277000
+	STRUCTURE_TYPE_GRAPHICS_SHADER_GROUP_CREATE_INFO_NV                              StructureType = 1000277001
+	STRUCTURE_TYPE_GRAPHICS_PIPELINE_SHADER_GROUPS_CREATE_INFO_NV                    StructureType = 1000277002
+	STRUCTURE_TYPE_INDIRECT_COMMANDS_LAYOUT_TOKEN_NV                                 StructureType = 1000277003
+	STRUCTURE_TYPE_INDIRECT_COMMANDS_LAYOUT_CREATE_INFO_NV                           StructureType = 1000277004
+	STRUCTURE_TYPE_GENERATED_COMMANDS_INFO_NV                                        StructureType = 1000277005
+	STRUCTURE_TYPE_GENERATED_COMMANDS_MEMORY_REQUIREMENTS_INFO_NV                    StructureType = 1000277006
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_FEATURES_NV             StructureType = 1000277007
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_INHERITED_VIEWPORT_SCISSOR_FEATURES_NV            StructureType = 1000278000
+	STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_VIEWPORT_SCISSOR_INFO_NV               StructureType = 1000278001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_INTEGER_DOT_PRODUCT_FEATURES_KHR           StructureType = 1000280000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_INTEGER_DOT_PRODUCT_PROPERTIES_KHR         StructureType = 1000280001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_TEXEL_BUFFER_ALIGNMENT_FEATURES_EXT               StructureType = 1000281000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_TEXEL_BUFFER_ALIGNMENT_PROPERTIES_EXT             StructureType = 1000281001
+	STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_RENDER_PASS_TRANSFORM_INFO_QCOM        StructureType = 1000282000
+	STRUCTURE_TYPE_RENDER_PASS_TRANSFORM_BEGIN_INFO_QCOM                             StructureType = 1000282001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_MEMORY_REPORT_FEATURES_EXT                 StructureType = 1000284000
+	STRUCTURE_TYPE_DEVICE_DEVICE_MEMORY_REPORT_CREATE_INFO_EXT                       StructureType = 1000284001
+	STRUCTURE_TYPE_DEVICE_MEMORY_REPORT_CALLBACK_DATA_EXT                            StructureType = 1000284002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_ROBUSTNESS_2_FEATURES_EXT                         StructureType = 1000286000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_ROBUSTNESS_2_PROPERTIES_EXT                       StructureType = 1000286001
+	STRUCTURE_TYPE_SAMPLER_CUSTOM_BORDER_COLOR_CREATE_INFO_EXT                       StructureType = 1000287000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_CUSTOM_BORDER_COLOR_PROPERTIES_EXT                StructureType = 1000287001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_CUSTOM_BORDER_COLOR_FEATURES_EXT                  StructureType = 1000287002
+	STRUCTURE_TYPE_PIPELINE_LIBRARY_CREATE_INFO_KHR                                  StructureType = 1000290000
+	STRUCTURE_TYPE_PRESENT_ID_KHR                                                    StructureType = 1000294000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_PRESENT_ID_FEATURES_KHR                           StructureType = 1000294001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_PRIVATE_DATA_FEATURES_EXT                         StructureType = 1000295000
+	STRUCTURE_TYPE_DEVICE_PRIVATE_DATA_CREATE_INFO_EXT                               StructureType = 1000295001
+	STRUCTURE_TYPE_PRIVATE_DATA_SLOT_CREATE_INFO_EXT                                 StructureType = 1000295002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_CREATION_CACHE_CONTROL_FEATURES_EXT      StructureType = 1000297000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_DIAGNOSTICS_CONFIG_FEATURES_NV                    StructureType = 1000300000
+	STRUCTURE_TYPE_DEVICE_DIAGNOSTICS_CONFIG_CREATE_INFO_NV                          StructureType = 1000300001
+	STRUCTURE_TYPE_MEMORY_BARRIER_2_KHR                                              StructureType = 1000314000
+	STRUCTURE_TYPE_BUFFER_MEMORY_BARRIER_2_KHR                                       StructureType = 1000314001
+	STRUCTURE_TYPE_IMAGE_MEMORY_BARRIER_2_KHR                                        StructureType = 1000314002
+	STRUCTURE_TYPE_DEPENDENCY_INFO_KHR                                               StructureType = 1000314003
+	STRUCTURE_TYPE_SUBMIT_INFO_2_KHR                                                 StructureType = 1000314004
+	STRUCTURE_TYPE_SEMAPHORE_SUBMIT_INFO_KHR                                         StructureType = 1000314005
+	STRUCTURE_TYPE_COMMAND_BUFFER_SUBMIT_INFO_KHR                                    StructureType = 1000314006
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SYNCHRONIZATION_2_FEATURES_KHR                    StructureType = 1000314007
+	STRUCTURE_TYPE_QUEUE_FAMILY_CHECKPOINT_PROPERTIES_2_NV                           StructureType = 1000314008
+	STRUCTURE_TYPE_CHECKPOINT_DATA_2_NV                                              StructureType = 1000314009
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SUBGROUP_UNIFORM_CONTROL_FLOW_FEATURES_KHR StructureType = 1000323000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_ZERO_INITIALIZE_WORKGROUP_MEMORY_FEATURES_KHR     StructureType = 1000325000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_ENUMS_PROPERTIES_NV         StructureType = 1000326000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_ENUMS_FEATURES_NV           StructureType = 1000326001
+	STRUCTURE_TYPE_PIPELINE_FRAGMENT_SHADING_RATE_ENUM_STATE_CREATE_INFO_NV          StructureType = 1000326002
+	STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_MOTION_TRIANGLES_DATA_NV          StructureType = 1000327000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_MOTION_BLUR_FEATURES_NV               StructureType = 1000327001
+	STRUCTURE_TYPE_ACCELERATION_STRUCTURE_MOTION_INFO_NV                             StructureType = 1000327002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_YCBCR_2_PLANE_444_FORMATS_FEATURES_EXT            StructureType = 1000330000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_2_FEATURES_EXT               StructureType = 1000332000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_2_PROPERTIES_EXT             StructureType = 1000332001
+	STRUCTURE_TYPE_COPY_COMMAND_TRANSFORM_INFO_QCOM                                  StructureType = 1000333000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_ROBUSTNESS_FEATURES_EXT                     StructureType = 1000335000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_WORKGROUP_MEMORY_EXPLICIT_LAYOUT_FEATURES_KHR     StructureType = 1000336000
+	STRUCTURE_TYPE_COPY_BUFFER_INFO_2_KHR                                            StructureType = 1000337000
+	STRUCTURE_TYPE_COPY_IMAGE_INFO_2_KHR                                             StructureType = 1000337001
+	STRUCTURE_TYPE_COPY_BUFFER_TO_IMAGE_INFO_2_KHR                                   StructureType = 1000337002
+	STRUCTURE_TYPE_COPY_IMAGE_TO_BUFFER_INFO_2_KHR                                   StructureType = 1000337003
+	STRUCTURE_TYPE_BLIT_IMAGE_INFO_2_KHR                                             StructureType = 1000337004
+	STRUCTURE_TYPE_RESOLVE_IMAGE_INFO_2_KHR                                          StructureType = 1000337005
+	STRUCTURE_TYPE_BUFFER_COPY_2_KHR                                                 StructureType = 1000337006
+	STRUCTURE_TYPE_IMAGE_COPY_2_KHR                                                  StructureType = 1000337007
+	STRUCTURE_TYPE_IMAGE_BLIT_2_KHR                                                  StructureType = 1000337008
+	STRUCTURE_TYPE_BUFFER_IMAGE_COPY_2_KHR                                           StructureType = 1000337009
+	STRUCTURE_TYPE_IMAGE_RESOLVE_2_KHR                                               StructureType = 1000337010
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_4444_FORMATS_FEATURES_EXT                         StructureType = 1000340000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_PIPELINE_FEATURES_KHR                 StructureType = 1000347000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_PIPELINE_PROPERTIES_KHR               StructureType = 1000347001
+	STRUCTURE_TYPE_RAY_TRACING_PIPELINE_CREATE_INFO_KHR                              StructureType = 1000347015
+	STRUCTURE_TYPE_RAY_TRACING_SHADER_GROUP_CREATE_INFO_KHR                          StructureType = 1000347016
+	STRUCTURE_TYPE_RAY_TRACING_PIPELINE_INTERFACE_CREATE_INFO_KHR                    StructureType = 1000347018
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_QUERY_FEATURES_KHR                            StructureType = 1000348013
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_MUTABLE_DESCRIPTOR_TYPE_FEATURES_VALVE            StructureType = 1000351000
+	STRUCTURE_TYPE_MUTABLE_DESCRIPTOR_TYPE_CREATE_INFO_VALVE                         StructureType = 1000351002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_VERTEX_INPUT_DYNAMIC_STATE_FEATURES_EXT           StructureType = 1000352000
+	STRUCTURE_TYPE_VERTEX_INPUT_BINDING_DESCRIPTION_2_EXT                            StructureType = 1000352001
+	STRUCTURE_TYPE_VERTEX_INPUT_ATTRIBUTE_DESCRIPTION_2_EXT                          StructureType = 1000352002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_DRM_PROPERTIES_EXT                                StructureType = 1000353000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_PRIMITIVE_TOPOLOGY_LIST_RESTART_FEATURES_EXT      StructureType = 1000356000
+	STRUCTURE_TYPE_SUBPASS_SHADING_PIPELINE_CREATE_INFO_HUAWEI                       StructureType = 1000369000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBPASS_SHADING_FEATURES_HUAWEI                   StructureType = 1000369001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBPASS_SHADING_PROPERTIES_HUAWEI                 StructureType = 1000369002
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_INVOCATION_MASK_FEATURES_HUAWEI                   StructureType = 1000370000
+	STRUCTURE_TYPE_MEMORY_GET_REMOTE_ADDRESS_INFO_NV                                 StructureType = 1000371000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_MEMORY_RDMA_FEATURES_NV                  StructureType = 1000371001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_2_FEATURES_EXT             StructureType = 1000377000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_COLOR_WRITE_ENABLE_FEATURES_EXT                   StructureType = 1000381000
+	STRUCTURE_TYPE_PIPELINE_COLOR_WRITE_CREATE_INFO_EXT                              StructureType = 1000381001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_GLOBAL_PRIORITY_QUERY_FEATURES_EXT                StructureType = 1000388000
+	STRUCTURE_TYPE_QUEUE_FAMILY_GLOBAL_PRIORITY_PROPERTIES_EXT                       StructureType = 1000388001
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTI_DRAW_FEATURES_EXT                           StructureType = 1000392000
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTI_DRAW_PROPERTIES_EXT                         StructureType = 1000392001
+	STRUCTURE_TYPE_ATTACHMENT_DESCRIPTION_2_KHR                                      StructureType = STRUCTURE_TYPE_ATTACHMENT_DESCRIPTION_2
+	STRUCTURE_TYPE_ATTACHMENT_DESCRIPTION_STENCIL_LAYOUT_KHR                         StructureType = STRUCTURE_TYPE_ATTACHMENT_DESCRIPTION_STENCIL_LAYOUT
+	STRUCTURE_TYPE_ATTACHMENT_REFERENCE_2_KHR                                        StructureType = STRUCTURE_TYPE_ATTACHMENT_REFERENCE_2
+	STRUCTURE_TYPE_ATTACHMENT_REFERENCE_STENCIL_LAYOUT_KHR                           StructureType = STRUCTURE_TYPE_ATTACHMENT_REFERENCE_STENCIL_LAYOUT
+	STRUCTURE_TYPE_BIND_BUFFER_MEMORY_DEVICE_GROUP_INFO_KHR                          StructureType = STRUCTURE_TYPE_BIND_BUFFER_MEMORY_DEVICE_GROUP_INFO
+	STRUCTURE_TYPE_BIND_BUFFER_MEMORY_INFO_KHR                                       StructureType = STRUCTURE_TYPE_BIND_BUFFER_MEMORY_INFO
+	STRUCTURE_TYPE_BIND_IMAGE_MEMORY_DEVICE_GROUP_INFO_KHR                           StructureType = STRUCTURE_TYPE_BIND_IMAGE_MEMORY_DEVICE_GROUP_INFO
+	STRUCTURE_TYPE_BIND_IMAGE_MEMORY_INFO_KHR                                        StructureType = STRUCTURE_TYPE_BIND_IMAGE_MEMORY_INFO
+	STRUCTURE_TYPE_BIND_IMAGE_PLANE_MEMORY_INFO_KHR                                  StructureType = STRUCTURE_TYPE_BIND_IMAGE_PLANE_MEMORY_INFO
+	STRUCTURE_TYPE_BUFFER_DEVICE_ADDRESS_INFO_KHR                                    StructureType = STRUCTURE_TYPE_BUFFER_DEVICE_ADDRESS_INFO
+	STRUCTURE_TYPE_BUFFER_DEVICE_ADDRESS_INFO_EXT                                    StructureType = STRUCTURE_TYPE_BUFFER_DEVICE_ADDRESS_INFO
+	STRUCTURE_TYPE_BUFFER_MEMORY_REQUIREMENTS_INFO_2_KHR                             StructureType = STRUCTURE_TYPE_BUFFER_MEMORY_REQUIREMENTS_INFO_2
+	STRUCTURE_TYPE_BUFFER_OPAQUE_CAPTURE_ADDRESS_CREATE_INFO_KHR                     StructureType = STRUCTURE_TYPE_BUFFER_OPAQUE_CAPTURE_ADDRESS_CREATE_INFO
+	// Backwards-compatible alias containing a typo
+	STRUCTURE_TYPE_DEBUG_REPORT_CREATE_INFO_EXT                                StructureType = STRUCTURE_TYPE_DEBUG_REPORT_CALLBACK_CREATE_INFO_EXT
+	STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_BINDING_FLAGS_CREATE_INFO_EXT         StructureType = STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_BINDING_FLAGS_CREATE_INFO
+	STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_SUPPORT_KHR                           StructureType = STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_SUPPORT
+	STRUCTURE_TYPE_DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_ALLOCATE_INFO_EXT  StructureType = STRUCTURE_TYPE_DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_ALLOCATE_INFO
+	STRUCTURE_TYPE_DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_LAYOUT_SUPPORT_EXT StructureType = STRUCTURE_TYPE_DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_LAYOUT_SUPPORT
+	STRUCTURE_TYPE_DESCRIPTOR_UPDATE_TEMPLATE_CREATE_INFO_KHR                  StructureType = STRUCTURE_TYPE_DESCRIPTOR_UPDATE_TEMPLATE_CREATE_INFO
+	STRUCTURE_TYPE_DEVICE_GROUP_BIND_SPARSE_INFO_KHR                           StructureType = STRUCTURE_TYPE_DEVICE_GROUP_BIND_SPARSE_INFO
+	STRUCTURE_TYPE_DEVICE_GROUP_COMMAND_BUFFER_BEGIN_INFO_KHR                  StructureType = STRUCTURE_TYPE_DEVICE_GROUP_COMMAND_BUFFER_BEGIN_INFO
+	STRUCTURE_TYPE_DEVICE_GROUP_DEVICE_CREATE_INFO_KHR                         StructureType = STRUCTURE_TYPE_DEVICE_GROUP_DEVICE_CREATE_INFO
+	STRUCTURE_TYPE_DEVICE_GROUP_RENDER_PASS_BEGIN_INFO_KHR                     StructureType = STRUCTURE_TYPE_DEVICE_GROUP_RENDER_PASS_BEGIN_INFO
+	STRUCTURE_TYPE_DEVICE_GROUP_SUBMIT_INFO_KHR                                StructureType = STRUCTURE_TYPE_DEVICE_GROUP_SUBMIT_INFO
+	STRUCTURE_TYPE_DEVICE_MEMORY_OPAQUE_CAPTURE_ADDRESS_INFO_KHR               StructureType = STRUCTURE_TYPE_DEVICE_MEMORY_OPAQUE_CAPTURE_ADDRESS_INFO
+	STRUCTURE_TYPE_EXPORT_FENCE_CREATE_INFO_KHR                                StructureType = STRUCTURE_TYPE_EXPORT_FENCE_CREATE_INFO
+	STRUCTURE_TYPE_EXPORT_MEMORY_ALLOCATE_INFO_KHR                             StructureType = STRUCTURE_TYPE_EXPORT_MEMORY_ALLOCATE_INFO
+	STRUCTURE_TYPE_EXPORT_SEMAPHORE_CREATE_INFO_KHR                            StructureType = STRUCTURE_TYPE_EXPORT_SEMAPHORE_CREATE_INFO
+	STRUCTURE_TYPE_EXTERNAL_BUFFER_PROPERTIES_KHR                              StructureType = STRUCTURE_TYPE_EXTERNAL_BUFFER_PROPERTIES
+	STRUCTURE_TYPE_EXTERNAL_FENCE_PROPERTIES_KHR                               StructureType = STRUCTURE_TYPE_EXTERNAL_FENCE_PROPERTIES
+	STRUCTURE_TYPE_EXTERNAL_IMAGE_FORMAT_PROPERTIES_KHR                        StructureType = STRUCTURE_TYPE_EXTERNAL_IMAGE_FORMAT_PROPERTIES
+	STRUCTURE_TYPE_EXTERNAL_MEMORY_BUFFER_CREATE_INFO_KHR                      StructureType = STRUCTURE_TYPE_EXTERNAL_MEMORY_BUFFER_CREATE_INFO
+	STRUCTURE_TYPE_EXTERNAL_MEMORY_IMAGE_CREATE_INFO_KHR                       StructureType = STRUCTURE_TYPE_EXTERNAL_MEMORY_IMAGE_CREATE_INFO
+	STRUCTURE_TYPE_EXTERNAL_SEMAPHORE_PROPERTIES_KHR                           StructureType = STRUCTURE_TYPE_EXTERNAL_SEMAPHORE_PROPERTIES
+	STRUCTURE_TYPE_FORMAT_PROPERTIES_2_KHR                                     StructureType = STRUCTURE_TYPE_FORMAT_PROPERTIES_2
+	STRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENTS_CREATE_INFO_KHR                     StructureType = STRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENTS_CREATE_INFO
+	STRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENT_IMAGE_INFO_KHR                       StructureType = STRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENT_IMAGE_INFO
+	STRUCTURE_TYPE_IMAGE_FORMAT_LIST_CREATE_INFO_KHR                           StructureType = STRUCTURE_TYPE_IMAGE_FORMAT_LIST_CREATE_INFO
+	STRUCTURE_TYPE_IMAGE_FORMAT_PROPERTIES_2_KHR                               StructureType = STRUCTURE_TYPE_IMAGE_FORMAT_PROPERTIES_2
+	STRUCTURE_TYPE_IMAGE_MEMORY_REQUIREMENTS_INFO_2_KHR                        StructureType = STRUCTURE_TYPE_IMAGE_MEMORY_REQUIREMENTS_INFO_2
+	STRUCTURE_TYPE_IMAGE_PLANE_MEMORY_REQUIREMENTS_INFO_KHR                    StructureType = STRUCTURE_TYPE_IMAGE_PLANE_MEMORY_REQUIREMENTS_INFO
+	STRUCTURE_TYPE_IMAGE_SPARSE_MEMORY_REQUIREMENTS_INFO_2_KHR                 StructureType = STRUCTURE_TYPE_IMAGE_SPARSE_MEMORY_REQUIREMENTS_INFO_2
+	STRUCTURE_TYPE_IMAGE_STENCIL_USAGE_CREATE_INFO_EXT                         StructureType = STRUCTURE_TYPE_IMAGE_STENCIL_USAGE_CREATE_INFO
+	STRUCTURE_TYPE_IMAGE_VIEW_USAGE_CREATE_INFO_KHR                            StructureType = STRUCTURE_TYPE_IMAGE_VIEW_USAGE_CREATE_INFO
+	STRUCTURE_TYPE_MEMORY_ALLOCATE_FLAGS_INFO_KHR                              StructureType = STRUCTURE_TYPE_MEMORY_ALLOCATE_FLAGS_INFO
+	STRUCTURE_TYPE_MEMORY_DEDICATED_ALLOCATE_INFO_KHR                          StructureType = STRUCTURE_TYPE_MEMORY_DEDICATED_ALLOCATE_INFO
+	STRUCTURE_TYPE_MEMORY_DEDICATED_REQUIREMENTS_KHR                           StructureType = STRUCTURE_TYPE_MEMORY_DEDICATED_REQUIREMENTS
+	STRUCTURE_TYPE_MEMORY_OPAQUE_CAPTURE_ADDRESS_ALLOCATE_INFO_KHR             StructureType = STRUCTURE_TYPE_MEMORY_OPAQUE_CAPTURE_ADDRESS_ALLOCATE_INFO
+	STRUCTURE_TYPE_MEMORY_REQUIREMENTS_2_KHR                                   StructureType = STRUCTURE_TYPE_MEMORY_REQUIREMENTS_2
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_16BIT_STORAGE_FEATURES_KHR                  StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_16BIT_STORAGE_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_8BIT_STORAGE_FEATURES_KHR                   StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_8BIT_STORAGE_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES_KHR          StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_BUFFER_ADDRESS_FEATURES_EXT                 StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES_EXT
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_STENCIL_RESOLVE_PROPERTIES_KHR        StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_STENCIL_RESOLVE_PROPERTIES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_FEATURES_EXT            StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_PROPERTIES_EXT          StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_PROPERTIES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_DRIVER_PROPERTIES_KHR                       StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_DRIVER_PROPERTIES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_BUFFER_INFO_KHR                    StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_BUFFER_INFO
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_FENCE_INFO_KHR                     StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_FENCE_INFO
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_IMAGE_FORMAT_INFO_KHR              StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_IMAGE_FORMAT_INFO
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_SEMAPHORE_INFO_KHR                 StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_SEMAPHORE_INFO
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_FEATURES_2_KHR                              StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_FEATURES_2
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_FLOAT_CONTROLS_PROPERTIES_KHR               StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_FLOAT_CONTROLS_PROPERTIES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_GROUP_PROPERTIES_KHR                        StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_GROUP_PROPERTIES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_HOST_QUERY_RESET_FEATURES_EXT               StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_HOST_QUERY_RESET_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_ID_PROPERTIES_KHR                           StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_ID_PROPERTIES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGELESS_FRAMEBUFFER_FEATURES_KHR          StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGELESS_FRAMEBUFFER_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_FORMAT_INFO_2_KHR                     StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_FORMAT_INFO_2
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_3_PROPERTIES_KHR                StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_3_PROPERTIES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_PROPERTIES_2_KHR                     StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_PROPERTIES_2
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_FEATURES_KHR                      StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_PROPERTIES_KHR                    StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_PROPERTIES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_POINT_CLIPPING_PROPERTIES_KHR               StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_POINT_CLIPPING_PROPERTIES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_PROPERTIES_2_KHR                            StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_PROPERTIES_2
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLER_FILTER_MINMAX_PROPERTIES_EXT        StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLER_FILTER_MINMAX_PROPERTIES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLER_YCBCR_CONVERSION_FEATURES_KHR       StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLER_YCBCR_CONVERSION_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SCALAR_BLOCK_LAYOUT_FEATURES_EXT            StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_SCALAR_BLOCK_LAYOUT_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SEPARATE_DEPTH_STENCIL_LAYOUTS_FEATURES_KHR StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_SEPARATE_DEPTH_STENCIL_LAYOUTS_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_INT64_FEATURES_KHR            StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_INT64_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_DRAW_PARAMETER_FEATURES              StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_DRAW_PARAMETERS_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_FLOAT16_INT8_FEATURES_KHR                   StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_FLOAT16_INT8_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_FLOAT16_INT8_FEATURES_KHR            StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_FLOAT16_INT8_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SUBGROUP_EXTENDED_TYPES_FEATURES_KHR StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SUBGROUP_EXTENDED_TYPES_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_SPARSE_IMAGE_FORMAT_INFO_2_KHR              StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_SPARSE_IMAGE_FORMAT_INFO_2
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_FEATURES_KHR             StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_PROPERTIES_KHR           StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_PROPERTIES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_UNIFORM_BUFFER_STANDARD_LAYOUT_FEATURES_KHR StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_UNIFORM_BUFFER_STANDARD_LAYOUT_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_VARIABLE_POINTERS_FEATURES_KHR              StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_VARIABLE_POINTERS_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_VARIABLE_POINTER_FEATURES                   StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_VARIABLE_POINTERS_FEATURES
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_VARIABLE_POINTER_FEATURES_KHR               StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_VARIABLE_POINTERS_FEATURES_KHR
+	STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_MEMORY_MODEL_FEATURES_KHR            StructureType = STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_MEMORY_MODEL_FEATURES
+	STRUCTURE_TYPE_PIPELINE_TESSELLATION_DOMAIN_ORIGIN_STATE_CREATE_INFO_KHR   StructureType = STRUCTURE_TYPE_PIPELINE_TESSELLATION_DOMAIN_ORIGIN_STATE_CREATE_INFO
+	// Backwards-compatible alias
+	STRUCTURE_TYPE_QUERY_POOL_CREATE_INFO_INTEL                         StructureType = STRUCTURE_TYPE_QUERY_POOL_PERFORMANCE_QUERY_CREATE_INFO_INTEL
+	STRUCTURE_TYPE_QUEUE_FAMILY_PROPERTIES_2_KHR                        StructureType = STRUCTURE_TYPE_QUEUE_FAMILY_PROPERTIES_2
+	STRUCTURE_TYPE_RENDER_PASS_ATTACHMENT_BEGIN_INFO_KHR                StructureType = STRUCTURE_TYPE_RENDER_PASS_ATTACHMENT_BEGIN_INFO
+	STRUCTURE_TYPE_RENDER_PASS_CREATE_INFO_2_KHR                        StructureType = STRUCTURE_TYPE_RENDER_PASS_CREATE_INFO_2
+	STRUCTURE_TYPE_RENDER_PASS_INPUT_ATTACHMENT_ASPECT_CREATE_INFO_KHR  StructureType = STRUCTURE_TYPE_RENDER_PASS_INPUT_ATTACHMENT_ASPECT_CREATE_INFO
+	STRUCTURE_TYPE_RENDER_PASS_MULTIVIEW_CREATE_INFO_KHR                StructureType = STRUCTURE_TYPE_RENDER_PASS_MULTIVIEW_CREATE_INFO
+	STRUCTURE_TYPE_SAMPLER_REDUCTION_MODE_CREATE_INFO_EXT               StructureType = STRUCTURE_TYPE_SAMPLER_REDUCTION_MODE_CREATE_INFO
+	STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_CREATE_INFO_KHR             StructureType = STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_CREATE_INFO
+	STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_IMAGE_FORMAT_PROPERTIES_KHR StructureType = STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_IMAGE_FORMAT_PROPERTIES
+	STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_INFO_KHR                    StructureType = STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_INFO
+	STRUCTURE_TYPE_SEMAPHORE_SIGNAL_INFO_KHR                            StructureType = STRUCTURE_TYPE_SEMAPHORE_SIGNAL_INFO
+	STRUCTURE_TYPE_SEMAPHORE_TYPE_CREATE_INFO_KHR                       StructureType = STRUCTURE_TYPE_SEMAPHORE_TYPE_CREATE_INFO
+	STRUCTURE_TYPE_SEMAPHORE_WAIT_INFO_KHR                              StructureType = STRUCTURE_TYPE_SEMAPHORE_WAIT_INFO
+	STRUCTURE_TYPE_SPARSE_IMAGE_FORMAT_PROPERTIES_2_KHR                 StructureType = STRUCTURE_TYPE_SPARSE_IMAGE_FORMAT_PROPERTIES_2
+	STRUCTURE_TYPE_SPARSE_IMAGE_MEMORY_REQUIREMENTS_2_KHR               StructureType = STRUCTURE_TYPE_SPARSE_IMAGE_MEMORY_REQUIREMENTS_2
+	STRUCTURE_TYPE_SUBPASS_BEGIN_INFO_KHR                               StructureType = STRUCTURE_TYPE_SUBPASS_BEGIN_INFO
+	STRUCTURE_TYPE_SUBPASS_DEPENDENCY_2_KHR                             StructureType = STRUCTURE_TYPE_SUBPASS_DEPENDENCY_2
+	STRUCTURE_TYPE_SUBPASS_DESCRIPTION_2_KHR                            StructureType = STRUCTURE_TYPE_SUBPASS_DESCRIPTION_2
+	STRUCTURE_TYPE_SUBPASS_DESCRIPTION_DEPTH_STENCIL_RESOLVE_KHR        StructureType = STRUCTURE_TYPE_SUBPASS_DESCRIPTION_DEPTH_STENCIL_RESOLVE
+	STRUCTURE_TYPE_SUBPASS_END_INFO_KHR                                 StructureType = STRUCTURE_TYPE_SUBPASS_END_INFO
+	// Backwards-compatible alias containing a typo
+	STRUCTURE_TYPE_SURFACE_CAPABILITIES2_EXT          StructureType = STRUCTURE_TYPE_SURFACE_CAPABILITIES_2_EXT
+	STRUCTURE_TYPE_TIMELINE_SEMAPHORE_SUBMIT_INFO_KHR StructureType = STRUCTURE_TYPE_TIMELINE_SEMAPHORE_SUBMIT_INFO
+)
+
+type SubgroupFeatureFlagBits = SubgroupFeatureFlags
+
+const (
+	SUBGROUP_FEATURE_BASIC_BIT            SubgroupFeatureFlagBits = 1 << 0
+	SUBGROUP_FEATURE_VOTE_BIT             SubgroupFeatureFlagBits = 1 << 1
+	SUBGROUP_FEATURE_ARITHMETIC_BIT       SubgroupFeatureFlagBits = 1 << 2
+	SUBGROUP_FEATURE_BALLOT_BIT           SubgroupFeatureFlagBits = 1 << 3
+	SUBGROUP_FEATURE_SHUFFLE_BIT          SubgroupFeatureFlagBits = 1 << 4
+	SUBGROUP_FEATURE_SHUFFLE_RELATIVE_BIT SubgroupFeatureFlagBits = 1 << 5
+	SUBGROUP_FEATURE_CLUSTERED_BIT        SubgroupFeatureFlagBits = 1 << 6
+	SUBGROUP_FEATURE_QUAD_BIT             SubgroupFeatureFlagBits = 1 << 7
+	SUBGROUP_FEATURE_PARTITIONED_BIT_NV   SubgroupFeatureFlagBits = 1000198000
+)
+
+type SubmitFlagBitsKHR = SubmitFlagsKHR
+
+const (
+	SUBMIT_PROTECTED_BIT_KHR SubmitFlagBitsKHR = 1 << 0
+)
+
+// SubpassContents: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkSubpassContents.html
+type SubpassContents int32
+
+const (
+	SUBPASS_CONTENTS_INLINE                    SubpassContents = 0
+	SUBPASS_CONTENTS_SECONDARY_COMMAND_BUFFERS SubpassContents = 1
+)
+
+type SubpassDescriptionFlagBits = SubpassDescriptionFlags
+
+const (
+	SUBPASS_DESCRIPTION_PER_VIEW_ATTRIBUTES_BIT_NVX      SubpassDescriptionFlagBits = 1000097000
+	SUBPASS_DESCRIPTION_PER_VIEW_POSITION_X_ONLY_BIT_NVX SubpassDescriptionFlagBits = 1000097000
+	SUBPASS_DESCRIPTION_FRAGMENT_REGION_BIT_QCOM         SubpassDescriptionFlagBits = 1000171000
+	SUBPASS_DESCRIPTION_SHADER_RESOLVE_BIT_QCOM          SubpassDescriptionFlagBits = 1000171000
+)
+
+type SurfaceCounterFlagBitsEXT = SurfaceCounterFlagsEXT
+
+const (
+	SURFACE_COUNTER_VBLANK_BIT_EXT SurfaceCounterFlagBitsEXT = 1 << 0
+	SURFACE_COUNTER_VBLANK_EXT     SurfaceCounterFlagBitsEXT = SURFACE_COUNTER_VBLANK_BIT_EXT
+)
+
+type SurfaceTransformFlagBitsKHR = SurfaceTransformFlagsKHR
+
+const (
+	SURFACE_TRANSFORM_IDENTITY_BIT_KHR                     SurfaceTransformFlagBitsKHR = 1 << 0
+	SURFACE_TRANSFORM_ROTATE_90_BIT_KHR                    SurfaceTransformFlagBitsKHR = 1 << 1
+	SURFACE_TRANSFORM_ROTATE_180_BIT_KHR                   SurfaceTransformFlagBitsKHR = 1 << 2
+	SURFACE_TRANSFORM_ROTATE_270_BIT_KHR                   SurfaceTransformFlagBitsKHR = 1 << 3
+	SURFACE_TRANSFORM_HORIZONTAL_MIRROR_BIT_KHR            SurfaceTransformFlagBitsKHR = 1 << 4
+	SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_90_BIT_KHR  SurfaceTransformFlagBitsKHR = 1 << 5
+	SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_180_BIT_KHR SurfaceTransformFlagBitsKHR = 1 << 6
+	SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_270_BIT_KHR SurfaceTransformFlagBitsKHR = 1 << 7
+	SURFACE_TRANSFORM_INHERIT_BIT_KHR                      SurfaceTransformFlagBitsKHR = 1 << 8
+)
+
+type SwapchainCreateFlagBitsKHR = SwapchainCreateFlagsKHR
+
+const (
+	// Swapchain is protected
+	SWAPCHAIN_CREATE_PROTECTED_BIT_KHR SwapchainCreateFlagBitsKHR = 1000001000
+	// Allow images with VK_IMAGE_CREATE_SPLIT_INSTANCE_BIND_REGIONS_BIT
+	SWAPCHAIN_CREATE_SPLIT_INSTANCE_BIND_REGIONS_BIT_KHR SwapchainCreateFlagBitsKHR = 1000060000
+	SWAPCHAIN_CREATE_MUTABLE_FORMAT_BIT_KHR              SwapchainCreateFlagBitsKHR = 1000200000
+)
+
+// SystemAllocationScope: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkSystemAllocationScope.html
+type SystemAllocationScope int32
+
+const (
+	SYSTEM_ALLOCATION_SCOPE_COMMAND  SystemAllocationScope = 0
+	SYSTEM_ALLOCATION_SCOPE_OBJECT   SystemAllocationScope = 1
+	SYSTEM_ALLOCATION_SCOPE_CACHE    SystemAllocationScope = 2
+	SYSTEM_ALLOCATION_SCOPE_DEVICE   SystemAllocationScope = 3
+	SYSTEM_ALLOCATION_SCOPE_INSTANCE SystemAllocationScope = 4
+)
+
+// TessellationDomainOrigin: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkTessellationDomainOrigin.html
+type TessellationDomainOrigin int32
+
+const (
+	TESSELLATION_DOMAIN_ORIGIN_UPPER_LEFT     TessellationDomainOrigin = 0
+	TESSELLATION_DOMAIN_ORIGIN_LOWER_LEFT     TessellationDomainOrigin = 1
+	TESSELLATION_DOMAIN_ORIGIN_LOWER_LEFT_KHR TessellationDomainOrigin = TESSELLATION_DOMAIN_ORIGIN_LOWER_LEFT
+	TESSELLATION_DOMAIN_ORIGIN_UPPER_LEFT_KHR TessellationDomainOrigin = TESSELLATION_DOMAIN_ORIGIN_UPPER_LEFT
+)
+
+// TessellationDomainOriginKHR: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkTessellationDomainOriginKHR.html
+type TessellationDomainOriginKHR = TessellationDomainOrigin
+
+// TimeDomainEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkTimeDomainEXT.html
+type TimeDomainEXT int32
+
+const (
+	TIME_DOMAIN_DEVICE_EXT                    TimeDomainEXT = 0
+	TIME_DOMAIN_CLOCK_MONOTONIC_EXT           TimeDomainEXT = 1
+	TIME_DOMAIN_CLOCK_MONOTONIC_RAW_EXT       TimeDomainEXT = 2
+	TIME_DOMAIN_QUERY_PERFORMANCE_COUNTER_EXT TimeDomainEXT = 3
+)
+
+type ToolPurposeFlagBitsEXT = ToolPurposeFlagsEXT
+
+const (
+	TOOL_PURPOSE_VALIDATION_BIT_EXT          ToolPurposeFlagBitsEXT = 1 << 0
+	TOOL_PURPOSE_PROFILING_BIT_EXT           ToolPurposeFlagBitsEXT = 1 << 1
+	TOOL_PURPOSE_TRACING_BIT_EXT             ToolPurposeFlagBitsEXT = 1 << 2
+	TOOL_PURPOSE_ADDITIONAL_FEATURES_BIT_EXT ToolPurposeFlagBitsEXT = 1 << 3
+	TOOL_PURPOSE_MODIFYING_FEATURES_BIT_EXT  ToolPurposeFlagBitsEXT = 1 << 4
+	TOOL_PURPOSE_DEBUG_REPORTING_BIT_EXT     ToolPurposeFlagBitsEXT = 1000245000
+	TOOL_PURPOSE_DEBUG_MARKERS_BIT_EXT       ToolPurposeFlagBitsEXT = 1000245000
+)
+
+// ValidationCacheHeaderVersionEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkValidationCacheHeaderVersionEXT.html
+type ValidationCacheHeaderVersionEXT int32
+
+const (
+	VALIDATION_CACHE_HEADER_VERSION_ONE_EXT ValidationCacheHeaderVersionEXT = 1
+)
+
+// ValidationCheckEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkValidationCheckEXT.html
+type ValidationCheckEXT int32
+
+const (
+	VALIDATION_CHECK_ALL_EXT     ValidationCheckEXT = 0
+	VALIDATION_CHECK_SHADERS_EXT ValidationCheckEXT = 1
+)
+
+// ValidationFeatureDisableEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkValidationFeatureDisableEXT.html
+type ValidationFeatureDisableEXT int32
+
+const (
+	VALIDATION_FEATURE_DISABLE_ALL_EXT                     ValidationFeatureDisableEXT = 0
+	VALIDATION_FEATURE_DISABLE_SHADERS_EXT                 ValidationFeatureDisableEXT = 1
+	VALIDATION_FEATURE_DISABLE_THREAD_SAFETY_EXT           ValidationFeatureDisableEXT = 2
+	VALIDATION_FEATURE_DISABLE_API_PARAMETERS_EXT          ValidationFeatureDisableEXT = 3
+	VALIDATION_FEATURE_DISABLE_OBJECT_LIFETIMES_EXT        ValidationFeatureDisableEXT = 4
+	VALIDATION_FEATURE_DISABLE_CORE_CHECKS_EXT             ValidationFeatureDisableEXT = 5
+	VALIDATION_FEATURE_DISABLE_UNIQUE_HANDLES_EXT          ValidationFeatureDisableEXT = 6
+	VALIDATION_FEATURE_DISABLE_SHADER_VALIDATION_CACHE_EXT ValidationFeatureDisableEXT = 7
+)
+
+// ValidationFeatureEnableEXT: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkValidationFeatureEnableEXT.html
+type ValidationFeatureEnableEXT int32
+
+const (
+	VALIDATION_FEATURE_ENABLE_GPU_ASSISTED_EXT                      ValidationFeatureEnableEXT = 0
+	VALIDATION_FEATURE_ENABLE_GPU_ASSISTED_RESERVE_BINDING_SLOT_EXT ValidationFeatureEnableEXT = 1
+	VALIDATION_FEATURE_ENABLE_BEST_PRACTICES_EXT                    ValidationFeatureEnableEXT = 2
+	VALIDATION_FEATURE_ENABLE_DEBUG_PRINTF_EXT                      ValidationFeatureEnableEXT = 3
+	VALIDATION_FEATURE_ENABLE_SYNCHRONIZATION_VALIDATION_EXT        ValidationFeatureEnableEXT = 4
+)
+
+// VendorId: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkVendorId.html
+type VendorId int32
+
+const (
+	// Vivante vendor ID
+	VENDOR_ID_VIV VendorId = 0x10001
+	// VeriSilicon vendor ID
+	VENDOR_ID_VSI VendorId = 0x10002
+	// Kazan Software Renderer
+	VENDOR_ID_KAZAN VendorId = 0x10003
+	// Codeplay Software Ltd. vendor ID
+	VENDOR_ID_CODEPLAY VendorId = 0x10004
+	// Mesa vendor ID
+	VENDOR_ID_MESA VendorId = 0x10005
+	// PoCL vendor ID
+	VENDOR_ID_POCL VendorId = 0x10006
+)
+
+// VertexInputRate: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkVertexInputRate.html
+type VertexInputRate int32
+
+const (
+	VERTEX_INPUT_RATE_VERTEX   VertexInputRate = 0
+	VERTEX_INPUT_RATE_INSTANCE VertexInputRate = 1
+)
+
+// ViewportCoordinateSwizzleNV: See https://www.khronos.org/registry/vulkan/specs/1.3-extensions/man/html/VkViewportCoordinateSwizzleNV.html
+type ViewportCoordinateSwizzleNV int32
+
+const (
+	VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_X_NV ViewportCoordinateSwizzleNV = 0
+	VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_X_NV ViewportCoordinateSwizzleNV = 1
+	VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_Y_NV ViewportCoordinateSwizzleNV = 2
+	VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_Y_NV ViewportCoordinateSwizzleNV = 3
+	VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_Z_NV ViewportCoordinateSwizzleNV = 4
+	VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_Z_NV ViewportCoordinateSwizzleNV = 5
+	VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_W_NV ViewportCoordinateSwizzleNV = 6
+	VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_W_NV ViewportCoordinateSwizzleNV = 7
+)
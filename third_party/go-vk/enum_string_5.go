@@ -0,0 +1,1666 @@
+// Code generated by "stringer -output=enum_string_5.go -type=SparseMemoryBindFlagBits,StencilFaceFlagBits,StencilOp,StructureType,SubgroupFeatureFlagBits,SubmitFlagBitsKHR,SubpassContents,SubpassDescriptionFlagBits,SurfaceCounterFlagBitsEXT,SurfaceTransformFlagBitsKHR,SwapchainCreateFlagBitsKHR,SystemAllocationScope,TessellationDomainOrigin,TimeDomainEXT,ToolPurposeFlagBitsEXT,ValidationCacheHeaderVersionEXT,ValidationCheckEXT,ValidationFeatureDisableEXT,ValidationFeatureEnableEXT,VendorId,VertexInputRate,ViewportCoordinateSwizzleNV"; DO NOT EDIT.
+
+package vk
+
+import "strconv"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SPARSE_MEMORY_BIND_METADATA_BIT-1]
+}
+
+const _SparseMemoryBindFlagBits_name = "SPARSE_MEMORY_BIND_METADATA_BIT"
+
+var _SparseMemoryBindFlagBits_index = [...]uint8{0, 31}
+
+func (i SparseMemoryBindFlagBits) String() string {
+	i -= 1
+	if i >= SparseMemoryBindFlagBits(len(_SparseMemoryBindFlagBits_index)-1) {
+		return "SparseMemoryBindFlagBits(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _SparseMemoryBindFlagBits_name[_SparseMemoryBindFlagBits_index[i]:_SparseMemoryBindFlagBits_index[i+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[STENCIL_FACE_FRONT_AND_BACK-3]
+	_ = x[STENCIL_FACE_FRONT_BIT-1]
+	_ = x[STENCIL_FACE_BACK_BIT-2]
+	_ = x[STENCIL_FRONT_AND_BACK-3]
+}
+
+const _StencilFaceFlagBits_name = "STENCIL_FACE_FRONT_BITSTENCIL_FACE_BACK_BITSTENCIL_FACE_FRONT_AND_BACK"
+
+var _StencilFaceFlagBits_index = [...]uint8{0, 22, 43, 70}
+
+func (i StencilFaceFlagBits) String() string {
+	i -= 1
+	if i >= StencilFaceFlagBits(len(_StencilFaceFlagBits_index)-1) {
+		return "StencilFaceFlagBits(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _StencilFaceFlagBits_name[_StencilFaceFlagBits_index[i]:_StencilFaceFlagBits_index[i+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[STENCIL_OP_KEEP-0]
+	_ = x[STENCIL_OP_ZERO-1]
+	_ = x[STENCIL_OP_REPLACE-2]
+	_ = x[STENCIL_OP_INCREMENT_AND_CLAMP-3]
+	_ = x[STENCIL_OP_DECREMENT_AND_CLAMP-4]
+	_ = x[STENCIL_OP_INVERT-5]
+	_ = x[STENCIL_OP_INCREMENT_AND_WRAP-6]
+	_ = x[STENCIL_OP_DECREMENT_AND_WRAP-7]
+}
+
+const _StencilOp_name = "STENCIL_OP_KEEPSTENCIL_OP_ZEROSTENCIL_OP_REPLACESTENCIL_OP_INCREMENT_AND_CLAMPSTENCIL_OP_DECREMENT_AND_CLAMPSTENCIL_OP_INVERTSTENCIL_OP_INCREMENT_AND_WRAPSTENCIL_OP_DECREMENT_AND_WRAP"
+
+var _StencilOp_index = [...]uint8{0, 15, 30, 48, 78, 108, 125, 154, 183}
+
+func (i StencilOp) String() string {
+	if i < 0 || i >= StencilOp(len(_StencilOp_index)-1) {
+		return "StencilOp(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _StencilOp_name[_StencilOp_index[i]:_StencilOp_index[i+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[STRUCTURE_TYPE_APPLICATION_INFO-0]
+	_ = x[STRUCTURE_TYPE_INSTANCE_CREATE_INFO-1]
+	_ = x[STRUCTURE_TYPE_DEVICE_QUEUE_CREATE_INFO-2]
+	_ = x[STRUCTURE_TYPE_DEVICE_CREATE_INFO-3]
+	_ = x[STRUCTURE_TYPE_SUBMIT_INFO-4]
+	_ = x[STRUCTURE_TYPE_MEMORY_ALLOCATE_INFO-5]
+	_ = x[STRUCTURE_TYPE_MAPPED_MEMORY_RANGE-6]
+	_ = x[STRUCTURE_TYPE_BIND_SPARSE_INFO-7]
+	_ = x[STRUCTURE_TYPE_FENCE_CREATE_INFO-8]
+	_ = x[STRUCTURE_TYPE_SEMAPHORE_CREATE_INFO-9]
+	_ = x[STRUCTURE_TYPE_EVENT_CREATE_INFO-10]
+	_ = x[STRUCTURE_TYPE_QUERY_POOL_CREATE_INFO-11]
+	_ = x[STRUCTURE_TYPE_BUFFER_CREATE_INFO-12]
+	_ = x[STRUCTURE_TYPE_BUFFER_VIEW_CREATE_INFO-13]
+	_ = x[STRUCTURE_TYPE_IMAGE_CREATE_INFO-14]
+	_ = x[STRUCTURE_TYPE_IMAGE_VIEW_CREATE_INFO-15]
+	_ = x[STRUCTURE_TYPE_SHADER_MODULE_CREATE_INFO-16]
+	_ = x[STRUCTURE_TYPE_PIPELINE_CACHE_CREATE_INFO-17]
+	_ = x[STRUCTURE_TYPE_PIPELINE_SHADER_STAGE_CREATE_INFO-18]
+	_ = x[STRUCTURE_TYPE_PIPELINE_VERTEX_INPUT_STATE_CREATE_INFO-19]
+	_ = x[STRUCTURE_TYPE_PIPELINE_INPUT_ASSEMBLY_STATE_CREATE_INFO-20]
+	_ = x[STRUCTURE_TYPE_PIPELINE_TESSELLATION_STATE_CREATE_INFO-21]
+	_ = x[STRUCTURE_TYPE_PIPELINE_VIEWPORT_STATE_CREATE_INFO-22]
+	_ = x[STRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_CREATE_INFO-23]
+	_ = x[STRUCTURE_TYPE_PIPELINE_MULTISAMPLE_STATE_CREATE_INFO-24]
+	_ = x[STRUCTURE_TYPE_PIPELINE_DEPTH_STENCIL_STATE_CREATE_INFO-25]
+	_ = x[STRUCTURE_TYPE_PIPELINE_COLOR_BLEND_STATE_CREATE_INFO-26]
+	_ = x[STRUCTURE_TYPE_PIPELINE_DYNAMIC_STATE_CREATE_INFO-27]
+	_ = x[STRUCTURE_TYPE_GRAPHICS_PIPELINE_CREATE_INFO-28]
+	_ = x[STRUCTURE_TYPE_COMPUTE_PIPELINE_CREATE_INFO-29]
+	_ = x[STRUCTURE_TYPE_PIPELINE_LAYOUT_CREATE_INFO-30]
+	_ = x[STRUCTURE_TYPE_SAMPLER_CREATE_INFO-31]
+	_ = x[STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_CREATE_INFO-32]
+	_ = x[STRUCTURE_TYPE_DESCRIPTOR_POOL_CREATE_INFO-33]
+	_ = x[STRUCTURE_TYPE_DESCRIPTOR_SET_ALLOCATE_INFO-34]
+	_ = x[STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET-35]
+	_ = x[STRUCTURE_TYPE_COPY_DESCRIPTOR_SET-36]
+	_ = x[STRUCTURE_TYPE_FRAMEBUFFER_CREATE_INFO-37]
+	_ = x[STRUCTURE_TYPE_RENDER_PASS_CREATE_INFO-38]
+	_ = x[STRUCTURE_TYPE_COMMAND_POOL_CREATE_INFO-39]
+	_ = x[STRUCTURE_TYPE_COMMAND_BUFFER_ALLOCATE_INFO-40]
+	_ = x[STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_INFO-41]
+	_ = x[STRUCTURE_TYPE_COMMAND_BUFFER_BEGIN_INFO-42]
+	_ = x[STRUCTURE_TYPE_RENDER_PASS_BEGIN_INFO-43]
+	_ = x[STRUCTURE_TYPE_BUFFER_MEMORY_BARRIER-44]
+	_ = x[STRUCTURE_TYPE_IMAGE_MEMORY_BARRIER-45]
+	_ = x[STRUCTURE_TYPE_MEMORY_BARRIER-46]
+	_ = x[STRUCTURE_TYPE_LOADER_INSTANCE_CREATE_INFO-47]
+	_ = x[STRUCTURE_TYPE_LOADER_DEVICE_CREATE_INFO-48]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_1_FEATURES-49]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_1_PROPERTIES-50]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_2_FEATURES-51]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_2_PROPERTIES-52]
+	_ = x[STRUCTURE_TYPE_SWAPCHAIN_CREATE_INFO_KHR-1000001000]
+	_ = x[STRUCTURE_TYPE_PRESENT_INFO_KHR-1000001001]
+	_ = x[STRUCTURE_TYPE_DISPLAY_MODE_CREATE_INFO_KHR-1000002000]
+	_ = x[STRUCTURE_TYPE_DISPLAY_SURFACE_CREATE_INFO_KHR-1000002001]
+	_ = x[STRUCTURE_TYPE_DISPLAY_PRESENT_INFO_KHR-1000003000]
+	_ = x[STRUCTURE_TYPE_DEBUG_REPORT_CALLBACK_CREATE_INFO_EXT-1000011000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_RASTERIZATION_ORDER_AMD-1000018000]
+	_ = x[STRUCTURE_TYPE_DEBUG_MARKER_OBJECT_NAME_INFO_EXT-1000022000]
+	_ = x[STRUCTURE_TYPE_DEBUG_MARKER_OBJECT_TAG_INFO_EXT-1000022001]
+	_ = x[STRUCTURE_TYPE_DEBUG_MARKER_MARKER_INFO_EXT-1000022002]
+	_ = x[STRUCTURE_TYPE_DEDICATED_ALLOCATION_IMAGE_CREATE_INFO_NV-1000026000]
+	_ = x[STRUCTURE_TYPE_DEDICATED_ALLOCATION_BUFFER_CREATE_INFO_NV-1000026001]
+	_ = x[STRUCTURE_TYPE_DEDICATED_ALLOCATION_MEMORY_ALLOCATE_INFO_NV-1000026002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_FEATURES_EXT-1000028000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_PROPERTIES_EXT-1000028001]
+	_ = x[STRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_STREAM_CREATE_INFO_EXT-1000028002]
+	_ = x[STRUCTURE_TYPE_CU_MODULE_CREATE_INFO_NVX-1000029000]
+	_ = x[STRUCTURE_TYPE_CU_FUNCTION_CREATE_INFO_NVX-1000029001]
+	_ = x[STRUCTURE_TYPE_CU_LAUNCH_INFO_NVX-1000029002]
+	_ = x[STRUCTURE_TYPE_IMAGE_VIEW_HANDLE_INFO_NVX-1000030000]
+	_ = x[STRUCTURE_TYPE_IMAGE_VIEW_ADDRESS_PROPERTIES_NVX-1000030001]
+	_ = x[STRUCTURE_TYPE_TEXTURE_LOD_GATHER_FORMAT_PROPERTIES_AMD-1000041000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_CORNER_SAMPLED_IMAGE_FEATURES_NV-1000050000]
+	_ = x[STRUCTURE_TYPE_RENDER_PASS_MULTIVIEW_CREATE_INFO-1000053000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_FEATURES-1000053001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_PROPERTIES-1000053002]
+	_ = x[STRUCTURE_TYPE_EXTERNAL_MEMORY_IMAGE_CREATE_INFO_NV-1000056000]
+	_ = x[STRUCTURE_TYPE_EXPORT_MEMORY_ALLOCATE_INFO_NV-1000056001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_FEATURES_2-1000059000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_PROPERTIES_2-1000059001]
+	_ = x[STRUCTURE_TYPE_FORMAT_PROPERTIES_2-1000059002]
+	_ = x[STRUCTURE_TYPE_IMAGE_FORMAT_PROPERTIES_2-1000059003]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_FORMAT_INFO_2-1000059004]
+	_ = x[STRUCTURE_TYPE_QUEUE_FAMILY_PROPERTIES_2-1000059005]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_PROPERTIES_2-1000059006]
+	_ = x[STRUCTURE_TYPE_SPARSE_IMAGE_FORMAT_PROPERTIES_2-1000059007]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SPARSE_IMAGE_FORMAT_INFO_2-1000059008]
+	_ = x[STRUCTURE_TYPE_MEMORY_ALLOCATE_FLAGS_INFO-1000060000]
+	_ = x[STRUCTURE_TYPE_DEVICE_GROUP_RENDER_PASS_BEGIN_INFO-1000060003]
+	_ = x[STRUCTURE_TYPE_DEVICE_GROUP_COMMAND_BUFFER_BEGIN_INFO-1000060004]
+	_ = x[STRUCTURE_TYPE_DEVICE_GROUP_SUBMIT_INFO-1000060005]
+	_ = x[STRUCTURE_TYPE_DEVICE_GROUP_BIND_SPARSE_INFO-1000060006]
+	_ = x[STRUCTURE_TYPE_DEVICE_GROUP_PRESENT_CAPABILITIES_KHR-1000060007]
+	_ = x[STRUCTURE_TYPE_IMAGE_SWAPCHAIN_CREATE_INFO_KHR-1000060008]
+	_ = x[STRUCTURE_TYPE_BIND_IMAGE_MEMORY_SWAPCHAIN_INFO_KHR-1000060009]
+	_ = x[STRUCTURE_TYPE_ACQUIRE_NEXT_IMAGE_INFO_KHR-1000060010]
+	_ = x[STRUCTURE_TYPE_DEVICE_GROUP_PRESENT_INFO_KHR-1000060011]
+	_ = x[STRUCTURE_TYPE_DEVICE_GROUP_SWAPCHAIN_CREATE_INFO_KHR-1000060012]
+	_ = x[STRUCTURE_TYPE_BIND_BUFFER_MEMORY_DEVICE_GROUP_INFO-1000060013]
+	_ = x[STRUCTURE_TYPE_BIND_IMAGE_MEMORY_DEVICE_GROUP_INFO-1000060014]
+	_ = x[STRUCTURE_TYPE_VALIDATION_FLAGS_EXT-1000061000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_DRAW_PARAMETERS_FEATURES-1000063000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_TEXTURE_COMPRESSION_ASTC_HDR_FEATURES_EXT-1000066000]
+	_ = x[STRUCTURE_TYPE_IMAGE_VIEW_ASTC_DECODE_MODE_EXT-1000067000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_ASTC_DECODE_FEATURES_EXT-1000067001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_GROUP_PROPERTIES-1000070000]
+	_ = x[STRUCTURE_TYPE_DEVICE_GROUP_DEVICE_CREATE_INFO-1000070001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_IMAGE_FORMAT_INFO-1000071000]
+	_ = x[STRUCTURE_TYPE_EXTERNAL_IMAGE_FORMAT_PROPERTIES-1000071001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_BUFFER_INFO-1000071002]
+	_ = x[STRUCTURE_TYPE_EXTERNAL_BUFFER_PROPERTIES-1000071003]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_ID_PROPERTIES-1000071004]
+	_ = x[STRUCTURE_TYPE_EXTERNAL_MEMORY_BUFFER_CREATE_INFO-1000072000]
+	_ = x[STRUCTURE_TYPE_EXTERNAL_MEMORY_IMAGE_CREATE_INFO-1000072001]
+	_ = x[STRUCTURE_TYPE_EXPORT_MEMORY_ALLOCATE_INFO-1000072002]
+	_ = x[STRUCTURE_TYPE_IMPORT_MEMORY_FD_INFO_KHR-1000074000]
+	_ = x[STRUCTURE_TYPE_MEMORY_FD_PROPERTIES_KHR-1000074001]
+	_ = x[STRUCTURE_TYPE_MEMORY_GET_FD_INFO_KHR-1000074002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_SEMAPHORE_INFO-1000076000]
+	_ = x[STRUCTURE_TYPE_EXTERNAL_SEMAPHORE_PROPERTIES-1000076001]
+	_ = x[STRUCTURE_TYPE_EXPORT_SEMAPHORE_CREATE_INFO-1000077000]
+	_ = x[STRUCTURE_TYPE_IMPORT_SEMAPHORE_FD_INFO_KHR-1000079000]
+	_ = x[STRUCTURE_TYPE_SEMAPHORE_GET_FD_INFO_KHR-1000079001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_PUSH_DESCRIPTOR_PROPERTIES_KHR-1000080000]
+	_ = x[STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_CONDITIONAL_RENDERING_INFO_EXT-1000081000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_CONDITIONAL_RENDERING_FEATURES_EXT-1000081001]
+	_ = x[STRUCTURE_TYPE_CONDITIONAL_RENDERING_BEGIN_INFO_EXT-1000081002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_FLOAT16_INT8_FEATURES-1000082000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_16BIT_STORAGE_FEATURES-1000083000]
+	_ = x[STRUCTURE_TYPE_PRESENT_REGIONS_KHR-1000084000]
+	_ = x[STRUCTURE_TYPE_DESCRIPTOR_UPDATE_TEMPLATE_CREATE_INFO-1000085000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_VIEWPORT_W_SCALING_STATE_CREATE_INFO_NV-1000087000]
+	_ = x[STRUCTURE_TYPE_SURFACE_CAPABILITIES_2_EXT-1000090000]
+	_ = x[STRUCTURE_TYPE_DISPLAY_POWER_INFO_EXT-1000091000]
+	_ = x[STRUCTURE_TYPE_DEVICE_EVENT_INFO_EXT-1000091001]
+	_ = x[STRUCTURE_TYPE_DISPLAY_EVENT_INFO_EXT-1000091002]
+	_ = x[STRUCTURE_TYPE_SWAPCHAIN_COUNTER_CREATE_INFO_EXT-1000091003]
+	_ = x[STRUCTURE_TYPE_PRESENT_TIMES_INFO_GOOGLE-1000092000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBGROUP_PROPERTIES-1000094000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_PER_VIEW_ATTRIBUTES_PROPERTIES_NVX-1000097000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_VIEWPORT_SWIZZLE_STATE_CREATE_INFO_NV-1000098000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_DISCARD_RECTANGLE_PROPERTIES_EXT-1000099000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_DISCARD_RECTANGLE_STATE_CREATE_INFO_EXT-1000099001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_CONSERVATIVE_RASTERIZATION_PROPERTIES_EXT-1000101000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_RASTERIZATION_CONSERVATIVE_STATE_CREATE_INFO_EXT-1000101001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_CLIP_ENABLE_FEATURES_EXT-1000102000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_RASTERIZATION_DEPTH_CLIP_STATE_CREATE_INFO_EXT-1000102001]
+	_ = x[STRUCTURE_TYPE_HDR_METADATA_EXT-1000105000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGELESS_FRAMEBUFFER_FEATURES-1000108000]
+	_ = x[STRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENTS_CREATE_INFO-1000108001]
+	_ = x[STRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENT_IMAGE_INFO-1000108002]
+	_ = x[STRUCTURE_TYPE_RENDER_PASS_ATTACHMENT_BEGIN_INFO-1000108003]
+	_ = x[STRUCTURE_TYPE_ATTACHMENT_DESCRIPTION_2-1000109000]
+	_ = x[STRUCTURE_TYPE_ATTACHMENT_REFERENCE_2-1000109001]
+	_ = x[STRUCTURE_TYPE_SUBPASS_DESCRIPTION_2-1000109002]
+	_ = x[STRUCTURE_TYPE_SUBPASS_DEPENDENCY_2-1000109003]
+	_ = x[STRUCTURE_TYPE_RENDER_PASS_CREATE_INFO_2-1000109004]
+	_ = x[STRUCTURE_TYPE_SUBPASS_BEGIN_INFO-1000109005]
+	_ = x[STRUCTURE_TYPE_SUBPASS_END_INFO-1000109006]
+	_ = x[STRUCTURE_TYPE_SHARED_PRESENT_SURFACE_CAPABILITIES_KHR-1000111000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_FENCE_INFO-1000112000]
+	_ = x[STRUCTURE_TYPE_EXTERNAL_FENCE_PROPERTIES-1000112001]
+	_ = x[STRUCTURE_TYPE_EXPORT_FENCE_CREATE_INFO-1000113000]
+	_ = x[STRUCTURE_TYPE_IMPORT_FENCE_FD_INFO_KHR-1000115000]
+	_ = x[STRUCTURE_TYPE_FENCE_GET_FD_INFO_KHR-1000115001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_PERFORMANCE_QUERY_FEATURES_KHR-1000116000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_PERFORMANCE_QUERY_PROPERTIES_KHR-1000116001]
+	_ = x[STRUCTURE_TYPE_QUERY_POOL_PERFORMANCE_CREATE_INFO_KHR-1000116002]
+	_ = x[STRUCTURE_TYPE_PERFORMANCE_QUERY_SUBMIT_INFO_KHR-1000116003]
+	_ = x[STRUCTURE_TYPE_ACQUIRE_PROFILING_LOCK_INFO_KHR-1000116004]
+	_ = x[STRUCTURE_TYPE_PERFORMANCE_COUNTER_KHR-1000116005]
+	_ = x[STRUCTURE_TYPE_PERFORMANCE_COUNTER_DESCRIPTION_KHR-1000116006]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_POINT_CLIPPING_PROPERTIES-1000117000]
+	_ = x[STRUCTURE_TYPE_RENDER_PASS_INPUT_ATTACHMENT_ASPECT_CREATE_INFO-1000117001]
+	_ = x[STRUCTURE_TYPE_IMAGE_VIEW_USAGE_CREATE_INFO-1000117002]
+	_ = x[STRUCTURE_TYPE_PIPELINE_TESSELLATION_DOMAIN_ORIGIN_STATE_CREATE_INFO-1000117003]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SURFACE_INFO_2_KHR-1000119000]
+	_ = x[STRUCTURE_TYPE_SURFACE_CAPABILITIES_2_KHR-1000119001]
+	_ = x[STRUCTURE_TYPE_SURFACE_FORMAT_2_KHR-1000119002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_VARIABLE_POINTERS_FEATURES-1000120000]
+	_ = x[STRUCTURE_TYPE_DISPLAY_PROPERTIES_2_KHR-1000121000]
+	_ = x[STRUCTURE_TYPE_DISPLAY_PLANE_PROPERTIES_2_KHR-1000121001]
+	_ = x[STRUCTURE_TYPE_DISPLAY_MODE_PROPERTIES_2_KHR-1000121002]
+	_ = x[STRUCTURE_TYPE_DISPLAY_PLANE_INFO_2_KHR-1000121003]
+	_ = x[STRUCTURE_TYPE_DISPLAY_PLANE_CAPABILITIES_2_KHR-1000121004]
+	_ = x[STRUCTURE_TYPE_MEMORY_DEDICATED_REQUIREMENTS-1000127000]
+	_ = x[STRUCTURE_TYPE_MEMORY_DEDICATED_ALLOCATE_INFO-1000127001]
+	_ = x[STRUCTURE_TYPE_DEBUG_UTILS_OBJECT_NAME_INFO_EXT-1000128000]
+	_ = x[STRUCTURE_TYPE_DEBUG_UTILS_OBJECT_TAG_INFO_EXT-1000128001]
+	_ = x[STRUCTURE_TYPE_DEBUG_UTILS_LABEL_EXT-1000128002]
+	_ = x[STRUCTURE_TYPE_DEBUG_UTILS_MESSENGER_CALLBACK_DATA_EXT-1000128003]
+	_ = x[STRUCTURE_TYPE_DEBUG_UTILS_MESSENGER_CREATE_INFO_EXT-1000128004]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLER_FILTER_MINMAX_PROPERTIES-1000130000]
+	_ = x[STRUCTURE_TYPE_SAMPLER_REDUCTION_MODE_CREATE_INFO-1000130001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_FEATURES_EXT-1000138000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_PROPERTIES_EXT-1000138001]
+	_ = x[STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_INLINE_UNIFORM_BLOCK_EXT-1000138002]
+	_ = x[STRUCTURE_TYPE_DESCRIPTOR_POOL_INLINE_UNIFORM_BLOCK_CREATE_INFO_EXT-1000138003]
+	_ = x[STRUCTURE_TYPE_SAMPLE_LOCATIONS_INFO_EXT-1000143000]
+	_ = x[STRUCTURE_TYPE_RENDER_PASS_SAMPLE_LOCATIONS_BEGIN_INFO_EXT-1000143001]
+	_ = x[STRUCTURE_TYPE_PIPELINE_SAMPLE_LOCATIONS_STATE_CREATE_INFO_EXT-1000143002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLE_LOCATIONS_PROPERTIES_EXT-1000143003]
+	_ = x[STRUCTURE_TYPE_MULTISAMPLE_PROPERTIES_EXT-1000143004]
+	_ = x[STRUCTURE_TYPE_PROTECTED_SUBMIT_INFO-1000145000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_PROTECTED_MEMORY_FEATURES-1000145001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_PROTECTED_MEMORY_PROPERTIES-1000145002]
+	_ = x[STRUCTURE_TYPE_DEVICE_QUEUE_INFO_2-1000145003]
+	_ = x[STRUCTURE_TYPE_BUFFER_MEMORY_REQUIREMENTS_INFO_2-1000146000]
+	_ = x[STRUCTURE_TYPE_IMAGE_MEMORY_REQUIREMENTS_INFO_2-1000146001]
+	_ = x[STRUCTURE_TYPE_IMAGE_SPARSE_MEMORY_REQUIREMENTS_INFO_2-1000146002]
+	_ = x[STRUCTURE_TYPE_MEMORY_REQUIREMENTS_2-1000146003]
+	_ = x[STRUCTURE_TYPE_SPARSE_IMAGE_MEMORY_REQUIREMENTS_2-1000146004]
+	_ = x[STRUCTURE_TYPE_IMAGE_FORMAT_LIST_CREATE_INFO-1000147000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_BLEND_OPERATION_ADVANCED_FEATURES_EXT-1000148000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_BLEND_OPERATION_ADVANCED_PROPERTIES_EXT-1000148001]
+	_ = x[STRUCTURE_TYPE_PIPELINE_COLOR_BLEND_ADVANCED_STATE_CREATE_INFO_EXT-1000148002]
+	_ = x[STRUCTURE_TYPE_PIPELINE_COVERAGE_TO_COLOR_STATE_CREATE_INFO_NV-1000149000]
+	_ = x[STRUCTURE_TYPE_ACCELERATION_STRUCTURE_BUILD_GEOMETRY_INFO_KHR-1000150000]
+	_ = x[STRUCTURE_TYPE_ACCELERATION_STRUCTURE_DEVICE_ADDRESS_INFO_KHR-1000150002]
+	_ = x[STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_AABBS_DATA_KHR-1000150003]
+	_ = x[STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_INSTANCES_DATA_KHR-1000150004]
+	_ = x[STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_TRIANGLES_DATA_KHR-1000150005]
+	_ = x[STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_KHR-1000150006]
+	_ = x[STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_ACCELERATION_STRUCTURE_KHR-1000150007]
+	_ = x[STRUCTURE_TYPE_ACCELERATION_STRUCTURE_VERSION_INFO_KHR-1000150009]
+	_ = x[STRUCTURE_TYPE_COPY_ACCELERATION_STRUCTURE_INFO_KHR-1000150010]
+	_ = x[STRUCTURE_TYPE_COPY_ACCELERATION_STRUCTURE_TO_MEMORY_INFO_KHR-1000150011]
+	_ = x[STRUCTURE_TYPE_COPY_MEMORY_TO_ACCELERATION_STRUCTURE_INFO_KHR-1000150012]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_ACCELERATION_STRUCTURE_FEATURES_KHR-1000150013]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_ACCELERATION_STRUCTURE_PROPERTIES_KHR-1000150014]
+	_ = x[STRUCTURE_TYPE_ACCELERATION_STRUCTURE_CREATE_INFO_KHR-1000150017]
+	_ = x[STRUCTURE_TYPE_ACCELERATION_STRUCTURE_BUILD_SIZES_INFO_KHR-1000150020]
+	_ = x[STRUCTURE_TYPE_PIPELINE_COVERAGE_MODULATION_STATE_CREATE_INFO_NV-1000152000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SM_BUILTINS_FEATURES_NV-1000154000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SM_BUILTINS_PROPERTIES_NV-1000154001]
+	_ = x[STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_CREATE_INFO-1000156000]
+	_ = x[STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_INFO-1000156001]
+	_ = x[STRUCTURE_TYPE_BIND_IMAGE_PLANE_MEMORY_INFO-1000156002]
+	_ = x[STRUCTURE_TYPE_IMAGE_PLANE_MEMORY_REQUIREMENTS_INFO-1000156003]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLER_YCBCR_CONVERSION_FEATURES-1000156004]
+	_ = x[STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_IMAGE_FORMAT_PROPERTIES-1000156005]
+	_ = x[STRUCTURE_TYPE_BIND_BUFFER_MEMORY_INFO-1000157000]
+	_ = x[STRUCTURE_TYPE_BIND_IMAGE_MEMORY_INFO-1000157001]
+	_ = x[STRUCTURE_TYPE_DRM_FORMAT_MODIFIER_PROPERTIES_LIST_EXT-1000158000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_DRM_FORMAT_MODIFIER_INFO_EXT-1000158002]
+	_ = x[STRUCTURE_TYPE_IMAGE_DRM_FORMAT_MODIFIER_LIST_CREATE_INFO_EXT-1000158003]
+	_ = x[STRUCTURE_TYPE_IMAGE_DRM_FORMAT_MODIFIER_EXPLICIT_CREATE_INFO_EXT-1000158004]
+	_ = x[STRUCTURE_TYPE_IMAGE_DRM_FORMAT_MODIFIER_PROPERTIES_EXT-1000158005]
+	_ = x[STRUCTURE_TYPE_VALIDATION_CACHE_CREATE_INFO_EXT-1000160000]
+	_ = x[STRUCTURE_TYPE_SHADER_MODULE_VALIDATION_CACHE_CREATE_INFO_EXT-1000160001]
+	_ = x[STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_BINDING_FLAGS_CREATE_INFO-1000161000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_FEATURES-1000161001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_PROPERTIES-1000161002]
+	_ = x[STRUCTURE_TYPE_DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_ALLOCATE_INFO-1000161003]
+	_ = x[STRUCTURE_TYPE_DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_LAYOUT_SUPPORT-1000161004]
+	_ = x[STRUCTURE_TYPE_PIPELINE_VIEWPORT_SHADING_RATE_IMAGE_STATE_CREATE_INFO_NV-1000164000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADING_RATE_IMAGE_FEATURES_NV-1000164001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADING_RATE_IMAGE_PROPERTIES_NV-1000164002]
+	_ = x[STRUCTURE_TYPE_PIPELINE_VIEWPORT_COARSE_SAMPLE_ORDER_STATE_CREATE_INFO_NV-1000164005]
+	_ = x[STRUCTURE_TYPE_RAY_TRACING_PIPELINE_CREATE_INFO_NV-1000165000]
+	_ = x[STRUCTURE_TYPE_ACCELERATION_STRUCTURE_CREATE_INFO_NV-1000165001]
+	_ = x[STRUCTURE_TYPE_GEOMETRY_NV-1000165003]
+	_ = x[STRUCTURE_TYPE_GEOMETRY_TRIANGLES_NV-1000165004]
+	_ = x[STRUCTURE_TYPE_GEOMETRY_AABB_NV-1000165005]
+	_ = x[STRUCTURE_TYPE_BIND_ACCELERATION_STRUCTURE_MEMORY_INFO_NV-1000165006]
+	_ = x[STRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_ACCELERATION_STRUCTURE_NV-1000165007]
+	_ = x[STRUCTURE_TYPE_ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_INFO_NV-1000165008]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_PROPERTIES_NV-1000165009]
+	_ = x[STRUCTURE_TYPE_RAY_TRACING_SHADER_GROUP_CREATE_INFO_NV-1000165011]
+	_ = x[STRUCTURE_TYPE_ACCELERATION_STRUCTURE_INFO_NV-1000165012]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_REPRESENTATIVE_FRAGMENT_TEST_FEATURES_NV-1000166000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_REPRESENTATIVE_FRAGMENT_TEST_STATE_CREATE_INFO_NV-1000166001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_3_PROPERTIES-1000168000]
+	_ = x[STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_SUPPORT-1000168001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_VIEW_IMAGE_FORMAT_INFO_EXT-1000170000]
+	_ = x[STRUCTURE_TYPE_FILTER_CUBIC_IMAGE_VIEW_IMAGE_FORMAT_PROPERTIES_EXT-1000170001]
+	_ = x[STRUCTURE_TYPE_DEVICE_QUEUE_GLOBAL_PRIORITY_CREATE_INFO_EXT-1000174000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SUBGROUP_EXTENDED_TYPES_FEATURES-1000175000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_8BIT_STORAGE_FEATURES-1000177000]
+	_ = x[STRUCTURE_TYPE_IMPORT_MEMORY_HOST_POINTER_INFO_EXT-1000178000]
+	_ = x[STRUCTURE_TYPE_MEMORY_HOST_POINTER_PROPERTIES_EXT-1000178001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_MEMORY_HOST_PROPERTIES_EXT-1000178002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_INT64_FEATURES-1000180000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CLOCK_FEATURES_KHR-1000181000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_COMPILER_CONTROL_CREATE_INFO_AMD-1000183000]
+	_ = x[STRUCTURE_TYPE_CALIBRATED_TIMESTAMP_INFO_EXT-1000184000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_AMD-1000185000]
+	_ = x[STRUCTURE_TYPE_DEVICE_MEMORY_OVERALLOCATION_CREATE_INFO_AMD-1000189000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_PROPERTIES_EXT-1000190000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_VERTEX_INPUT_DIVISOR_STATE_CREATE_INFO_EXT-1000190001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_FEATURES_EXT-1000190002]
+	_ = x[STRUCTURE_TYPE_PIPELINE_CREATION_FEEDBACK_CREATE_INFO_EXT-1000192000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_DRIVER_PROPERTIES-1000196000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_FLOAT_CONTROLS_PROPERTIES-1000197000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_STENCIL_RESOLVE_PROPERTIES-1000199000]
+	_ = x[STRUCTURE_TYPE_SUBPASS_DESCRIPTION_DEPTH_STENCIL_RESOLVE-1000199001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_COMPUTE_SHADER_DERIVATIVES_FEATURES_NV-1000201000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_MESH_SHADER_FEATURES_NV-1000202000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_MESH_SHADER_PROPERTIES_NV-1000202001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADER_BARYCENTRIC_FEATURES_NV-1000203000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_IMAGE_FOOTPRINT_FEATURES_NV-1000204000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_VIEWPORT_EXCLUSIVE_SCISSOR_STATE_CREATE_INFO_NV-1000205000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_EXCLUSIVE_SCISSOR_FEATURES_NV-1000205002]
+	_ = x[STRUCTURE_TYPE_CHECKPOINT_DATA_NV-1000206000]
+	_ = x[STRUCTURE_TYPE_QUEUE_FAMILY_CHECKPOINT_PROPERTIES_NV-1000206001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_FEATURES-1000207000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_PROPERTIES-1000207001]
+	_ = x[STRUCTURE_TYPE_SEMAPHORE_TYPE_CREATE_INFO-1000207002]
+	_ = x[STRUCTURE_TYPE_TIMELINE_SEMAPHORE_SUBMIT_INFO-1000207003]
+	_ = x[STRUCTURE_TYPE_SEMAPHORE_WAIT_INFO-1000207004]
+	_ = x[STRUCTURE_TYPE_SEMAPHORE_SIGNAL_INFO-1000207005]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_INTEGER_FUNCTIONS_2_FEATURES_INTEL-1000209000]
+	_ = x[STRUCTURE_TYPE_QUERY_POOL_PERFORMANCE_QUERY_CREATE_INFO_INTEL-1000210000]
+	_ = x[STRUCTURE_TYPE_INITIALIZE_PERFORMANCE_API_INFO_INTEL-1000210001]
+	_ = x[STRUCTURE_TYPE_PERFORMANCE_MARKER_INFO_INTEL-1000210002]
+	_ = x[STRUCTURE_TYPE_PERFORMANCE_STREAM_MARKER_INFO_INTEL-1000210003]
+	_ = x[STRUCTURE_TYPE_PERFORMANCE_OVERRIDE_INFO_INTEL-1000210004]
+	_ = x[STRUCTURE_TYPE_PERFORMANCE_CONFIGURATION_ACQUIRE_INFO_INTEL-1000210005]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_MEMORY_MODEL_FEATURES-1000211000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_PCI_BUS_INFO_PROPERTIES_EXT-1000212000]
+	_ = x[STRUCTURE_TYPE_DISPLAY_NATIVE_HDR_SURFACE_CAPABILITIES_AMD-1000213000]
+	_ = x[STRUCTURE_TYPE_SWAPCHAIN_DISPLAY_NATIVE_HDR_CREATE_INFO_AMD-1000213001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_TERMINATE_INVOCATION_FEATURES_KHR-1000215000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_FEATURES_EXT-1000218000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_PROPERTIES_EXT-1000218001]
+	_ = x[STRUCTURE_TYPE_RENDER_PASS_FRAGMENT_DENSITY_MAP_CREATE_INFO_EXT-1000218002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SCALAR_BLOCK_LAYOUT_FEATURES-1000221000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBGROUP_SIZE_CONTROL_PROPERTIES_EXT-1000225000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_SHADER_STAGE_REQUIRED_SUBGROUP_SIZE_CREATE_INFO_EXT-1000225001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBGROUP_SIZE_CONTROL_FEATURES_EXT-1000225002]
+	_ = x[STRUCTURE_TYPE_FRAGMENT_SHADING_RATE_ATTACHMENT_INFO_KHR-1000226000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_FRAGMENT_SHADING_RATE_STATE_CREATE_INFO_KHR-1000226001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_PROPERTIES_KHR-1000226002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_FEATURES_KHR-1000226003]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_KHR-1000226004]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_2_AMD-1000227000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_COHERENT_MEMORY_FEATURES_AMD-1000229000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_IMAGE_ATOMIC_INT64_FEATURES_EXT-1000234000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_BUDGET_PROPERTIES_EXT-1000237000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_PRIORITY_FEATURES_EXT-1000238000]
+	_ = x[STRUCTURE_TYPE_MEMORY_PRIORITY_ALLOCATE_INFO_EXT-1000238001]
+	_ = x[STRUCTURE_TYPE_SURFACE_PROTECTED_CAPABILITIES_KHR-1000239000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_DEDICATED_ALLOCATION_IMAGE_ALIASING_FEATURES_NV-1000240000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SEPARATE_DEPTH_STENCIL_LAYOUTS_FEATURES-1000241000]
+	_ = x[STRUCTURE_TYPE_ATTACHMENT_REFERENCE_STENCIL_LAYOUT-1000241001]
+	_ = x[STRUCTURE_TYPE_ATTACHMENT_DESCRIPTION_STENCIL_LAYOUT-1000241002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES_EXT-1000244000]
+	_ = x[STRUCTURE_TYPE_BUFFER_DEVICE_ADDRESS_INFO-1000244001]
+	_ = x[STRUCTURE_TYPE_BUFFER_DEVICE_ADDRESS_CREATE_INFO_EXT-1000244002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_TOOL_PROPERTIES_EXT-1000245000]
+	_ = x[STRUCTURE_TYPE_IMAGE_STENCIL_USAGE_CREATE_INFO-1000246000]
+	_ = x[STRUCTURE_TYPE_VALIDATION_FEATURES_EXT-1000247000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_PRESENT_WAIT_FEATURES_KHR-1000248000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_COOPERATIVE_MATRIX_FEATURES_NV-1000249000]
+	_ = x[STRUCTURE_TYPE_COOPERATIVE_MATRIX_PROPERTIES_NV-1000249001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_COOPERATIVE_MATRIX_PROPERTIES_NV-1000249002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_COVERAGE_REDUCTION_MODE_FEATURES_NV-1000250000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_COVERAGE_REDUCTION_STATE_CREATE_INFO_NV-1000250001]
+	_ = x[STRUCTURE_TYPE_FRAMEBUFFER_MIXED_SAMPLES_COMBINATION_NV-1000250002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADER_INTERLOCK_FEATURES_EXT-1000251000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_YCBCR_IMAGE_ARRAYS_FEATURES_EXT-1000252000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_UNIFORM_BUFFER_STANDARD_LAYOUT_FEATURES-1000253000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_PROVOKING_VERTEX_FEATURES_EXT-1000254000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_RASTERIZATION_PROVOKING_VERTEX_STATE_CREATE_INFO_EXT-1000254001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_PROVOKING_VERTEX_PROPERTIES_EXT-1000254002]
+	_ = x[STRUCTURE_TYPE_HEADLESS_SURFACE_CREATE_INFO_EXT-1000256000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES-1000257000]
+	_ = x[STRUCTURE_TYPE_BUFFER_OPAQUE_CAPTURE_ADDRESS_CREATE_INFO-1000257002]
+	_ = x[STRUCTURE_TYPE_MEMORY_OPAQUE_CAPTURE_ADDRESS_ALLOCATE_INFO-1000257003]
+	_ = x[STRUCTURE_TYPE_DEVICE_MEMORY_OPAQUE_CAPTURE_ADDRESS_INFO-1000257004]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_LINE_RASTERIZATION_FEATURES_EXT-1000259000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_RASTERIZATION_LINE_STATE_CREATE_INFO_EXT-1000259001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_LINE_RASTERIZATION_PROPERTIES_EXT-1000259002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT_FEATURES_EXT-1000260000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_HOST_QUERY_RESET_FEATURES-1000261000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_INDEX_TYPE_UINT8_FEATURES_EXT-1000265000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_FEATURES_EXT-1000267000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_EXECUTABLE_PROPERTIES_FEATURES_KHR-1000269000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_INFO_KHR-1000269001]
+	_ = x[STRUCTURE_TYPE_PIPELINE_EXECUTABLE_PROPERTIES_KHR-1000269002]
+	_ = x[STRUCTURE_TYPE_PIPELINE_EXECUTABLE_INFO_KHR-1000269003]
+	_ = x[STRUCTURE_TYPE_PIPELINE_EXECUTABLE_STATISTIC_KHR-1000269004]
+	_ = x[STRUCTURE_TYPE_PIPELINE_EXECUTABLE_INTERNAL_REPRESENTATION_KHR-1000269005]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT_2_FEATURES_EXT-1000273000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_DEMOTE_TO_HELPER_INVOCATION_FEATURES_EXT-1000276000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_PROPERTIES_NV-1000277000]
+	_ = x[STRUCTURE_TYPE_GRAPHICS_SHADER_GROUP_CREATE_INFO_NV-1000277001]
+	_ = x[STRUCTURE_TYPE_GRAPHICS_PIPELINE_SHADER_GROUPS_CREATE_INFO_NV-1000277002]
+	_ = x[STRUCTURE_TYPE_INDIRECT_COMMANDS_LAYOUT_TOKEN_NV-1000277003]
+	_ = x[STRUCTURE_TYPE_INDIRECT_COMMANDS_LAYOUT_CREATE_INFO_NV-1000277004]
+	_ = x[STRUCTURE_TYPE_GENERATED_COMMANDS_INFO_NV-1000277005]
+	_ = x[STRUCTURE_TYPE_GENERATED_COMMANDS_MEMORY_REQUIREMENTS_INFO_NV-1000277006]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_FEATURES_NV-1000277007]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_INHERITED_VIEWPORT_SCISSOR_FEATURES_NV-1000278000]
+	_ = x[STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_VIEWPORT_SCISSOR_INFO_NV-1000278001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_INTEGER_DOT_PRODUCT_FEATURES_KHR-1000280000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_INTEGER_DOT_PRODUCT_PROPERTIES_KHR-1000280001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_TEXEL_BUFFER_ALIGNMENT_FEATURES_EXT-1000281000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_TEXEL_BUFFER_ALIGNMENT_PROPERTIES_EXT-1000281001]
+	_ = x[STRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_RENDER_PASS_TRANSFORM_INFO_QCOM-1000282000]
+	_ = x[STRUCTURE_TYPE_RENDER_PASS_TRANSFORM_BEGIN_INFO_QCOM-1000282001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_MEMORY_REPORT_FEATURES_EXT-1000284000]
+	_ = x[STRUCTURE_TYPE_DEVICE_DEVICE_MEMORY_REPORT_CREATE_INFO_EXT-1000284001]
+	_ = x[STRUCTURE_TYPE_DEVICE_MEMORY_REPORT_CALLBACK_DATA_EXT-1000284002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_ROBUSTNESS_2_FEATURES_EXT-1000286000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_ROBUSTNESS_2_PROPERTIES_EXT-1000286001]
+	_ = x[STRUCTURE_TYPE_SAMPLER_CUSTOM_BORDER_COLOR_CREATE_INFO_EXT-1000287000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_CUSTOM_BORDER_COLOR_PROPERTIES_EXT-1000287001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_CUSTOM_BORDER_COLOR_FEATURES_EXT-1000287002]
+	_ = x[STRUCTURE_TYPE_PIPELINE_LIBRARY_CREATE_INFO_KHR-1000290000]
+	_ = x[STRUCTURE_TYPE_PRESENT_ID_KHR-1000294000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_PRESENT_ID_FEATURES_KHR-1000294001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_PRIVATE_DATA_FEATURES_EXT-1000295000]
+	_ = x[STRUCTURE_TYPE_DEVICE_PRIVATE_DATA_CREATE_INFO_EXT-1000295001]
+	_ = x[STRUCTURE_TYPE_PRIVATE_DATA_SLOT_CREATE_INFO_EXT-1000295002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_CREATION_CACHE_CONTROL_FEATURES_EXT-1000297000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_DIAGNOSTICS_CONFIG_FEATURES_NV-1000300000]
+	_ = x[STRUCTURE_TYPE_DEVICE_DIAGNOSTICS_CONFIG_CREATE_INFO_NV-1000300001]
+	_ = x[STRUCTURE_TYPE_MEMORY_BARRIER_2_KHR-1000314000]
+	_ = x[STRUCTURE_TYPE_BUFFER_MEMORY_BARRIER_2_KHR-1000314001]
+	_ = x[STRUCTURE_TYPE_IMAGE_MEMORY_BARRIER_2_KHR-1000314002]
+	_ = x[STRUCTURE_TYPE_DEPENDENCY_INFO_KHR-1000314003]
+	_ = x[STRUCTURE_TYPE_SUBMIT_INFO_2_KHR-1000314004]
+	_ = x[STRUCTURE_TYPE_SEMAPHORE_SUBMIT_INFO_KHR-1000314005]
+	_ = x[STRUCTURE_TYPE_COMMAND_BUFFER_SUBMIT_INFO_KHR-1000314006]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SYNCHRONIZATION_2_FEATURES_KHR-1000314007]
+	_ = x[STRUCTURE_TYPE_QUEUE_FAMILY_CHECKPOINT_PROPERTIES_2_NV-1000314008]
+	_ = x[STRUCTURE_TYPE_CHECKPOINT_DATA_2_NV-1000314009]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SUBGROUP_UNIFORM_CONTROL_FLOW_FEATURES_KHR-1000323000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_ZERO_INITIALIZE_WORKGROUP_MEMORY_FEATURES_KHR-1000325000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_ENUMS_PROPERTIES_NV-1000326000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_ENUMS_FEATURES_NV-1000326001]
+	_ = x[STRUCTURE_TYPE_PIPELINE_FRAGMENT_SHADING_RATE_ENUM_STATE_CREATE_INFO_NV-1000326002]
+	_ = x[STRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_MOTION_TRIANGLES_DATA_NV-1000327000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_MOTION_BLUR_FEATURES_NV-1000327001]
+	_ = x[STRUCTURE_TYPE_ACCELERATION_STRUCTURE_MOTION_INFO_NV-1000327002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_YCBCR_2_PLANE_444_FORMATS_FEATURES_EXT-1000330000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_2_FEATURES_EXT-1000332000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_2_PROPERTIES_EXT-1000332001]
+	_ = x[STRUCTURE_TYPE_COPY_COMMAND_TRANSFORM_INFO_QCOM-1000333000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_ROBUSTNESS_FEATURES_EXT-1000335000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_WORKGROUP_MEMORY_EXPLICIT_LAYOUT_FEATURES_KHR-1000336000]
+	_ = x[STRUCTURE_TYPE_COPY_BUFFER_INFO_2_KHR-1000337000]
+	_ = x[STRUCTURE_TYPE_COPY_IMAGE_INFO_2_KHR-1000337001]
+	_ = x[STRUCTURE_TYPE_COPY_BUFFER_TO_IMAGE_INFO_2_KHR-1000337002]
+	_ = x[STRUCTURE_TYPE_COPY_IMAGE_TO_BUFFER_INFO_2_KHR-1000337003]
+	_ = x[STRUCTURE_TYPE_BLIT_IMAGE_INFO_2_KHR-1000337004]
+	_ = x[STRUCTURE_TYPE_RESOLVE_IMAGE_INFO_2_KHR-1000337005]
+	_ = x[STRUCTURE_TYPE_BUFFER_COPY_2_KHR-1000337006]
+	_ = x[STRUCTURE_TYPE_IMAGE_COPY_2_KHR-1000337007]
+	_ = x[STRUCTURE_TYPE_IMAGE_BLIT_2_KHR-1000337008]
+	_ = x[STRUCTURE_TYPE_BUFFER_IMAGE_COPY_2_KHR-1000337009]
+	_ = x[STRUCTURE_TYPE_IMAGE_RESOLVE_2_KHR-1000337010]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_4444_FORMATS_FEATURES_EXT-1000340000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_PIPELINE_FEATURES_KHR-1000347000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_PIPELINE_PROPERTIES_KHR-1000347001]
+	_ = x[STRUCTURE_TYPE_RAY_TRACING_PIPELINE_CREATE_INFO_KHR-1000347015]
+	_ = x[STRUCTURE_TYPE_RAY_TRACING_SHADER_GROUP_CREATE_INFO_KHR-1000347016]
+	_ = x[STRUCTURE_TYPE_RAY_TRACING_PIPELINE_INTERFACE_CREATE_INFO_KHR-1000347018]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_QUERY_FEATURES_KHR-1000348013]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_MUTABLE_DESCRIPTOR_TYPE_FEATURES_VALVE-1000351000]
+	_ = x[STRUCTURE_TYPE_MUTABLE_DESCRIPTOR_TYPE_CREATE_INFO_VALVE-1000351002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_VERTEX_INPUT_DYNAMIC_STATE_FEATURES_EXT-1000352000]
+	_ = x[STRUCTURE_TYPE_VERTEX_INPUT_BINDING_DESCRIPTION_2_EXT-1000352001]
+	_ = x[STRUCTURE_TYPE_VERTEX_INPUT_ATTRIBUTE_DESCRIPTION_2_EXT-1000352002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_DRM_PROPERTIES_EXT-1000353000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_PRIMITIVE_TOPOLOGY_LIST_RESTART_FEATURES_EXT-1000356000]
+	_ = x[STRUCTURE_TYPE_SUBPASS_SHADING_PIPELINE_CREATE_INFO_HUAWEI-1000369000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBPASS_SHADING_FEATURES_HUAWEI-1000369001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SUBPASS_SHADING_PROPERTIES_HUAWEI-1000369002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_INVOCATION_MASK_FEATURES_HUAWEI-1000370000]
+	_ = x[STRUCTURE_TYPE_MEMORY_GET_REMOTE_ADDRESS_INFO_NV-1000371000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_MEMORY_RDMA_FEATURES_NV-1000371001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_2_FEATURES_EXT-1000377000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_COLOR_WRITE_ENABLE_FEATURES_EXT-1000381000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_COLOR_WRITE_CREATE_INFO_EXT-1000381001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_GLOBAL_PRIORITY_QUERY_FEATURES_EXT-1000388000]
+	_ = x[STRUCTURE_TYPE_QUEUE_FAMILY_GLOBAL_PRIORITY_PROPERTIES_EXT-1000388001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTI_DRAW_FEATURES_EXT-1000392000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTI_DRAW_PROPERTIES_EXT-1000392001]
+	_ = x[STRUCTURE_TYPE_ATTACHMENT_DESCRIPTION_2_KHR-1000109000]
+	_ = x[STRUCTURE_TYPE_ATTACHMENT_DESCRIPTION_STENCIL_LAYOUT_KHR-1000241002]
+	_ = x[STRUCTURE_TYPE_ATTACHMENT_REFERENCE_2_KHR-1000109001]
+	_ = x[STRUCTURE_TYPE_ATTACHMENT_REFERENCE_STENCIL_LAYOUT_KHR-1000241001]
+	_ = x[STRUCTURE_TYPE_BIND_BUFFER_MEMORY_DEVICE_GROUP_INFO_KHR-1000060013]
+	_ = x[STRUCTURE_TYPE_BIND_BUFFER_MEMORY_INFO_KHR-1000157000]
+	_ = x[STRUCTURE_TYPE_BIND_IMAGE_MEMORY_DEVICE_GROUP_INFO_KHR-1000060014]
+	_ = x[STRUCTURE_TYPE_BIND_IMAGE_MEMORY_INFO_KHR-1000157001]
+	_ = x[STRUCTURE_TYPE_BIND_IMAGE_PLANE_MEMORY_INFO_KHR-1000156002]
+	_ = x[STRUCTURE_TYPE_BUFFER_DEVICE_ADDRESS_INFO_KHR-1000244001]
+	_ = x[STRUCTURE_TYPE_BUFFER_DEVICE_ADDRESS_INFO_EXT-1000244001]
+	_ = x[STRUCTURE_TYPE_BUFFER_MEMORY_REQUIREMENTS_INFO_2_KHR-1000146000]
+	_ = x[STRUCTURE_TYPE_BUFFER_OPAQUE_CAPTURE_ADDRESS_CREATE_INFO_KHR-1000257002]
+	_ = x[STRUCTURE_TYPE_DEBUG_REPORT_CREATE_INFO_EXT-1000011000]
+	_ = x[STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_BINDING_FLAGS_CREATE_INFO_EXT-1000161000]
+	_ = x[STRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_SUPPORT_KHR-1000168001]
+	_ = x[STRUCTURE_TYPE_DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_ALLOCATE_INFO_EXT-1000161003]
+	_ = x[STRUCTURE_TYPE_DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_LAYOUT_SUPPORT_EXT-1000161004]
+	_ = x[STRUCTURE_TYPE_DESCRIPTOR_UPDATE_TEMPLATE_CREATE_INFO_KHR-1000085000]
+	_ = x[STRUCTURE_TYPE_DEVICE_GROUP_BIND_SPARSE_INFO_KHR-1000060006]
+	_ = x[STRUCTURE_TYPE_DEVICE_GROUP_COMMAND_BUFFER_BEGIN_INFO_KHR-1000060004]
+	_ = x[STRUCTURE_TYPE_DEVICE_GROUP_DEVICE_CREATE_INFO_KHR-1000070001]
+	_ = x[STRUCTURE_TYPE_DEVICE_GROUP_RENDER_PASS_BEGIN_INFO_KHR-1000060003]
+	_ = x[STRUCTURE_TYPE_DEVICE_GROUP_SUBMIT_INFO_KHR-1000060005]
+	_ = x[STRUCTURE_TYPE_DEVICE_MEMORY_OPAQUE_CAPTURE_ADDRESS_INFO_KHR-1000257004]
+	_ = x[STRUCTURE_TYPE_EXPORT_FENCE_CREATE_INFO_KHR-1000113000]
+	_ = x[STRUCTURE_TYPE_EXPORT_MEMORY_ALLOCATE_INFO_KHR-1000072002]
+	_ = x[STRUCTURE_TYPE_EXPORT_SEMAPHORE_CREATE_INFO_KHR-1000077000]
+	_ = x[STRUCTURE_TYPE_EXTERNAL_BUFFER_PROPERTIES_KHR-1000071003]
+	_ = x[STRUCTURE_TYPE_EXTERNAL_FENCE_PROPERTIES_KHR-1000112001]
+	_ = x[STRUCTURE_TYPE_EXTERNAL_IMAGE_FORMAT_PROPERTIES_KHR-1000071001]
+	_ = x[STRUCTURE_TYPE_EXTERNAL_MEMORY_BUFFER_CREATE_INFO_KHR-1000072000]
+	_ = x[STRUCTURE_TYPE_EXTERNAL_MEMORY_IMAGE_CREATE_INFO_KHR-1000072001]
+	_ = x[STRUCTURE_TYPE_EXTERNAL_SEMAPHORE_PROPERTIES_KHR-1000076001]
+	_ = x[STRUCTURE_TYPE_FORMAT_PROPERTIES_2_KHR-1000059002]
+	_ = x[STRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENTS_CREATE_INFO_KHR-1000108001]
+	_ = x[STRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENT_IMAGE_INFO_KHR-1000108002]
+	_ = x[STRUCTURE_TYPE_IMAGE_FORMAT_LIST_CREATE_INFO_KHR-1000147000]
+	_ = x[STRUCTURE_TYPE_IMAGE_FORMAT_PROPERTIES_2_KHR-1000059003]
+	_ = x[STRUCTURE_TYPE_IMAGE_MEMORY_REQUIREMENTS_INFO_2_KHR-1000146001]
+	_ = x[STRUCTURE_TYPE_IMAGE_PLANE_MEMORY_REQUIREMENTS_INFO_KHR-1000156003]
+	_ = x[STRUCTURE_TYPE_IMAGE_SPARSE_MEMORY_REQUIREMENTS_INFO_2_KHR-1000146002]
+	_ = x[STRUCTURE_TYPE_IMAGE_STENCIL_USAGE_CREATE_INFO_EXT-1000246000]
+	_ = x[STRUCTURE_TYPE_IMAGE_VIEW_USAGE_CREATE_INFO_KHR-1000117002]
+	_ = x[STRUCTURE_TYPE_MEMORY_ALLOCATE_FLAGS_INFO_KHR-1000060000]
+	_ = x[STRUCTURE_TYPE_MEMORY_DEDICATED_ALLOCATE_INFO_KHR-1000127001]
+	_ = x[STRUCTURE_TYPE_MEMORY_DEDICATED_REQUIREMENTS_KHR-1000127000]
+	_ = x[STRUCTURE_TYPE_MEMORY_OPAQUE_CAPTURE_ADDRESS_ALLOCATE_INFO_KHR-1000257003]
+	_ = x[STRUCTURE_TYPE_MEMORY_REQUIREMENTS_2_KHR-1000146003]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_16BIT_STORAGE_FEATURES_KHR-1000083000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_8BIT_STORAGE_FEATURES_KHR-1000177000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES_KHR-1000257000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_BUFFER_ADDRESS_FEATURES_EXT-1000244000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_STENCIL_RESOLVE_PROPERTIES_KHR-1000199000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_FEATURES_EXT-1000161001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_PROPERTIES_EXT-1000161002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_DRIVER_PROPERTIES_KHR-1000196000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_BUFFER_INFO_KHR-1000071002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_FENCE_INFO_KHR-1000112000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_IMAGE_FORMAT_INFO_KHR-1000071000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_SEMAPHORE_INFO_KHR-1000076000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_FEATURES_2_KHR-1000059000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_FLOAT_CONTROLS_PROPERTIES_KHR-1000197000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_GROUP_PROPERTIES_KHR-1000070000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_HOST_QUERY_RESET_FEATURES_EXT-1000261000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_ID_PROPERTIES_KHR-1000071004]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGELESS_FRAMEBUFFER_FEATURES_KHR-1000108000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_FORMAT_INFO_2_KHR-1000059004]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_3_PROPERTIES_KHR-1000168000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_PROPERTIES_2_KHR-1000059006]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_FEATURES_KHR-1000053001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_PROPERTIES_KHR-1000053002]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_POINT_CLIPPING_PROPERTIES_KHR-1000117000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_PROPERTIES_2_KHR-1000059001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLER_FILTER_MINMAX_PROPERTIES_EXT-1000130000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLER_YCBCR_CONVERSION_FEATURES_KHR-1000156004]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SCALAR_BLOCK_LAYOUT_FEATURES_EXT-1000221000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SEPARATE_DEPTH_STENCIL_LAYOUTS_FEATURES_KHR-1000241000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_INT64_FEATURES_KHR-1000180000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_DRAW_PARAMETER_FEATURES-1000063000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_FLOAT16_INT8_FEATURES_KHR-1000082000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_FLOAT16_INT8_FEATURES_KHR-1000082000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SUBGROUP_EXTENDED_TYPES_FEATURES_KHR-1000175000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_SPARSE_IMAGE_FORMAT_INFO_2_KHR-1000059008]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_FEATURES_KHR-1000207000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_PROPERTIES_KHR-1000207001]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_UNIFORM_BUFFER_STANDARD_LAYOUT_FEATURES_KHR-1000253000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_VARIABLE_POINTERS_FEATURES_KHR-1000120000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_VARIABLE_POINTER_FEATURES-1000120000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_VARIABLE_POINTER_FEATURES_KHR-1000120000]
+	_ = x[STRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_MEMORY_MODEL_FEATURES_KHR-1000211000]
+	_ = x[STRUCTURE_TYPE_PIPELINE_TESSELLATION_DOMAIN_ORIGIN_STATE_CREATE_INFO_KHR-1000117003]
+	_ = x[STRUCTURE_TYPE_QUERY_POOL_CREATE_INFO_INTEL-1000210000]
+	_ = x[STRUCTURE_TYPE_QUEUE_FAMILY_PROPERTIES_2_KHR-1000059005]
+	_ = x[STRUCTURE_TYPE_RENDER_PASS_ATTACHMENT_BEGIN_INFO_KHR-1000108003]
+	_ = x[STRUCTURE_TYPE_RENDER_PASS_CREATE_INFO_2_KHR-1000109004]
+	_ = x[STRUCTURE_TYPE_RENDER_PASS_INPUT_ATTACHMENT_ASPECT_CREATE_INFO_KHR-1000117001]
+	_ = x[STRUCTURE_TYPE_RENDER_PASS_MULTIVIEW_CREATE_INFO_KHR-1000053000]
+	_ = x[STRUCTURE_TYPE_SAMPLER_REDUCTION_MODE_CREATE_INFO_EXT-1000130001]
+	_ = x[STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_CREATE_INFO_KHR-1000156000]
+	_ = x[STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_IMAGE_FORMAT_PROPERTIES_KHR-1000156005]
+	_ = x[STRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_INFO_KHR-1000156001]
+	_ = x[STRUCTURE_TYPE_SEMAPHORE_SIGNAL_INFO_KHR-1000207005]
+	_ = x[STRUCTURE_TYPE_SEMAPHORE_TYPE_CREATE_INFO_KHR-1000207002]
+	_ = x[STRUCTURE_TYPE_SEMAPHORE_WAIT_INFO_KHR-1000207004]
+	_ = x[STRUCTURE_TYPE_SPARSE_IMAGE_FORMAT_PROPERTIES_2_KHR-1000059007]
+	_ = x[STRUCTURE_TYPE_SPARSE_IMAGE_MEMORY_REQUIREMENTS_2_KHR-1000146004]
+	_ = x[STRUCTURE_TYPE_SUBPASS_BEGIN_INFO_KHR-1000109005]
+	_ = x[STRUCTURE_TYPE_SUBPASS_DEPENDENCY_2_KHR-1000109003]
+	_ = x[STRUCTURE_TYPE_SUBPASS_DESCRIPTION_2_KHR-1000109002]
+	_ = x[STRUCTURE_TYPE_SUBPASS_DESCRIPTION_DEPTH_STENCIL_RESOLVE_KHR-1000199001]
+	_ = x[STRUCTURE_TYPE_SUBPASS_END_INFO_KHR-1000109006]
+	_ = x[STRUCTURE_TYPE_SURFACE_CAPABILITIES2_EXT-1000090000]
+	_ = x[STRUCTURE_TYPE_TIMELINE_SEMAPHORE_SUBMIT_INFO_KHR-1000207003]
+	_ = x[STRUCTURE_TYPE_EXPORT_MEMORY_WIN32_HANDLE_INFO_NV-1000057001]
+	_ = x[STRUCTURE_TYPE_SURFACE_FULL_SCREEN_EXCLUSIVE_WIN32_INFO_EXT-1000255001]
+	_ = x[STRUCTURE_TYPE_D3D12_FENCE_SUBMIT_INFO_KHR-1000078002]
+	_ = x[STRUCTURE_TYPE_MEMORY_GET_WIN32_HANDLE_INFO_KHR-1000073003]
+	_ = x[STRUCTURE_TYPE_SURFACE_CAPABILITIES_FULL_SCREEN_EXCLUSIVE_EXT-1000255002]
+	_ = x[STRUCTURE_TYPE_EXPORT_FENCE_WIN32_HANDLE_INFO_KHR-1000114001]
+	_ = x[STRUCTURE_TYPE_EXPORT_SEMAPHORE_WIN32_HANDLE_INFO_KHR-1000078001]
+	_ = x[STRUCTURE_TYPE_IMPORT_SEMAPHORE_WIN32_HANDLE_INFO_KHR-1000078000]
+	_ = x[STRUCTURE_TYPE_IMPORT_FENCE_WIN32_HANDLE_INFO_KHR-1000114000]
+	_ = x[STRUCTURE_TYPE_SURFACE_FULL_SCREEN_EXCLUSIVE_INFO_EXT-1000255000]
+	_ = x[STRUCTURE_TYPE_EXPORT_MEMORY_WIN32_HANDLE_INFO_KHR-1000073001]
+	_ = x[STRUCTURE_TYPE_WIN32_KEYED_MUTEX_ACQUIRE_RELEASE_INFO_NV-1000058000]
+	_ = x[STRUCTURE_TYPE_MEMORY_WIN32_HANDLE_PROPERTIES_KHR-1000073002]
+	_ = x[STRUCTURE_TYPE_SEMAPHORE_GET_WIN32_HANDLE_INFO_KHR-1000078003]
+	_ = x[STRUCTURE_TYPE_WIN32_SURFACE_CREATE_INFO_KHR-1000009000]
+	_ = x[STRUCTURE_TYPE_WIN32_KEYED_MUTEX_ACQUIRE_RELEASE_INFO_KHR-1000075000]
+	_ = x[STRUCTURE_TYPE_IMPORT_MEMORY_WIN32_HANDLE_INFO_KHR-1000073000]
+	_ = x[STRUCTURE_TYPE_FENCE_GET_WIN32_HANDLE_INFO_KHR-1000114002]
+	_ = x[STRUCTURE_TYPE_IMPORT_MEMORY_WIN32_HANDLE_INFO_NV-1000057000]
+}
+
+const _StructureType_name = "STRUCTURE_TYPE_APPLICATION_INFOSTRUCTURE_TYPE_INSTANCE_CREATE_INFOSTRUCTURE_TYPE_DEVICE_QUEUE_CREATE_INFOSTRUCTURE_TYPE_DEVICE_CREATE_INFOSTRUCTURE_TYPE_SUBMIT_INFOSTRUCTURE_TYPE_MEMORY_ALLOCATE_INFOSTRUCTURE_TYPE_MAPPED_MEMORY_RANGESTRUCTURE_TYPE_BIND_SPARSE_INFOSTRUCTURE_TYPE_FENCE_CREATE_INFOSTRUCTURE_TYPE_SEMAPHORE_CREATE_INFOSTRUCTURE_TYPE_EVENT_CREATE_INFOSTRUCTURE_TYPE_QUERY_POOL_CREATE_INFOSTRUCTURE_TYPE_BUFFER_CREATE_INFOSTRUCTURE_TYPE_BUFFER_VIEW_CREATE_INFOSTRUCTURE_TYPE_IMAGE_CREATE_INFOSTRUCTURE_TYPE_IMAGE_VIEW_CREATE_INFOSTRUCTURE_TYPE_SHADER_MODULE_CREATE_INFOSTRUCTURE_TYPE_PIPELINE_CACHE_CREATE_INFOSTRUCTURE_TYPE_PIPELINE_SHADER_STAGE_CREATE_INFOSTRUCTURE_TYPE_PIPELINE_VERTEX_INPUT_STATE_CREATE_INFOSTRUCTURE_TYPE_PIPELINE_INPUT_ASSEMBLY_STATE_CREATE_INFOSTRUCTURE_TYPE_PIPELINE_TESSELLATION_STATE_CREATE_INFOSTRUCTURE_TYPE_PIPELINE_VIEWPORT_STATE_CREATE_INFOSTRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_CREATE_INFOSTRUCTURE_TYPE_PIPELINE_MULTISAMPLE_STATE_CREATE_INFOSTRUCTURE_TYPE_PIPELINE_DEPTH_STENCIL_STATE_CREATE_INFOSTRUCTURE_TYPE_PIPELINE_COLOR_BLEND_STATE_CREATE_INFOSTRUCTURE_TYPE_PIPELINE_DYNAMIC_STATE_CREATE_INFOSTRUCTURE_TYPE_GRAPHICS_PIPELINE_CREATE_INFOSTRUCTURE_TYPE_COMPUTE_PIPELINE_CREATE_INFOSTRUCTURE_TYPE_PIPELINE_LAYOUT_CREATE_INFOSTRUCTURE_TYPE_SAMPLER_CREATE_INFOSTRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_CREATE_INFOSTRUCTURE_TYPE_DESCRIPTOR_POOL_CREATE_INFOSTRUCTURE_TYPE_DESCRIPTOR_SET_ALLOCATE_INFOSTRUCTURE_TYPE_WRITE_DESCRIPTOR_SETSTRUCTURE_TYPE_COPY_DESCRIPTOR_SETSTRUCTURE_TYPE_FRAMEBUFFER_CREATE_INFOSTRUCTURE_TYPE_RENDER_PASS_CREATE_INFOSTRUCTURE_TYPE_COMMAND_POOL_CREATE_INFOSTRUCTURE_TYPE_COMMAND_BUFFER_ALLOCATE_INFOSTRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_INFOSTRUCTURE_TYPE_COMMAND_BUFFER_BEGIN_INFOSTRUCTURE_TYPE_RENDER_PASS_BEGIN_INFOSTRUCTURE_TYPE_BUFFER_MEMORY_BARRIERSTRUCTURE_TYPE_IMAGE_MEMORY_BARRIERSTRUCTURE_TYPE_MEMORY_BARRIERSTRUCTURE_TYPE_LOADER_INSTANCE_CREATE_INFOSTRUCTURE_TYPE_LOADER_DEVICE_CREATE_INFOSTRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_1_FEATURESSTRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_1_PROPERTIESSTRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_2_FEATURESSTRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_1_2_PROPERTIESSTRUCTURE_TYPE_SWAPCHAIN_CREATE_INFO_KHRSTRUCTURE_TYPE_PRESENT_INFO_KHRSTRUCTURE_TYPE_DISPLAY_MODE_CREATE_INFO_KHRSTRUCTURE_TYPE_DISPLAY_SURFACE_CREATE_INFO_KHRSTRUCTURE_TYPE_DISPLAY_PRESENT_INFO_KHRSTRUCTURE_TYPE_WIN32_SURFACE_CREATE_INFO_KHRSTRUCTURE_TYPE_DEBUG_REPORT_CALLBACK_CREATE_INFO_EXTSTRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_RASTERIZATION_ORDER_AMDSTRUCTURE_TYPE_DEBUG_MARKER_OBJECT_NAME_INFO_EXTSTRUCTURE_TYPE_DEBUG_MARKER_OBJECT_TAG_INFO_EXTSTRUCTURE_TYPE_DEBUG_MARKER_MARKER_INFO_EXTSTRUCTURE_TYPE_DEDICATED_ALLOCATION_IMAGE_CREATE_INFO_NVSTRUCTURE_TYPE_DEDICATED_ALLOCATION_BUFFER_CREATE_INFO_NVSTRUCTURE_TYPE_DEDICATED_ALLOCATION_MEMORY_ALLOCATE_INFO_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_TRANSFORM_FEEDBACK_PROPERTIES_EXTSTRUCTURE_TYPE_PIPELINE_RASTERIZATION_STATE_STREAM_CREATE_INFO_EXTSTRUCTURE_TYPE_CU_MODULE_CREATE_INFO_NVXSTRUCTURE_TYPE_CU_FUNCTION_CREATE_INFO_NVXSTRUCTURE_TYPE_CU_LAUNCH_INFO_NVXSTRUCTURE_TYPE_IMAGE_VIEW_HANDLE_INFO_NVXSTRUCTURE_TYPE_IMAGE_VIEW_ADDRESS_PROPERTIES_NVXSTRUCTURE_TYPE_TEXTURE_LOD_GATHER_FORMAT_PROPERTIES_AMDSTRUCTURE_TYPE_PHYSICAL_DEVICE_CORNER_SAMPLED_IMAGE_FEATURES_NVSTRUCTURE_TYPE_RENDER_PASS_MULTIVIEW_CREATE_INFOSTRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_FEATURESSTRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_PROPERTIESSTRUCTURE_TYPE_EXTERNAL_MEMORY_IMAGE_CREATE_INFO_NVSTRUCTURE_TYPE_EXPORT_MEMORY_ALLOCATE_INFO_NVSTRUCTURE_TYPE_IMPORT_MEMORY_WIN32_HANDLE_INFO_NVSTRUCTURE_TYPE_EXPORT_MEMORY_WIN32_HANDLE_INFO_NVSTRUCTURE_TYPE_WIN32_KEYED_MUTEX_ACQUIRE_RELEASE_INFO_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_FEATURES_2STRUCTURE_TYPE_PHYSICAL_DEVICE_PROPERTIES_2STRUCTURE_TYPE_FORMAT_PROPERTIES_2STRUCTURE_TYPE_IMAGE_FORMAT_PROPERTIES_2STRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_FORMAT_INFO_2STRUCTURE_TYPE_QUEUE_FAMILY_PROPERTIES_2STRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_PROPERTIES_2STRUCTURE_TYPE_SPARSE_IMAGE_FORMAT_PROPERTIES_2STRUCTURE_TYPE_PHYSICAL_DEVICE_SPARSE_IMAGE_FORMAT_INFO_2STRUCTURE_TYPE_MEMORY_ALLOCATE_FLAGS_INFOSTRUCTURE_TYPE_DEVICE_GROUP_RENDER_PASS_BEGIN_INFOSTRUCTURE_TYPE_DEVICE_GROUP_COMMAND_BUFFER_BEGIN_INFOSTRUCTURE_TYPE_DEVICE_GROUP_SUBMIT_INFOSTRUCTURE_TYPE_DEVICE_GROUP_BIND_SPARSE_INFOSTRUCTURE_TYPE_DEVICE_GROUP_PRESENT_CAPABILITIES_KHRSTRUCTURE_TYPE_IMAGE_SWAPCHAIN_CREATE_INFO_KHRSTRUCTURE_TYPE_BIND_IMAGE_MEMORY_SWAPCHAIN_INFO_KHRSTRUCTURE_TYPE_ACQUIRE_NEXT_IMAGE_INFO_KHRSTRUCTURE_TYPE_DEVICE_GROUP_PRESENT_INFO_KHRSTRUCTURE_TYPE_DEVICE_GROUP_SWAPCHAIN_CREATE_INFO_KHRSTRUCTURE_TYPE_BIND_BUFFER_MEMORY_DEVICE_GROUP_INFOSTRUCTURE_TYPE_BIND_IMAGE_MEMORY_DEVICE_GROUP_INFOSTRUCTURE_TYPE_VALIDATION_FLAGS_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_DRAW_PARAMETERS_FEATURESSTRUCTURE_TYPE_PHYSICAL_DEVICE_TEXTURE_COMPRESSION_ASTC_HDR_FEATURES_EXTSTRUCTURE_TYPE_IMAGE_VIEW_ASTC_DECODE_MODE_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_ASTC_DECODE_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_GROUP_PROPERTIESSTRUCTURE_TYPE_DEVICE_GROUP_DEVICE_CREATE_INFOSTRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_IMAGE_FORMAT_INFOSTRUCTURE_TYPE_EXTERNAL_IMAGE_FORMAT_PROPERTIESSTRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_BUFFER_INFOSTRUCTURE_TYPE_EXTERNAL_BUFFER_PROPERTIESSTRUCTURE_TYPE_PHYSICAL_DEVICE_ID_PROPERTIESSTRUCTURE_TYPE_EXTERNAL_MEMORY_BUFFER_CREATE_INFOSTRUCTURE_TYPE_EXTERNAL_MEMORY_IMAGE_CREATE_INFOSTRUCTURE_TYPE_EXPORT_MEMORY_ALLOCATE_INFOSTRUCTURE_TYPE_IMPORT_MEMORY_WIN32_HANDLE_INFO_KHRSTRUCTURE_TYPE_EXPORT_MEMORY_WIN32_HANDLE_INFO_KHRSTRUCTURE_TYPE_MEMORY_WIN32_HANDLE_PROPERTIES_KHRSTRUCTURE_TYPE_MEMORY_GET_WIN32_HANDLE_INFO_KHRSTRUCTURE_TYPE_IMPORT_MEMORY_FD_INFO_KHRSTRUCTURE_TYPE_MEMORY_FD_PROPERTIES_KHRSTRUCTURE_TYPE_MEMORY_GET_FD_INFO_KHRSTRUCTURE_TYPE_WIN32_KEYED_MUTEX_ACQUIRE_RELEASE_INFO_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_SEMAPHORE_INFOSTRUCTURE_TYPE_EXTERNAL_SEMAPHORE_PROPERTIESSTRUCTURE_TYPE_EXPORT_SEMAPHORE_CREATE_INFOSTRUCTURE_TYPE_IMPORT_SEMAPHORE_WIN32_HANDLE_INFO_KHRSTRUCTURE_TYPE_EXPORT_SEMAPHORE_WIN32_HANDLE_INFO_KHRSTRUCTURE_TYPE_D3D12_FENCE_SUBMIT_INFO_KHRSTRUCTURE_TYPE_SEMAPHORE_GET_WIN32_HANDLE_INFO_KHRSTRUCTURE_TYPE_IMPORT_SEMAPHORE_FD_INFO_KHRSTRUCTURE_TYPE_SEMAPHORE_GET_FD_INFO_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_PUSH_DESCRIPTOR_PROPERTIES_KHRSTRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_CONDITIONAL_RENDERING_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_CONDITIONAL_RENDERING_FEATURES_EXTSTRUCTURE_TYPE_CONDITIONAL_RENDERING_BEGIN_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_FLOAT16_INT8_FEATURESSTRUCTURE_TYPE_PHYSICAL_DEVICE_16BIT_STORAGE_FEATURESSTRUCTURE_TYPE_PRESENT_REGIONS_KHRSTRUCTURE_TYPE_DESCRIPTOR_UPDATE_TEMPLATE_CREATE_INFOSTRUCTURE_TYPE_PIPELINE_VIEWPORT_W_SCALING_STATE_CREATE_INFO_NVSTRUCTURE_TYPE_SURFACE_CAPABILITIES_2_EXTSTRUCTURE_TYPE_DISPLAY_POWER_INFO_EXTSTRUCTURE_TYPE_DEVICE_EVENT_INFO_EXTSTRUCTURE_TYPE_DISPLAY_EVENT_INFO_EXTSTRUCTURE_TYPE_SWAPCHAIN_COUNTER_CREATE_INFO_EXTSTRUCTURE_TYPE_PRESENT_TIMES_INFO_GOOGLESTRUCTURE_TYPE_PHYSICAL_DEVICE_SUBGROUP_PROPERTIESSTRUCTURE_TYPE_PHYSICAL_DEVICE_MULTIVIEW_PER_VIEW_ATTRIBUTES_PROPERTIES_NVXSTRUCTURE_TYPE_PIPELINE_VIEWPORT_SWIZZLE_STATE_CREATE_INFO_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_DISCARD_RECTANGLE_PROPERTIES_EXTSTRUCTURE_TYPE_PIPELINE_DISCARD_RECTANGLE_STATE_CREATE_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_CONSERVATIVE_RASTERIZATION_PROPERTIES_EXTSTRUCTURE_TYPE_PIPELINE_RASTERIZATION_CONSERVATIVE_STATE_CREATE_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_CLIP_ENABLE_FEATURES_EXTSTRUCTURE_TYPE_PIPELINE_RASTERIZATION_DEPTH_CLIP_STATE_CREATE_INFO_EXTSTRUCTURE_TYPE_HDR_METADATA_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGELESS_FRAMEBUFFER_FEATURESSTRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENTS_CREATE_INFOSTRUCTURE_TYPE_FRAMEBUFFER_ATTACHMENT_IMAGE_INFOSTRUCTURE_TYPE_RENDER_PASS_ATTACHMENT_BEGIN_INFOSTRUCTURE_TYPE_ATTACHMENT_DESCRIPTION_2STRUCTURE_TYPE_ATTACHMENT_REFERENCE_2STRUCTURE_TYPE_SUBPASS_DESCRIPTION_2STRUCTURE_TYPE_SUBPASS_DEPENDENCY_2STRUCTURE_TYPE_RENDER_PASS_CREATE_INFO_2STRUCTURE_TYPE_SUBPASS_BEGIN_INFOSTRUCTURE_TYPE_SUBPASS_END_INFOSTRUCTURE_TYPE_SHARED_PRESENT_SURFACE_CAPABILITIES_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_FENCE_INFOSTRUCTURE_TYPE_EXTERNAL_FENCE_PROPERTIESSTRUCTURE_TYPE_EXPORT_FENCE_CREATE_INFOSTRUCTURE_TYPE_IMPORT_FENCE_WIN32_HANDLE_INFO_KHRSTRUCTURE_TYPE_EXPORT_FENCE_WIN32_HANDLE_INFO_KHRSTRUCTURE_TYPE_FENCE_GET_WIN32_HANDLE_INFO_KHRSTRUCTURE_TYPE_IMPORT_FENCE_FD_INFO_KHRSTRUCTURE_TYPE_FENCE_GET_FD_INFO_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_PERFORMANCE_QUERY_FEATURES_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_PERFORMANCE_QUERY_PROPERTIES_KHRSTRUCTURE_TYPE_QUERY_POOL_PERFORMANCE_CREATE_INFO_KHRSTRUCTURE_TYPE_PERFORMANCE_QUERY_SUBMIT_INFO_KHRSTRUCTURE_TYPE_ACQUIRE_PROFILING_LOCK_INFO_KHRSTRUCTURE_TYPE_PERFORMANCE_COUNTER_KHRSTRUCTURE_TYPE_PERFORMANCE_COUNTER_DESCRIPTION_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_POINT_CLIPPING_PROPERTIESSTRUCTURE_TYPE_RENDER_PASS_INPUT_ATTACHMENT_ASPECT_CREATE_INFOSTRUCTURE_TYPE_IMAGE_VIEW_USAGE_CREATE_INFOSTRUCTURE_TYPE_PIPELINE_TESSELLATION_DOMAIN_ORIGIN_STATE_CREATE_INFOSTRUCTURE_TYPE_PHYSICAL_DEVICE_SURFACE_INFO_2_KHRSTRUCTURE_TYPE_SURFACE_CAPABILITIES_2_KHRSTRUCTURE_TYPE_SURFACE_FORMAT_2_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_VARIABLE_POINTERS_FEATURESSTRUCTURE_TYPE_DISPLAY_PROPERTIES_2_KHRSTRUCTURE_TYPE_DISPLAY_PLANE_PROPERTIES_2_KHRSTRUCTURE_TYPE_DISPLAY_MODE_PROPERTIES_2_KHRSTRUCTURE_TYPE_DISPLAY_PLANE_INFO_2_KHRSTRUCTURE_TYPE_DISPLAY_PLANE_CAPABILITIES_2_KHRSTRUCTURE_TYPE_MEMORY_DEDICATED_REQUIREMENTSSTRUCTURE_TYPE_MEMORY_DEDICATED_ALLOCATE_INFOSTRUCTURE_TYPE_DEBUG_UTILS_OBJECT_NAME_INFO_EXTSTRUCTURE_TYPE_DEBUG_UTILS_OBJECT_TAG_INFO_EXTSTRUCTURE_TYPE_DEBUG_UTILS_LABEL_EXTSTRUCTURE_TYPE_DEBUG_UTILS_MESSENGER_CALLBACK_DATA_EXTSTRUCTURE_TYPE_DEBUG_UTILS_MESSENGER_CREATE_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLER_FILTER_MINMAX_PROPERTIESSTRUCTURE_TYPE_SAMPLER_REDUCTION_MODE_CREATE_INFOSTRUCTURE_TYPE_PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_INLINE_UNIFORM_BLOCK_PROPERTIES_EXTSTRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_INLINE_UNIFORM_BLOCK_EXTSTRUCTURE_TYPE_DESCRIPTOR_POOL_INLINE_UNIFORM_BLOCK_CREATE_INFO_EXTSTRUCTURE_TYPE_SAMPLE_LOCATIONS_INFO_EXTSTRUCTURE_TYPE_RENDER_PASS_SAMPLE_LOCATIONS_BEGIN_INFO_EXTSTRUCTURE_TYPE_PIPELINE_SAMPLE_LOCATIONS_STATE_CREATE_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLE_LOCATIONS_PROPERTIES_EXTSTRUCTURE_TYPE_MULTISAMPLE_PROPERTIES_EXTSTRUCTURE_TYPE_PROTECTED_SUBMIT_INFOSTRUCTURE_TYPE_PHYSICAL_DEVICE_PROTECTED_MEMORY_FEATURESSTRUCTURE_TYPE_PHYSICAL_DEVICE_PROTECTED_MEMORY_PROPERTIESSTRUCTURE_TYPE_DEVICE_QUEUE_INFO_2STRUCTURE_TYPE_BUFFER_MEMORY_REQUIREMENTS_INFO_2STRUCTURE_TYPE_IMAGE_MEMORY_REQUIREMENTS_INFO_2STRUCTURE_TYPE_IMAGE_SPARSE_MEMORY_REQUIREMENTS_INFO_2STRUCTURE_TYPE_MEMORY_REQUIREMENTS_2STRUCTURE_TYPE_SPARSE_IMAGE_MEMORY_REQUIREMENTS_2STRUCTURE_TYPE_IMAGE_FORMAT_LIST_CREATE_INFOSTRUCTURE_TYPE_PHYSICAL_DEVICE_BLEND_OPERATION_ADVANCED_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_BLEND_OPERATION_ADVANCED_PROPERTIES_EXTSTRUCTURE_TYPE_PIPELINE_COLOR_BLEND_ADVANCED_STATE_CREATE_INFO_EXTSTRUCTURE_TYPE_PIPELINE_COVERAGE_TO_COLOR_STATE_CREATE_INFO_NVSTRUCTURE_TYPE_ACCELERATION_STRUCTURE_BUILD_GEOMETRY_INFO_KHRSTRUCTURE_TYPE_ACCELERATION_STRUCTURE_DEVICE_ADDRESS_INFO_KHRSTRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_AABBS_DATA_KHRSTRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_INSTANCES_DATA_KHRSTRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_TRIANGLES_DATA_KHRSTRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_KHRSTRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_ACCELERATION_STRUCTURE_KHRSTRUCTURE_TYPE_ACCELERATION_STRUCTURE_VERSION_INFO_KHRSTRUCTURE_TYPE_COPY_ACCELERATION_STRUCTURE_INFO_KHRSTRUCTURE_TYPE_COPY_ACCELERATION_STRUCTURE_TO_MEMORY_INFO_KHRSTRUCTURE_TYPE_COPY_MEMORY_TO_ACCELERATION_STRUCTURE_INFO_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_ACCELERATION_STRUCTURE_FEATURES_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_ACCELERATION_STRUCTURE_PROPERTIES_KHRSTRUCTURE_TYPE_ACCELERATION_STRUCTURE_CREATE_INFO_KHRSTRUCTURE_TYPE_ACCELERATION_STRUCTURE_BUILD_SIZES_INFO_KHRSTRUCTURE_TYPE_PIPELINE_COVERAGE_MODULATION_STATE_CREATE_INFO_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SM_BUILTINS_FEATURES_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SM_BUILTINS_PROPERTIES_NVSTRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_CREATE_INFOSTRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_INFOSTRUCTURE_TYPE_BIND_IMAGE_PLANE_MEMORY_INFOSTRUCTURE_TYPE_IMAGE_PLANE_MEMORY_REQUIREMENTS_INFOSTRUCTURE_TYPE_PHYSICAL_DEVICE_SAMPLER_YCBCR_CONVERSION_FEATURESSTRUCTURE_TYPE_SAMPLER_YCBCR_CONVERSION_IMAGE_FORMAT_PROPERTIESSTRUCTURE_TYPE_BIND_BUFFER_MEMORY_INFOSTRUCTURE_TYPE_BIND_IMAGE_MEMORY_INFOSTRUCTURE_TYPE_DRM_FORMAT_MODIFIER_PROPERTIES_LIST_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_DRM_FORMAT_MODIFIER_INFO_EXTSTRUCTURE_TYPE_IMAGE_DRM_FORMAT_MODIFIER_LIST_CREATE_INFO_EXTSTRUCTURE_TYPE_IMAGE_DRM_FORMAT_MODIFIER_EXPLICIT_CREATE_INFO_EXTSTRUCTURE_TYPE_IMAGE_DRM_FORMAT_MODIFIER_PROPERTIES_EXTSTRUCTURE_TYPE_VALIDATION_CACHE_CREATE_INFO_EXTSTRUCTURE_TYPE_SHADER_MODULE_VALIDATION_CACHE_CREATE_INFO_EXTSTRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_BINDING_FLAGS_CREATE_INFOSTRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_FEATURESSTRUCTURE_TYPE_PHYSICAL_DEVICE_DESCRIPTOR_INDEXING_PROPERTIESSTRUCTURE_TYPE_DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_ALLOCATE_INFOSTRUCTURE_TYPE_DESCRIPTOR_SET_VARIABLE_DESCRIPTOR_COUNT_LAYOUT_SUPPORTSTRUCTURE_TYPE_PIPELINE_VIEWPORT_SHADING_RATE_IMAGE_STATE_CREATE_INFO_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADING_RATE_IMAGE_FEATURES_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADING_RATE_IMAGE_PROPERTIES_NVSTRUCTURE_TYPE_PIPELINE_VIEWPORT_COARSE_SAMPLE_ORDER_STATE_CREATE_INFO_NVSTRUCTURE_TYPE_RAY_TRACING_PIPELINE_CREATE_INFO_NVSTRUCTURE_TYPE_ACCELERATION_STRUCTURE_CREATE_INFO_NVSTRUCTURE_TYPE_GEOMETRY_NVSTRUCTURE_TYPE_GEOMETRY_TRIANGLES_NVSTRUCTURE_TYPE_GEOMETRY_AABB_NVSTRUCTURE_TYPE_BIND_ACCELERATION_STRUCTURE_MEMORY_INFO_NVSTRUCTURE_TYPE_WRITE_DESCRIPTOR_SET_ACCELERATION_STRUCTURE_NVSTRUCTURE_TYPE_ACCELERATION_STRUCTURE_MEMORY_REQUIREMENTS_INFO_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_PROPERTIES_NVSTRUCTURE_TYPE_RAY_TRACING_SHADER_GROUP_CREATE_INFO_NVSTRUCTURE_TYPE_ACCELERATION_STRUCTURE_INFO_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_REPRESENTATIVE_FRAGMENT_TEST_FEATURES_NVSTRUCTURE_TYPE_PIPELINE_REPRESENTATIVE_FRAGMENT_TEST_STATE_CREATE_INFO_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_MAINTENANCE_3_PROPERTIESSTRUCTURE_TYPE_DESCRIPTOR_SET_LAYOUT_SUPPORTSTRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_VIEW_IMAGE_FORMAT_INFO_EXTSTRUCTURE_TYPE_FILTER_CUBIC_IMAGE_VIEW_IMAGE_FORMAT_PROPERTIES_EXTSTRUCTURE_TYPE_DEVICE_QUEUE_GLOBAL_PRIORITY_CREATE_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SUBGROUP_EXTENDED_TYPES_FEATURESSTRUCTURE_TYPE_PHYSICAL_DEVICE_8BIT_STORAGE_FEATURESSTRUCTURE_TYPE_IMPORT_MEMORY_HOST_POINTER_INFO_EXTSTRUCTURE_TYPE_MEMORY_HOST_POINTER_PROPERTIES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_MEMORY_HOST_PROPERTIES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_INT64_FEATURESSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CLOCK_FEATURES_KHRSTRUCTURE_TYPE_PIPELINE_COMPILER_CONTROL_CREATE_INFO_AMDSTRUCTURE_TYPE_CALIBRATED_TIMESTAMP_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_AMDSTRUCTURE_TYPE_DEVICE_MEMORY_OVERALLOCATION_CREATE_INFO_AMDSTRUCTURE_TYPE_PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_PROPERTIES_EXTSTRUCTURE_TYPE_PIPELINE_VERTEX_INPUT_DIVISOR_STATE_CREATE_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_VERTEX_ATTRIBUTE_DIVISOR_FEATURES_EXTSTRUCTURE_TYPE_PIPELINE_CREATION_FEEDBACK_CREATE_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_DRIVER_PROPERTIESSTRUCTURE_TYPE_PHYSICAL_DEVICE_FLOAT_CONTROLS_PROPERTIESSTRUCTURE_TYPE_PHYSICAL_DEVICE_DEPTH_STENCIL_RESOLVE_PROPERTIESSTRUCTURE_TYPE_SUBPASS_DESCRIPTION_DEPTH_STENCIL_RESOLVESTRUCTURE_TYPE_PHYSICAL_DEVICE_COMPUTE_SHADER_DERIVATIVES_FEATURES_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_MESH_SHADER_FEATURES_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_MESH_SHADER_PROPERTIES_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADER_BARYCENTRIC_FEATURES_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_IMAGE_FOOTPRINT_FEATURES_NVSTRUCTURE_TYPE_PIPELINE_VIEWPORT_EXCLUSIVE_SCISSOR_STATE_CREATE_INFO_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_EXCLUSIVE_SCISSOR_FEATURES_NVSTRUCTURE_TYPE_CHECKPOINT_DATA_NVSTRUCTURE_TYPE_QUEUE_FAMILY_CHECKPOINT_PROPERTIES_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_FEATURESSTRUCTURE_TYPE_PHYSICAL_DEVICE_TIMELINE_SEMAPHORE_PROPERTIESSTRUCTURE_TYPE_SEMAPHORE_TYPE_CREATE_INFOSTRUCTURE_TYPE_TIMELINE_SEMAPHORE_SUBMIT_INFOSTRUCTURE_TYPE_SEMAPHORE_WAIT_INFOSTRUCTURE_TYPE_SEMAPHORE_SIGNAL_INFOSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_INTEGER_FUNCTIONS_2_FEATURES_INTELSTRUCTURE_TYPE_QUERY_POOL_PERFORMANCE_QUERY_CREATE_INFO_INTELSTRUCTURE_TYPE_INITIALIZE_PERFORMANCE_API_INFO_INTELSTRUCTURE_TYPE_PERFORMANCE_MARKER_INFO_INTELSTRUCTURE_TYPE_PERFORMANCE_STREAM_MARKER_INFO_INTELSTRUCTURE_TYPE_PERFORMANCE_OVERRIDE_INFO_INTELSTRUCTURE_TYPE_PERFORMANCE_CONFIGURATION_ACQUIRE_INFO_INTELSTRUCTURE_TYPE_PHYSICAL_DEVICE_VULKAN_MEMORY_MODEL_FEATURESSTRUCTURE_TYPE_PHYSICAL_DEVICE_PCI_BUS_INFO_PROPERTIES_EXTSTRUCTURE_TYPE_DISPLAY_NATIVE_HDR_SURFACE_CAPABILITIES_AMDSTRUCTURE_TYPE_SWAPCHAIN_DISPLAY_NATIVE_HDR_CREATE_INFO_AMDSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_TERMINATE_INVOCATION_FEATURES_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_PROPERTIES_EXTSTRUCTURE_TYPE_RENDER_PASS_FRAGMENT_DENSITY_MAP_CREATE_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_SCALAR_BLOCK_LAYOUT_FEATURESSTRUCTURE_TYPE_PHYSICAL_DEVICE_SUBGROUP_SIZE_CONTROL_PROPERTIES_EXTSTRUCTURE_TYPE_PIPELINE_SHADER_STAGE_REQUIRED_SUBGROUP_SIZE_CREATE_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_SUBGROUP_SIZE_CONTROL_FEATURES_EXTSTRUCTURE_TYPE_FRAGMENT_SHADING_RATE_ATTACHMENT_INFO_KHRSTRUCTURE_TYPE_PIPELINE_FRAGMENT_SHADING_RATE_STATE_CREATE_INFO_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_PROPERTIES_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_FEATURES_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_CORE_PROPERTIES_2_AMDSTRUCTURE_TYPE_PHYSICAL_DEVICE_COHERENT_MEMORY_FEATURES_AMDSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_IMAGE_ATOMIC_INT64_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_BUDGET_PROPERTIES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_MEMORY_PRIORITY_FEATURES_EXTSTRUCTURE_TYPE_MEMORY_PRIORITY_ALLOCATE_INFO_EXTSTRUCTURE_TYPE_SURFACE_PROTECTED_CAPABILITIES_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_DEDICATED_ALLOCATION_IMAGE_ALIASING_FEATURES_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_SEPARATE_DEPTH_STENCIL_LAYOUTS_FEATURESSTRUCTURE_TYPE_ATTACHMENT_REFERENCE_STENCIL_LAYOUTSTRUCTURE_TYPE_ATTACHMENT_DESCRIPTION_STENCIL_LAYOUTSTRUCTURE_TYPE_PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURES_EXTSTRUCTURE_TYPE_BUFFER_DEVICE_ADDRESS_INFOSTRUCTURE_TYPE_BUFFER_DEVICE_ADDRESS_CREATE_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_TOOL_PROPERTIES_EXTSTRUCTURE_TYPE_IMAGE_STENCIL_USAGE_CREATE_INFOSTRUCTURE_TYPE_VALIDATION_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_PRESENT_WAIT_FEATURES_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_COOPERATIVE_MATRIX_FEATURES_NVSTRUCTURE_TYPE_COOPERATIVE_MATRIX_PROPERTIES_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_COOPERATIVE_MATRIX_PROPERTIES_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_COVERAGE_REDUCTION_MODE_FEATURES_NVSTRUCTURE_TYPE_PIPELINE_COVERAGE_REDUCTION_STATE_CREATE_INFO_NVSTRUCTURE_TYPE_FRAMEBUFFER_MIXED_SAMPLES_COMBINATION_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADER_INTERLOCK_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_YCBCR_IMAGE_ARRAYS_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_UNIFORM_BUFFER_STANDARD_LAYOUT_FEATURESSTRUCTURE_TYPE_PHYSICAL_DEVICE_PROVOKING_VERTEX_FEATURES_EXTSTRUCTURE_TYPE_PIPELINE_RASTERIZATION_PROVOKING_VERTEX_STATE_CREATE_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_PROVOKING_VERTEX_PROPERTIES_EXTSTRUCTURE_TYPE_SURFACE_FULL_SCREEN_EXCLUSIVE_INFO_EXTSTRUCTURE_TYPE_SURFACE_FULL_SCREEN_EXCLUSIVE_WIN32_INFO_EXTSTRUCTURE_TYPE_SURFACE_CAPABILITIES_FULL_SCREEN_EXCLUSIVE_EXTSTRUCTURE_TYPE_HEADLESS_SURFACE_CREATE_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_BUFFER_DEVICE_ADDRESS_FEATURESSTRUCTURE_TYPE_BUFFER_OPAQUE_CAPTURE_ADDRESS_CREATE_INFOSTRUCTURE_TYPE_MEMORY_OPAQUE_CAPTURE_ADDRESS_ALLOCATE_INFOSTRUCTURE_TYPE_DEVICE_MEMORY_OPAQUE_CAPTURE_ADDRESS_INFOSTRUCTURE_TYPE_PHYSICAL_DEVICE_LINE_RASTERIZATION_FEATURES_EXTSTRUCTURE_TYPE_PIPELINE_RASTERIZATION_LINE_STATE_CREATE_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_LINE_RASTERIZATION_PROPERTIES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_HOST_QUERY_RESET_FEATURESSTRUCTURE_TYPE_PHYSICAL_DEVICE_INDEX_TYPE_UINT8_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_EXECUTABLE_PROPERTIES_FEATURES_KHRSTRUCTURE_TYPE_PIPELINE_INFO_KHRSTRUCTURE_TYPE_PIPELINE_EXECUTABLE_PROPERTIES_KHRSTRUCTURE_TYPE_PIPELINE_EXECUTABLE_INFO_KHRSTRUCTURE_TYPE_PIPELINE_EXECUTABLE_STATISTIC_KHRSTRUCTURE_TYPE_PIPELINE_EXECUTABLE_INTERNAL_REPRESENTATION_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_ATOMIC_FLOAT_2_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_DEMOTE_TO_HELPER_INVOCATION_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_PROPERTIES_NVSTRUCTURE_TYPE_GRAPHICS_SHADER_GROUP_CREATE_INFO_NVSTRUCTURE_TYPE_GRAPHICS_PIPELINE_SHADER_GROUPS_CREATE_INFO_NVSTRUCTURE_TYPE_INDIRECT_COMMANDS_LAYOUT_TOKEN_NVSTRUCTURE_TYPE_INDIRECT_COMMANDS_LAYOUT_CREATE_INFO_NVSTRUCTURE_TYPE_GENERATED_COMMANDS_INFO_NVSTRUCTURE_TYPE_GENERATED_COMMANDS_MEMORY_REQUIREMENTS_INFO_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_GENERATED_COMMANDS_FEATURES_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_INHERITED_VIEWPORT_SCISSOR_FEATURES_NVSTRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_VIEWPORT_SCISSOR_INFO_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_INTEGER_DOT_PRODUCT_FEATURES_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_INTEGER_DOT_PRODUCT_PROPERTIES_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_TEXEL_BUFFER_ALIGNMENT_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_TEXEL_BUFFER_ALIGNMENT_PROPERTIES_EXTSTRUCTURE_TYPE_COMMAND_BUFFER_INHERITANCE_RENDER_PASS_TRANSFORM_INFO_QCOMSTRUCTURE_TYPE_RENDER_PASS_TRANSFORM_BEGIN_INFO_QCOMSTRUCTURE_TYPE_PHYSICAL_DEVICE_DEVICE_MEMORY_REPORT_FEATURES_EXTSTRUCTURE_TYPE_DEVICE_DEVICE_MEMORY_REPORT_CREATE_INFO_EXTSTRUCTURE_TYPE_DEVICE_MEMORY_REPORT_CALLBACK_DATA_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_ROBUSTNESS_2_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_ROBUSTNESS_2_PROPERTIES_EXTSTRUCTURE_TYPE_SAMPLER_CUSTOM_BORDER_COLOR_CREATE_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_CUSTOM_BORDER_COLOR_PROPERTIES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_CUSTOM_BORDER_COLOR_FEATURES_EXTSTRUCTURE_TYPE_PIPELINE_LIBRARY_CREATE_INFO_KHRSTRUCTURE_TYPE_PRESENT_ID_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_PRESENT_ID_FEATURES_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_PRIVATE_DATA_FEATURES_EXTSTRUCTURE_TYPE_DEVICE_PRIVATE_DATA_CREATE_INFO_EXTSTRUCTURE_TYPE_PRIVATE_DATA_SLOT_CREATE_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_PIPELINE_CREATION_CACHE_CONTROL_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_DIAGNOSTICS_CONFIG_FEATURES_NVSTRUCTURE_TYPE_DEVICE_DIAGNOSTICS_CONFIG_CREATE_INFO_NVSTRUCTURE_TYPE_MEMORY_BARRIER_2_KHRSTRUCTURE_TYPE_BUFFER_MEMORY_BARRIER_2_KHRSTRUCTURE_TYPE_IMAGE_MEMORY_BARRIER_2_KHRSTRUCTURE_TYPE_DEPENDENCY_INFO_KHRSTRUCTURE_TYPE_SUBMIT_INFO_2_KHRSTRUCTURE_TYPE_SEMAPHORE_SUBMIT_INFO_KHRSTRUCTURE_TYPE_COMMAND_BUFFER_SUBMIT_INFO_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_SYNCHRONIZATION_2_FEATURES_KHRSTRUCTURE_TYPE_QUEUE_FAMILY_CHECKPOINT_PROPERTIES_2_NVSTRUCTURE_TYPE_CHECKPOINT_DATA_2_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_SHADER_SUBGROUP_UNIFORM_CONTROL_FLOW_FEATURES_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_ZERO_INITIALIZE_WORKGROUP_MEMORY_FEATURES_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_ENUMS_PROPERTIES_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_SHADING_RATE_ENUMS_FEATURES_NVSTRUCTURE_TYPE_PIPELINE_FRAGMENT_SHADING_RATE_ENUM_STATE_CREATE_INFO_NVSTRUCTURE_TYPE_ACCELERATION_STRUCTURE_GEOMETRY_MOTION_TRIANGLES_DATA_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_MOTION_BLUR_FEATURES_NVSTRUCTURE_TYPE_ACCELERATION_STRUCTURE_MOTION_INFO_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_YCBCR_2_PLANE_444_FORMATS_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_2_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_FRAGMENT_DENSITY_MAP_2_PROPERTIES_EXTSTRUCTURE_TYPE_COPY_COMMAND_TRANSFORM_INFO_QCOMSTRUCTURE_TYPE_PHYSICAL_DEVICE_IMAGE_ROBUSTNESS_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_WORKGROUP_MEMORY_EXPLICIT_LAYOUT_FEATURES_KHRSTRUCTURE_TYPE_COPY_BUFFER_INFO_2_KHRSTRUCTURE_TYPE_COPY_IMAGE_INFO_2_KHRSTRUCTURE_TYPE_COPY_BUFFER_TO_IMAGE_INFO_2_KHRSTRUCTURE_TYPE_COPY_IMAGE_TO_BUFFER_INFO_2_KHRSTRUCTURE_TYPE_BLIT_IMAGE_INFO_2_KHRSTRUCTURE_TYPE_RESOLVE_IMAGE_INFO_2_KHRSTRUCTURE_TYPE_BUFFER_COPY_2_KHRSTRUCTURE_TYPE_IMAGE_COPY_2_KHRSTRUCTURE_TYPE_IMAGE_BLIT_2_KHRSTRUCTURE_TYPE_BUFFER_IMAGE_COPY_2_KHRSTRUCTURE_TYPE_IMAGE_RESOLVE_2_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_4444_FORMATS_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_PIPELINE_FEATURES_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_TRACING_PIPELINE_PROPERTIES_KHRSTRUCTURE_TYPE_RAY_TRACING_PIPELINE_CREATE_INFO_KHRSTRUCTURE_TYPE_RAY_TRACING_SHADER_GROUP_CREATE_INFO_KHRSTRUCTURE_TYPE_RAY_TRACING_PIPELINE_INTERFACE_CREATE_INFO_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_RAY_QUERY_FEATURES_KHRSTRUCTURE_TYPE_PHYSICAL_DEVICE_MUTABLE_DESCRIPTOR_TYPE_FEATURES_VALVESTRUCTURE_TYPE_MUTABLE_DESCRIPTOR_TYPE_CREATE_INFO_VALVESTRUCTURE_TYPE_PHYSICAL_DEVICE_VERTEX_INPUT_DYNAMIC_STATE_FEATURES_EXTSTRUCTURE_TYPE_VERTEX_INPUT_BINDING_DESCRIPTION_2_EXTSTRUCTURE_TYPE_VERTEX_INPUT_ATTRIBUTE_DESCRIPTION_2_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_DRM_PROPERTIES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_PRIMITIVE_TOPOLOGY_LIST_RESTART_FEATURES_EXTSTRUCTURE_TYPE_SUBPASS_SHADING_PIPELINE_CREATE_INFO_HUAWEISTRUCTURE_TYPE_PHYSICAL_DEVICE_SUBPASS_SHADING_FEATURES_HUAWEISTRUCTURE_TYPE_PHYSICAL_DEVICE_SUBPASS_SHADING_PROPERTIES_HUAWEISTRUCTURE_TYPE_PHYSICAL_DEVICE_INVOCATION_MASK_FEATURES_HUAWEISTRUCTURE_TYPE_MEMORY_GET_REMOTE_ADDRESS_INFO_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_EXTERNAL_MEMORY_RDMA_FEATURES_NVSTRUCTURE_TYPE_PHYSICAL_DEVICE_EXTENDED_DYNAMIC_STATE_2_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_COLOR_WRITE_ENABLE_FEATURES_EXTSTRUCTURE_TYPE_PIPELINE_COLOR_WRITE_CREATE_INFO_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_GLOBAL_PRIORITY_QUERY_FEATURES_EXTSTRUCTURE_TYPE_QUEUE_FAMILY_GLOBAL_PRIORITY_PROPERTIES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_MULTI_DRAW_FEATURES_EXTSTRUCTURE_TYPE_PHYSICAL_DEVICE_MULTI_DRAW_PROPERTIES_EXT"
+
+var _StructureType_map = map[StructureType]string{
+	0:          _StructureType_name[0:31],
+	1:          _StructureType_name[31:66],
+	2:          _StructureType_name[66:105],
+	3:          _StructureType_name[105:138],
+	4:          _StructureType_name[138:164],
+	5:          _StructureType_name[164:199],
+	6:          _StructureType_name[199:233],
+	7:          _StructureType_name[233:264],
+	8:          _StructureType_name[264:296],
+	9:          _StructureType_name[296:332],
+	10:         _StructureType_name[332:364],
+	11:         _StructureType_name[364:401],
+	12:         _StructureType_name[401:434],
+	13:         _StructureType_name[434:472],
+	14:         _StructureType_name[472:504],
+	15:         _StructureType_name[504:541],
+	16:         _StructureType_name[541:581],
+	17:         _StructureType_name[581:622],
+	18:         _StructureType_name[622:670],
+	19:         _StructureType_name[670:724],
+	20:         _StructureType_name[724:780],
+	21:         _StructureType_name[780:834],
+	22:         _StructureType_name[834:884],
+	23:         _StructureType_name[884:939],
+	24:         _StructureType_name[939:992],
+	25:         _StructureType_name[992:1047],
+	26:         _StructureType_name[1047:1100],
+	27:         _StructureType_name[1100:1149],
+	28:         _StructureType_name[1149:1193],
+	29:         _StructureType_name[1193:1236],
+	30:         _StructureType_name[1236:1278],
+	31:         _StructureType_name[1278:1312],
+	32:         _StructureType_name[1312:1360],
+	33:         _StructureType_name[1360:1402],
+	34:         _StructureType_name[1402:1445],
+	35:         _StructureType_name[1445:1480],
+	36:         _StructureType_name[1480:1514],
+	37:         _StructureType_name[1514:1552],
+	38:         _StructureType_name[1552:1590],
+	39:         _StructureType_name[1590:1629],
+	40:         _StructureType_name[1629:1672],
+	41:         _StructureType_name[1672:1718],
+	42:         _StructureType_name[1718:1758],
+	43:         _StructureType_name[1758:1795],
+	44:         _StructureType_name[1795:1831],
+	45:         _StructureType_name[1831:1866],
+	46:         _StructureType_name[1866:1895],
+	47:         _StructureType_name[1895:1937],
+	48:         _StructureType_name[1937:1977],
+	49:         _StructureType_name[1977:2027],
+	50:         _StructureType_name[2027:2079],
+	51:         _StructureType_name[2079:2129],
+	52:         _StructureType_name[2129:2181],
+	1000001000: _StructureType_name[2181:2221],
+	1000001001: _StructureType_name[2221:2252],
+	1000002000: _StructureType_name[2252:2295],
+	1000002001: _StructureType_name[2295:2341],
+	1000003000: _StructureType_name[2341:2380],
+	1000009000: _StructureType_name[2380:2424],
+	1000011000: _StructureType_name[2424:2476],
+	1000018000: _StructureType_name[2476:2543],
+	1000022000: _StructureType_name[2543:2591],
+	1000022001: _StructureType_name[2591:2638],
+	1000022002: _StructureType_name[2638:2681],
+	1000026000: _StructureType_name[2681:2737],
+	1000026001: _StructureType_name[2737:2794],
+	1000026002: _StructureType_name[2794:2853],
+	1000028000: _StructureType_name[2853:2915],
+	1000028001: _StructureType_name[2915:2979],
+	1000028002: _StructureType_name[2979:3045],
+	1000029000: _StructureType_name[3045:3085],
+	1000029001: _StructureType_name[3085:3127],
+	1000029002: _StructureType_name[3127:3160],
+	1000030000: _StructureType_name[3160:3201],
+	1000030001: _StructureType_name[3201:3249],
+	1000041000: _StructureType_name[3249:3304],
+	1000050000: _StructureType_name[3304:3367],
+	1000053000: _StructureType_name[3367:3415],
+	1000053001: _StructureType_name[3415:3464],
+	1000053002: _StructureType_name[3464:3515],
+	1000056000: _StructureType_name[3515:3566],
+	1000056001: _StructureType_name[3566:3611],
+	1000057000: _StructureType_name[3611:3660],
+	1000057001: _StructureType_name[3660:3709],
+	1000058000: _StructureType_name[3709:3765],
+	1000059000: _StructureType_name[3765:3806],
+	1000059001: _StructureType_name[3806:3849],
+	1000059002: _StructureType_name[3849:3883],
+	1000059003: _StructureType_name[3883:3923],
+	1000059004: _StructureType_name[3923:3973],
+	1000059005: _StructureType_name[3973:4013],
+	1000059006: _StructureType_name[4013:4063],
+	1000059007: _StructureType_name[4063:4110],
+	1000059008: _StructureType_name[4110:4167],
+	1000060000: _StructureType_name[4167:4208],
+	1000060003: _StructureType_name[4208:4258],
+	1000060004: _StructureType_name[4258:4311],
+	1000060005: _StructureType_name[4311:4350],
+	1000060006: _StructureType_name[4350:4394],
+	1000060007: _StructureType_name[4394:4446],
+	1000060008: _StructureType_name[4446:4492],
+	1000060009: _StructureType_name[4492:4543],
+	1000060010: _StructureType_name[4543:4585],
+	1000060011: _StructureType_name[4585:4629],
+	1000060012: _StructureType_name[4629:4682],
+	1000060013: _StructureType_name[4682:4733],
+	1000060014: _StructureType_name[4733:4783],
+	1000061000: _StructureType_name[4783:4818],
+	1000063000: _StructureType_name[4818:4880],
+	1000066000: _StructureType_name[4880:4952],
+	1000067000: _StructureType_name[4952:4998],
+	1000067001: _StructureType_name[4998:5053],
+	1000070000: _StructureType_name[5053:5100],
+	1000070001: _StructureType_name[5100:5146],
+	1000071000: _StructureType_name[5146:5203],
+	1000071001: _StructureType_name[5203:5250],
+	1000071002: _StructureType_name[5250:5301],
+	1000071003: _StructureType_name[5301:5342],
+	1000071004: _StructureType_name[5342:5386],
+	1000072000: _StructureType_name[5386:5435],
+	1000072001: _StructureType_name[5435:5483],
+	1000072002: _StructureType_name[5483:5525],
+	1000073000: _StructureType_name[5525:5575],
+	1000073001: _StructureType_name[5575:5625],
+	1000073002: _StructureType_name[5625:5674],
+	1000073003: _StructureType_name[5674:5721],
+	1000074000: _StructureType_name[5721:5761],
+	1000074001: _StructureType_name[5761:5800],
+	1000074002: _StructureType_name[5800:5837],
+	1000075000: _StructureType_name[5837:5894],
+	1000076000: _StructureType_name[5894:5948],
+	1000076001: _StructureType_name[5948:5992],
+	1000077000: _StructureType_name[5992:6035],
+	1000078000: _StructureType_name[6035:6088],
+	1000078001: _StructureType_name[6088:6141],
+	1000078002: _StructureType_name[6141:6183],
+	1000078003: _StructureType_name[6183:6233],
+	1000079000: _StructureType_name[6233:6276],
+	1000079001: _StructureType_name[6276:6316],
+	1000080000: _StructureType_name[6316:6377],
+	1000081000: _StructureType_name[6377:6449],
+	1000081001: _StructureType_name[6449:6514],
+	1000081002: _StructureType_name[6514:6565],
+	1000082000: _StructureType_name[6565:6624],
+	1000083000: _StructureType_name[6624:6677],
+	1000084000: _StructureType_name[6677:6711],
+	1000085000: _StructureType_name[6711:6764],
+	1000087000: _StructureType_name[6764:6827],
+	1000090000: _StructureType_name[6827:6868],
+	1000091000: _StructureType_name[6868:6905],
+	1000091001: _StructureType_name[6905:6941],
+	1000091002: _StructureType_name[6941:6978],
+	1000091003: _StructureType_name[6978:7026],
+	1000092000: _StructureType_name[7026:7066],
+	1000094000: _StructureType_name[7066:7116],
+	1000097000: _StructureType_name[7116:7191],
+	1000098000: _StructureType_name[7191:7252],
+	1000099000: _StructureType_name[7252:7315],
+	1000099001: _StructureType_name[7315:7378],
+	1000101000: _StructureType_name[7378:7450],
+	1000101001: _StructureType_name[7450:7522],
+	1000102000: _StructureType_name[7522:7583],
+	1000102001: _StructureType_name[7583:7653],
+	1000105000: _StructureType_name[7653:7684],
+	1000108000: _StructureType_name[7684:7745],
+	1000108001: _StructureType_name[7745:7795],
+	1000108002: _StructureType_name[7795:7843],
+	1000108003: _StructureType_name[7843:7891],
+	1000109000: _StructureType_name[7891:7930],
+	1000109001: _StructureType_name[7930:7967],
+	1000109002: _StructureType_name[7967:8003],
+	1000109003: _StructureType_name[8003:8038],
+	1000109004: _StructureType_name[8038:8078],
+	1000109005: _StructureType_name[8078:8111],
+	1000109006: _StructureType_name[8111:8142],
+	1000111000: _StructureType_name[8142:8196],
+	1000112000: _StructureType_name[8196:8246],
+	1000112001: _StructureType_name[8246:8286],
+	1000113000: _StructureType_name[8286:8325],
+	1000114000: _StructureType_name[8325:8374],
+	1000114001: _StructureType_name[8374:8423],
+	1000114002: _StructureType_name[8423:8469],
+	1000115000: _StructureType_name[8469:8508],
+	1000115001: _StructureType_name[8508:8544],
+	1000116000: _StructureType_name[8544:8605],
+	1000116001: _StructureType_name[8605:8668],
+	1000116002: _StructureType_name[8668:8721],
+	1000116003: _StructureType_name[8721:8769],
+	1000116004: _StructureType_name[8769:8815],
+	1000116005: _StructureType_name[8815:8853],
+	1000116006: _StructureType_name[8853:8903],
+	1000117000: _StructureType_name[8903:8959],
+	1000117001: _StructureType_name[8959:9021],
+	1000117002: _StructureType_name[9021:9064],
+	1000117003: _StructureType_name[9064:9132],
+	1000119000: _StructureType_name[9132:9181],
+	1000119001: _StructureType_name[9181:9222],
+	1000119002: _StructureType_name[9222:9257],
+	1000120000: _StructureType_name[9257:9314],
+	1000121000: _StructureType_name[9314:9353],
+	1000121001: _StructureType_name[9353:9398],
+	1000121002: _StructureType_name[9398:9442],
+	1000121003: _StructureType_name[9442:9481],
+	1000121004: _StructureType_name[9481:9528],
+	1000127000: _StructureType_name[9528:9572],
+	1000127001: _StructureType_name[9572:9617],
+	1000128000: _StructureType_name[9617:9664],
+	1000128001: _StructureType_name[9664:9710],
+	1000128002: _StructureType_name[9710:9746],
+	1000128003: _StructureType_name[9746:9800],
+	1000128004: _StructureType_name[9800:9852],
+	1000130000: _StructureType_name[9852:9915],
+	1000130001: _StructureType_name[9915:9964],
+	1000138000: _StructureType_name[9964:10028],
+	1000138001: _StructureType_name[10028:10094],
+	1000138002: _StructureType_name[10094:10154],
+	1000138003: _StructureType_name[10154:10221],
+	1000143000: _StructureType_name[10221:10261],
+	1000143001: _StructureType_name[10261:10319],
+	1000143002: _StructureType_name[10319:10381],
+	1000143003: _StructureType_name[10381:10443],
+	1000143004: _StructureType_name[10443:10484],
+	1000145000: _StructureType_name[10484:10520],
+	1000145001: _StructureType_name[10520:10576],
+	1000145002: _StructureType_name[10576:10634],
+	1000145003: _StructureType_name[10634:10668],
+	1000146000: _StructureType_name[10668:10716],
+	1000146001: _StructureType_name[10716:10763],
+	1000146002: _StructureType_name[10763:10817],
+	1000146003: _StructureType_name[10817:10853],
+	1000146004: _StructureType_name[10853:10902],
+	1000147000: _StructureType_name[10902:10946],
+	1000148000: _StructureType_name[10946:11014],
+	1000148001: _StructureType_name[11014:11084],
+	1000148002: _StructureType_name[11084:11150],
+	1000149000: _StructureType_name[11150:11212],
+	1000150000: _StructureType_name[11212:11273],
+	1000150002: _StructureType_name[11273:11334],
+	1000150003: _StructureType_name[11334:11395],
+	1000150004: _StructureType_name[11395:11460],
+	1000150005: _StructureType_name[11460:11525],
+	1000150006: _StructureType_name[11525:11575],
+	1000150007: _StructureType_name[11575:11637],
+	1000150009: _StructureType_name[11637:11691],
+	1000150010: _StructureType_name[11691:11742],
+	1000150011: _StructureType_name[11742:11803],
+	1000150012: _StructureType_name[11803:11864],
+	1000150013: _StructureType_name[11864:11930],
+	1000150014: _StructureType_name[11930:11998],
+	1000150017: _StructureType_name[11998:12051],
+	1000150020: _StructureType_name[12051:12109],
+	1000152000: _StructureType_name[12109:12173],
+	1000154000: _StructureType_name[12173:12234],
+	1000154001: _StructureType_name[12234:12297],
+	1000156000: _StructureType_name[12297:12348],
+	1000156001: _StructureType_name[12348:12392],
+	1000156002: _StructureType_name[12392:12435],
+	1000156003: _StructureType_name[12435:12486],
+	1000156004: _StructureType_name[12486:12550],
+	1000156005: _StructureType_name[12550:12613],
+	1000157000: _StructureType_name[12613:12651],
+	1000157001: _StructureType_name[12651:12688],
+	1000158000: _StructureType_name[12688:12742],
+	1000158002: _StructureType_name[12742:12807],
+	1000158003: _StructureType_name[12807:12868],
+	1000158004: _StructureType_name[12868:12933],
+	1000158005: _StructureType_name[12933:12988],
+	1000160000: _StructureType_name[12988:13035],
+	1000160001: _StructureType_name[13035:13096],
+	1000161000: _StructureType_name[13096:13158],
+	1000161001: _StructureType_name[13158:13217],
+	1000161002: _StructureType_name[13217:13278],
+	1000161003: _StructureType_name[13278:13347],
+	1000161004: _StructureType_name[13347:13417],
+	1000164000: _StructureType_name[13417:13489],
+	1000164001: _StructureType_name[13489:13550],
+	1000164002: _StructureType_name[13550:13613],
+	1000164005: _StructureType_name[13613:13686],
+	1000165000: _StructureType_name[13686:13736],
+	1000165001: _StructureType_name[13736:13788],
+	1000165003: _StructureType_name[13788:13814],
+	1000165004: _StructureType_name[13814:13850],
+	1000165005: _StructureType_name[13850:13881],
+	1000165006: _StructureType_name[13881:13938],
+	1000165007: _StructureType_name[13938:13999],
+	1000165008: _StructureType_name[13999:14064],
+	1000165009: _StructureType_name[14064:14120],
+	1000165011: _StructureType_name[14120:14174],
+	1000165012: _StructureType_name[14174:14219],
+	1000166000: _StructureType_name[14219:14290],
+	1000166001: _StructureType_name[14290:14363],
+	1000168000: _StructureType_name[14363:14418],
+	1000168001: _StructureType_name[14418:14462],
+	1000170000: _StructureType_name[14462:14525],
+	1000170001: _StructureType_name[14525:14591],
+	1000174000: _StructureType_name[14591:14650],
+	1000175000: _StructureType_name[14650:14720],
+	1000177000: _StructureType_name[14720:14772],
+	1000178000: _StructureType_name[14772:14822],
+	1000178001: _StructureType_name[14822:14871],
+	1000178002: _StructureType_name[14871:14937],
+	1000180000: _StructureType_name[14937:14996],
+	1000181000: _StructureType_name[14996:15052],
+	1000183000: _StructureType_name[15052:15108],
+	1000184000: _StructureType_name[15108:15152],
+	1000185000: _StructureType_name[15152:15209],
+	1000189000: _StructureType_name[15209:15268],
+	1000190000: _StructureType_name[15268:15338],
+	1000190001: _StructureType_name[15338:15404],
+	1000190002: _StructureType_name[15404:15472],
+	1000192000: _StructureType_name[15472:15529],
+	1000196000: _StructureType_name[15529:15577],
+	1000197000: _StructureType_name[15577:15633],
+	1000199000: _StructureType_name[15633:15696],
+	1000199001: _StructureType_name[15696:15752],
+	1000201000: _StructureType_name[15752:15821],
+	1000202000: _StructureType_name[15821:15875],
+	1000202001: _StructureType_name[15875:15931],
+	1000203000: _StructureType_name[15931:16001],
+	1000204000: _StructureType_name[16001:16066],
+	1000205000: _StructureType_name[16066:16137],
+	1000205002: _StructureType_name[16137:16197],
+	1000206000: _StructureType_name[16197:16230],
+	1000206001: _StructureType_name[16230:16282],
+	1000207000: _StructureType_name[16282:16340],
+	1000207001: _StructureType_name[16340:16400],
+	1000207002: _StructureType_name[16400:16441],
+	1000207003: _StructureType_name[16441:16486],
+	1000207004: _StructureType_name[16486:16520],
+	1000207005: _StructureType_name[16520:16556],
+	1000209000: _StructureType_name[16556:16628],
+	1000210000: _StructureType_name[16628:16689],
+	1000210001: _StructureType_name[16689:16741],
+	1000210002: _StructureType_name[16741:16785],
+	1000210003: _StructureType_name[16785:16836],
+	1000210004: _StructureType_name[16836:16882],
+	1000210005: _StructureType_name[16882:16941],
+	1000211000: _StructureType_name[16941:17000],
+	1000212000: _StructureType_name[17000:17058],
+	1000213000: _StructureType_name[17058:17116],
+	1000213001: _StructureType_name[17116:17175],
+	1000215000: _StructureType_name[17175:17246],
+	1000218000: _StructureType_name[17246:17310],
+	1000218001: _StructureType_name[17310:17376],
+	1000218002: _StructureType_name[17376:17439],
+	1000221000: _StructureType_name[17439:17498],
+	1000225000: _StructureType_name[17498:17565],
+	1000225001: _StructureType_name[17565:17640],
+	1000225002: _StructureType_name[17640:17705],
+	1000226000: _StructureType_name[17705:17761],
+	1000226001: _StructureType_name[17761:17828],
+	1000226002: _StructureType_name[17828:17895],
+	1000226003: _StructureType_name[17895:17960],
+	1000226004: _StructureType_name[17960:18016],
+	1000227000: _StructureType_name[18016:18075],
+	1000229000: _StructureType_name[18075:18134],
+	1000234000: _StructureType_name[18134:18203],
+	1000237000: _StructureType_name[18203:18262],
+	1000238000: _StructureType_name[18262:18321],
+	1000238001: _StructureType_name[18321:18369],
+	1000239000: _StructureType_name[18369:18418],
+	1000240000: _StructureType_name[18418:18496],
+	1000241000: _StructureType_name[18496:18566],
+	1000241001: _StructureType_name[18566:18616],
+	1000241002: _StructureType_name[18616:18668],
+	1000244000: _StructureType_name[18668:18733],
+	1000244001: _StructureType_name[18733:18774],
+	1000244002: _StructureType_name[18774:18826],
+	1000245000: _StructureType_name[18826:18876],
+	1000246000: _StructureType_name[18876:18922],
+	1000247000: _StructureType_name[18922:18960],
+	1000248000: _StructureType_name[18960:19016],
+	1000249000: _StructureType_name[19016:19077],
+	1000249001: _StructureType_name[19077:19124],
+	1000249002: _StructureType_name[19124:19187],
+	1000250000: _StructureType_name[19187:19253],
+	1000250001: _StructureType_name[19253:19316],
+	1000250002: _StructureType_name[19316:19371],
+	1000251000: _StructureType_name[19371:19440],
+	1000252000: _StructureType_name[19440:19502],
+	1000253000: _StructureType_name[19502:19572],
+	1000254000: _StructureType_name[19572:19632],
+	1000254001: _StructureType_name[19632:19708],
+	1000254002: _StructureType_name[19708:19770],
+	1000255000: _StructureType_name[19770:19823],
+	1000255001: _StructureType_name[19823:19882],
+	1000255002: _StructureType_name[19882:19943],
+	1000256000: _StructureType_name[19943:19990],
+	1000257000: _StructureType_name[19990:20051],
+	1000257002: _StructureType_name[20051:20107],
+	1000257003: _StructureType_name[20107:20165],
+	1000257004: _StructureType_name[20165:20221],
+	1000259000: _StructureType_name[20221:20283],
+	1000259001: _StructureType_name[20283:20347],
+	1000259002: _StructureType_name[20347:20411],
+	1000260000: _StructureType_name[20411:20474],
+	1000261000: _StructureType_name[20474:20530],
+	1000265000: _StructureType_name[20530:20590],
+	1000267000: _StructureType_name[20590:20656],
+	1000269000: _StructureType_name[20656:20730],
+	1000269001: _StructureType_name[20730:20762],
+	1000269002: _StructureType_name[20762:20811],
+	1000269003: _StructureType_name[20811:20854],
+	1000269004: _StructureType_name[20854:20902],
+	1000269005: _StructureType_name[20902:20964],
+	1000273000: _StructureType_name[20964:21029],
+	1000276000: _StructureType_name[21029:21107],
+	1000277000: _StructureType_name[21107:21177],
+	1000277001: _StructureType_name[21177:21228],
+	1000277002: _StructureType_name[21228:21289],
+	1000277003: _StructureType_name[21289:21337],
+	1000277004: _StructureType_name[21337:21391],
+	1000277005: _StructureType_name[21391:21432],
+	1000277006: _StructureType_name[21432:21493],
+	1000277007: _StructureType_name[21493:21561],
+	1000278000: _StructureType_name[21561:21630],
+	1000278001: _StructureType_name[21630:21696],
+	1000280000: _StructureType_name[21696:21766],
+	1000280001: _StructureType_name[21766:21838],
+	1000281000: _StructureType_name[21838:21904],
+	1000281001: _StructureType_name[21904:21972],
+	1000282000: _StructureType_name[21972:22045],
+	1000282001: _StructureType_name[22045:22097],
+	1000284000: _StructureType_name[22097:22161],
+	1000284001: _StructureType_name[22161:22219],
+	1000284002: _StructureType_name[22219:22272],
+	1000286000: _StructureType_name[22272:22328],
+	1000286001: _StructureType_name[22328:22386],
+	1000287000: _StructureType_name[22386:22444],
+	1000287001: _StructureType_name[22444:22509],
+	1000287002: _StructureType_name[22509:22572],
+	1000290000: _StructureType_name[22572:22619],
+	1000294000: _StructureType_name[22619:22648],
+	1000294001: _StructureType_name[22648:22702],
+	1000295000: _StructureType_name[22702:22758],
+	1000295001: _StructureType_name[22758:22808],
+	1000295002: _StructureType_name[22808:22856],
+	1000297000: _StructureType_name[22856:22931],
+	1000300000: _StructureType_name[22931:22992],
+	1000300001: _StructureType_name[22992:23047],
+	1000314000: _StructureType_name[23047:23082],
+	1000314001: _StructureType_name[23082:23124],
+	1000314002: _StructureType_name[23124:23165],
+	1000314003: _StructureType_name[23165:23199],
+	1000314004: _StructureType_name[23199:23231],
+	1000314005: _StructureType_name[23231:23271],
+	1000314006: _StructureType_name[23271:23316],
+	1000314007: _StructureType_name[23316:23377],
+	1000314008: _StructureType_name[23377:23431],
+	1000314009: _StructureType_name[23431:23466],
+	1000323000: _StructureType_name[23466:23546],
+	1000325000: _StructureType_name[23546:23622],
+	1000326000: _StructureType_name[23622:23694],
+	1000326001: _StructureType_name[23694:23764],
+	1000326002: _StructureType_name[23764:23835],
+	1000327000: _StructureType_name[23835:23906],
+	1000327001: _StructureType_name[23906:23972],
+	1000327002: _StructureType_name[23972:24024],
+	1000330000: _StructureType_name[24024:24093],
+	1000332000: _StructureType_name[24093:24159],
+	1000332001: _StructureType_name[24159:24227],
+	1000333000: _StructureType_name[24227:24274],
+	1000335000: _StructureType_name[24274:24334],
+	1000336000: _StructureType_name[24334:24410],
+	1000337000: _StructureType_name[24410:24447],
+	1000337001: _StructureType_name[24447:24483],
+	1000337002: _StructureType_name[24483:24529],
+	1000337003: _StructureType_name[24529:24575],
+	1000337004: _StructureType_name[24575:24611],
+	1000337005: _StructureType_name[24611:24650],
+	1000337006: _StructureType_name[24650:24682],
+	1000337007: _StructureType_name[24682:24713],
+	1000337008: _StructureType_name[24713:24744],
+	1000337009: _StructureType_name[24744:24782],
+	1000337010: _StructureType_name[24782:24816],
+	1000340000: _StructureType_name[24816:24872],
+	1000347000: _StructureType_name[24872:24936],
+	1000347001: _StructureType_name[24936:25002],
+	1000347015: _StructureType_name[25002:25053],
+	1000347016: _StructureType_name[25053:25108],
+	1000347018: _StructureType_name[25108:25169],
+	1000348013: _StructureType_name[25169:25222],
+	1000351000: _StructureType_name[25222:25291],
+	1000351002: _StructureType_name[25291:25347],
+	1000352000: _StructureType_name[25347:25417],
+	1000352001: _StructureType_name[25417:25470],
+	1000352002: _StructureType_name[25470:25525],
+	1000353000: _StructureType_name[25525:25574],
+	1000356000: _StructureType_name[25574:25649],
+	1000369000: _StructureType_name[25649:25707],
+	1000369001: _StructureType_name[25707:25769],
+	1000369002: _StructureType_name[25769:25833],
+	1000370000: _StructureType_name[25833:25895],
+	1000371000: _StructureType_name[25895:25943],
+	1000371001: _StructureType_name[25943:26006],
+	1000377000: _StructureType_name[26006:26074],
+	1000381000: _StructureType_name[26074:26136],
+	1000381001: _StructureType_name[26136:26187],
+	1000388000: _StructureType_name[26187:26252],
+	1000388001: _StructureType_name[26252:26310],
+	1000392000: _StructureType_name[26310:26364],
+	1000392001: _StructureType_name[26364:26420],
+}
+
+func (i StructureType) String() string {
+	if str, ok := _StructureType_map[i]; ok {
+		return str
+	}
+	return "StructureType(" + strconv.FormatInt(int64(i), 10) + ")"
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SUBGROUP_FEATURE_BASIC_BIT-1]
+	_ = x[SUBGROUP_FEATURE_VOTE_BIT-2]
+	_ = x[SUBGROUP_FEATURE_ARITHMETIC_BIT-4]
+	_ = x[SUBGROUP_FEATURE_BALLOT_BIT-8]
+	_ = x[SUBGROUP_FEATURE_SHUFFLE_BIT-16]
+	_ = x[SUBGROUP_FEATURE_SHUFFLE_RELATIVE_BIT-32]
+	_ = x[SUBGROUP_FEATURE_CLUSTERED_BIT-64]
+	_ = x[SUBGROUP_FEATURE_QUAD_BIT-128]
+	_ = x[SUBGROUP_FEATURE_PARTITIONED_BIT_NV-1000198000]
+}
+
+const (
+	_SubgroupFeatureFlagBits_name_0 = "SUBGROUP_FEATURE_BASIC_BITSUBGROUP_FEATURE_VOTE_BIT"
+	_SubgroupFeatureFlagBits_name_1 = "SUBGROUP_FEATURE_ARITHMETIC_BIT"
+	_SubgroupFeatureFlagBits_name_2 = "SUBGROUP_FEATURE_BALLOT_BIT"
+	_SubgroupFeatureFlagBits_name_3 = "SUBGROUP_FEATURE_SHUFFLE_BIT"
+	_SubgroupFeatureFlagBits_name_4 = "SUBGROUP_FEATURE_SHUFFLE_RELATIVE_BIT"
+	_SubgroupFeatureFlagBits_name_5 = "SUBGROUP_FEATURE_CLUSTERED_BIT"
+	_SubgroupFeatureFlagBits_name_6 = "SUBGROUP_FEATURE_QUAD_BIT"
+	_SubgroupFeatureFlagBits_name_7 = "SUBGROUP_FEATURE_PARTITIONED_BIT_NV"
+)
+
+var (
+	_SubgroupFeatureFlagBits_index_0 = [...]uint8{0, 26, 51}
+)
+
+func (i SubgroupFeatureFlagBits) String() string {
+	switch {
+	case 1 <= i && i <= 2:
+		i -= 1
+		return _SubgroupFeatureFlagBits_name_0[_SubgroupFeatureFlagBits_index_0[i]:_SubgroupFeatureFlagBits_index_0[i+1]]
+	case i == 4:
+		return _SubgroupFeatureFlagBits_name_1
+	case i == 8:
+		return _SubgroupFeatureFlagBits_name_2
+	case i == 16:
+		return _SubgroupFeatureFlagBits_name_3
+	case i == 32:
+		return _SubgroupFeatureFlagBits_name_4
+	case i == 64:
+		return _SubgroupFeatureFlagBits_name_5
+	case i == 128:
+		return _SubgroupFeatureFlagBits_name_6
+	case i == 1000198000:
+		return _SubgroupFeatureFlagBits_name_7
+	default:
+		return "SubgroupFeatureFlagBits(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SUBMIT_PROTECTED_BIT_KHR-1]
+}
+
+const _SubmitFlagBitsKHR_name = "SUBMIT_PROTECTED_BIT_KHR"
+
+var _SubmitFlagBitsKHR_index = [...]uint8{0, 24}
+
+func (i SubmitFlagBitsKHR) String() string {
+	i -= 1
+	if i >= SubmitFlagBitsKHR(len(_SubmitFlagBitsKHR_index)-1) {
+		return "SubmitFlagBitsKHR(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _SubmitFlagBitsKHR_name[_SubmitFlagBitsKHR_index[i]:_SubmitFlagBitsKHR_index[i+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SUBPASS_CONTENTS_INLINE-0]
+	_ = x[SUBPASS_CONTENTS_SECONDARY_COMMAND_BUFFERS-1]
+}
+
+const _SubpassContents_name = "SUBPASS_CONTENTS_INLINESUBPASS_CONTENTS_SECONDARY_COMMAND_BUFFERS"
+
+var _SubpassContents_index = [...]uint8{0, 23, 65}
+
+func (i SubpassContents) String() string {
+	if i < 0 || i >= SubpassContents(len(_SubpassContents_index)-1) {
+		return "SubpassContents(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _SubpassContents_name[_SubpassContents_index[i]:_SubpassContents_index[i+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SUBPASS_DESCRIPTION_PER_VIEW_ATTRIBUTES_BIT_NVX-1000097000]
+	_ = x[SUBPASS_DESCRIPTION_PER_VIEW_POSITION_X_ONLY_BIT_NVX-1000097000]
+	_ = x[SUBPASS_DESCRIPTION_SHADER_RESOLVE_BIT_QCOM-1000171000]
+	_ = x[SUBPASS_DESCRIPTION_FRAGMENT_REGION_BIT_QCOM-1000171000]
+}
+
+const (
+	_SubpassDescriptionFlagBits_name_0 = "SUBPASS_DESCRIPTION_PER_VIEW_ATTRIBUTES_BIT_NVX"
+	_SubpassDescriptionFlagBits_name_1 = "SUBPASS_DESCRIPTION_SHADER_RESOLVE_BIT_QCOM"
+)
+
+func (i SubpassDescriptionFlagBits) String() string {
+	switch {
+	case i == 1000097000:
+		return _SubpassDescriptionFlagBits_name_0
+	case i == 1000171000:
+		return _SubpassDescriptionFlagBits_name_1
+	default:
+		return "SubpassDescriptionFlagBits(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SURFACE_COUNTER_VBLANK_BIT_EXT-1]
+	_ = x[SURFACE_COUNTER_VBLANK_EXT-1]
+}
+
+const _SurfaceCounterFlagBitsEXT_name = "SURFACE_COUNTER_VBLANK_BIT_EXT"
+
+var _SurfaceCounterFlagBitsEXT_index = [...]uint8{0, 30}
+
+func (i SurfaceCounterFlagBitsEXT) String() string {
+	i -= 1
+	if i >= SurfaceCounterFlagBitsEXT(len(_SurfaceCounterFlagBitsEXT_index)-1) {
+		return "SurfaceCounterFlagBitsEXT(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _SurfaceCounterFlagBitsEXT_name[_SurfaceCounterFlagBitsEXT_index[i]:_SurfaceCounterFlagBitsEXT_index[i+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SURFACE_TRANSFORM_IDENTITY_BIT_KHR-1]
+	_ = x[SURFACE_TRANSFORM_ROTATE_90_BIT_KHR-2]
+	_ = x[SURFACE_TRANSFORM_ROTATE_180_BIT_KHR-4]
+	_ = x[SURFACE_TRANSFORM_ROTATE_270_BIT_KHR-8]
+	_ = x[SURFACE_TRANSFORM_HORIZONTAL_MIRROR_BIT_KHR-16]
+	_ = x[SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_90_BIT_KHR-32]
+	_ = x[SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_180_BIT_KHR-64]
+	_ = x[SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_270_BIT_KHR-128]
+	_ = x[SURFACE_TRANSFORM_INHERIT_BIT_KHR-256]
+}
+
+const (
+	_SurfaceTransformFlagBitsKHR_name_0 = "SURFACE_TRANSFORM_IDENTITY_BIT_KHRSURFACE_TRANSFORM_ROTATE_90_BIT_KHR"
+	_SurfaceTransformFlagBitsKHR_name_1 = "SURFACE_TRANSFORM_ROTATE_180_BIT_KHR"
+	_SurfaceTransformFlagBitsKHR_name_2 = "SURFACE_TRANSFORM_ROTATE_270_BIT_KHR"
+	_SurfaceTransformFlagBitsKHR_name_3 = "SURFACE_TRANSFORM_HORIZONTAL_MIRROR_BIT_KHR"
+	_SurfaceTransformFlagBitsKHR_name_4 = "SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_90_BIT_KHR"
+	_SurfaceTransformFlagBitsKHR_name_5 = "SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_180_BIT_KHR"
+	_SurfaceTransformFlagBitsKHR_name_6 = "SURFACE_TRANSFORM_HORIZONTAL_MIRROR_ROTATE_270_BIT_KHR"
+	_SurfaceTransformFlagBitsKHR_name_7 = "SURFACE_TRANSFORM_INHERIT_BIT_KHR"
+)
+
+var (
+	_SurfaceTransformFlagBitsKHR_index_0 = [...]uint8{0, 34, 69}
+)
+
+func (i SurfaceTransformFlagBitsKHR) String() string {
+	switch {
+	case 1 <= i && i <= 2:
+		i -= 1
+		return _SurfaceTransformFlagBitsKHR_name_0[_SurfaceTransformFlagBitsKHR_index_0[i]:_SurfaceTransformFlagBitsKHR_index_0[i+1]]
+	case i == 4:
+		return _SurfaceTransformFlagBitsKHR_name_1
+	case i == 8:
+		return _SurfaceTransformFlagBitsKHR_name_2
+	case i == 16:
+		return _SurfaceTransformFlagBitsKHR_name_3
+	case i == 32:
+		return _SurfaceTransformFlagBitsKHR_name_4
+	case i == 64:
+		return _SurfaceTransformFlagBitsKHR_name_5
+	case i == 128:
+		return _SurfaceTransformFlagBitsKHR_name_6
+	case i == 256:
+		return _SurfaceTransformFlagBitsKHR_name_7
+	default:
+		return "SurfaceTransformFlagBitsKHR(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SWAPCHAIN_CREATE_PROTECTED_BIT_KHR-1000001000]
+	_ = x[SWAPCHAIN_CREATE_SPLIT_INSTANCE_BIND_REGIONS_BIT_KHR-1000060000]
+	_ = x[SWAPCHAIN_CREATE_MUTABLE_FORMAT_BIT_KHR-1000200000]
+}
+
+const (
+	_SwapchainCreateFlagBitsKHR_name_0 = "SWAPCHAIN_CREATE_PROTECTED_BIT_KHR"
+	_SwapchainCreateFlagBitsKHR_name_1 = "SWAPCHAIN_CREATE_SPLIT_INSTANCE_BIND_REGIONS_BIT_KHR"
+	_SwapchainCreateFlagBitsKHR_name_2 = "SWAPCHAIN_CREATE_MUTABLE_FORMAT_BIT_KHR"
+)
+
+func (i SwapchainCreateFlagBitsKHR) String() string {
+	switch {
+	case i == 1000001000:
+		return _SwapchainCreateFlagBitsKHR_name_0
+	case i == 1000060000:
+		return _SwapchainCreateFlagBitsKHR_name_1
+	case i == 1000200000:
+		return _SwapchainCreateFlagBitsKHR_name_2
+	default:
+		return "SwapchainCreateFlagBitsKHR(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SYSTEM_ALLOCATION_SCOPE_COMMAND-0]
+	_ = x[SYSTEM_ALLOCATION_SCOPE_OBJECT-1]
+	_ = x[SYSTEM_ALLOCATION_SCOPE_CACHE-2]
+	_ = x[SYSTEM_ALLOCATION_SCOPE_DEVICE-3]
+	_ = x[SYSTEM_ALLOCATION_SCOPE_INSTANCE-4]
+}
+
+const _SystemAllocationScope_name = "SYSTEM_ALLOCATION_SCOPE_COMMANDSYSTEM_ALLOCATION_SCOPE_OBJECTSYSTEM_ALLOCATION_SCOPE_CACHESYSTEM_ALLOCATION_SCOPE_DEVICESYSTEM_ALLOCATION_SCOPE_INSTANCE"
+
+var _SystemAllocationScope_index = [...]uint8{0, 31, 61, 90, 120, 152}
+
+func (i SystemAllocationScope) String() string {
+	if i < 0 || i >= SystemAllocationScope(len(_SystemAllocationScope_index)-1) {
+		return "SystemAllocationScope(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _SystemAllocationScope_name[_SystemAllocationScope_index[i]:_SystemAllocationScope_index[i+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[TESSELLATION_DOMAIN_ORIGIN_UPPER_LEFT-0]
+	_ = x[TESSELLATION_DOMAIN_ORIGIN_LOWER_LEFT-1]
+	_ = x[TESSELLATION_DOMAIN_ORIGIN_LOWER_LEFT_KHR-1]
+	_ = x[TESSELLATION_DOMAIN_ORIGIN_UPPER_LEFT_KHR-0]
+}
+
+const _TessellationDomainOrigin_name = "TESSELLATION_DOMAIN_ORIGIN_UPPER_LEFTTESSELLATION_DOMAIN_ORIGIN_LOWER_LEFT"
+
+var _TessellationDomainOrigin_index = [...]uint8{0, 37, 74}
+
+func (i TessellationDomainOrigin) String() string {
+	if i < 0 || i >= TessellationDomainOrigin(len(_TessellationDomainOrigin_index)-1) {
+		return "TessellationDomainOrigin(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _TessellationDomainOrigin_name[_TessellationDomainOrigin_index[i]:_TessellationDomainOrigin_index[i+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[TIME_DOMAIN_DEVICE_EXT-0]
+	_ = x[TIME_DOMAIN_CLOCK_MONOTONIC_EXT-1]
+	_ = x[TIME_DOMAIN_CLOCK_MONOTONIC_RAW_EXT-2]
+	_ = x[TIME_DOMAIN_QUERY_PERFORMANCE_COUNTER_EXT-3]
+}
+
+const _TimeDomainEXT_name = "TIME_DOMAIN_DEVICE_EXTTIME_DOMAIN_CLOCK_MONOTONIC_EXTTIME_DOMAIN_CLOCK_MONOTONIC_RAW_EXTTIME_DOMAIN_QUERY_PERFORMANCE_COUNTER_EXT"
+
+var _TimeDomainEXT_index = [...]uint8{0, 22, 53, 88, 129}
+
+func (i TimeDomainEXT) String() string {
+	if i < 0 || i >= TimeDomainEXT(len(_TimeDomainEXT_index)-1) {
+		return "TimeDomainEXT(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _TimeDomainEXT_name[_TimeDomainEXT_index[i]:_TimeDomainEXT_index[i+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[TOOL_PURPOSE_VALIDATION_BIT_EXT-1]
+	_ = x[TOOL_PURPOSE_PROFILING_BIT_EXT-2]
+	_ = x[TOOL_PURPOSE_TRACING_BIT_EXT-4]
+	_ = x[TOOL_PURPOSE_ADDITIONAL_FEATURES_BIT_EXT-8]
+	_ = x[TOOL_PURPOSE_MODIFYING_FEATURES_BIT_EXT-16]
+	_ = x[TOOL_PURPOSE_DEBUG_REPORTING_BIT_EXT-1000245000]
+	_ = x[TOOL_PURPOSE_DEBUG_MARKERS_BIT_EXT-1000245000]
+}
+
+const (
+	_ToolPurposeFlagBitsEXT_name_0 = "TOOL_PURPOSE_VALIDATION_BIT_EXTTOOL_PURPOSE_PROFILING_BIT_EXT"
+	_ToolPurposeFlagBitsEXT_name_1 = "TOOL_PURPOSE_TRACING_BIT_EXT"
+	_ToolPurposeFlagBitsEXT_name_2 = "TOOL_PURPOSE_ADDITIONAL_FEATURES_BIT_EXT"
+	_ToolPurposeFlagBitsEXT_name_3 = "TOOL_PURPOSE_MODIFYING_FEATURES_BIT_EXT"
+	_ToolPurposeFlagBitsEXT_name_4 = "TOOL_PURPOSE_DEBUG_REPORTING_BIT_EXT"
+)
+
+var (
+	_ToolPurposeFlagBitsEXT_index_0 = [...]uint8{0, 31, 61}
+)
+
+func (i ToolPurposeFlagBitsEXT) String() string {
+	switch {
+	case 1 <= i && i <= 2:
+		i -= 1
+		return _ToolPurposeFlagBitsEXT_name_0[_ToolPurposeFlagBitsEXT_index_0[i]:_ToolPurposeFlagBitsEXT_index_0[i+1]]
+	case i == 4:
+		return _ToolPurposeFlagBitsEXT_name_1
+	case i == 8:
+		return _ToolPurposeFlagBitsEXT_name_2
+	case i == 16:
+		return _ToolPurposeFlagBitsEXT_name_3
+	case i == 1000245000:
+		return _ToolPurposeFlagBitsEXT_name_4
+	default:
+		return "ToolPurposeFlagBitsEXT(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[VALIDATION_CACHE_HEADER_VERSION_ONE_EXT-1]
+}
+
+const _ValidationCacheHeaderVersionEXT_name = "VALIDATION_CACHE_HEADER_VERSION_ONE_EXT"
+
+var _ValidationCacheHeaderVersionEXT_index = [...]uint8{0, 39}
+
+func (i ValidationCacheHeaderVersionEXT) String() string {
+	i -= 1
+	if i < 0 || i >= ValidationCacheHeaderVersionEXT(len(_ValidationCacheHeaderVersionEXT_index)-1) {
+		return "ValidationCacheHeaderVersionEXT(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _ValidationCacheHeaderVersionEXT_name[_ValidationCacheHeaderVersionEXT_index[i]:_ValidationCacheHeaderVersionEXT_index[i+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[VALIDATION_CHECK_ALL_EXT-0]
+	_ = x[VALIDATION_CHECK_SHADERS_EXT-1]
+}
+
+const _ValidationCheckEXT_name = "VALIDATION_CHECK_ALL_EXTVALIDATION_CHECK_SHADERS_EXT"
+
+var _ValidationCheckEXT_index = [...]uint8{0, 24, 52}
+
+func (i ValidationCheckEXT) String() string {
+	if i < 0 || i >= ValidationCheckEXT(len(_ValidationCheckEXT_index)-1) {
+		return "ValidationCheckEXT(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _ValidationCheckEXT_name[_ValidationCheckEXT_index[i]:_ValidationCheckEXT_index[i+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[VALIDATION_FEATURE_DISABLE_ALL_EXT-0]
+	_ = x[VALIDATION_FEATURE_DISABLE_SHADERS_EXT-1]
+	_ = x[VALIDATION_FEATURE_DISABLE_THREAD_SAFETY_EXT-2]
+	_ = x[VALIDATION_FEATURE_DISABLE_API_PARAMETERS_EXT-3]
+	_ = x[VALIDATION_FEATURE_DISABLE_OBJECT_LIFETIMES_EXT-4]
+	_ = x[VALIDATION_FEATURE_DISABLE_CORE_CHECKS_EXT-5]
+	_ = x[VALIDATION_FEATURE_DISABLE_UNIQUE_HANDLES_EXT-6]
+	_ = x[VALIDATION_FEATURE_DISABLE_SHADER_VALIDATION_CACHE_EXT-7]
+}
+
+const _ValidationFeatureDisableEXT_name = "VALIDATION_FEATURE_DISABLE_ALL_EXTVALIDATION_FEATURE_DISABLE_SHADERS_EXTVALIDATION_FEATURE_DISABLE_THREAD_SAFETY_EXTVALIDATION_FEATURE_DISABLE_API_PARAMETERS_EXTVALIDATION_FEATURE_DISABLE_OBJECT_LIFETIMES_EXTVALIDATION_FEATURE_DISABLE_CORE_CHECKS_EXTVALIDATION_FEATURE_DISABLE_UNIQUE_HANDLES_EXTVALIDATION_FEATURE_DISABLE_SHADER_VALIDATION_CACHE_EXT"
+
+var _ValidationFeatureDisableEXT_index = [...]uint16{0, 34, 72, 116, 161, 208, 250, 295, 349}
+
+func (i ValidationFeatureDisableEXT) String() string {
+	if i < 0 || i >= ValidationFeatureDisableEXT(len(_ValidationFeatureDisableEXT_index)-1) {
+		return "ValidationFeatureDisableEXT(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _ValidationFeatureDisableEXT_name[_ValidationFeatureDisableEXT_index[i]:_ValidationFeatureDisableEXT_index[i+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[VALIDATION_FEATURE_ENABLE_GPU_ASSISTED_EXT-0]
+	_ = x[VALIDATION_FEATURE_ENABLE_GPU_ASSISTED_RESERVE_BINDING_SLOT_EXT-1]
+	_ = x[VALIDATION_FEATURE_ENABLE_BEST_PRACTICES_EXT-2]
+	_ = x[VALIDATION_FEATURE_ENABLE_DEBUG_PRINTF_EXT-3]
+	_ = x[VALIDATION_FEATURE_ENABLE_SYNCHRONIZATION_VALIDATION_EXT-4]
+}
+
+const _ValidationFeatureEnableEXT_name = "VALIDATION_FEATURE_ENABLE_GPU_ASSISTED_EXTVALIDATION_FEATURE_ENABLE_GPU_ASSISTED_RESERVE_BINDING_SLOT_EXTVALIDATION_FEATURE_ENABLE_BEST_PRACTICES_EXTVALIDATION_FEATURE_ENABLE_DEBUG_PRINTF_EXTVALIDATION_FEATURE_ENABLE_SYNCHRONIZATION_VALIDATION_EXT"
+
+var _ValidationFeatureEnableEXT_index = [...]uint8{0, 42, 105, 149, 191, 247}
+
+func (i ValidationFeatureEnableEXT) String() string {
+	if i < 0 || i >= ValidationFeatureEnableEXT(len(_ValidationFeatureEnableEXT_index)-1) {
+		return "ValidationFeatureEnableEXT(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _ValidationFeatureEnableEXT_name[_ValidationFeatureEnableEXT_index[i]:_ValidationFeatureEnableEXT_index[i+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[VENDOR_ID_VIV-65537]
+	_ = x[VENDOR_ID_VSI-65538]
+	_ = x[VENDOR_ID_KAZAN-65539]
+	_ = x[VENDOR_ID_CODEPLAY-65540]
+	_ = x[VENDOR_ID_MESA-65541]
+	_ = x[VENDOR_ID_POCL-65542]
+}
+
+const _VendorId_name = "VENDOR_ID_VIVVENDOR_ID_VSIVENDOR_ID_KAZANVENDOR_ID_CODEPLAYVENDOR_ID_MESAVENDOR_ID_POCL"
+
+var _VendorId_index = [...]uint8{0, 13, 26, 41, 59, 73, 87}
+
+func (i VendorId) String() string {
+	i -= 65537
+	if i < 0 || i >= VendorId(len(_VendorId_index)-1) {
+		return "VendorId(" + strconv.FormatInt(int64(i+65537), 10) + ")"
+	}
+	return _VendorId_name[_VendorId_index[i]:_VendorId_index[i+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[VERTEX_INPUT_RATE_VERTEX-0]
+	_ = x[VERTEX_INPUT_RATE_INSTANCE-1]
+}
+
+const _VertexInputRate_name = "VERTEX_INPUT_RATE_VERTEXVERTEX_INPUT_RATE_INSTANCE"
+
+var _VertexInputRate_index = [...]uint8{0, 24, 50}
+
+func (i VertexInputRate) String() string {
+	if i < 0 || i >= VertexInputRate(len(_VertexInputRate_index)-1) {
+		return "VertexInputRate(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _VertexInputRate_name[_VertexInputRate_index[i]:_VertexInputRate_index[i+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_X_NV-0]
+	_ = x[VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_X_NV-1]
+	_ = x[VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_Y_NV-2]
+	_ = x[VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_Y_NV-3]
+	_ = x[VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_Z_NV-4]
+	_ = x[VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_Z_NV-5]
+	_ = x[VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_W_NV-6]
+	_ = x[VIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_W_NV-7]
+}
+
+const _ViewportCoordinateSwizzleNV_name = "VIEWPORT_COORDINATE_SWIZZLE_POSITIVE_X_NVVIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_X_NVVIEWPORT_COORDINATE_SWIZZLE_POSITIVE_Y_NVVIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_Y_NVVIEWPORT_COORDINATE_SWIZZLE_POSITIVE_Z_NVVIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_Z_NVVIEWPORT_COORDINATE_SWIZZLE_POSITIVE_W_NVVIEWPORT_COORDINATE_SWIZZLE_NEGATIVE_W_NV"
+
+var _ViewportCoordinateSwizzleNV_index = [...]uint16{0, 41, 82, 123, 164, 205, 246, 287, 328}
+
+func (i ViewportCoordinateSwizzleNV) String() string {
+	if i < 0 || i >= ViewportCoordinateSwizzleNV(len(_ViewportCoordinateSwizzleNV_index)-1) {
+		return "ViewportCoordinateSwizzleNV(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _ViewportCoordinateSwizzleNV_name[_ViewportCoordinateSwizzleNV_index[i]:_ViewportCoordinateSwizzleNV_index[i+1]]
+}
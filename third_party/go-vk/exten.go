@@ -0,0 +1,177 @@
+// Code generated by go-vk from vk.xml at 2023-02-03 15:01:43.3342377 -0600 CST m=+1.344077801. DO NOT EDIT.
+package vk
+
+// Platform-specific values for
+const (
+	EXT_FRAGMENT_DENSITY_MAP_2_EXTENSION_NAME             = "VK_EXT_fragment_density_map2"
+	AMD_GCN_SHADER_SPEC_VERSION                           = 1
+	EXT_VALIDATION_FLAGS_SPEC_VERSION                     = 2
+	AMD_DISPLAY_NATIVE_HDR_SPEC_VERSION                   = 1
+	EXT_SUBGROUP_SIZE_CONTROL_EXTENSION_NAME              = "VK_EXT_subgroup_size_control"
+	KHR_16BIT_STORAGE_SPEC_VERSION                        = 1
+	GOOGLE_DISPLAY_TIMING_SPEC_VERSION                    = 1
+	KHR_SWAPCHAIN_MUTABLE_FORMAT_EXTENSION_NAME           = "VK_KHR_swapchain_mutable_format"
+	EXT_HDR_METADATA_EXTENSION_NAME                       = "VK_EXT_hdr_metadata"
+	EXT_INLINE_UNIFORM_BLOCK_EXTENSION_NAME               = "VK_EXT_inline_uniform_block"
+	INTEL_SHADER_INTEGER_FUNCTIONS_2_EXTENSION_NAME       = "VK_INTEL_shader_integer_functions2"
+	KHR_DRAW_INDIRECT_COUNT_SPEC_VERSION                  = 1
+	AMD_SHADER_CORE_PROPERTIES_2_SPEC_VERSION             = 1
+	KHR_SHADER_NON_SEMANTIC_INFO_EXTENSION_NAME           = "VK_KHR_shader_non_semantic_info"
+	KHR_EXTERNAL_FENCE_CAPABILITIES_EXTENSION_NAME        = "VK_KHR_external_fence_capabilities"
+	EXT_IMAGE_DRM_FORMAT_MODIFIER_EXTENSION_NAME          = "VK_EXT_image_drm_format_modifier"
+	AMD_SHADER_INFO_EXTENSION_NAME                        = "VK_AMD_shader_info"
+	EXT_LINE_RASTERIZATION_EXTENSION_NAME                 = "VK_EXT_line_rasterization"
+	EXT_SHADER_SUBGROUP_BALLOT_EXTENSION_NAME             = "VK_EXT_shader_subgroup_ballot"
+	EXT_EXTERNAL_MEMORY_DMA_BUF_SPEC_VERSION              = 1
+	EXT_COLOR_WRITE_ENABLE_SPEC_VERSION                   = 1
+	EXT_EXTERNAL_MEMORY_HOST_SPEC_VERSION                 = 1
+	KHR_IMAGELESS_FRAMEBUFFER_SPEC_VERSION                = 1
+	EXT_MULTI_DRAW_SPEC_VERSION                           = 1
+	KHR_VARIABLE_POINTERS_SPEC_VERSION                    = 1
+	KHR_SHADER_FLOAT_CONTROLS_SPEC_VERSION                = 4
+	EXT_BLEND_OPERATION_ADVANCED_SPEC_VERSION             = 2
+	KHR_SHADER_CLOCK_EXTENSION_NAME                       = "VK_KHR_shader_clock"
+	EXT_CONDITIONAL_RENDERING_EXTENSION_NAME              = "VK_EXT_conditional_rendering"
+	VALVE_MUTABLE_DESCRIPTOR_TYPE_SPEC_VERSION            = 1
+	AMD_SHADER_CORE_PROPERTIES_EXTENSION_NAME             = "VK_AMD_shader_core_properties"
+	EXT_DISCARD_RECTANGLES_SPEC_VERSION                   = 1
+	NV_SCISSOR_EXCLUSIVE_SPEC_VERSION                     = 1
+	KHR_SHADER_SUBGROUP_EXTENDED_TYPES_EXTENSION_NAME     = "VK_KHR_shader_subgroup_extended_types"
+	AMD_DEVICE_COHERENT_MEMORY_SPEC_VERSION               = 1
+	QCOM_ROTATED_COPY_COMMANDS_SPEC_VERSION               = 1
+	NV_FRAGMENT_SHADING_RATE_ENUMS_SPEC_VERSION           = 1
+	NV_FRAGMENT_SHADING_RATE_ENUMS_EXTENSION_NAME         = "VK_NV_fragment_shading_rate_enums"
+	NVX_IMAGE_VIEW_HANDLE_EXTENSION_NAME                  = "VK_NVX_image_view_handle"
+	KHR_SAMPLER_MIRROR_CLAMP_TO_EDGE_SPEC_VERSION         = 3
+	HUAWEI_SUBPASS_SHADING_SPEC_VERSION                   = 2
+	KHR_SPIRV_1_4_EXTENSION_NAME                          = "VK_KHR_spirv_1_4"
+	EXT_SEPARATE_STENCIL_USAGE_SPEC_VERSION               = 1
+	KHR_COPY_COMMANDS_2_EXTENSION_NAME                    = "VK_KHR_copy_commands2"
+	KHR_PERFORMANCE_QUERY_EXTENSION_NAME                  = "VK_KHR_performance_query"
+	KHR_8BIT_STORAGE_EXTENSION_NAME                       = "VK_KHR_8bit_storage"
+	KHR_FRAGMENT_SHADING_RATE_EXTENSION_NAME              = "VK_KHR_fragment_shading_rate"
+	EXT_INDEX_TYPE_UINT8_EXTENSION_NAME                   = "VK_EXT_index_type_uint8"
+	KHR_INCREMENTAL_PRESENT_SPEC_VERSION                  = 2
+	KHR_DRIVER_PROPERTIES_SPEC_VERSION                    = 1
+	AMD_GPU_SHADER_HALF_FLOAT_SPEC_VERSION                = 2
+	EXT_LOAD_STORE_OP_NONE_EXTENSION_NAME                 = "VK_EXT_load_store_op_none"
+	EXT_DEBUG_UTILS_SPEC_VERSION                          = 2
+	AMD_SHADER_CORE_PROPERTIES_2_EXTENSION_NAME           = "VK_AMD_shader_core_properties2"
+	KHR_SURFACE_PROTECTED_CAPABILITIES_SPEC_VERSION       = 1
+	KHR_VULKAN_MEMORY_MODEL_SPEC_VERSION                  = 3
+	KHR_SYNCHRONIZATION_2_EXTENSION_NAME                  = "VK_KHR_synchronization2"
+	KHR_IMAGE_FORMAT_LIST_SPEC_VERSION                    = 1
+	KHR_PRESENT_ID_SPEC_VERSION                           = 1
+	KHR_GET_MEMORY_REQUIREMENTS_2_EXTENSION_NAME          = "VK_KHR_get_memory_requirements2"
+	EXT_IMAGE_ROBUSTNESS_EXTENSION_NAME                   = "VK_EXT_image_robustness"
+	KHR_GET_SURFACE_CAPABILITIES_2_SPEC_VERSION           = 1
+	KHR_DEVICE_GROUP_CREATION_SPEC_VERSION                = 1
+	KHR_BUFFER_DEVICE_ADDRESS_SPEC_VERSION                = 1
+	KHR_SURFACE_SPEC_VERSION                              = 25
+	AMD_SHADER_EXPLICIT_VERTEX_PARAMETER_EXTENSION_NAME   = "VK_AMD_shader_explicit_vertex_parameter"
+	EXT_DEBUG_UTILS_EXTENSION_NAME                        = "VK_EXT_debug_utils"
+	EXT_DEPTH_CLIP_ENABLE_SPEC_VERSION                    = 1
+	KHR_WORKGROUP_MEMORY_EXPLICIT_LAYOUT_EXTENSION_NAME   = "VK_KHR_workgroup_memory_explicit_layout"
+	KHR_EXTERNAL_MEMORY_CAPABILITIES_SPEC_VERSION         = 1
+	QCOM_ROTATED_COPY_COMMANDS_EXTENSION_NAME             = "VK_QCOM_rotated_copy_commands"
+	EXT_DISPLAY_CONTROL_EXTENSION_NAME                    = "VK_EXT_display_control"
+	EXT_SAMPLER_FILTER_MINMAX_EXTENSION_NAME              = "VK_EXT_sampler_filter_minmax"
+	KHR_MULTIVIEW_EXTENSION_NAME                          = "VK_KHR_multiview"
+	KHR_EXTERNAL_SEMAPHORE_FD_EXTENSION_NAME              = "VK_KHR_external_semaphore_fd"
+	KHR_SURFACE_EXTENSION_NAME                            = "VK_KHR_surface"
+	KHR_16BIT_STORAGE_EXTENSION_NAME                      = "VK_KHR_16bit_storage"
+	EXT_FRAGMENT_SHADER_INTERLOCK_EXTENSION_NAME          = "VK_EXT_fragment_shader_interlock"
+	AMD_SHADER_TRINARY_MINMAX_EXTENSION_NAME              = "VK_AMD_shader_trinary_minmax"
+	EXT_SHADER_DEMOTE_TO_HELPER_INVOCATION_EXTENSION_NAME = "VK_EXT_shader_demote_to_helper_invocation"
+	EXT_VERTEX_INPUT_DYNAMIC_STATE_SPEC_VERSION           = 2
+	EXT_EXTENDED_DYNAMIC_STATE_2_SPEC_VERSION             = 1
+	EXT_SHADER_STENCIL_EXPORT_EXTENSION_NAME              = "VK_EXT_shader_stencil_export"
+	EXT_POST_DEPTH_COVERAGE_SPEC_VERSION                  = 1
+	NVX_MULTIVIEW_PER_VIEW_ATTRIBUTES_SPEC_VERSION        = 1
+	KHR_DEPTH_STENCIL_RESOLVE_SPEC_VERSION                = 1
+	KHR_MAINTENANCE3_EXTENSION_NAME                       = "VK_KHR_maintenance3"
+	EXT_VERTEX_ATTRIBUTE_DIVISOR_EXTENSION_NAME           = "VK_EXT_vertex_attribute_divisor"
+	NV_FRAGMENT_SHADER_BARYCENTRIC_SPEC_VERSION           = 1
+	NV_DEDICATED_ALLOCATION_EXTENSION_NAME                = "VK_NV_dedicated_allocation"
+	NVX_MULTIVIEW_PER_VIEW_ATTRIBUTES_EXTENSION_NAME      = "VK_NVX_multiview_per_view_attributes"
+	EXT_SHADER_SUBGROUP_BALLOT_SPEC_VERSION               = 1
+	GOOGLE_HLSL_FUNCTIONALITY1_EXTENSION_NAME             = "VK_GOOGLE_hlsl_functionality1"
+	EXT_VERTEX_ATTRIBUTE_DIVISOR_SPEC_VERSION             = 3
+	KHR_DISPLAY_SWAPCHAIN_SPEC_VERSION                    = 10
+	KHR_EXTERNAL_MEMORY_EXTENSION_NAME                    = "VK_KHR_external_memory"
+	EXT_DISPLAY_CONTROL_SPEC_VERSION                      = 1
+	EXT_TOOLING_INFO_EXTENSION_NAME                       = "VK_EXT_tooling_info"
+	KHR_SWAPCHAIN_SPEC_VERSION                            = 70
+	EXT_EXTERNAL_MEMORY_HOST_EXTENSION_NAME               = "VK_EXT_external_memory_host"
+	NV_DEVICE_DIAGNOSTICS_CONFIG_SPEC_VERSION             = 1
+	KHR_SPIRV_1_4_SPEC_VERSION                            = 1
+	NV_REPRESENTATIVE_FRAGMENT_TEST_SPEC_VERSION          = 2
+	HUAWEI_INVOCATION_MASK_EXTENSION_NAME                 = "VK_HUAWEI_invocation_mask"
+	KHR_ACCELERATION_STRUCTURE_EXTENSION_NAME             = "VK_KHR_acceleration_structure"
+	KHR_SHADER_DRAW_PARAMETERS_EXTENSION_NAME             = "VK_KHR_shader_draw_parameters"
+	NV_CLIP_SPACE_W_SCALING_SPEC_VERSION                  = 1
+	KHR_ZERO_INITIALIZE_WORKGROUP_MEMORY_SPEC_VERSION     = 1
+	EXT_ROBUSTNESS_2_SPEC_VERSION                         = 1
+	NV_SCISSOR_EXCLUSIVE_EXTENSION_NAME                   = "VK_NV_scissor_exclusive"
+	NV_DEDICATED_ALLOCATION_IMAGE_ALIASING_SPEC_VERSION   = 1
+	EXT_POST_DEPTH_COVERAGE_EXTENSION_NAME                = "VK_EXT_post_depth_coverage"
+	NV_RAY_TRACING_MOTION_BLUR_EXTENSION_NAME             = "VK_NV_ray_tracing_motion_blur"
+	KHR_DESCRIPTOR_UPDATE_TEMPLATE_SPEC_VERSION           = 1
+	GOOGLE_HLSL_FUNCTIONALITY1_SPEC_VERSION               = 1
+	GOOGLE_USER_TYPE_EXTENSION_NAME                       = "VK_GOOGLE_user_type"
+	NV_DEDICATED_ALLOCATION_IMAGE_ALIASING_EXTENSION_NAME = "VK_NV_dedicated_allocation_image_aliasing"
+	AMD_NEGATIVE_VIEWPORT_HEIGHT_EXTENSION_NAME           = "VK_AMD_negative_viewport_height"
+	AMD_RASTERIZATION_ORDER_EXTENSION_NAME                = "VK_AMD_rasterization_order"
+	GOOGLE_DISPLAY_TIMING_EXTENSION_NAME                  = "VK_GOOGLE_display_timing"
+	EXT_DIRECT_MODE_DISPLAY_SPEC_VERSION                  = 1
+	AMD_DEVICE_COHERENT_MEMORY_EXTENSION_NAME             = "VK_AMD_device_coherent_memory"
+	KHR_CREATE_RENDERPASS_2_EXTENSION_NAME                = "VK_KHR_create_renderpass2"
+	NV_SHADING_RATE_IMAGE_SPEC_VERSION                    = 3
+	EXT_EXTENDED_DYNAMIC_STATE_SPEC_VERSION               = 1
+	KHR_CREATE_RENDERPASS_2_SPEC_VERSION                  = 1
+	AMD_SHADER_BALLOT_EXTENSION_NAME                      = "VK_AMD_shader_ballot"
+	EXT_SCALAR_BLOCK_LAYOUT_EXTENSION_NAME                = "VK_EXT_scalar_block_layout"
+	EXT_CALIBRATED_TIMESTAMPS_SPEC_VERSION                = 2
+	KHR_SWAPCHAIN_MUTABLE_FORMAT_SPEC_VERSION             = 1
+	EXT_SHADER_SUBGROUP_VOTE_EXTENSION_NAME               = "VK_EXT_shader_subgroup_vote"
+	KHR_VULKAN_MEMORY_MODEL_EXTENSION_NAME                = "VK_KHR_vulkan_memory_model"
+	KHR_SHADER_ATOMIC_INT64_EXTENSION_NAME                = "VK_KHR_shader_atomic_int64"
+	EXT_DISPLAY_SURFACE_COUNTER_EXTENSION_NAME            = "VK_EXT_display_surface_counter"
+	EXT_DISPLAY_SURFACE_COUNTER_SPEC_VERSION              = 1
+	KHR_EXTERNAL_SEMAPHORE_CAPABILITIES_SPEC_VERSION      = 1
+	NV_SHADER_SUBGROUP_PARTITIONED_EXTENSION_NAME         = "VK_NV_shader_subgroup_partitioned"
+	KHR_SHADER_ATOMIC_INT64_SPEC_VERSION                  = 1
+	KHR_RAY_QUERY_SPEC_VERSION                            = 1
+	EXT_PHYSICAL_DEVICE_DRM_SPEC_VERSION                  = 1
+	KHR_SHADER_INTEGER_DOT_PRODUCT_EXTENSION_NAME         = "VK_KHR_shader_integer_dot_product"
+	AMD_TEXTURE_GATHER_BIAS_LOD_EXTENSION_NAME            = "VK_AMD_texture_gather_bias_lod"
+	KHR_MAINTENANCE1_EXTENSION_NAME                       = "VK_KHR_maintenance1"
+	EXT_CALIBRATED_TIMESTAMPS_EXTENSION_NAME              = "VK_EXT_calibrated_timestamps"
+	NV_SAMPLE_MASK_OVERRIDE_COVERAGE_SPEC_VERSION         = 1
+	EXT_PIPELINE_CREATION_FEEDBACK_EXTENSION_NAME         = "VK_EXT_pipeline_creation_feedback"
+	EXT_HOST_QUERY_RESET_SPEC_VERSION                     = 1
+	EXT_SHADER_VIEWPORT_INDEX_LAYER_EXTENSION_NAME        = "VK_EXT_shader_viewport_index_layer"
+	KHR_SEPARATE_DEPTH_STENCIL_LAYOUTS_SPEC_VERSION       = 1
+	AMD_SHADER_BALLOT_SPEC_VERSION                        = 1
+	NV_VIEWPORT_ARRAY2_EXTENSION_NAME                     = "VK_NV_viewport_array2"
+	NV_EXTERNAL_MEMORY_RDMA_SPEC_VERSION                  = 1
+	EXT_TRANSFORM_FEEDBACK_EXTENSION_NAME                 = "VK_EXT_transform_feedback"
+	KHR_DEVICE_GROUP_EXTENSION_NAME                       = "VK_KHR_device_group"
+	EXT_4444_FORMATS_EXTENSION_NAME                       = "VK_EXT_4444_formats"
+	NV_SAMPLE_MASK_OVERRIDE_COVERAGE_EXTENSION_NAME       = "VK_NV_sample_mask_override_coverage"
+	INTEL_SHADER_INTEGER_FUNCTIONS_2_SPEC_VERSION         = 1
+	EXT_SHADER_ATOMIC_FLOAT_2_SPEC_VERSION                = 1
+	EXT_SEPARATE_STENCIL_USAGE_EXTENSION_NAME             = "VK_EXT_separate_stencil_usage"
+	EXT_MEMORY_PRIORITY_EXTENSION_NAME                    = "VK_EXT_memory_priority"
+	KHR_GET_SURFACE_CAPABILITIES_2_EXTENSION_NAME         = "VK_KHR_get_surface_capabilities2"
+	KHR_SAMPLER_YCBCR_CONVERSION_SPEC_VERSION             = 14
+	KHR_EXTERNAL_FENCE_CAPABILITIES_SPEC_VERSION          = 1
+	EXT_SHADER_SUBGROUP_VOTE_SPEC_VERSION                 = 1
+	EXT_PRIMITIVE_TOPOLOGY_LIST_RESTART_SPEC_VERSION      = 1
+	EXT_CUSTOM_BORDER_COLOR_SPEC_VERSION                  = 12
+	KHR_8BIT_STORAGE_SPEC_VERSION                         = 1
+	NV_EXTERNAL_MEMORY_EXTENSION_NAME                     = "VK_NV_external_memory"
+	AMD_PIPELINE_COMPILER_CONTROL_EXTENSION_NAME          = "VK_AMD_pipeline_compiler_control"
+	EXT_YCBCR_2PLANE_444_FORMATS_EXTENSION_NAME           = "VK_EXT_ycbcr_2plane_444_formats"
+	NV_INHERITED_VIEWPORT_SCISSOR_SPEC_VERSION            = 1
+)
@@ -0,0 +1,25 @@
+//go:build windows
+// Code generated by go-vk from vk.xml at 2023-02-03 15:01:44.330991 -0600 CST m=+2.340831101. DO NOT EDIT.
+package vk
+
+// Platform-specific values for
+const (
+	KHR_EXTERNAL_SEMAPHORE_WIN32_SPEC_VERSION   = 1
+	NV_ACQUIRE_WINRT_DISPLAY_EXTENSION_NAME     = "VK_NV_acquire_winrt_display"
+	KHR_EXTERNAL_FENCE_WIN32_SPEC_VERSION       = 1
+	NV_EXTERNAL_MEMORY_WIN32_EXTENSION_NAME     = "VK_NV_external_memory_win32"
+	NV_EXTERNAL_MEMORY_WIN32_SPEC_VERSION       = 1
+	NV_WIN32_KEYED_MUTEX_SPEC_VERSION           = 2
+	NV_ACQUIRE_WINRT_DISPLAY_SPEC_VERSION       = 1
+	KHR_EXTERNAL_MEMORY_WIN32_EXTENSION_NAME    = "VK_KHR_external_memory_win32"
+	KHR_EXTERNAL_SEMAPHORE_WIN32_EXTENSION_NAME = "VK_KHR_external_semaphore_win32"
+	KHR_EXTERNAL_MEMORY_WIN32_SPEC_VERSION      = 1
+	KHR_EXTERNAL_FENCE_WIN32_EXTENSION_NAME     = "VK_KHR_external_fence_win32"
+	EXT_FULL_SCREEN_EXCLUSIVE_EXTENSION_NAME    = "VK_EXT_full_screen_exclusive"
+	KHR_WIN32_SURFACE_SPEC_VERSION              = 6
+	EXT_FULL_SCREEN_EXCLUSIVE_SPEC_VERSION      = 4
+	KHR_WIN32_KEYED_MUTEX_SPEC_VERSION          = 1
+	KHR_WIN32_KEYED_MUTEX_EXTENSION_NAME        = "VK_KHR_win32_keyed_mutex"
+	KHR_WIN32_SURFACE_EXTENSION_NAME            = "VK_KHR_win32_surface"
+	NV_WIN32_KEYED_MUTEX_EXTENSION_NAME         = "VK_NV_win32_keyed_mutex"
+)
@@ -0,0 +1,5 @@
+module github.com/bbredesen/go-vk
+
+go 1.18
+
+require golang.org/x/sys v0.4.0
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"unsafe"
+
+	"github.com/bbredesen/go-vk"
+	"github.com/bbredesen/vkm"
+)
+
+// pushConstants mirrors the push constant block declared in shader.vert and quad_shader.vert. Two 4x4 matrices are 128
+// bytes, which is the minimum push constant size every Vulkan implementation must support.
+type pushConstants struct {
+	// projection maps pixel coordinates to clip space
+	projection vkm.Mat
+	// model places the text geometry, which is built in pixels relative to the start of the baseline
+	model vkm.Mat
+}
+
+// pixelProjection returns an orthographic projection from pixel coordinates, with the origin at the top left of the
+// framebuffer and Y increasing down, to Vulkan clip space.
+func pixelProjection(extent vk.Extent2D) vkm.Mat {
+	return vkm.OrthoProjection(float32(extent.Width), float32(extent.Height), 0, 1).
+		Translate(vkm.NewVec(-1, -1, 0))
+}
+
+// textModel returns a model transform placing the start of the baseline at pixel (x, y).
+func textModel(x, y float32) vkm.Mat {
+	return vkm.NewMatTranslate(vkm.NewVec(x, y, 0))
+}
+
+func (pc *pushConstants) bytes() []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(pc)), unsafe.Sizeof(*pc))
+}
@@ -0,0 +1,71 @@
+package ttf
+
+// A collection (.ttc or .otc) starts with a 'ttcf' header that lists the offset of each face's table directory. Faces
+// often share tables, such as glyf, which is why table offsets are always from the start of the file.
+const collectionTag = 0x74746366 // 'ttcf'
+
+// Collection is a TrueType or OpenType collection, a file holding several faces. A single font file can also be read
+// as a collection of one face, so callers don't need to tell them apart.
+type Collection struct {
+	src     []byte
+	offsets []uint32
+}
+
+// IsCollection reports whether src starts with a collection header.
+func IsCollection(src []byte) bool {
+	return len(src) >= 4 && u32(src) == collectionTag
+}
+
+// ParseCollection reads the header of a collection, or of a single font, and returns the offset of every face's table
+// directory. The faces themselves are only decoded by Face and Tables.
+func ParseCollection(src []byte) (*Collection, error) {
+	if !IsCollection(src) {
+		if len(src) < 12 {
+			return nil, ErrInvalidFont
+		}
+		return &Collection{src: src, offsets: []uint32{0}}, nil
+	}
+
+	// Versions 1.0 and 2.0 share the start of the header; 2.0 adds a DSIG table after the offsets, which isn't needed
+	if len(src) < 12 {
+		return nil, ErrInvalidFont
+	}
+	numFonts := int(u32(src[8:]))
+	if numFonts == 0 || len(src) < 12+4*numFonts {
+		return nil, ErrInvalidFont
+	}
+
+	c := &Collection{src: src, offsets: make([]uint32, numFonts)}
+	for i := range c.offsets {
+		c.offsets[i] = u32(src[12+4*i:])
+		if uint64(c.offsets[i])+12 > uint64(len(src)) {
+			return nil, ErrInvalidFont
+		}
+	}
+	return c, nil
+}
+
+// NumFaces returns the number of faces in the collection.
+func (c *Collection) NumFaces() int {
+	return len(c.offsets)
+}
+
+// Face decodes face i of the collection, as Parse does for a single font.
+func (c *Collection) Face(i int) (*Font, error) {
+	if i < 0 || i >= len(c.offsets) {
+		return nil, ErrFaceOutOfRange
+	}
+	return parse(c.src, int(c.offsets[i]))
+}
+
+// Tables returns the raw bytes of every table of face i, keyed by tag, as ReadTables does for a single font.
+func (c *Collection) Tables(i int) (map[string][]byte, error) {
+	if i < 0 || i >= len(c.offsets) {
+		return nil, ErrFaceOutOfRange
+	}
+	f := &Font{src: c.src}
+	if err := f.parseTableDirectory(int(c.offsets[i])); err != nil {
+		return nil, err
+	}
+	return f.tables, nil
+}
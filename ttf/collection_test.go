@@ -0,0 +1,147 @@
+package ttf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/bbredesen/ttf-renderer/internal/otbuild"
+)
+
+// testCollection returns a collection of Go-Regular and Go-Mono, and the two fonts on their own.
+func testCollection(t *testing.T) (src []byte, regular, mono *Font) {
+	t.Helper()
+	regular, regularTables := loadFont(t, "Go-Regular.ttf")
+	mono, monoTables := loadFont(t, "Go-Mono.ttf")
+	return otbuild.Collection(regularTables, monoTables), regular, mono
+}
+
+func TestCollection(t *testing.T) {
+	src, regular, mono := testCollection(t)
+	if !IsCollection(src) {
+		t.Fatal("IsCollection is false for a ttcf header")
+	}
+	c, err := ParseCollection(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.NumFaces() != 2 {
+		t.Fatalf("got %d faces, want 2", c.NumFaces())
+	}
+
+	// Each face reads the tables listed in its own directory, not those of the first face
+	for i, want := range []*Font{regular, mono} {
+		tables, err := c.Tables(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, tag := range []string{"glyf", "loca", "head", "hmtx"} {
+			if !bytes.Equal(tables[tag], want.Table(tag)) {
+				t.Errorf("face %d has a different %s table", i, tag)
+			}
+		}
+
+		f, err := c.Face(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.NumGlyphs != want.NumGlyphs {
+			t.Errorf("face %d has %d glyphs, want %d", i, f.NumGlyphs, want.NumGlyphs)
+		}
+		got, err := f.LoadGlyph(glyphO)
+		if err != nil {
+			t.Fatal(err)
+		}
+		o, err := want.LoadGlyph(glyphO)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, o) {
+			t.Errorf("face %d has a different 'o'", i)
+		}
+	}
+
+	for _, i := range []int{-1, 2} {
+		if _, err := c.Face(i); !errors.Is(err, ErrFaceOutOfRange) {
+			t.Errorf("Face(%d): got error %v, want %v", i, err, ErrFaceOutOfRange)
+		}
+		if _, err := c.Tables(i); !errors.Is(err, ErrFaceOutOfRange) {
+			t.Errorf("Tables(%d): got error %v, want %v", i, err, ErrFaceOutOfRange)
+		}
+	}
+}
+
+// TestSingleFontCollection reads a plain font file as a collection of one face.
+func TestSingleFontCollection(t *testing.T) {
+	_, tables := loadFont(t, "Go-Regular.ttf")
+	src := otbuild.Font(tables)
+	if IsCollection(src) {
+		t.Error("IsCollection is true for a single font")
+	}
+	c, err := ParseCollection(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.NumFaces() != 1 {
+		t.Fatalf("got %d faces, want 1", c.NumFaces())
+	}
+	if _, err := c.Face(0); err != nil {
+		t.Error(err)
+	}
+	if _, err := c.Face(1); !errors.Is(err, ErrFaceOutOfRange) {
+		t.Errorf("Face(1): got error %v, want %v", err, ErrFaceOutOfRange)
+	}
+}
+
+func TestInvalidCollection(t *testing.T) {
+	src, _, _ := testCollection(t)
+
+	// withU32 returns a copy of src with the uint32 at offset i replaced by v
+	withU32 := func(i int, v uint32) []byte {
+		b := append([]byte(nil), src...)
+		binary.BigEndian.PutUint32(b[i:], v)
+		return b
+	}
+
+	for _, test := range []struct {
+		name string
+		src  []byte
+	}{
+		{"truncated header", src[:10]},
+		{"truncated offsets", src[:12+4]},
+		{"no faces", withU32(8, 0)},
+		{"more faces than offsets", withU32(8, uint32(len(src)))},
+		{"face past the end", withU32(16, uint32(len(src)))},
+		{"face directory cut short", withU32(16, uint32(len(src)-4))},
+	} {
+		if _, err := ParseCollection(test.src); !errors.Is(err, ErrInvalidFont) {
+			t.Errorf("%s: got error %v, want %v", test.name, err, ErrInvalidFont)
+		}
+	}
+
+	// A table that runs past the end of the file is only found when the face is read
+	dir := int(binary.BigEndian.Uint32(src[16:]))
+	c, err := ParseCollection(withU32(dir+12+8, uint32(len(src))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Face(1); !errors.Is(err, ErrInvalidFont) {
+		t.Errorf("got error %v from a table past the end of the file, want %v", err, ErrInvalidFont)
+	}
+	if _, err := c.Face(0); err != nil {
+		t.Errorf("the first face, whose tables are intact: %v", err)
+	}
+}
+
+func TestZeroUnitsPerEm(t *testing.T) {
+	_, tables := loadFont(t, "Go-Regular.ttf")
+	head := append([]byte(nil), tables["head"]...)
+	binary.BigEndian.PutUint16(head[18:], 0)
+	tables["head"] = head
+
+	if _, err := Parse(otbuild.Font(tables)); !errors.Is(err, ErrInvalidFont) {
+		t.Errorf("got error %v from a head table with zero units per em, want %v", err, ErrInvalidFont)
+	}
+}
@@ -0,0 +1,198 @@
+// Package ttf reads glyph outlines directly from the glyf and loca tables of a TrueType font. Unlike sfnt.LoadGlyph,
+// outlines are returned unscaled, in font units, so callers can apply their own exact transform and compute true
+// bounds from the geometry. The glyphs of a variable font can be loaded at any instance of its design space with
+// SetVariation.
+package ttf
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+var (
+	ErrInvalidFont     = errors.New("ttf: invalid font data")
+	ErrMissingTable    = errors.New("ttf: required table not found")
+	ErrNotTrueType     = errors.New("ttf: font does not contain TrueType (glyf) outlines")
+	ErrGlyphOutOfRange = errors.New("ttf: glyph index out of range")
+	ErrFaceOutOfRange  = errors.New("ttf: face index out of range")
+)
+
+// Font is a parsed TrueType font. Only the tables needed to extract outlines are decoded up front; any other table
+// can be fetched by tag with Table.
+type Font struct {
+	src    []byte
+	tables map[string][]byte
+
+	UnitsPerEm uint16
+	// Bounds is the font-wide bounding box from the head table: xMin, yMin, xMax, yMax in font units.
+	Bounds [4]int16
+
+	NumGlyphs int
+
+	// Axes and Instances describe the design space of a variable font, and are empty for any other font
+	Axes      []Axis
+	Instances []NamedInstance
+
+	loca []uint32
+	glyf []byte
+
+	// coords are the normalized coordinates of the instance selected by SetVariation, or nil for the default instance
+	coords []float32
+	gvar   *gvarTable
+	hvar   *hvarTable
+	hmtx   *hmtxTable
+}
+
+// Parse reads the table directory of a TrueType font and decodes the head, maxp and loca tables. It returns
+// ErrNotTrueType if the font has no glyf table, e.g. an OpenType font with CFF outlines. Use ParseCollection for a
+// font collection.
+func Parse(src []byte) (*Font, error) {
+	return parse(src, 0)
+}
+
+// parse decodes the face whose table directory is at offset in src.
+func parse(src []byte, offset int) (*Font, error) {
+	f := &Font{src: src}
+
+	if err := f.parseTableDirectory(offset); err != nil {
+		return nil, err
+	}
+
+	if f.tables["glyf"] == nil {
+		return nil, ErrNotTrueType
+	}
+
+	indexToLocFormat, err := f.parseHead()
+	if err != nil {
+		return nil, err
+	}
+	if err := f.parseMaxp(); err != nil {
+		return nil, err
+	}
+	if err := f.parseLoca(indexToLocFormat); err != nil {
+		return nil, err
+	}
+	f.glyf = f.tables["glyf"]
+
+	if err := f.parseFvar(); err != nil {
+		return nil, err
+	}
+	if f.gvar, err = parseGvar(f.tables["gvar"], len(f.Axes), f.NumGlyphs); err != nil {
+		return nil, err
+	}
+	if f.hvar, err = parseHvar(f.tables["HVAR"], len(f.Axes)); err != nil {
+		return nil, err
+	}
+	if f.hmtx, err = parseHmtx(f.tables["hhea"], f.tables["hmtx"], f.NumGlyphs); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// Table returns the raw bytes of the table with the given tag, or nil if the font does not contain that table.
+func (f *Font) Table(tag string) []byte {
+	return f.tables[tag]
+}
+
+// ReadTables returns the raw bytes of every table in an sfnt font, keyed by tag, without decoding any of them. Unlike
+// Parse, this works for any outline format, so it can be used to read the OpenType layout tables of a CFF font.
+func ReadTables(src []byte) (map[string][]byte, error) {
+	f := &Font{src: src}
+	if err := f.parseTableDirectory(0); err != nil {
+		return nil, err
+	}
+	return f.tables, nil
+}
+
+func (f *Font) parseTableDirectory(offset int) error {
+	if len(f.src) < offset+12 {
+		return ErrInvalidFont
+	}
+	numTables := int(u16(f.src[offset+4:]))
+
+	if len(f.src) < offset+12+16*numTables {
+		return ErrInvalidFont
+	}
+
+	f.tables = make(map[string][]byte, numTables)
+	for i := 0; i < numTables; i++ {
+		rec := f.src[offset+12+16*i:]
+		tag := string(rec[0:4])
+		tOffset, tLength := u32(rec[8:]), u32(rec[12:])
+
+		if uint64(tOffset)+uint64(tLength) > uint64(len(f.src)) {
+			return ErrInvalidFont
+		}
+		f.tables[tag] = f.src[tOffset : tOffset+tLength]
+	}
+	return nil
+}
+
+func (f *Font) parseHead() (indexToLocFormat int16, err error) {
+	head := f.tables["head"]
+	if head == nil {
+		return 0, ErrMissingTable
+	}
+	if len(head) < 54 || u32(head[12:]) != 0x5F0F3CF5 {
+		return 0, ErrInvalidFont
+	}
+
+	// Every outline is scaled by ppem over units per em
+	f.UnitsPerEm = u16(head[18:])
+	if f.UnitsPerEm == 0 {
+		return 0, ErrInvalidFont
+	}
+	for i := range f.Bounds {
+		f.Bounds[i] = int16(u16(head[36+2*i:]))
+	}
+
+	return int16(u16(head[50:])), nil
+}
+
+func (f *Font) parseMaxp() error {
+	maxp := f.tables["maxp"]
+	if maxp == nil {
+		return ErrMissingTable
+	}
+	if len(maxp) < 6 {
+		return ErrInvalidFont
+	}
+	f.NumGlyphs = int(u16(maxp[4:]))
+	return nil
+}
+
+func (f *Font) parseLoca(indexToLocFormat int16) error {
+	loca := f.tables["loca"]
+	if loca == nil {
+		return ErrMissingTable
+	}
+
+	n := f.NumGlyphs + 1
+	f.loca = make([]uint32, n)
+
+	switch indexToLocFormat {
+	case 0:
+		// Short offsets are stored divided by two
+		if len(loca) < 2*n {
+			return ErrInvalidFont
+		}
+		for i := range f.loca {
+			f.loca[i] = 2 * uint32(u16(loca[2*i:]))
+		}
+	case 1:
+		if len(loca) < 4*n {
+			return ErrInvalidFont
+		}
+		for i := range f.loca {
+			f.loca[i] = u32(loca[4*i:])
+		}
+	default:
+		return ErrInvalidFont
+	}
+
+	return nil
+}
+
+func u16(b []byte) uint16 { return binary.BigEndian.Uint16(b) }
+func u32(b []byte) uint32 { return binary.BigEndian.Uint32(b) }
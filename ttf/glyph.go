@@ -0,0 +1,427 @@
+package ttf
+
+import (
+	"math"
+
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// Point is an outline point in font units, with the Y axis increasing up as stored in the font.
+type Point struct {
+	X, Y    float32
+	OnCurve bool
+}
+
+// Contour is a closed outline. The first point is always on-curve, and implied on-curve midpoints between consecutive
+// off-curve points have been made explicit, so every off-curve point is the control point of exactly one quadratic
+// segment.
+type Contour []Point
+
+// Rect is an axis-aligned rectangle in font units.
+type Rect struct {
+	XMin, YMin, XMax, YMax float32
+}
+
+// Glyph is the decoded outline of a single glyph. Composite glyphs have been flattened, with each component's
+// transform applied.
+type Glyph struct {
+	Contours []Contour
+}
+
+// Glyph flags, from the OpenType glyf specification
+const (
+	flagOnCurve    = 0x01
+	flagXShort     = 0x02
+	flagYShort     = 0x04
+	flagRepeat     = 0x08
+	flagXSameOrPos = 0x10
+	flagYSameOrPos = 0x20
+)
+
+// Composite glyph component flags
+const (
+	argsAreWords          = 0x0001
+	argsAreXYValues       = 0x0002
+	weHaveAScale          = 0x0008
+	moreComponents        = 0x0020
+	weHaveAnXAndYScale    = 0x0040
+	weHaveATwoByTwo       = 0x0080
+	scaledComponentOffset = 0x0800
+)
+
+// maxCompositeDepth guards against malicious or broken fonts with cyclic component references.
+const maxCompositeDepth = 8
+
+// LoadGlyph decodes the outline of glyph x. Glyphs without an outline (such as a space) return a Glyph with no
+// contours.
+func (f *Font) LoadGlyph(x sfnt.GlyphIndex) (*Glyph, error) {
+	points, ends, err := f.loadPoints(int(x), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Glyph{Contours: buildContours(points, ends)}, nil
+}
+
+// loadPoints returns the raw points of glyph x, as stored in the font, along with the index of the last point of each
+// contour. Implied midpoints are not yet inserted, because composite point matching refers to raw point numbers.
+func (f *Font) loadPoints(x int, depth int) (points []Point, ends []int, err error) {
+	if x < 0 || x >= f.NumGlyphs {
+		return nil, nil, ErrGlyphOutOfRange
+	}
+	if depth > maxCompositeDepth {
+		return nil, nil, ErrInvalidFont
+	}
+
+	start, end := f.loca[x], f.loca[x+1]
+	if start == end {
+		return nil, nil, nil
+	}
+	if start > end || int(end) > len(f.glyf) {
+		return nil, nil, ErrInvalidFont
+	}
+
+	r := newReader(f.glyf[start:end])
+	numContours := int16(r.U16())
+	r.Skip(8) // Header bounds; we compute our own from the points
+
+	if numContours >= 0 {
+		points, ends = decodeSimple(r, int(numContours))
+	} else {
+		points, ends, err = f.decodeComposite(r, x, depth)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if r.Err() != nil {
+		return nil, nil, r.Err()
+	}
+
+	// In a variable font, gvar moves the points of a simple glyph. Points that a variation leaves out are interpolated
+	// from the original positions of those it moves.
+	if numContours > 0 && f.coords != nil && f.gvar != nil {
+		deltas, err := f.gvar.glyphDeltas(x, f.coords, points, ends, len(points)+phantomPointsPerGlyph)
+		if err != nil {
+			return nil, nil, err
+		}
+		for i := 0; i < len(deltas) && i < len(points); i++ {
+			points[i].X += deltas[i].x
+			points[i].Y += deltas[i].y
+		}
+	}
+
+	return points, ends, nil
+}
+
+func decodeSimple(r *reader, numContours int) (points []Point, ends []int) {
+	if numContours == 0 {
+		return nil, nil
+	}
+
+	ends = make([]int, numContours)
+	for i := range ends {
+		ends[i] = int(r.U16())
+		if i > 0 && ends[i] < ends[i-1] {
+			r.Fail()
+			return nil, nil
+		}
+	}
+	numPoints := ends[numContours-1] + 1
+
+	// Skip hinting instructions
+	r.Skip(int(r.U16()))
+
+	flags := make([]uint8, 0, numPoints)
+	for len(flags) < numPoints && r.Err() == nil {
+		fl := r.U8()
+		flags = append(flags, fl)
+		if fl&flagRepeat != 0 {
+			for count := r.U8(); count > 0 && len(flags) < numPoints; count-- {
+				flags = append(flags, fl)
+			}
+		}
+	}
+	if r.Err() != nil {
+		return nil, nil
+	}
+
+	points = make([]Point, numPoints)
+
+	var x int32
+	for i, fl := range flags {
+		switch {
+		case fl&flagXShort != 0 && fl&flagXSameOrPos != 0:
+			x += int32(r.U8())
+		case fl&flagXShort != 0:
+			x -= int32(r.U8())
+		case fl&flagXSameOrPos == 0:
+			x += int32(int16(r.U16()))
+		}
+		points[i].X = float32(x)
+		points[i].OnCurve = fl&flagOnCurve != 0
+	}
+
+	var y int32
+	for i, fl := range flags {
+		switch {
+		case fl&flagYShort != 0 && fl&flagYSameOrPos != 0:
+			y += int32(r.U8())
+		case fl&flagYShort != 0:
+			y -= int32(r.U8())
+		case fl&flagYSameOrPos == 0:
+			y += int32(int16(r.U16()))
+		}
+		points[i].Y = float32(y)
+	}
+
+	return points, ends
+}
+
+// component is one of the glyphs a composite glyph is made of, placed with a transform: x' = a*x + c*y + dx,
+// y' = b*x + d*y + dy. arg1 and arg2 are either the offset (dx, dy) or, for point matching, a pair of point numbers.
+type component struct {
+	flags      uint16
+	glyph      int
+	arg1, arg2 int32
+	a, b, c, d float32
+}
+
+// readComponents reads the component records of a composite glyph.
+func readComponents(r *reader) ([]component, error) {
+	var components []component
+	for {
+		c := component{flags: r.U16(), glyph: int(r.U16()), a: 1, d: 1}
+
+		switch {
+		case c.flags&argsAreWords != 0 && c.flags&argsAreXYValues != 0:
+			c.arg1, c.arg2 = int32(int16(r.U16())), int32(int16(r.U16()))
+		case c.flags&argsAreWords != 0:
+			c.arg1, c.arg2 = int32(r.U16()), int32(r.U16())
+		case c.flags&argsAreXYValues != 0:
+			c.arg1, c.arg2 = int32(int8(r.U8())), int32(int8(r.U8()))
+		default:
+			c.arg1, c.arg2 = int32(r.U8()), int32(r.U8())
+		}
+
+		switch {
+		case c.flags&weHaveAScale != 0:
+			c.a = r.F2Dot14()
+			c.d = c.a
+		case c.flags&weHaveAnXAndYScale != 0:
+			c.a, c.d = r.F2Dot14(), r.F2Dot14()
+		case c.flags&weHaveATwoByTwo != 0:
+			c.a, c.b, c.c, c.d = r.F2Dot14(), r.F2Dot14(), r.F2Dot14(), r.F2Dot14()
+		}
+
+		if r.Err() != nil {
+			return nil, r.Err()
+		}
+		components = append(components, c)
+
+		if c.flags&moreComponents == 0 {
+			return components, nil
+		}
+	}
+}
+
+// decodeComposite loads and places the components of glyph x. In a variable font, gvar moves each component's offset.
+func (f *Font) decodeComposite(r *reader, x, depth int) (points []Point, ends []int, err error) {
+	components, err := readComponents(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var deltas []delta
+	if f.coords != nil && f.gvar != nil {
+		deltas, err = f.gvar.glyphDeltas(x, f.coords, nil, nil, len(components)+phantomPointsPerGlyph)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for k, comp := range components {
+		a, b, c, d := comp.a, comp.b, comp.c, comp.d
+
+		cPoints, cEnds, err := f.loadPoints(comp.glyph, depth+1)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for i, p := range cPoints {
+			cPoints[i].X = a*p.X + c*p.Y
+			cPoints[i].Y = b*p.X + d*p.Y
+		}
+
+		var dx, dy float32
+		if comp.flags&argsAreXYValues != 0 {
+			dx, dy = float32(comp.arg1), float32(comp.arg2)
+			if deltas != nil {
+				dx, dy = dx+deltas[k].x, dy+deltas[k].y
+			}
+			if comp.flags&scaledComponentOffset != 0 {
+				dx, dy = a*dx+c*dy, b*dx+d*dy
+			}
+		} else {
+			// Point matching: arg1 is a point number in the glyph so far, arg2 is a point number in the component. The
+			// component is moved so that the two points coincide.
+			if int(comp.arg1) >= len(points) || int(comp.arg2) >= len(cPoints) {
+				return nil, nil, ErrInvalidFont
+			}
+			dx, dy = points[comp.arg1].X-cPoints[comp.arg2].X, points[comp.arg1].Y-cPoints[comp.arg2].Y
+		}
+
+		base := len(points)
+		for _, p := range cPoints {
+			p.X += dx
+			p.Y += dy
+			points = append(points, p)
+		}
+		for _, e := range cEnds {
+			ends = append(ends, base+e)
+		}
+	}
+
+	return points, ends, nil
+}
+
+// buildContours splits the raw point list into contours, rotating each so that it begins on-curve and inserting the
+// implied on-curve midpoint between every pair of consecutive off-curve points.
+func buildContours(points []Point, ends []int) []Contour {
+	contours := make([]Contour, 0, len(ends))
+
+	start := 0
+	for _, end := range ends {
+		raw := points[start : end+1]
+		start = end + 1
+
+		n := len(raw)
+		if n == 0 {
+			continue
+		}
+
+		// Find the first on-curve point. If there isn't one, the contour starts at the implied midpoint between the
+		// last and first points.
+		first := -1
+		for i, p := range raw {
+			if p.OnCurve {
+				first = i
+				break
+			}
+		}
+
+		// Walk the remaining points. When the contour starts on an existing point, that point is not visited again, so
+		// the contour is left implicitly closed.
+		contour := make(Contour, 0, 2*n)
+		count := n
+		if first < 0 {
+			contour = append(contour, midpoint(raw[n-1], raw[0]))
+			first = 0
+		} else {
+			contour = append(contour, raw[first])
+			first++
+			count--
+		}
+
+		for i := 0; i < count; i++ {
+			p := raw[(first+i)%n]
+			prev := contour[len(contour)-1]
+			if !p.OnCurve && !prev.OnCurve {
+				contour = append(contour, midpoint(prev, p))
+			}
+			contour = append(contour, p)
+		}
+
+		// If the last point is off-curve, it curves back to contour[0], which is always on-curve.
+		contours = append(contours, contour)
+	}
+
+	return contours
+}
+
+func midpoint(p, q Point) Point {
+	return Point{X: (p.X + q.X) / 2, Y: (p.Y + q.Y) / 2, OnCurve: true}
+}
+
+// Bounds returns the bounding box of every on- and off-curve point in the glyph. A quadratic segment never leaves the
+// hull of its control points, so the box always contains the rendered outline. A glyph with no contours has a zero
+// Rect.
+func (g *Glyph) Bounds() Rect {
+	if len(g.Contours) == 0 {
+		return Rect{}
+	}
+
+	r := Rect{
+		XMin: math.MaxFloat32, YMin: math.MaxFloat32,
+		XMax: -math.MaxFloat32, YMax: -math.MaxFloat32,
+	}
+	for _, c := range g.Contours {
+		for _, p := range c {
+			r.XMin, r.XMax = min32(r.XMin, p.X), max32(r.XMax, p.X)
+			r.YMin, r.YMax = min32(r.YMin, p.Y), max32(r.YMax, p.Y)
+		}
+	}
+	return r
+}
+
+// Segments converts the glyph outline to sfnt segments, in font units. To match sfnt.LoadGlyph, the Y axis is flipped
+// so that it increases down, and every contour is explicitly closed back to its starting point.
+func (g *Glyph) Segments() sfnt.Segments {
+	var segs sfnt.Segments
+
+	for _, c := range g.Contours {
+		n := len(c)
+		segs = append(segs, sfnt.Segment{
+			Op:   sfnt.SegmentOpMoveTo,
+			Args: [3]fixed.Point26_6{c[0].fixed()},
+		})
+
+		for i := 1; i <= n; i++ {
+			p := c[i%n]
+			if p.OnCurve {
+				segs = append(segs, sfnt.Segment{
+					Op:   sfnt.SegmentOpLineTo,
+					Args: [3]fixed.Point26_6{p.fixed()},
+				})
+			} else {
+				i++
+				segs = append(segs, sfnt.Segment{
+					Op:   sfnt.SegmentOpQuadTo,
+					Args: [3]fixed.Point26_6{p.fixed(), c[i%n].fixed()},
+				})
+			}
+		}
+	}
+
+	return segs
+}
+
+// Fixed converts r to a 26.6 rectangle in font units, with the Y axis flipped to increase down as in Segments.
+func (r Rect) Fixed() fixed.Rectangle26_6 {
+	return fixed.Rectangle26_6{
+		Min: Point{X: r.XMin, Y: r.YMax}.fixed(),
+		Max: Point{X: r.XMax, Y: r.YMin}.fixed(),
+	}
+}
+
+func (p Point) fixed() fixed.Point26_6 {
+	return fixed.Point26_6{
+		X: fixed.Int26_6(math.Round(float64(p.X) * 64)),
+		Y: fixed.Int26_6(math.Round(float64(-p.Y) * 64)),
+	}
+}
+
+func min32(a, b float32) float32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max32(a, b float32) float32 {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,263 @@
+package ttf
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/bbredesen/ttf-renderer/internal/otbuild"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+const fontDir = "../testdata/fonts"
+
+// loadFont reads a font from testdata, with its tables.
+func loadFont(t *testing.T, name string) (*Font, map[string][]byte) {
+	t.Helper()
+	src, err := os.ReadFile(filepath.Join(fontDir, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := Parse(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tables, err := ReadTables(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f, tables
+}
+
+// replaceGlyphs rewrites the glyf and loca tables of f into tables, with the given glyphs in place of the originals.
+// The glyphs are rewritten with long offsets.
+func replaceGlyphs(f *Font, tables map[string][]byte, glyphs map[int][]byte) {
+	var glyf []byte
+	loca := make(otbuild.Table, f.NumGlyphs+1)
+	for i := 0; i < f.NumGlyphs; i++ {
+		loca[i] = uint32(len(glyf))
+		if g, ok := glyphs[i]; ok {
+			glyf = append(glyf, g...)
+		} else {
+			glyf = append(glyf, f.glyf[f.loca[i]:f.loca[i+1]]...)
+		}
+	}
+	loca[f.NumGlyphs] = uint32(len(glyf))
+	tables["glyf"], tables["loca"] = glyf, loca.Bytes()
+
+	head := append([]byte(nil), tables["head"]...)
+	binary.BigEndian.PutUint16(head[50:], 1)
+	tables["head"] = head
+}
+
+// sameSegments reports whether a and b have the same ops, with points no more than half a unit apart. sfnt rounds the
+// implied on-curve point between two off-curve points down to a whole unit, where Segments keeps it exact.
+func sameSegments(a, b sfnt.Segments) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Op != b[i].Op {
+			return false
+		}
+		for j := range a[i].Args {
+			d := a[i].Args[j].Sub(b[i].Args[j])
+			if d.X < -32 || d.X > 32 || d.Y < -32 || d.Y > 32 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// TestSegmentsMatchSfnt loads every glyph of the Go fonts, which sfnt also decodes, at a ppem equal to the units per
+// em so that both are in font units.
+func TestSegmentsMatchSfnt(t *testing.T) {
+	for _, name := range []string{"Go-Regular.ttf", "Go-Bold-Italic.ttf", "Go-Mono.ttf"} {
+		t.Run(name, func(t *testing.T) {
+			f, _ := loadFont(t, name)
+			src, err := os.ReadFile(filepath.Join(fontDir, name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			sf, err := sfnt.Parse(src)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var b sfnt.Buffer
+			ppem := fixed.I(int(sf.UnitsPerEm()))
+			for x := 0; x < f.NumGlyphs; x++ {
+				want, err := sf.LoadGlyph(&b, sfnt.GlyphIndex(x), ppem, &sfnt.LoadGlyphOptions{})
+				if err != nil {
+					t.Fatalf("sfnt can't load glyph %d: %v", x, err)
+				}
+				g, err := f.LoadGlyph(sfnt.GlyphIndex(x))
+				if err != nil {
+					t.Fatalf("glyph %d: %v", x, err)
+				}
+				if got := g.Segments(); !sameSegments(got, want) {
+					t.Errorf("glyph %d has segments\n%v\nwant\n%v", x, got, want)
+				}
+			}
+		})
+	}
+}
+
+// composite encodes a composite glyph from component records, each a flags word, a glyph, and its arguments and
+// scale. moreComponents is set on every record but the last.
+func composite(components ...otbuild.Table) []byte {
+	glyph := otbuild.Table{-1, 0, 0, 0, 0}
+	for i, c := range components {
+		if i+1 < len(components) {
+			c = append(otbuild.Table{c[0].(int) | moreComponents}, c[1:]...)
+		}
+		glyph = append(glyph, c...)
+	}
+	return glyph.Bytes()
+}
+
+func TestComposite(t *testing.T) {
+	f, tables := loadFont(t, "Go-Regular.ttf")
+	lPoints, _, err := f.loadPoints(glyphL, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oPoints, oEnds, err := f.loadPoints(glyphO, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Point 3 of a half size 'o' is matched to point 2 of 'l'. The other glyphs place 'o' at twice its width and half
+	// its height, with an offset scaled along with it; and turned a quarter turn counterclockwise, with an offset that
+	// isn't.
+	const (
+		glyphMatched   = glyphComposite
+		glyphScaled    = glyphComposite + 1
+		glyphTwoByTwo  = glyphComposite + 2
+		matchedPoint   = 2
+		componentPoint = 3
+	)
+	replaceGlyphs(f, tables, map[int][]byte{
+		glyphMatched: composite(
+			otbuild.Table{argsAreXYValues, glyphL, uint8(0), uint8(0)},
+			otbuild.Table{weHaveAScale, glyphO, uint8(matchedPoint), uint8(componentPoint), otbuild.F2Dot14(0.5)},
+		),
+		glyphScaled: composite(
+			otbuild.Table{argsAreWords | argsAreXYValues | weHaveAnXAndYScale | scaledComponentOffset, glyphO, 100, -50,
+				otbuild.F2Dot14(1.99993896484375), otbuild.F2Dot14(0.5)},
+		),
+		glyphTwoByTwo: composite(
+			otbuild.Table{argsAreXYValues | weHaveATwoByTwo, glyphO, uint8(10), uint8(20),
+				otbuild.F2Dot14(0), otbuild.F2Dot14(1), otbuild.F2Dot14(-1), otbuild.F2Dot14(0)},
+		),
+	})
+	f, err = Parse(otbuild.Font(tables))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The matched points coincide, and the rest of the 'o' keeps its shape around them
+	points, ends, err := f.loadPoints(glyphMatched, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(points) != len(lPoints)+len(oPoints) {
+		t.Fatalf("got %d points, want %d", len(points), len(lPoints)+len(oPoints))
+	}
+	if !reflect.DeepEqual(points[:len(lPoints)], lPoints) {
+		t.Errorf("the 'l' moved")
+	}
+	anchor := points[len(lPoints)+componentPoint]
+	if anchor.X != lPoints[matchedPoint].X || anchor.Y != lPoints[matchedPoint].Y {
+		t.Errorf("point %d of the 'o' is at (%v, %v), want it on point %d of the 'l' at (%v, %v)", componentPoint,
+			anchor.X, anchor.Y, matchedPoint, lPoints[matchedPoint].X, lPoints[matchedPoint].Y)
+	}
+	for i, p := range oPoints {
+		got := points[len(lPoints)+i]
+		wantX := anchor.X + (p.X-oPoints[componentPoint].X)/2
+		wantY := anchor.Y + (p.Y-oPoints[componentPoint].Y)/2
+		if got.X != wantX || got.Y != wantY || got.OnCurve != p.OnCurve {
+			t.Errorf("point %d of the 'o' is %+v, want (%v, %v)", i, got, wantX, wantY)
+		}
+	}
+	if len(ends) != 1+len(oEnds) || ends[len(ends)-1] != len(points)-1 {
+		t.Errorf("got contour ends %v for %d points", ends, len(points))
+	}
+
+	for _, test := range []struct {
+		glyph     int
+		transform func(p Point) (x, y float32)
+	}{
+		// A scale of 2 doesn't fit in 2.14, so it is just short of it
+		{glyphScaled, func(p Point) (float32, float32) { return 1.9999390 * (p.X + 100), 0.5 * (p.Y - 50) }},
+		{glyphTwoByTwo, func(p Point) (float32, float32) { return -p.Y + 10, p.X + 20 }},
+	} {
+		points, _, err := f.loadPoints(test.glyph, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(points) != len(oPoints) {
+			t.Fatalf("glyph %d has %d points, want %d", test.glyph, len(points), len(oPoints))
+		}
+		for i, p := range oPoints {
+			x, y := test.transform(p)
+			if !near(points[i].X, x) || !near(points[i].Y, y) {
+				t.Errorf("glyph %d point %d is (%v, %v), want (%v, %v)", test.glyph, i, points[i].X, points[i].Y, x, y)
+			}
+		}
+	}
+}
+
+func near(a, b float32) bool {
+	return a-b < 0.01 && b-a < 0.01
+}
+
+func TestCompositeCycle(t *testing.T) {
+	f, tables := loadFont(t, "Go-Regular.ttf")
+
+	// One composite refers to itself, and two others to each other
+	const glyphSelf, glyphA, glyphB = glyphComposite, glyphComposite + 1, glyphComposite + 2
+	replaceGlyphs(f, tables, map[int][]byte{
+		glyphSelf: composite(otbuild.Table{argsAreXYValues, glyphSelf, uint8(0), uint8(0)}),
+		glyphA:    composite(otbuild.Table{argsAreXYValues, glyphB, uint8(0), uint8(0)}),
+		glyphB: composite(
+			otbuild.Table{argsAreXYValues, glyphL, uint8(0), uint8(0)},
+			otbuild.Table{argsAreXYValues, glyphA, uint8(0), uint8(0)},
+		),
+	})
+	f, err := Parse(otbuild.Font(tables))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, x := range []int{glyphSelf, glyphA, glyphB} {
+		if _, err := f.LoadGlyph(sfnt.GlyphIndex(x)); !errors.Is(err, ErrInvalidFont) {
+			t.Errorf("glyph %d: got error %v, want %v", x, err, ErrInvalidFont)
+		}
+	}
+	if _, err := f.LoadGlyph(glyphL); err != nil {
+		t.Errorf("glyph %d, which isn't part of a cycle: %v", glyphL, err)
+	}
+}
+
+func TestCompositePointOutOfRange(t *testing.T) {
+	f, tables := loadFont(t, "Go-Regular.ttf")
+	replaceGlyphs(f, tables, map[int][]byte{
+		glyphComposite: composite(
+			otbuild.Table{argsAreXYValues, glyphL, uint8(0), uint8(0)},
+			otbuild.Table{0, glyphO, uint8(200), uint8(0)},
+		),
+	})
+	f, err := Parse(otbuild.Font(tables))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.LoadGlyph(glyphComposite); !errors.Is(err, ErrInvalidFont) {
+		t.Errorf("got error %v matching a point the glyph doesn't have, want %v", err, ErrInvalidFont)
+	}
+}
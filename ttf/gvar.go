@@ -0,0 +1,297 @@
+package ttf
+
+// gvarTable holds the glyph variations of a variable TrueType font. Each glyph has a list of tuple variations, each
+// of which moves some of the glyph's points by a delta, scaled by how close the selected instance is to the
+// variation's peak.
+type gvarTable struct {
+	axisCount    int
+	sharedTuples [][]float32
+	data         []byte
+	// offsets of each glyph's variation data in data, with one extra for the end of the last
+	offsets []uint32
+}
+
+// Flags of the tuple variation headers in gvar
+const (
+	sharedPointNumbers    = 0x8000
+	tupleCountMask        = 0x0FFF
+	embeddedPeakTuple     = 0x8000
+	intermediateRegion    = 0x4000
+	privatePointNumbers   = 0x2000
+	tupleIndexMask        = 0x0FFF
+	pointsAreWords        = 0x80
+	pointRunCountMask     = 0x7F
+	deltasAreZero         = 0x80
+	deltasAreWords        = 0x40
+	deltaRunCountMask     = 0x3F
+	phantomPointsPerGlyph = 4
+)
+
+func parseGvar(gvar []byte, axisCount, numGlyphs int) (*gvarTable, error) {
+	if gvar == nil {
+		return nil, nil
+	}
+
+	r := newReader(gvar)
+	r.Skip(4) // version
+	if int(r.U16()) != axisCount {
+		return nil, ErrInvalidFont
+	}
+	sharedTupleCount := int(r.U16())
+	sharedTuplesOffset := int(r.U32())
+	glyphCount := int(r.U16())
+	flags := r.U16()
+	dataOffset := r.U32()
+	if r.Err() != nil || glyphCount != numGlyphs {
+		return nil, ErrInvalidFont
+	}
+
+	g := &gvarTable{axisCount: axisCount, data: gvar, offsets: make([]uint32, glyphCount+1)}
+	for i := range g.offsets {
+		// Short offsets are stored divided by two
+		if flags&1 != 0 {
+			g.offsets[i] = dataOffset + r.U32()
+		} else {
+			g.offsets[i] = dataOffset + 2*uint32(r.U16())
+		}
+		if i > 0 && g.offsets[i] < g.offsets[i-1] {
+			return nil, ErrInvalidFont
+		}
+	}
+	if r.Err() != nil || int(g.offsets[glyphCount]) > len(gvar) {
+		return nil, ErrInvalidFont
+	}
+
+	r.Seek(sharedTuplesOffset)
+	g.sharedTuples = make([][]float32, sharedTupleCount)
+	for i := range g.sharedTuples {
+		g.sharedTuples[i] = readTuple(r, axisCount)
+	}
+	if r.Err() != nil {
+		return nil, r.Err()
+	}
+
+	return g, nil
+}
+
+// delta is the movement of a point, in font units.
+type delta struct {
+	x, y float32
+}
+
+// glyphDeltas returns the movement at coords of each of the n points of glyph x, where the last four are the glyph's
+// phantom points, whose horizontal distance is its advance. For a simple glyph, points and ends are its outline
+// points and contour ends, which are used to interpolate the points a variation leaves out; for a composite glyph,
+// each point is a component's offset, and they are nil.
+func (g *gvarTable) glyphDeltas(x int, coords []float32, points []Point, ends []int, n int) ([]delta, error) {
+	start, end := g.offsets[x], g.offsets[x+1]
+	if start == end {
+		return nil, nil
+	}
+
+	r := newReader(g.data[start:end])
+	tupleCount := r.U16()
+	serialized := newReader(r.Data())
+	serialized.Seek(int(r.U16()))
+
+	var shared []int
+	if tupleCount&sharedPointNumbers != 0 {
+		shared = readPointNumbers(serialized)
+	}
+
+	deltas := make([]delta, n)
+	var tuple []delta
+	var touched []bool
+
+	for t := 0; t < int(tupleCount&tupleCountMask); t++ {
+		size := int(r.U16())
+		index := r.U16()
+
+		var peak, regionStart, regionEnd []float32
+		if index&embeddedPeakTuple != 0 {
+			peak = readTuple(r, g.axisCount)
+		} else if i := int(index & tupleIndexMask); i < len(g.sharedTuples) {
+			peak = g.sharedTuples[i]
+		} else {
+			return nil, ErrInvalidFont
+		}
+		if index&intermediateRegion != 0 {
+			regionStart, regionEnd = readTuple(r, g.axisCount), readTuple(r, g.axisCount)
+		}
+
+		data := newReader(serialized.Bytes(size))
+		if r.Err() != nil || serialized.Err() != nil {
+			return nil, ErrInvalidFont
+		}
+
+		scalar := tupleScalar(coords, peak, regionStart, regionEnd)
+		if scalar == 0 {
+			continue
+		}
+
+		numbers := shared
+		if index&privatePointNumbers != 0 {
+			numbers = readPointNumbers(data)
+		}
+
+		// A nil list of point numbers covers every point
+		count := len(numbers)
+		if numbers == nil {
+			count = n
+		}
+		xs, ys := readDeltas(data, count), readDeltas(data, count)
+		if data.Err() != nil {
+			return nil, data.Err()
+		}
+
+		if numbers == nil {
+			for i := range deltas {
+				deltas[i].x += scalar * xs[i]
+				deltas[i].y += scalar * ys[i]
+			}
+			continue
+		}
+
+		if tuple == nil {
+			tuple, touched = make([]delta, n), make([]bool, n)
+		} else {
+			for i := range tuple {
+				tuple[i], touched[i] = delta{}, false
+			}
+		}
+		for k, p := range numbers {
+			if p < n {
+				tuple[p], touched[p] = delta{xs[k], ys[k]}, true
+			}
+		}
+		if ends != nil {
+			interpolateUntouched(tuple, touched, points, ends)
+		}
+		for i := range deltas {
+			deltas[i].x += scalar * tuple[i].x
+			deltas[i].y += scalar * tuple[i].y
+		}
+	}
+
+	if r.Err() != nil {
+		return nil, r.Err()
+	}
+	return deltas, nil
+}
+
+func readTuple(r *reader, axisCount int) []float32 {
+	tuple := make([]float32, axisCount)
+	for i := range tuple {
+		tuple[i] = r.F2Dot14()
+	}
+	return tuple
+}
+
+// readPointNumbers reads a packed list of point numbers, stored as runs of increments. It returns nil if the list
+// covers every point of the glyph.
+func readPointNumbers(r *reader) []int {
+	count := int(r.U8())
+	if count == 0 {
+		return nil
+	}
+	if count&0x80 != 0 {
+		count = (count&0x7F)<<8 | int(r.U8())
+	}
+
+	numbers := make([]int, 0, count)
+	p := 0
+	for len(numbers) < count && r.Err() == nil {
+		control := r.U8()
+		for run := int(control&pointRunCountMask) + 1; run > 0 && len(numbers) < count; run-- {
+			if control&pointsAreWords != 0 {
+				p += int(r.U16())
+			} else {
+				p += int(r.U8())
+			}
+			numbers = append(numbers, p)
+		}
+	}
+	return numbers
+}
+
+// readDeltas reads n packed deltas, stored as runs of zeros, bytes or words.
+func readDeltas(r *reader, n int) []float32 {
+	deltas := make([]float32, 0, n)
+	for len(deltas) < n && r.Err() == nil {
+		control := r.U8()
+		for run := int(control&deltaRunCountMask) + 1; run > 0 && len(deltas) < n; run-- {
+			switch {
+			case control&deltasAreZero != 0:
+				deltas = append(deltas, 0)
+			case control&deltasAreWords != 0:
+				deltas = append(deltas, float32(int16(r.U16())))
+			default:
+				deltas = append(deltas, float32(int8(r.U8())))
+			}
+		}
+	}
+	if len(deltas) < n {
+		r.Fail()
+	}
+	return deltas
+}
+
+// interpolateUntouched infers the deltas of the points of each contour that a variation doesn't move, from the
+// nearest moved points before and after them along the contour. A point between the two, along an axis, is
+// interpolated; one outside them takes the delta of the nearer. A contour with no moved points stays where it is.
+func interpolateUntouched(deltas []delta, touched []bool, points []Point, ends []int) {
+	start := 0
+	for _, end := range ends {
+		if end >= len(points) {
+			break
+		}
+
+		first := -1
+		for i := start; i <= end; i++ {
+			if touched[i] {
+				first = i
+				break
+			}
+		}
+		if first < 0 {
+			start = end + 1
+			continue
+		}
+
+		// Walk the contour from the first moved point, filling in the gap before each moved point
+		n := end - start + 1
+		prev := first
+		for k := 1; k <= n; k++ {
+			i := start + (first-start+k)%n
+			if !touched[i] {
+				continue
+			}
+			for gap := start + (prev-start+1)%n; gap != i; gap = start + (gap-start+1)%n {
+				deltas[gap].x = interpolateDelta(points[gap].X, points[prev].X, points[i].X, deltas[prev].x, deltas[i].x)
+				deltas[gap].y = interpolateDelta(points[gap].Y, points[prev].Y, points[i].Y, deltas[prev].y, deltas[i].y)
+			}
+			prev = i
+		}
+
+		start = end + 1
+	}
+}
+
+func interpolateDelta(v, a, b, da, db float32) float32 {
+	if a == b {
+		if da == db {
+			return da
+		}
+		return 0
+	}
+	if a > b {
+		a, b, da, db = b, a, db, da
+	}
+	switch {
+	case v <= a:
+		return da
+	case v >= b:
+		return db
+	}
+	return da + (v-a)*(db-da)/(b-a)
+}
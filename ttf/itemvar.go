@@ -0,0 +1,157 @@
+package ttf
+
+// itemVariationStore holds the deltas of values such as advance widths in a variable font, shared by tables like
+// HVAR. Each delta set is a row of deltas, one for each of the regions of the design space the values vary over.
+type itemVariationStore struct {
+	regions []region
+	data    []itemVariationData
+}
+
+// region is a part of the design space, with its start, peak and end along each axis in normalized coordinates.
+type region struct {
+	start, peak, end []float32
+}
+
+type itemVariationData struct {
+	itemCount     int
+	wordCount     int
+	longWords     bool
+	regionIndexes []uint16
+	deltaSets     []byte
+}
+
+func parseItemVariationStore(b []byte, axisCount int) (*itemVariationStore, error) {
+	r := newReader(b)
+	if r.U16() != 1 {
+		return nil, ErrInvalidFont
+	}
+	regionListOffset := int(r.U32())
+	dataCount := int(r.U16())
+
+	s := &itemVariationStore{data: make([]itemVariationData, dataCount)}
+	dataOffsets := make([]int, dataCount)
+	for i := range dataOffsets {
+		dataOffsets[i] = int(r.U32())
+	}
+
+	r.Seek(regionListOffset)
+	if int(r.U16()) != axisCount {
+		return nil, ErrInvalidFont
+	}
+	s.regions = make([]region, r.U16())
+	for i := range s.regions {
+		rg := region{make([]float32, axisCount), make([]float32, axisCount), make([]float32, axisCount)}
+		for k := 0; k < axisCount; k++ {
+			rg.start[k], rg.peak[k], rg.end[k] = r.F2Dot14(), r.F2Dot14(), r.F2Dot14()
+		}
+		s.regions[i] = rg
+	}
+
+	for i, offset := range dataOffsets {
+		r.Seek(offset)
+		d := &s.data[i]
+		d.itemCount = int(r.U16())
+		wordDeltaCount := r.U16()
+		d.wordCount, d.longWords = int(wordDeltaCount&0x7FFF), wordDeltaCount&0x8000 != 0
+		d.regionIndexes = make([]uint16, r.U16())
+		for k := range d.regionIndexes {
+			d.regionIndexes[k] = r.U16()
+			if int(d.regionIndexes[k]) >= len(s.regions) {
+				return nil, ErrInvalidFont
+			}
+		}
+		if d.wordCount > len(d.regionIndexes) {
+			return nil, ErrInvalidFont
+		}
+		d.deltaSets = r.Bytes(d.itemCount * d.rowSize())
+	}
+
+	if r.Err() != nil {
+		return nil, r.Err()
+	}
+	return s, nil
+}
+
+// rowSize is the size of a delta set: words, or 32-bit values with longWords, for the first wordCount regions, and
+// bytes, or words with longWords, for the rest.
+func (d *itemVariationData) rowSize() int {
+	word, short := 2, 1
+	if d.longWords {
+		word, short = 4, 2
+	}
+	return word*d.wordCount + short*(len(d.regionIndexes)-d.wordCount)
+}
+
+// delta returns the adjustment at coords of the value whose delta set is item inner of data outer.
+func (s *itemVariationStore) delta(outer, inner int, coords []float32) float32 {
+	if outer >= len(s.data) || inner >= s.data[outer].itemCount {
+		return 0
+	}
+	d := &s.data[outer]
+	r := newReader(d.deltaSets)
+	r.Seek(inner * d.rowSize())
+
+	var sum float32
+	for k, regionIndex := range d.regionIndexes {
+		var v int32
+		switch {
+		case k < d.wordCount && d.longWords:
+			v = int32(r.U32())
+		case k < d.wordCount, d.longWords:
+			v = int32(int16(r.U16()))
+		default:
+			v = int32(int8(r.U8()))
+		}
+
+		rg := s.regions[regionIndex]
+		if scalar := tupleScalar(coords, rg.peak, rg.start, rg.end); scalar != 0 {
+			sum += scalar * float32(v)
+		}
+	}
+	return sum
+}
+
+// deltaSetIndexMap maps a glyph, or another item, to the delta set that varies its value. Items past the end of the
+// map use its last entry.
+type deltaSetIndexMap struct {
+	outer, inner []uint16
+}
+
+func parseDeltaSetIndexMap(b []byte) (*deltaSetIndexMap, error) {
+	r := newReader(b)
+	format := r.U8()
+	entryFormat := r.U8()
+	var count int
+	switch format {
+	case 0:
+		count = int(r.U16())
+	case 1:
+		count = int(r.U32())
+	default:
+		return nil, ErrInvalidFont
+	}
+
+	entrySize := int(entryFormat>>4&3) + 1
+	innerBits := uint(entryFormat&0xF) + 1
+	entries := r.Bytes(entrySize * count)
+	if r.Err() != nil || count == 0 {
+		return nil, ErrInvalidFont
+	}
+
+	m := &deltaSetIndexMap{outer: make([]uint16, count), inner: make([]uint16, count)}
+	for i := range m.outer {
+		var entry uint32
+		for _, b := range entries[entrySize*i : entrySize*(i+1)] {
+			entry = entry<<8 | uint32(b)
+		}
+		m.outer[i], m.inner[i] = uint16(entry>>innerBits), uint16(entry&(1<<innerBits-1))
+	}
+	return m, nil
+}
+
+func (m *deltaSetIndexMap) lookup(i int) (outer, inner int) {
+	if i >= len(m.outer) {
+		i = len(m.outer) - 1
+	}
+	return int(m.outer[i]), int(m.inner[i])
+}
@@ -0,0 +1,136 @@
+package ttf
+
+import (
+	"math"
+
+	"golang.org/x/image/font/sfnt"
+)
+
+// hmtxTable holds the advance width of each glyph. Glyphs past the last horizontal metric share its advance, which is
+// common in monospaced fonts.
+type hmtxTable struct {
+	advances []uint16
+}
+
+func parseHmtx(hhea, hmtx []byte, numGlyphs int) (*hmtxTable, error) {
+	if hhea == nil || hmtx == nil {
+		return nil, nil
+	}
+	if len(hhea) < 36 {
+		return nil, ErrInvalidFont
+	}
+	numHMetrics := int(u16(hhea[34:]))
+	if numHMetrics == 0 || numHMetrics > numGlyphs || len(hmtx) < 4*numHMetrics {
+		return nil, ErrInvalidFont
+	}
+
+	h := &hmtxTable{advances: make([]uint16, numHMetrics)}
+	for i := range h.advances {
+		h.advances[i] = u16(hmtx[4*i:])
+	}
+	return h, nil
+}
+
+// hvarTable holds the variations of each glyph's advance width. Without a mapping, the delta set of glyph i is item i
+// of the store's first data.
+type hvarTable struct {
+	store      *itemVariationStore
+	advanceMap *deltaSetIndexMap
+}
+
+func parseHvar(hvar []byte, axisCount int) (*hvarTable, error) {
+	if hvar == nil {
+		return nil, nil
+	}
+
+	r := newReader(hvar)
+	r.Skip(4) // version
+	storeOffset := r.U32()
+	advanceMapOffset := r.U32()
+	if r.Err() != nil || int(storeOffset) >= len(hvar) || int(advanceMapOffset) >= len(hvar) {
+		return nil, ErrInvalidFont
+	}
+
+	h := &hvarTable{}
+	var err error
+	if h.store, err = parseItemVariationStore(hvar[storeOffset:], axisCount); err != nil {
+		return nil, err
+	}
+	if advanceMapOffset != 0 {
+		if h.advanceMap, err = parseDeltaSetIndexMap(hvar[advanceMapOffset:]); err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
+
+// GlyphAdvance returns the advance width of glyph x in font units, rounded to a whole unit, at the instance selected
+// by SetVariation. The variation comes from the HVAR table if the font has one, and otherwise from the movement of the
+// glyph's phantom points in gvar.
+func (f *Font) GlyphAdvance(x sfnt.GlyphIndex) (int32, error) {
+	if int(x) >= f.NumGlyphs {
+		return 0, ErrGlyphOutOfRange
+	}
+	if f.hmtx == nil {
+		return 0, ErrMissingTable
+	}
+
+	i := int(x)
+	if i >= len(f.hmtx.advances) {
+		i = len(f.hmtx.advances) - 1
+	}
+	advance := float32(f.hmtx.advances[i])
+
+	switch {
+	case f.coords == nil:
+	case f.hvar != nil:
+		outer, inner := 0, int(x)
+		if f.hvar.advanceMap != nil {
+			outer, inner = f.hvar.advanceMap.lookup(int(x))
+		}
+		advance += f.hvar.store.delta(outer, inner, f.coords)
+	case f.gvar != nil:
+		n, err := f.pointCount(int(x))
+		if err != nil {
+			return 0, err
+		}
+		deltas, err := f.gvar.glyphDeltas(int(x), f.coords, nil, nil, n+phantomPointsPerGlyph)
+		if err != nil {
+			return 0, err
+		}
+		if deltas != nil {
+			// The first two phantom points are at the glyph's origin and advance
+			advance += deltas[n+1].x - deltas[n].x
+		}
+	}
+
+	return int32(math.Round(float64(advance))), nil
+}
+
+// pointCount returns the number of points gvar varies in glyph x, not counting its phantom points: its outline
+// points, or the number of components of a composite glyph.
+func (f *Font) pointCount(x int) (int, error) {
+	start, end := f.loca[x], f.loca[x+1]
+	if start == end {
+		return 0, nil
+	}
+	if start > end || int(end) > len(f.glyf) {
+		return 0, ErrInvalidFont
+	}
+
+	r := newReader(f.glyf[start:end])
+	numContours := int16(r.U16())
+	r.Skip(8)
+
+	if numContours >= 0 {
+		if numContours == 0 {
+			return 0, nil
+		}
+		r.Skip(2 * (int(numContours) - 1))
+		n := int(r.U16()) + 1
+		return n, r.Err()
+	}
+
+	components, err := readComponents(r)
+	return len(components), err
+}
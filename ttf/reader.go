@@ -0,0 +1,12 @@
+package ttf
+
+import "github.com/bbredesen/ttf-renderer/internal/otread"
+
+// reader is a bounds-checked cursor over big-endian table data, shared with packages shaping, colr and woff. Its
+// first out-of-range read sets its error to ErrInvalidFont, and every read after that returns zero, so callers can
+// decode a whole record and check its error once.
+type reader = otread.Reader
+
+func newReader(b []byte) *reader {
+	return otread.New(b, ErrInvalidFont)
+}
@@ -0,0 +1,242 @@
+package ttf
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Axis is a design axis of a variable font, from the fvar table, such as weight ('wght') or width ('wdth'). Values are
+// in the axis's own units, e.g. 100 to 900 for weight.
+type Axis struct {
+	Tag               string
+	Min, Default, Max float32
+	NameID            uint16
+	Hidden            bool
+	segments          []axisSegment
+}
+
+// axisSegment maps a normalized coordinate to another, as one point of the piecewise linear mapping in avar.
+type axisSegment struct {
+	from, to float32
+}
+
+// NamedInstance is a predefined instance of a variable font, such as "Bold Condensed". Coords holds a value for each
+// axis, in the order of Font.Axes. PostScriptNameID is zero if the font doesn't name the instance's PostScript name.
+type NamedInstance struct {
+	SubfamilyNameID  uint16
+	PostScriptNameID uint16
+	Coords           []float32
+}
+
+// parseFvar reads the axes and named instances of a variable font, and the avar mapping of each axis if there is one.
+func (f *Font) parseFvar() error {
+	fvar := f.tables["fvar"]
+	if fvar == nil {
+		return nil
+	}
+
+	r := newReader(fvar)
+	r.Skip(4) // version
+	axesOffset := int(r.U16())
+	r.Skip(2)
+	axisCount := int(r.U16())
+	axisSize := int(r.U16())
+	instanceCount := int(r.U16())
+	instanceSize := int(r.U16())
+	if r.Err() != nil || axisSize < 20 || instanceSize < 4+4*axisCount {
+		return ErrInvalidFont
+	}
+
+	f.Axes = make([]Axis, axisCount)
+	for i := range f.Axes {
+		r := newReader(fvar)
+		r.Seek(axesOffset + axisSize*i)
+		a := &f.Axes[i]
+		a.Tag = string(r.Bytes(4))
+		a.Min, a.Default, a.Max = fixed16(r.U32()), fixed16(r.U32()), fixed16(r.U32())
+		a.Hidden = r.U16()&1 != 0
+		a.NameID = r.U16()
+		if r.Err() != nil || a.Min > a.Default || a.Default > a.Max {
+			return ErrInvalidFont
+		}
+	}
+
+	// Instances follow the axes, and may end with a PostScript name ID
+	f.Instances = make([]NamedInstance, instanceCount)
+	for i := range f.Instances {
+		r := newReader(fvar)
+		r.Seek(axesOffset + axisSize*axisCount + instanceSize*i)
+		in := &f.Instances[i]
+		in.SubfamilyNameID = r.U16()
+		r.Skip(2) // flags
+		in.Coords = make([]float32, axisCount)
+		for k := range in.Coords {
+			in.Coords[k] = fixed16(r.U32())
+		}
+		if instanceSize >= 6+4*axisCount {
+			in.PostScriptNameID = r.U16()
+		}
+		if r.Err() != nil {
+			return ErrInvalidFont
+		}
+	}
+
+	return f.parseAvar()
+}
+
+func (f *Font) parseAvar() error {
+	avar := f.tables["avar"]
+	if avar == nil {
+		return nil
+	}
+
+	r := newReader(avar)
+	r.Skip(6) // version, reserved
+	if int(r.U16()) != len(f.Axes) {
+		return ErrInvalidFont
+	}
+	for i := range f.Axes {
+		n := int(r.U16())
+		segments := make([]axisSegment, n)
+		for k := range segments {
+			segments[k] = axisSegment{r.F2Dot14(), r.F2Dot14()}
+		}
+		f.Axes[i].segments = segments
+	}
+	return r.Err()
+}
+
+// normalize maps a value on the axis to the range -1 to 1, where 0 is the default, applying the avar mapping. Like the
+// values stored in the font, the result has the precision of an F2Dot14.
+func (a *Axis) normalize(v float32) float32 {
+	v = clamp32(v, a.Min, a.Max)
+
+	var n float32
+	switch {
+	case v < a.Default:
+		n = (v - a.Default) / (a.Default - a.Min)
+	case v > a.Default:
+		n = (v - a.Default) / (a.Max - a.Default)
+	}
+
+	// The avar segments are sorted, and must map -1, 0 and 1 to themselves for the mapping to be used
+	for k := 1; k < len(a.segments); k++ {
+		s0, s1 := a.segments[k-1], a.segments[k]
+		if n <= s1.from {
+			if s1.from > s0.from {
+				n = s0.to + (s1.to-s0.to)*(n-s0.from)/(s1.from-s0.from)
+			} else {
+				n = s1.to
+			}
+			break
+		}
+	}
+
+	return float32(math.Round(float64(n)*(1<<14))) / (1 << 14)
+}
+
+// SetVariation selects the instance of a variable font that glyphs are loaded from, and advances measured in. values
+// maps axis tags to values in each axis's own units; axes that aren't set take their default. Values outside an axis's
+// range are clamped to it. Passing nil, or calling it on a font with no axes, selects the default instance.
+func (f *Font) SetVariation(values map[string]float32) error {
+	for tag := range values {
+		found := false
+		for _, a := range f.Axes {
+			found = found || a.Tag == tag
+		}
+		if !found {
+			return fmt.Errorf("ttf: font has no %q axis", tag)
+		}
+	}
+
+	f.coords = nil
+	for i, a := range f.Axes {
+		v, ok := values[a.Tag]
+		if !ok {
+			continue
+		}
+		if n := a.normalize(v); n != 0 {
+			if f.coords == nil {
+				f.coords = make([]float32, len(f.Axes))
+			}
+			f.coords[i] = n
+		}
+	}
+	return nil
+}
+
+// IsDefaultInstance reports whether the font is at the default instance, where outlines and advances are those stored
+// in the glyf and hmtx tables.
+func (f *Font) IsDefaultInstance() bool {
+	return f.coords == nil
+}
+
+// ParseVariation parses a comma separated list of axis values, such as "wght=700,wdth=85", as passed to
+// SetVariation.
+func ParseVariation(s string) (map[string]float32, error) {
+	values := make(map[string]float32)
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		tag, value, ok := strings.Cut(field, "=")
+		if !ok || len(tag) == 0 || len(tag) > 4 {
+			return nil, fmt.Errorf("ttf: invalid axis value %q; want tag=value", field)
+		}
+		v, err := strconv.ParseFloat(value, 32)
+		if err != nil {
+			return nil, fmt.Errorf("ttf: invalid axis value %q: %w", field, err)
+		}
+		// Tags shorter than four characters are padded with spaces
+		values[(tag + "   ")[:4]] = float32(v)
+	}
+	return values, nil
+}
+
+// tupleScalar returns how much of a variation applies at coords: 1 at its peak, falling to 0 at the edges of its
+// region. Without an explicit region, it spans from 0 to the peak on each axis.
+func tupleScalar(coords, peak, start, end []float32) float32 {
+	scalar := float32(1)
+	for i, p := range peak {
+		if p == 0 {
+			continue
+		}
+		v := coords[i]
+		if v == p {
+			continue
+		}
+
+		if start != nil {
+			s, e := start[i], end[i]
+			if s > p || p > e || (s < 0 && e > 0) {
+				continue
+			}
+			if v < s || v > e {
+				return 0
+			}
+			if v < p {
+				scalar *= (v - s) / (p - s)
+			} else {
+				scalar *= (e - v) / (e - p)
+			}
+			continue
+		}
+
+		if v == 0 || (v < 0) != (p < 0) || (p > 0 && v > p) || (p < 0 && v < p) {
+			return 0
+		}
+		scalar *= v / p
+	}
+	return scalar
+}
+
+func fixed16(v uint32) float32 {
+	return float32(int32(v)) / (1 << 16)
+}
+
+func clamp32(v, lo, hi float32) float32 {
+	return max32(lo, min32(v, hi))
+}
@@ -0,0 +1,236 @@
+package ttf
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bbredesen/ttf-renderer/internal/otbuild"
+)
+
+// Glyphs of Go-Regular used by the tests. 'o' is a simple glyph with two contours. Go-Regular has no composite
+// glyphs, so variableFont replaces 'é' with a composite of 'l' and 'o'.
+const (
+	glyphL         = 79
+	glyphO         = 82
+	glyphComposite = 171
+)
+
+// variableFont adds a weight axis from 100 to 900 to Go-Regular, with a named instance at 700 and an avar mapping that
+// puts 650 a quarter of the way to the heaviest weight. At wght=900, gvar moves the outer contour of 'o' right by 20
+// units and widens it by 100, and moves the 'o' of the composite glyph right by 30. With hvar, HVAR widens 'o' by 60
+// instead.
+func variableFont(t *testing.T, hvar bool) *Font {
+	t.Helper()
+	f, tables := loadFont(t, "Go-Regular.ttf")
+
+	// The composite places 'o' 600 units to the right of 'l'
+	replaceGlyphs(f, tables, map[int][]byte{
+		glyphComposite: otbuild.Table{
+			-1, 0, 0, 0, 0,
+			argsAreWords | argsAreXYValues | moreComponents, glyphL, 0, 0,
+			argsAreWords | argsAreXYValues, glyphO, 600, 0,
+		}.Bytes(),
+	})
+
+	tables["fvar"] = otbuild.Table{
+		uint32(0x00010000), 16, 2, 1, 20, 1, 10,
+		"wght", otbuild.Fixed(100), otbuild.Fixed(400), otbuild.Fixed(900), 0, 256,
+		257, 0, otbuild.Fixed(700), 258,
+	}.Bytes()
+
+	tables["avar"] = otbuild.Table{
+		uint32(0x00010000), 0, 1,
+		4,
+		otbuild.F2Dot14(-1), otbuild.F2Dot14(-1), 0, 0,
+		otbuild.F2Dot14(0.5), otbuild.F2Dot14(0.25), otbuild.F2Dot14(1), otbuild.F2Dot14(1),
+	}.Bytes()
+
+	// 'o' moves two points of its outer contour, which carries the rest of the contour with them, and its second
+	// phantom point. Every point of the composite is listed, one per component and then the phantom points.
+	n, err := f.pointCount(glyphO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := []byte{3, 2, 0, 1, byte(n), 2, 20, 20, 100, 0x82}
+	composite := []byte{5, 0, 30, 0, 0, 0, 0, 0x85}
+	variations := map[int][]byte{
+		glyphO:         append(otbuild.Table{1, 8, len(o), privatePointNumbers}.Bytes(), o...),
+		glyphComposite: append(otbuild.Table{1, 8, len(composite), 0}.Bytes(), composite...),
+	}
+
+	offsets := make(otbuild.Table, f.NumGlyphs+1)
+	var glyphData []byte
+	for i := range offsets {
+		offsets[i] = uint32(len(glyphData))
+		glyphData = append(glyphData, variations[i]...)
+	}
+	sharedTuples := otbuild.Table{otbuild.F2Dot14(1)}.Bytes()
+	headerSize := 20 + 4*len(offsets)
+	tables["gvar"] = otbuild.Table{
+		uint32(0x00010000), 1, 1, uint32(headerSize), f.NumGlyphs, 1, uint32(headerSize + len(sharedTuples)),
+		offsets.Bytes(), sharedTuples, glyphData,
+	}.Bytes()
+
+	if hvar {
+		// One region peaking at wght=900, and a map that sends 'o' to the second delta set and every other glyph to
+		// the first
+		advanceMap := make([]byte, glyphO+2)
+		advanceMap[glyphO] = 1
+		tables["HVAR"] = otbuild.Table{
+			uint32(0x00010000), uint32(20), uint32(20 + 34), uint32(0), uint32(0),
+			1, uint32(12), 1, uint32(22),
+			1, 1, 0, otbuild.F2Dot14(1), otbuild.F2Dot14(1),
+			2, 1, 1, 0, 0, 60,
+			0, len(advanceMap), advanceMap,
+		}.Bytes()
+	}
+
+	v, err := Parse(otbuild.Font(tables))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestAxes(t *testing.T) {
+	f := variableFont(t, false)
+	if len(f.Axes) != 1 || len(f.Instances) != 1 {
+		t.Fatalf("got %d axes and %d instances, want 1 of each", len(f.Axes), len(f.Instances))
+	}
+	a := f.Axes[0]
+	if a.Tag != "wght" || a.Min != 100 || a.Default != 400 || a.Max != 900 || a.NameID != 256 {
+		t.Errorf("got axis %+v", a)
+	}
+	in := f.Instances[0]
+	if in.SubfamilyNameID != 257 || in.PostScriptNameID != 258 || !reflect.DeepEqual(in.Coords, []float32{700}) {
+		t.Errorf("got instance %+v", in)
+	}
+
+	for _, test := range []struct {
+		value, want float32
+	}{
+		{100, -1}, {250, -0.5}, {400, 0}, {650, 0.25}, {700, 6554.0 / (1 << 14)}, {900, 1}, {1000, 1}, {0, -1},
+	} {
+		if got := a.normalize(test.value); got != test.want {
+			t.Errorf("normalize(%v) = %v, want %v", test.value, got, test.want)
+		}
+	}
+
+	if err := f.SetVariation(map[string]float32{"wdth": 85}); err == nil {
+		t.Error("set a variation on an axis the font doesn't have")
+	}
+	if err := f.SetVariation(map[string]float32{"wght": 400}); err != nil || !f.IsDefaultInstance() {
+		t.Errorf("wght=400 isn't the default instance: %v", err)
+	}
+}
+
+func TestGlyphVariations(t *testing.T) {
+	f := variableFont(t, false)
+	defaultO, err := f.LoadGlyph(glyphO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaultComposite, err := f.LoadGlyph(glyphComposite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaultAdvance, err := f.GlyphAdvance(glyphO)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// At wght=650 the variation applies by a quarter, after avar; below the default it doesn't apply at all
+	for _, test := range []struct {
+		weight float32
+		scale  float32
+	}{
+		{900, 1}, {650, 0.25}, {400, 0}, {100, 0},
+	} {
+		if err := f.SetVariation(map[string]float32{"wght": test.weight}); err != nil {
+			t.Fatal(err)
+		}
+
+		o, err := f.LoadGlyph(glyphO)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, p := range o.Contours[0] {
+			if q := defaultO.Contours[0][i]; p.X != q.X+20*test.scale || p.Y != q.Y {
+				t.Errorf("wght=%v: outer point %d of 'o' is at %v, want %v moved by %v", test.weight, i, p, q, 20*test.scale)
+				break
+			}
+		}
+		if !reflect.DeepEqual(o.Contours[1], defaultO.Contours[1]) {
+			t.Errorf("wght=%v: inner contour of 'o' moved", test.weight)
+		}
+
+		advance, err := f.GlyphAdvance(glyphO)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := defaultAdvance + int32(100*test.scale); advance != want {
+			t.Errorf("wght=%v: advance of 'o' is %d, want %d", test.weight, advance, want)
+		}
+
+		composite, err := f.LoadGlyph(glyphComposite)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(composite.Contours[0], defaultComposite.Contours[0]) {
+			t.Errorf("wght=%v: 'l' of the composite moved", test.weight)
+		}
+		// The component 'o' varies too, so its outer contour moves by both
+		for k, dx := range []float32{50 * test.scale, 30 * test.scale} {
+			for i, p := range composite.Contours[k+1] {
+				if q := defaultComposite.Contours[k+1][i]; p.X != q.X+dx || p.Y != q.Y {
+					t.Errorf("wght=%v: point %d of contour %d of the composite is at %v, want %v moved by %v", test.weight,
+						i, k+1, p, q, dx)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestHVAR(t *testing.T) {
+	f := variableFont(t, true)
+	defaultAdvance, err := f.GlyphAdvance(glyphO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaultComposite, err := f.GlyphAdvance(glyphComposite)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := f.SetVariation(map[string]float32{"wght": 900}); err != nil {
+		t.Fatal(err)
+	}
+	// HVAR takes precedence over the phantom points in gvar
+	if advance, _ := f.GlyphAdvance(glyphO); advance != defaultAdvance+60 {
+		t.Errorf("advance of 'o' is %d, want %d", advance, defaultAdvance+60)
+	}
+	if advance, _ := f.GlyphAdvance(glyphComposite); advance != defaultComposite {
+		t.Errorf("advance of the composite is %d, want %d", advance, defaultComposite)
+	}
+}
+
+func TestParseVariation(t *testing.T) {
+	got, err := ParseVariation("wght=700, wdth=85,opsz=12.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]float32{"wght": 700, "wdth": 85, "opsz": 12.5}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got, _ := ParseVariation("ab=1"); got["ab  "] != 1 {
+		t.Errorf("short tag isn't padded: %v", got)
+	}
+	for _, s := range []string{"wght", "wght=bold", "toolong=1", "=1"} {
+		if _, err := ParseVariation(s); err == nil {
+			t.Errorf("parsed %q", s)
+		}
+	}
+}
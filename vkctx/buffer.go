@@ -0,0 +1,52 @@
+package vkctx
+
+import (
+	"github.com/bbredesen/go-vk"
+)
+
+// CopyBuffer copies size bytes from the start of srcBuffer to the start of dstBuffer, and waits for the copy to finish.
+func (app *Context) CopyBuffer(srcBuffer, dstBuffer vk.Buffer, size vk.DeviceSize) {
+	cbuf := app.BeginOneTimeCommands()
+
+	region := vk.BufferCopy{
+		SrcOffset: 0,
+		DstOffset: 0,
+		Size:      size,
+	}
+
+	vk.CmdCopyBuffer(cbuf, srcBuffer, dstBuffer, []vk.BufferCopy{region})
+
+	app.EndOneTimeCommands(cbuf)
+}
+
+// CreateBuffer creates a buffer and allocates and binds memory for it, with the requested properties.
+func (app *Context) CreateBuffer(usage vk.BufferUsageFlags, size vk.DeviceSize, memProps vk.MemoryPropertyFlags) (buffer vk.Buffer, memory vk.DeviceMemory) {
+
+	bufferCI := vk.BufferCreateInfo{
+		Size:        size,
+		Usage:       usage,
+		SharingMode: vk.SHARING_MODE_EXCLUSIVE,
+	}
+
+	var r vk.Result
+
+	if r, buffer = vk.CreateBuffer(app.Device, &bufferCI, nil); r != vk.SUCCESS {
+		panic("Could not create buffer: " + r.String())
+	}
+
+	memReq := vk.GetBufferMemoryRequirements(app.Device, buffer)
+
+	memAllocInfo := vk.MemoryAllocateInfo{
+		AllocationSize:  memReq.Size,
+		MemoryTypeIndex: uint32(app.FindMemoryType(memReq.MemoryTypeBits, memProps)), //vk.MEMORY_PROPERTY_HOST_VISIBLE_BIT|vk.MEMORY_PROPERTY_HOST_COHERENT_BIT)),
+	}
+
+	if r, memory = vk.AllocateMemory(app.Device, &memAllocInfo, nil); r != vk.SUCCESS {
+		panic("Could not allocate memory for buffer: " + r.String())
+	}
+	if r := vk.BindBufferMemory(app.Device, buffer, memory, 0); r != vk.SUCCESS {
+		panic("Could not bind memory for buffer: " + r.String())
+	}
+
+	return
+}
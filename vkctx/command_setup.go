@@ -19,6 +19,10 @@ func (ctx *Context) createCommandPool() {
 	ctx.CommandPool = commandPool
 
 	// 2) Allocate primary command buffers, one for each swapchain image, from the pool
+	ctx.allocateCommandBuffers()
+}
+
+func (ctx *Context) allocateCommandBuffers() {
 	allocInfo := vk.CommandBufferAllocateInfo{
 		CommandPool:        ctx.CommandPool,
 		Level:              vk.COMMAND_BUFFER_LEVEL_PRIMARY,
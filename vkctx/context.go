@@ -2,7 +2,7 @@ package vkctx
 
 import (
 	"github.com/bbredesen/go-vk"
-	"golang.org/x/sys/windows"
+	"github.com/bbredesen/ttf-renderer/shared"
 )
 
 type Context struct {
@@ -14,6 +14,10 @@ type Context struct {
 	PhysicalDevice vk.PhysicalDevice
 	Device         vk.Device
 
+	// StencilFormat is the format of stencil attachments on the physical device, which may have a depth component too;
+	// see CreateStencilImage
+	StencilFormat vk.Format
+
 	GraphicsQueueFamilyIndex, PresentQueueFamilyIndex uint32
 	GraphicsQueue, PresentQueue                       vk.Queue
 
@@ -32,12 +36,19 @@ type Context struct {
 	// Sync objects
 	ImageAvailableSemaphore, RenderFinishedSemaphore vk.Semaphore
 	InFlightFence                                    vk.Fence
+
+	// Headless is set by InitializeHeadless. There is no surface or swapchain; the single "swapchain" image is an
+	// offscreen color image that can be read back with ReadOffscreenImage.
+	Headless        bool
+	offscreenMemory vk.DeviceMemory
 }
 
-func (ctx *Context) Initialize(hInstance windows.Handle, hWnd windows.HWND) {
+// Initialize creates the instance, a surface for window, the device and the swapchain. EnableInstanceExtensions must
+// include the window's required instance extensions.
+func (ctx *Context) Initialize(window shared.Window) {
 
 	ctx.createInstance()
-	ctx.createSurface(hInstance, hWnd)
+	ctx.Surface = window.CreateSurface(ctx.Instance)
 
 	ctx.selectPhysicalDevice()
 	ctx.createLogicalDevice()
@@ -56,13 +67,46 @@ func (ctx *Context) Teardown() {
 	ctx.destroySyncObjects()
 	ctx.destroyCommandPool()
 
-	ctx.cleanupSwapchain()
+	if ctx.Headless {
+		ctx.destroyOffscreenTarget()
+	} else {
+		ctx.cleanupSwapchain()
+	}
 
 	vk.DestroyDevice(ctx.Device, nil)
-	vk.DestroySurfaceKHR(ctx.Instance, ctx.Surface, nil)
+	if !ctx.Headless {
+		vk.DestroySurfaceKHR(ctx.Instance, ctx.Surface, nil)
+	}
 	vk.DestroyInstance(ctx.Instance, nil)
 }
 
+// HasApiLayer reports whether the named instance layer is installed, e.g. so that validation can be enabled only where
+// it is available.
+func HasApiLayer(name string) bool {
+	r, layers := vk.EnumerateInstanceLayerProperties()
+	if r != vk.SUCCESS {
+		panic("Could not enumerate instance layers: " + r.String())
+	}
+	for _, l := range layers {
+		if l.LayerName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateStencilImage creates a stencil attachment of the given extent in StencilFormat, with a view of every aspect of
+// the format, as attachments require.
+func (ctx *Context) CreateStencilImage(extent vk.Extent2D) (image vk.Image, imageMemory vk.DeviceMemory, view vk.ImageView) {
+	image, imageMemory = ctx.CreateImage(extent, ctx.StencilFormat, vk.IMAGE_TILING_OPTIMAL, vk.IMAGE_USAGE_DEPTH_STENCIL_ATTACHMENT_BIT, vk.MEMORY_PROPERTY_DEVICE_LOCAL_BIT)
+
+	aspect := vk.ImageAspectFlags(vk.IMAGE_ASPECT_STENCIL_BIT)
+	if ctx.StencilFormat != vk.FORMAT_S8_UINT {
+		aspect |= vk.ImageAspectFlags(vk.IMAGE_ASPECT_DEPTH_BIT)
+	}
+	return image, imageMemory, ctx.CreateImageView(image, ctx.StencilFormat, aspect)
+}
+
 func (ctx *Context) CreateImage(extent vk.Extent2D, format vk.Format, tiling vk.ImageTiling, usage vk.ImageUsageFlags, memProps vk.MemoryPropertyFlags) (image vk.Image, imageMemory vk.DeviceMemory) {
 
 	imageCI := vk.ImageCreateInfo{
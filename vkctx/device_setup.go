@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/bbredesen/go-vk"
-	"golang.org/x/sys/windows"
 )
 
 func (ctx *Context) createInstance() {
@@ -28,20 +27,6 @@ func (ctx *Context) createInstance() {
 	}
 }
 
-func (app *Context) createSurface(hInstance windows.Handle, hWnd windows.HWND) {
-	ci := vk.Win32SurfaceCreateInfoKHR{
-		Hinstance: hInstance,
-		Hwnd:      hWnd,
-	}
-
-	var r vk.Result
-	r, app.Surface = vk.CreateWin32SurfaceKHR(app.Instance, &ci, nil)
-	if r != vk.SUCCESS {
-		fmt.Printf("Could not create surface!\n")
-		panic(r)
-	}
-}
-
 func (app *Context) selectPhysicalDevice() {
 	r, devices := vk.EnumeratePhysicalDevices(app.Instance)
 	if r != vk.SUCCESS {
@@ -51,6 +36,7 @@ func (app *Context) selectPhysicalDevice() {
 	for _, dev := range devices {
 		if app.isDeviceSuitable(dev) {
 			app.PhysicalDevice = dev
+			app.StencilFormat = findStencilFormat(dev)
 			return
 		}
 	}
@@ -58,6 +44,21 @@ func (app *Context) selectPhysicalDevice() {
 	panic("Could not find a suitable physical device!")
 }
 
+// stencilFormats are the formats tried for stencil attachments, in order of preference. Only a stencil is needed, but
+// implementations don't have to support S8 on its own, so combined depth and stencil formats stand in for it, with the
+// depth left unused. Every implementation supports at least one of the last two.
+var stencilFormats = []vk.Format{vk.FORMAT_S8_UINT, vk.FORMAT_D24_UNORM_S8_UINT, vk.FORMAT_D32_SFLOAT_S8_UINT}
+
+func findStencilFormat(device vk.PhysicalDevice) vk.Format {
+	for _, format := range stencilFormats {
+		props := vk.GetPhysicalDeviceFormatProperties(device, format)
+		if props.OptimalTilingFeatures&vk.FormatFeatureFlags(vk.FORMAT_FEATURE_DEPTH_STENCIL_ATTACHMENT_BIT) != 0 {
+			return format
+		}
+	}
+	panic("Could not find a supported stencil attachment format!")
+}
+
 func (app *Context) isDeviceSuitable(device vk.PhysicalDevice) bool {
 	// props := vk.GetPhysicalDeviceProperties(device)
 
@@ -70,20 +71,20 @@ func (app *Context) isDeviceSuitable(device vk.PhysicalDevice) bool {
 
 	/* Suitability is:
 	1) Support for the queue families we want to use (graphics)
-	2) Support for the surface presentation extensions we want to use
+	2) Support for the surface presentation extensions we want to use (not needed when headless)
 	3) Support for swap chains // TODO
-	4) Support for sampler anisotropy
-	*/
 
-	features := vk.GetPhysicalDeviceFeatures(device)
+	Optional features, such as sampler anisotropy, are enabled where they are supported, so that software
+	implementations like lavapipe are suitable too.
+	*/
 
-	extSupport := app.checkDeviceExtensionSupport(device)
-	if !extSupport {
-		panic("extensions not supported!")
+	// A device without the extensions may be followed by one that has them
+	if !app.checkDeviceExtensionSupport(device) {
+		return false
 	}
 	inds := app.analyzeQueueFamilies(device)
 
-	return extSupport && inds.graphicsIndex.HasValue() && inds.presentIndex.HasValue() && features.SamplerAnisotropy
+	return inds.graphicsIndex.HasValue() && inds.presentIndex.HasValue()
 }
 
 func (app *Context) checkDeviceExtensionSupport(device vk.PhysicalDevice) bool {
@@ -120,7 +121,19 @@ func (app *Context) analyzeQueueFamilies(device vk.PhysicalDevice) queueFamIndic
 	for i, p := range qfp {
 		if (p.QueueFlags & vk.QUEUE_GRAPHICS_BIT) != 0 {
 			inds.graphicsIndex.Set(uint32(i))
+
+			if app.Headless {
+				// Nothing is presented, so the graphics queue stands in for the present queue
+				inds.presentIndex.Set(uint32(i))
+			}
 		}
+		if app.Headless {
+			if inds.isComplete() {
+				break
+			}
+			continue
+		}
+
 		r, surf := vk.GetPhysicalDeviceSurfaceSupportKHR(device, uint32(i), app.Surface)
 		if r != vk.SUCCESS {
 			panic(r)
@@ -165,9 +178,10 @@ func (app *Context) createLogicalDevice() {
 		}
 	}
 
+	supported := vk.GetPhysicalDeviceFeatures(app.PhysicalDevice)
 	deviceFeatures := vk.PhysicalDeviceFeatures{
-		SamplerAnisotropy: true,
-		FillModeNonSolid:  true,
+		SamplerAnisotropy: supported.SamplerAnisotropy,
+		FillModeNonSolid:  supported.FillModeNonSolid,
 	}
 
 	createInfo := vk.DeviceCreateInfo{
@@ -0,0 +1,118 @@
+package vkctx
+
+import (
+	"image"
+	"unsafe"
+
+	"github.com/bbredesen/go-vk"
+)
+
+// OffscreenImageFormat is the format of the color image rendered to in headless mode. It maps directly onto the byte
+// layout of image.RGBA.
+const OffscreenImageFormat = vk.FORMAT_R8G8B8A8_UNORM
+
+// InitializeHeadless is the equivalent of Initialize for machines without a display, such as CI runners using a
+// software implementation like lavapipe. No surface or swapchain is created. Instead, a single offscreen color image
+// of the requested extent stands in for the swapchain images, so framebuffers and command buffers can be created
+// exactly as they are for a window. Nothing is presented; call ReadOffscreenImage after rendering to get the result.
+//
+// The caller should not request any surface or swapchain extensions.
+func (ctx *Context) InitializeHeadless(extent vk.Extent2D) {
+	ctx.Headless = true
+
+	ctx.createInstance()
+
+	ctx.selectPhysicalDevice()
+	ctx.createLogicalDevice()
+
+	ctx.createOffscreenTarget(extent)
+
+	ctx.createCommandPool()
+	ctx.createSyncObjects()
+}
+
+func (ctx *Context) createOffscreenTarget(extent vk.Extent2D) {
+	img, memory := ctx.CreateImage(extent, OffscreenImageFormat, vk.IMAGE_TILING_OPTIMAL,
+		vk.IMAGE_USAGE_COLOR_ATTACHMENT_BIT|vk.IMAGE_USAGE_TRANSFER_SRC_BIT, vk.MEMORY_PROPERTY_DEVICE_LOCAL_BIT)
+
+	ctx.offscreenMemory = memory
+	ctx.SwapchainImages = []vk.Image{img}
+	ctx.SwapchainImageFormat = OffscreenImageFormat
+	ctx.SwapchainExtent = extent
+
+	ctx.createSwapchainImageViews()
+}
+
+func (ctx *Context) destroyOffscreenTarget() {
+	ctx.destroyImageViews()
+
+	vk.DestroyImage(ctx.Device, ctx.SwapchainImages[0], nil)
+	vk.FreeMemory(ctx.Device, ctx.offscreenMemory, nil)
+	ctx.SwapchainImages = nil
+}
+
+// ReadOffscreenImage copies the headless color image back to host memory. The render pass must leave the image in
+// IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL, and rendering must have been submitted to the graphics queue before calling this.
+// Blocks until the copy is complete.
+func (ctx *Context) ReadOffscreenImage() *image.RGBA {
+	if !ctx.Headless {
+		panic("ReadOffscreenImage requires a headless context")
+	}
+
+	extent := ctx.SwapchainExtent
+	size := vk.DeviceSize(extent.Width * extent.Height * 4)
+
+	buffer, memory := ctx.CreateBuffer(vk.BUFFER_USAGE_TRANSFER_DST_BIT, size, vk.MEMORY_PROPERTY_HOST_VISIBLE_BIT|vk.MEMORY_PROPERTY_HOST_COHERENT_BIT)
+	defer vk.FreeMemory(ctx.Device, memory, nil)
+	defer vk.DestroyBuffer(ctx.Device, buffer, nil)
+
+	cb := ctx.BeginOneTimeCommands()
+
+	// The render pass has already transitioned the image to TRANSFER_SRC, but its color writes still need to be made
+	// visible to the copy
+	vk.CmdPipelineBarrier(cb, vk.PIPELINE_STAGE_COLOR_ATTACHMENT_OUTPUT_BIT, vk.PIPELINE_STAGE_TRANSFER_BIT, 0, nil, nil,
+		[]vk.ImageMemoryBarrier{{
+			SrcAccessMask:       vk.ACCESS_COLOR_ATTACHMENT_WRITE_BIT,
+			DstAccessMask:       vk.ACCESS_TRANSFER_READ_BIT,
+			OldLayout:           vk.IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL,
+			NewLayout:           vk.IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL,
+			SrcQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+			DstQueueFamilyIndex: vk.QUEUE_FAMILY_IGNORED,
+			Image:               ctx.SwapchainImages[0],
+			SubresourceRange: vk.ImageSubresourceRange{
+				AspectMask: vk.IMAGE_ASPECT_COLOR_BIT,
+				LevelCount: 1,
+				LayerCount: 1,
+			},
+		}},
+	)
+
+	vk.CmdCopyImageToBuffer(cb, ctx.SwapchainImages[0], vk.IMAGE_LAYOUT_TRANSFER_SRC_OPTIMAL, buffer, []vk.BufferImageCopy{{
+		// Zero row length and image height mean the buffer is tightly packed
+		ImageSubresource: vk.ImageSubresourceLayers{
+			AspectMask: vk.IMAGE_ASPECT_COLOR_BIT,
+			LayerCount: 1,
+		},
+		ImageExtent: vk.Extent3D{Width: extent.Width, Height: extent.Height, Depth: 1},
+	}})
+
+	vk.CmdPipelineBarrier(cb, vk.PIPELINE_STAGE_TRANSFER_BIT, vk.PIPELINE_STAGE_HOST_BIT, 0,
+		[]vk.MemoryBarrier{{
+			SrcAccessMask: vk.ACCESS_TRANSFER_WRITE_BIT,
+			DstAccessMask: vk.ACCESS_HOST_READ_BIT,
+		}}, nil, nil,
+	)
+
+	ctx.EndOneTimeCommands(cb)
+
+	r, ptr := vk.MapMemory(ctx.Device, memory, 0, size, 0)
+	if r != vk.SUCCESS {
+		panic("Could not map readback buffer: " + r.String())
+	}
+	defer vk.UnmapMemory(ctx.Device, memory)
+
+	img := image.NewRGBA(image.Rect(0, 0, int(extent.Width), int(extent.Height)))
+	copy(img.Pix, unsafe.Slice((*byte)(unsafe.Pointer(ptr)), size))
+
+	return img
+}
@@ -183,7 +183,9 @@ func (app *Context) cleanupSwapchain() {
 	vk.DestroySwapchainKHR(app.Device, app.Swapchain, nil)
 }
 
-func (app *Context) recreateSwapchain() {
+// RecreateSwapchain rebuilds the swapchain and its image views, e.g. after the window has been resized. Framebuffers
+// reference the swapchain image views, so the caller is responsible for destroying them first and rebuilding them after.
+func (app *Context) RecreateSwapchain() {
 	if r := vk.DeviceWaitIdle(app.Device); r != vk.SUCCESS {
 		panic(r)
 	}
@@ -192,6 +194,13 @@ func (app *Context) recreateSwapchain() {
 
 	app.createSwapchain()
 	app.createSwapchainImageViews()
+
+	// One primary command buffer is recorded per swapchain image, and the new swapchain may not have the same number
+	// of images.
+	if len(app.CommandBuffers) != len(app.SwapchainImages) {
+		vk.FreeCommandBuffers(app.Device, app.CommandPool, app.CommandBuffers)
+		app.allocateCommandBuffers()
+	}
 }
 
 func (app *Context) createImageView(image vk.Image, format vk.Format, aspectMask vk.ImageAspectFlags) vk.ImageView {
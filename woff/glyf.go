@@ -0,0 +1,376 @@
+package woff
+
+import "encoding/binary"
+
+// Flags of a simple glyph's points, as written to the reconstructed glyf table
+const (
+	flagOnCurve       = 0x01
+	flagXShort        = 0x02
+	flagYShort        = 0x04
+	flagXSameOrPos    = 0x10
+	flagYSameOrPos    = 0x20
+	flagOverlapSimple = 0x40
+)
+
+// Flags of a composite glyph's components
+const (
+	argsAreWords       = 0x0001
+	weHaveAScale       = 0x0008
+	moreComponents     = 0x0020
+	weHaveAnXAndYScale = 0x0040
+	weHaveATwoByTwo    = 0x0080
+	weHaveInstructions = 0x0100
+)
+
+// reconstructedGlyf is a glyf table rebuilt from its WOFF2 transform, with its loca table, and the xMin of every
+// glyph's bounding box, which a transformed hmtx table leaves out of its left side bearings.
+type reconstructedGlyf struct {
+	glyf, loca []byte
+	xMins      []int16
+}
+
+// reconstructGlyf rebuilds the glyf and loca tables from a transformed glyf table. The transform splits the glyphs
+// into streams of like values, such as the number of points in each contour, the flags of each point, and the points'
+// coordinates, packed as variable-length triplets.
+func reconstructGlyf(data []byte) (*reconstructedGlyf, error) {
+	r := newReader(data)
+	r.U16() // reserved
+	optionFlags := r.U16()
+	numGlyphs := int(r.U16())
+	indexFormat := r.U16()
+
+	var sizes [7]uint32
+	for i := range sizes {
+		sizes[i] = r.U32()
+	}
+	streams := make([]*reader, len(sizes))
+	for i, size := range sizes {
+		streams[i] = newReader(r.Bytes(int(size)))
+	}
+	nContours, nPoints, flags, glyphs, composites, bboxes, instructions :=
+		streams[0], streams[1], streams[2], streams[3], streams[4], streams[5], streams[6]
+
+	// A bitmap of the glyphs whose bounding box is stored, rather than computed from their points, starts the bbox
+	// stream. The optional overlap bitmap, of glyphs whose contours overlap, follows the streams.
+	bboxBitmap := bboxes.Bytes(4 * ((numGlyphs + 31) / 32))
+	var overlapBitmap []byte
+	if optionFlags&1 != 0 {
+		overlapBitmap = r.Bytes((numGlyphs + 7) / 8)
+	}
+	if r.Err() != nil || bboxes.Err() != nil {
+		return nil, ErrInvalidFont
+	}
+	bitSet := func(bitmap []byte, i int) bool {
+		return bitmap != nil && bitmap[i>>3]&(0x80>>(i&7)) != 0
+	}
+
+	g := &reconstructedGlyf{xMins: make([]int16, numGlyphs)}
+	offsets := make([]uint32, numGlyphs+1)
+
+	var points []point
+	for i := 0; i < numGlyphs; i++ {
+		offsets[i] = uint32(len(g.glyf))
+		numContours := int16(nContours.U16())
+		hasBBox := bitSet(bboxBitmap, i)
+
+		var bbox [4]int16
+		if hasBBox {
+			for k := range bbox {
+				bbox[k] = int16(bboxes.U16())
+			}
+		}
+
+		switch {
+		case numContours == 0:
+			// An empty glyph has no bounding box, and takes up no space in glyf
+			if hasBBox {
+				return nil, ErrInvalidFont
+			}
+			continue
+
+		case numContours < 0:
+			// The components of a composite glyph are stored as they are in glyf, but it must have a bounding box
+			if !hasBBox {
+				return nil, ErrInvalidFont
+			}
+			components, haveInstructions := readComposite(composites)
+			g.glyf = appendGlyphHeader(g.glyf, numContours, bbox)
+			g.glyf = append(g.glyf, components...)
+			if haveInstructions {
+				g.glyf = appendInstructions(g.glyf, glyphs, instructions)
+			}
+
+		default:
+			endPoints := make([]uint16, numContours)
+			total := 0
+			for c := range endPoints {
+				total += int(u255(nPoints))
+				endPoints[c] = uint16(total - 1)
+			}
+			if nPoints.Err() != nil || total > 0xFFFF {
+				return nil, ErrInvalidFont
+			}
+
+			points = decodeTriplets(points[:0], flags, glyphs, total)
+			if !hasBBox {
+				bbox = pointBounds(points)
+			}
+
+			g.glyf = appendGlyphHeader(g.glyf, numContours, bbox)
+			for _, end := range endPoints {
+				g.glyf = binary.BigEndian.AppendUint16(g.glyf, end)
+			}
+			g.glyf = appendInstructions(g.glyf, glyphs, instructions)
+			g.glyf = appendPoints(g.glyf, points, bitSet(overlapBitmap, i))
+		}
+
+		g.xMins[i] = bbox[0]
+
+		// Glyphs are padded to four bytes, which keeps every offset even for the short loca format
+		for len(g.glyf)%4 != 0 {
+			g.glyf = append(g.glyf, 0)
+		}
+	}
+	offsets[numGlyphs] = uint32(len(g.glyf))
+
+	for _, s := range streams {
+		if s.Err() != nil {
+			return nil, ErrInvalidFont
+		}
+	}
+
+	switch indexFormat {
+	case 0:
+		if len(g.glyf) > 2*0xFFFF {
+			return nil, ErrInvalidFont
+		}
+		for _, offset := range offsets {
+			g.loca = binary.BigEndian.AppendUint16(g.loca, uint16(offset/2))
+		}
+	case 1:
+		for _, offset := range offsets {
+			g.loca = binary.BigEndian.AppendUint32(g.loca, offset)
+		}
+	default:
+		return nil, ErrInvalidFont
+	}
+
+	return g, nil
+}
+
+type point struct {
+	x, y    int32
+	onCurve bool
+}
+
+// decodeTriplets reads n points of a simple glyph. Each point's flag byte says whether it is on the curve, and how its
+// offset from the previous point is packed into the glyph stream: from one byte for a small move along one axis, up
+// to four for a move of up to 65535 units along both.
+func decodeTriplets(points []point, flags, glyphs *reader, n int) []point {
+	withSign := func(flag uint8, v int32) int32 {
+		if flag&1 != 0 {
+			return v
+		}
+		return -v
+	}
+
+	var x, y int32
+	for i := 0; i < n; i++ {
+		flag := flags.U8()
+		onCurve := flag&0x80 == 0
+		flag &= 0x7F
+
+		var dx, dy int32
+		switch {
+		case flag < 10:
+			b := int32(glyphs.U8())
+			dy = withSign(flag, int32(flag&14)<<7+b)
+		case flag < 20:
+			b := int32(glyphs.U8())
+			dx = withSign(flag, int32((flag-10)&14)<<7+b)
+		case flag < 84:
+			b0, b1 := int32(flag-20), int32(glyphs.U8())
+			dx = withSign(flag, 1+(b0&0x30)+b1>>4)
+			dy = withSign(flag>>1, 1+(b0&0x0C)<<2+b1&0x0F)
+		case flag < 120:
+			b0 := int32(flag - 84)
+			b1, b2 := int32(glyphs.U8()), int32(glyphs.U8())
+			dx = withSign(flag, 1+(b0/12)<<8+b1)
+			dy = withSign(flag>>1, 1+((b0%12)>>2)<<8+b2)
+		case flag < 124:
+			b1, b2, b3 := int32(glyphs.U8()), int32(glyphs.U8()), int32(glyphs.U8())
+			dx = withSign(flag, b1<<4+b2>>4)
+			dy = withSign(flag>>1, (b2&0x0F)<<8+b3)
+		default:
+			b1, b2, b3, b4 := int32(glyphs.U8()), int32(glyphs.U8()), int32(glyphs.U8()), int32(glyphs.U8())
+			dx = withSign(flag, b1<<8+b2)
+			dy = withSign(flag>>1, b3<<8+b4)
+		}
+
+		x, y = x+dx, y+dy
+		points = append(points, point{x, y, onCurve})
+	}
+	return points
+}
+
+func pointBounds(points []point) (bbox [4]int16) {
+	for i, p := range points {
+		x, y := int16(p.x), int16(p.y)
+		if i == 0 || x < bbox[0] {
+			bbox[0] = x
+		}
+		if i == 0 || y < bbox[1] {
+			bbox[1] = y
+		}
+		if i == 0 || x > bbox[2] {
+			bbox[2] = x
+		}
+		if i == 0 || y > bbox[3] {
+			bbox[3] = y
+		}
+	}
+	return bbox
+}
+
+// readComposite returns the components of a composite glyph from the composite stream, and whether it has
+// instructions. The size of each component depends on its flags.
+func readComposite(composites *reader) (components []byte, haveInstructions bool) {
+	start := composites.Offset()
+	for {
+		flags := composites.U16()
+		composites.U16() // glyph index
+
+		size := 2
+		if flags&argsAreWords != 0 {
+			size = 4
+		}
+		switch {
+		case flags&weHaveAScale != 0:
+			size += 2
+		case flags&weHaveAnXAndYScale != 0:
+			size += 4
+		case flags&weHaveATwoByTwo != 0:
+			size += 8
+		}
+		composites.Bytes(size)
+
+		haveInstructions = haveInstructions || flags&weHaveInstructions != 0
+		if composites.Err() != nil || flags&moreComponents == 0 {
+			break
+		}
+	}
+	if composites.Err() != nil {
+		return nil, false
+	}
+	return composites.Data()[start:composites.Offset()], haveInstructions
+}
+
+func appendGlyphHeader(glyf []byte, numContours int16, bbox [4]int16) []byte {
+	glyf = binary.BigEndian.AppendUint16(glyf, uint16(numContours))
+	for _, v := range bbox {
+		glyf = binary.BigEndian.AppendUint16(glyf, uint16(v))
+	}
+	return glyf
+}
+
+// appendInstructions appends a glyph's instructions, whose length is in the glyph stream and whose bytes are in the
+// instruction stream.
+func appendInstructions(glyf []byte, glyphs, instructions *reader) []byte {
+	n := u255(glyphs)
+	glyf = binary.BigEndian.AppendUint16(glyf, n)
+	return append(glyf, instructions.Bytes(int(n))...)
+}
+
+// appendPoints appends the flags, then the x and then y coordinates of a simple glyph's points, each coordinate as a
+// delta from the previous point in one byte where it fits.
+func appendPoints(glyf []byte, points []point, overlap bool) []byte {
+	var xs, ys []byte
+	var prevX, prevY int32
+	for i, p := range points {
+		var flag uint8
+		if p.onCurve {
+			flag |= flagOnCurve
+		}
+		if i == 0 && overlap {
+			flag |= flagOverlapSimple
+		}
+
+		dx, dy := p.x-prevX, p.y-prevY
+		prevX, prevY = p.x, p.y
+
+		switch {
+		case dx == 0:
+			flag |= flagXSameOrPos
+		case dx > -256 && dx < 256:
+			flag |= flagXShort
+			if dx > 0 {
+				flag |= flagXSameOrPos
+			} else {
+				dx = -dx
+			}
+			xs = append(xs, uint8(dx))
+		default:
+			xs = binary.BigEndian.AppendUint16(xs, uint16(int16(dx)))
+		}
+
+		switch {
+		case dy == 0:
+			flag |= flagYSameOrPos
+		case dy > -256 && dy < 256:
+			flag |= flagYShort
+			if dy > 0 {
+				flag |= flagYSameOrPos
+			} else {
+				dy = -dy
+			}
+			ys = append(ys, uint8(dy))
+		default:
+			ys = binary.BigEndian.AppendUint16(ys, uint16(int16(dy)))
+		}
+
+		glyf = append(glyf, flag)
+	}
+	glyf = append(glyf, xs...)
+	return append(glyf, ys...)
+}
+
+// reconstructHmtx rebuilds an hmtx table from its WOFF2 transform, which can leave out left side bearings that equal
+// the xMin of their glyph's bounding box.
+func reconstructHmtx(data []byte, numHMetrics int, xMins []int16) ([]byte, error) {
+	numGlyphs := len(xMins)
+	if numHMetrics < 1 || numHMetrics > numGlyphs {
+		return nil, ErrInvalidFont
+	}
+
+	r := newReader(data)
+	flags := r.U8()
+	if flags&0xFC != 0 || flags&3 == 0 {
+		return nil, ErrInvalidFont
+	}
+
+	advances := make([]uint16, numHMetrics)
+	for i := range advances {
+		advances[i] = r.U16()
+	}
+	lsbs := make([]int16, numGlyphs)
+	for i := range lsbs {
+		// Bit 0 leaves out the bearings of glyphs with an advance, and bit 1 those of the glyphs that share the last
+		if (i < numHMetrics && flags&1 != 0) || (i >= numHMetrics && flags&2 != 0) {
+			lsbs[i] = xMins[i]
+		} else {
+			lsbs[i] = int16(r.U16())
+		}
+	}
+	if r.Err() != nil {
+		return nil, r.Err()
+	}
+
+	hmtx := make([]byte, 0, 4*numHMetrics+2*(numGlyphs-numHMetrics))
+	for i, lsb := range lsbs {
+		if i < numHMetrics {
+			hmtx = binary.BigEndian.AppendUint16(hmtx, advances[i])
+		}
+		hmtx = binary.BigEndian.AppendUint16(hmtx, uint16(lsb))
+	}
+	return hmtx, nil
+}
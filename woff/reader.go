@@ -0,0 +1,53 @@
+package woff
+
+import "github.com/bbredesen/ttf-renderer/internal/otread"
+
+// reader is a bounds-checked cursor over big-endian data, shared with packages ttf, shaping and colr. Its first
+// out-of-range or malformed read sets its error to ErrInvalidFont.
+type reader = otread.Reader
+
+func newReader(b []byte) *reader {
+	return otread.New(b, ErrInvalidFont)
+}
+
+// base128 reads a UIntBase128: a big-endian number in up to five bytes of seven bits, where every byte but the last
+// has its high bit set.
+func base128(r *reader) uint32 {
+	var v uint32
+	for i := 0; i < 5; i++ {
+		b := r.U8()
+		// No leading zeros, and no overflow
+		if (i == 0 && b == 0x80) || v&0xFE000000 != 0 {
+			r.Fail()
+		}
+		if r.Err() != nil {
+			return 0
+		}
+		v = v<<7 | uint32(b&0x7F)
+		if b&0x80 == 0 {
+			return v
+		}
+	}
+	r.Fail()
+	return 0
+}
+
+// u255 reads a 255UInt16, which takes one byte for values below 253, and up to three for the rest.
+func u255(r *reader) uint16 {
+	const (
+		oneMoreByteCode1 = 255
+		oneMoreByteCode2 = 254
+		wordCode         = 253
+		lowestUCode      = 253
+	)
+	switch code := r.U8(); code {
+	case wordCode:
+		return r.U16()
+	case oneMoreByteCode1:
+		return uint16(r.U8()) + lowestUCode
+	case oneMoreByteCode2:
+		return uint16(r.U8()) + 2*lowestUCode
+	default:
+		return uint16(code)
+	}
+}
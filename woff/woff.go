@@ -0,0 +1,207 @@
+// Package woff decodes web fonts in the WOFF and WOFF2 formats into plain sfnt (TrueType or OpenType) font data, which
+// can then be parsed as if it had been read from a .ttf, .otf or .ttc file.
+//
+// WOFF compresses each table separately with zlib. WOFF2 compresses all of the tables together with Brotli, and
+// stores the glyf and loca tables, and optionally hmtx, in a transformed form that has to be reconstructed.
+package woff
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sort"
+)
+
+var (
+	ErrInvalidFont = errors.New("woff: invalid font data")
+	ErrNotWOFF     = errors.New("woff: not a WOFF or WOFF2 font")
+)
+
+const (
+	signatureWOFF  = 0x774F4646 // 'wOFF'
+	signatureWOFF2 = 0x774F4632 // 'wOF2'
+
+	flavorCollection = 0x74746366 // 'ttcf'
+)
+
+// IsWOFF reports whether src starts with the signature of a WOFF or WOFF2 font.
+func IsWOFF(src []byte) bool {
+	if len(src) < 4 {
+		return false
+	}
+	signature := u32(src)
+	return signature == signatureWOFF || signature == signatureWOFF2
+}
+
+// Decode returns the sfnt font data wrapped by the WOFF or WOFF2 font in src. A WOFF2 file holding a collection
+// decodes to a collection. It returns ErrNotWOFF if src is in neither format.
+func Decode(src []byte) ([]byte, error) {
+	if len(src) < 4 {
+		return nil, ErrNotWOFF
+	}
+	switch u32(src) {
+	case signatureWOFF:
+		return decodeWOFF(src)
+	case signatureWOFF2:
+		return decodeWOFF2(src)
+	}
+	return nil, ErrNotWOFF
+}
+
+// decodeWOFF decodes a WOFF 1.0 font. Each table is stored zlib-compressed, unless that wouldn't make it smaller.
+func decodeWOFF(src []byte) ([]byte, error) {
+	const headerSize, entrySize = 44, 20
+	if len(src) < headerSize {
+		return nil, ErrInvalidFont
+	}
+	flavor := u32(src[4:])
+	numTables := int(u16(src[12:]))
+	if flavor == flavorCollection || len(src) < headerSize+entrySize*numTables {
+		return nil, ErrInvalidFont
+	}
+
+	tables := make([]table, numTables)
+	for i := range tables {
+		entry := src[headerSize+entrySize*i:]
+		offset, compLength, origLength := u32(entry[4:]), u32(entry[8:]), u32(entry[12:])
+		if uint64(offset)+uint64(compLength) > uint64(len(src)) || compLength > origLength {
+			return nil, ErrInvalidFont
+		}
+		data := src[offset : offset+compLength]
+
+		if compLength < origLength {
+			zr, err := zlib.NewReader(bytes.NewReader(data))
+			if err != nil {
+				return nil, err
+			}
+			decompressed := make([]byte, origLength)
+			if _, err := io.ReadFull(zr, decompressed); err != nil {
+				return nil, err
+			}
+			data = decompressed
+		}
+
+		tables[i] = table{tag: u32(entry), data: data}
+	}
+
+	return buildSFNT([]face{{flavor: flavor, tables: allTables(numTables)}}, tables), nil
+}
+
+// table is a decoded table, and face is one font in the file: its sfnt version, and the indices of its tables in a list
+// shared by every face of a collection.
+type table struct {
+	tag  uint32
+	data []byte
+}
+
+type face struct {
+	flavor uint32
+	tables []int
+}
+
+func allTables(n int) []int {
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	return indices
+}
+
+// buildSFNT writes the table directory of each face, followed by every table, each padded to four bytes. More than
+// one face makes a collection, whose faces share the tables they have in common. A single font's head table gets the
+// checksum adjustment for the whole file.
+func buildSFNT(faces []face, tables []table) []byte {
+	var headerSize int
+	if len(faces) > 1 {
+		headerSize = 12 + 4*len(faces)
+	}
+	directorySize := 0
+	for _, f := range faces {
+		directorySize += 12 + 16*len(f.tables)
+	}
+
+	// Place the tables after the directories
+	offsets := make([]uint32, len(tables))
+	size := headerSize + directorySize
+	for i, t := range tables {
+		offsets[i] = uint32(size)
+		size += (len(t.data) + 3) &^ 3
+	}
+
+	out := make([]byte, size)
+	for i, t := range tables {
+		copy(out[offsets[i]:], t.data)
+	}
+
+	// A single font's checksum adjustment is computed with it set to zero
+	headIndex := -1
+	if len(faces) == 1 {
+		for _, i := range faces[0].tables {
+			if tables[i].tag == tagHead && len(tables[i].data) >= 12 {
+				headIndex = i
+				binary.BigEndian.PutUint32(out[offsets[i]+8:], 0)
+			}
+		}
+	}
+
+	if len(faces) > 1 {
+		binary.BigEndian.PutUint32(out, flavorCollection)
+		binary.BigEndian.PutUint32(out[4:], 0x00010000)
+		binary.BigEndian.PutUint32(out[8:], uint32(len(faces)))
+	}
+
+	directory := headerSize
+	for k, f := range faces {
+		if len(faces) > 1 {
+			binary.BigEndian.PutUint32(out[12+4*k:], uint32(directory))
+		}
+
+		// Table records are sorted by tag
+		indices := append([]int(nil), f.tables...)
+		sort.Slice(indices, func(a, b int) bool { return tables[indices[a]].tag < tables[indices[b]].tag })
+
+		numTables := len(indices)
+		entrySelector := 0
+		for 2<<entrySelector <= numTables {
+			entrySelector++
+		}
+		searchRange := 16 << entrySelector
+
+		d := out[directory:]
+		binary.BigEndian.PutUint32(d, f.flavor)
+		binary.BigEndian.PutUint16(d[4:], uint16(numTables))
+		binary.BigEndian.PutUint16(d[6:], uint16(searchRange))
+		binary.BigEndian.PutUint16(d[8:], uint16(entrySelector))
+		binary.BigEndian.PutUint16(d[10:], uint16(16*numTables-searchRange))
+
+		for j, i := range indices {
+			record := d[12+16*j:]
+			length := len(tables[i].data)
+			binary.BigEndian.PutUint32(record, tables[i].tag)
+			binary.BigEndian.PutUint32(record[4:], checksum(out[offsets[i]:int(offsets[i])+(length+3)&^3]))
+			binary.BigEndian.PutUint32(record[8:], offsets[i])
+			binary.BigEndian.PutUint32(record[12:], uint32(length))
+		}
+		directory += 12 + 16*numTables
+	}
+
+	if headIndex >= 0 {
+		binary.BigEndian.PutUint32(out[offsets[headIndex]+8:], 0xB1B0AFBA-checksum(out))
+	}
+
+	return out
+}
+
+// checksum returns the sum of b as big-endian 32-bit words. The length of b must be a multiple of four.
+func checksum(b []byte) uint32 {
+	var sum uint32
+	for i := 0; i+4 <= len(b); i += 4 {
+		sum += u32(b[i:])
+	}
+	return sum
+}
+
+func u16(b []byte) uint16 { return binary.BigEndian.Uint16(b) }
+func u32(b []byte) uint32 { return binary.BigEndian.Uint32(b) }
@@ -0,0 +1,179 @@
+package woff
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/andybalholm/brotli"
+)
+
+const (
+	tagGlyf = 0x676C7966 // 'glyf'
+	tagHead = 0x68656164 // 'head'
+	tagHhea = 0x68686561 // 'hhea'
+	tagHmtx = 0x686D7478 // 'hmtx'
+	tagLoca = 0x6C6F6361 // 'loca'
+)
+
+// knownTags are the tags a WOFF2 table directory entry can refer to by index, in place of spelling the tag out.
+var knownTags = [63]string{
+	"cmap", "head", "hhea", "hmtx", "maxp", "name", "OS/2", "post", "cvt ", "fpgm", "glyf", "loca", "prep", "CFF ",
+	"VORG", "EBDT", "EBLC", "gasp", "hdmx", "kern", "LTSH", "PCLT", "VDMX", "vhea", "vmtx", "BASE", "GDEF", "GPOS",
+	"GSUB", "EBSC", "JSTF", "MATH", "CBDT", "CBLC", "COLR", "CPAL", "SVG ", "sbix", "acnt", "avar", "bdat", "bloc",
+	"bsln", "cvar", "fdsc", "feat", "fmtx", "fvar", "gvar", "hsty", "just", "lcar", "mort", "morx", "opbd", "prop",
+	"trak", "Zapf", "Silf", "Glat", "Gloc", "Feat", "Sill",
+}
+
+// woff2Entry is a table directory entry. length is the number of bytes the table takes up in the decompressed
+// stream: its transformed length if transformed is set, and its original length otherwise.
+type woff2Entry struct {
+	tag         uint32
+	origLength  uint32
+	length      uint32
+	transformed bool
+}
+
+// decodeWOFF2 decodes a WOFF2 font or collection. Every table is read from a single Brotli stream, and the glyf, loca
+// and hmtx tables are reconstructed from their transformed forms.
+func decodeWOFF2(src []byte) ([]byte, error) {
+	r := newReader(src)
+	r.U32() // signature
+	flavor := r.U32()
+	r.U32() // length
+	numTables := int(r.U16())
+	r.U16() // reserved
+	r.U32() // totalSfntSize
+	totalCompressedSize := r.U32()
+	r.Bytes(24) // version, and the metadata and private blocks
+	if r.Err() != nil || numTables == 0 {
+		return nil, ErrInvalidFont
+	}
+
+	entries := make([]woff2Entry, numTables)
+	var streamSize uint64
+	for i := range entries {
+		e := &entries[i]
+		flags := r.U8()
+		if tagIndex := flags & 0x3F; tagIndex == 0x3F {
+			e.tag = r.U32()
+		} else {
+			e.tag = u32([]byte(knownTags[tagIndex]))
+		}
+		e.origLength = base128(r)
+
+		// The null transform is version 3 for glyf and loca, and 0 for every other table
+		version := flags >> 6
+		if e.tag == tagGlyf || e.tag == tagLoca {
+			e.transformed = version == 0
+		} else {
+			e.transformed = version != 0
+		}
+
+		e.length = e.origLength
+		if e.transformed {
+			e.length = base128(r)
+		}
+		streamSize += uint64(e.length)
+	}
+
+	faces := []face{{flavor: flavor, tables: allTables(numTables)}}
+	if flavor == flavorCollection {
+		faces = readCollectionDirectory(r, numTables)
+	}
+	if r.Err() != nil {
+		return nil, r.Err()
+	}
+
+	// The compressed stream follows the directories, and decompresses to every table in directory order
+	compressed := r.Bytes(int(totalCompressedSize))
+	if r.Err() != nil || streamSize > 1<<30 {
+		return nil, ErrInvalidFont
+	}
+	stream := make([]byte, streamSize)
+	if _, err := io.ReadFull(brotli.NewReader(bytes.NewReader(compressed)), stream); err != nil {
+		return nil, err
+	}
+
+	tables := make([]table, numTables)
+	for i, e := range entries {
+		tables[i] = table{tag: e.tag, data: stream[:e.length]}
+		stream = stream[e.length:]
+	}
+
+	// A transformed glyf table is reconstructed together with its loca table, and a transformed hmtx table needs the
+	// bounding boxes of the glyphs. In a collection, faces may share them.
+	xMins := make(map[int][]int16)
+	hmtxDone := make(map[int]bool)
+	for _, f := range faces {
+		glyf, loca, hmtx, hhea := -1, -1, -1, -1
+		for _, i := range f.tables {
+			switch tables[i].tag {
+			case tagGlyf:
+				glyf = i
+			case tagLoca:
+				loca = i
+			case tagHmtx:
+				hmtx = i
+			case tagHhea:
+				hhea = i
+			}
+		}
+
+		if glyf >= 0 && entries[glyf].transformed {
+			if loca < 0 || !entries[loca].transformed || entries[loca].length != 0 {
+				return nil, ErrInvalidFont
+			}
+			if xMins[glyf] == nil {
+				g, err := reconstructGlyf(tables[glyf].data)
+				if err != nil {
+					return nil, err
+				}
+				tables[glyf].data, tables[loca].data = g.glyf, g.loca
+				xMins[glyf] = g.xMins
+			}
+		} else if loca >= 0 && entries[loca].transformed {
+			return nil, ErrInvalidFont
+		}
+
+		if hmtx >= 0 && entries[hmtx].transformed && !hmtxDone[hmtx] {
+			if glyf < 0 || xMins[glyf] == nil || hhea < 0 || len(tables[hhea].data) < 36 {
+				return nil, ErrInvalidFont
+			}
+			numHMetrics := int(u16(tables[hhea].data[34:]))
+			data, err := reconstructHmtx(tables[hmtx].data, numHMetrics, xMins[glyf])
+			if err != nil {
+				return nil, err
+			}
+			if uint32(len(data)) != entries[hmtx].origLength {
+				return nil, ErrInvalidFont
+			}
+			tables[hmtx].data = data
+			hmtxDone[hmtx] = true
+		}
+	}
+
+	return buildSFNT(faces, tables), nil
+}
+
+// readCollectionDirectory reads the faces of a collection, each of which lists the tables it uses.
+func readCollectionDirectory(r *reader, numTables int) []face {
+	r.U32() // version
+	numFonts := int(u255(r))
+	if numFonts == 0 {
+		r.Fail()
+	}
+
+	faces := make([]face, 0, numFonts)
+	for k := 0; k < numFonts && r.Err() == nil; k++ {
+		n := int(u255(r))
+		f := face{flavor: r.U32(), tables: make([]int, n)}
+		for j := range f.tables {
+			f.tables[j] = int(u255(r))
+			if f.tables[j] >= numTables {
+				r.Fail()
+			}
+		}
+		faces = append(faces, f)
+	}
+	return faces
+}
@@ -0,0 +1,266 @@
+package woff
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/bbredesen/ttf-renderer/ttf"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+const fontDir = "../testdata/fonts"
+
+func readFont(t *testing.T, name string) []byte {
+	t.Helper()
+	src, err := os.ReadFile(filepath.Join(fontDir, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return src
+}
+
+// sortedTags returns the tags of tables in order, so that fonts can be encoded and compared deterministically.
+func sortedTags(tables map[string][]byte) []string {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// encodeWOFF wraps the tables of an sfnt font in a WOFF 1.0 file, compressing each table that gets smaller.
+func encodeWOFF(t *testing.T, src []byte) []byte {
+	tables, err := ttf.ReadTables(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tags := sortedTags(tables)
+
+	header := make([]byte, 44+20*len(tags))
+	binary.BigEndian.PutUint32(header, signatureWOFF)
+	binary.BigEndian.PutUint32(header[4:], u32(src))
+	binary.BigEndian.PutUint16(header[12:], uint16(len(tags)))
+
+	var data []byte
+	for i, tag := range tags {
+		table := tables[tag]
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		zw.Write(table)
+		zw.Close()
+		stored := table
+		if compressed.Len() < len(table) {
+			stored = compressed.Bytes()
+		}
+
+		entry := header[44+20*i:]
+		copy(entry, tag)
+		binary.BigEndian.PutUint32(entry[4:], uint32(len(header)+len(data)))
+		binary.BigEndian.PutUint32(entry[8:], uint32(len(stored)))
+		binary.BigEndian.PutUint32(entry[12:], uint32(len(table)))
+		data = append(data, stored...)
+		for len(data)%4 != 0 {
+			data = append(data, 0)
+		}
+	}
+	return append(header, data...)
+}
+
+// encodeWOFF2 wraps the tables of an sfnt font in a WOFF2 file without transforming any of them, naming known tables
+// by index and the rest by tag.
+func encodeWOFF2(t *testing.T, src []byte) []byte {
+	tables, err := ttf.ReadTables(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tags := sortedTags(tables)
+
+	appendBase128 := func(b []byte, v uint32) []byte {
+		var digits []byte
+		for {
+			digits = append([]byte{byte(v & 0x7F)}, digits...)
+			v >>= 7
+			if v == 0 {
+				break
+			}
+		}
+		for i := range digits[:len(digits)-1] {
+			digits[i] |= 0x80
+		}
+		return append(b, digits...)
+	}
+
+	var directory []byte
+	var stream bytes.Buffer
+	bw := brotli.NewWriter(&stream)
+	for _, tag := range tags {
+		flags := byte(0x3F)
+		for i, known := range knownTags {
+			if known == tag {
+				flags = byte(i)
+			}
+		}
+		if tag == "glyf" || tag == "loca" {
+			flags |= 3 << 6 // the null transform
+		}
+		directory = append(directory, flags)
+		if flags&0x3F == 0x3F {
+			directory = append(directory, tag...)
+		}
+		directory = appendBase128(directory, uint32(len(tables[tag])))
+		bw.Write(tables[tag])
+	}
+	bw.Close()
+
+	header := make([]byte, 48)
+	binary.BigEndian.PutUint32(header, signatureWOFF2)
+	binary.BigEndian.PutUint32(header[4:], u32(src))
+	binary.BigEndian.PutUint16(header[12:], uint16(len(tags)))
+	binary.BigEndian.PutUint32(header[20:], uint32(stream.Len()))
+
+	out := append(header, directory...)
+	return append(out, stream.Bytes()...)
+}
+
+// compareTables checks that the decoded font has the same tables as the original, apart from the checksum adjustment.
+func compareTables(t *testing.T, original, decoded []byte) {
+	t.Helper()
+	want, err := ttf.ReadTables(original)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ttf.ReadTables(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Errorf("got %d tables, want %d", len(got), len(want))
+	}
+	for tag, table := range want {
+		g := got[tag]
+		if tag == "head" && len(g) == len(table) {
+			g = append([]byte(nil), g...)
+			copy(g[8:12], table[8:12])
+		}
+		if !bytes.Equal(g, table) {
+			t.Errorf("table %q differs", tag)
+		}
+	}
+
+	if _, err := sfnt.Parse(decoded); err != nil {
+		t.Errorf("sfnt can't parse the decoded font: %v", err)
+	}
+	if sum := checksum(decoded); sum != 0xB1B0AFBA {
+		t.Errorf("checksum of the decoded font is %#x, want 0xB1B0AFBA", sum)
+	}
+}
+
+func TestWOFF(t *testing.T) {
+	for _, name := range []string{"Go-Regular.ttf", "CFFTest.otf"} {
+		src := readFont(t, name)
+		encoded := encodeWOFF(t, src)
+		if !IsWOFF(encoded) {
+			t.Fatalf("%s: IsWOFF is false for the encoded font", name)
+		}
+
+		decoded, err := Decode(encoded)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		compareTables(t, src, decoded)
+	}
+}
+
+func TestWOFF2NullTransform(t *testing.T) {
+	src := readFont(t, "Go-Regular.ttf")
+	decoded, err := Decode(encodeWOFF2(t, src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	compareTables(t, src, decoded)
+}
+
+// TestWOFF2 decodes a font whose glyf and loca tables are transformed, and checks that every glyph's reconstructed
+// outline lies within its bounding box and that metrics survive.
+func TestWOFF2(t *testing.T) {
+	decoded, err := Decode(readFont(t, "OpenSans-LightItalic.woff2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum := checksum(decoded); sum != 0xB1B0AFBA {
+		t.Errorf("checksum of the decoded font is %#x, want 0xB1B0AFBA", sum)
+	}
+
+	f, err := sfnt.Parse(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outlines, err := ttf.Parse(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if outlines.NumGlyphs != f.NumGlyphs() || outlines.NumGlyphs == 0 {
+		t.Fatalf("ttf found %d glyphs, sfnt %d", outlines.NumGlyphs, f.NumGlyphs())
+	}
+
+	var b sfnt.Buffer
+	unitsPerEm := fixed.I(int(f.UnitsPerEm()))
+	for i := 0; i < outlines.NumGlyphs; i++ {
+		glyph, err := outlines.LoadGlyph(sfnt.GlyphIndex(i))
+		if err != nil {
+			t.Fatalf("glyph %d: %v", i, err)
+		}
+		if len(glyph.Contours) == 0 {
+			continue
+		}
+
+		// GlyphBounds reads the box from the glyph's header, and Y points down
+		bounds, _, err := f.GlyphBounds(&b, sfnt.GlyphIndex(i), unitsPerEm, font.HintingNone)
+		if err != nil {
+			t.Fatalf("glyph %d: %v", i, err)
+		}
+		r := glyph.Bounds()
+		if fixed.I(int(r.XMin)) < bounds.Min.X || fixed.I(int(r.XMax)) > bounds.Max.X ||
+			fixed.I(int(-r.YMax)) < bounds.Min.Y || fixed.I(int(-r.YMin)) > bounds.Max.Y {
+			t.Errorf("glyph %d: outline %v is outside its bounding box %v", i, r, bounds)
+		}
+	}
+
+	// A few glyphs rasterized at a known size, as a check on the decoded coordinates
+	for _, r := range "Hgo" {
+		idx, err := f.GlyphIndex(&b, r)
+		if err != nil || idx == 0 {
+			t.Fatalf("no glyph for %q", r)
+		}
+		advance, err := f.GlyphAdvance(&b, idx, unitsPerEm, font.HintingNone)
+		if err != nil || advance <= 0 {
+			t.Errorf("%q: advance %v, %v", r, advance, err)
+		}
+	}
+}
+
+func TestNotWOFF(t *testing.T) {
+	src := readFont(t, "Go-Regular.ttf")
+	if IsWOFF(src) {
+		t.Error("IsWOFF is true for a TrueType font")
+	}
+	if _, err := Decode(src); !errors.Is(err, ErrNotWOFF) {
+		t.Errorf("got error %v, want ErrNotWOFF", err)
+	}
+
+	// A truncated file is invalid, rather than not WOFF
+	encoded := encodeWOFF(t, src)
+	if _, err := Decode(encoded[:100]); err == nil {
+		t.Error("decoded a truncated WOFF file")
+	}
+}